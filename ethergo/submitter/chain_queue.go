@@ -2,12 +2,14 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"sort"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/lmittmann/w3/module/eth"
@@ -76,6 +78,7 @@ func (t *txSubmitterImpl) chainPendingQueue(parentCtx context.Context, chainID *
 	// we need to figure out which ones are still valid, and which ones need to be bumped.
 	// once this is done, we'll be ready to submit them
 	// we're going to handle this by updating txes in place
+	broadcast := lowerNoncesBroadcast(currentNonce, txes)
 	for i := range txes {
 		tx := txes[i]
 
@@ -84,7 +87,7 @@ func (t *txSubmitterImpl) chainPendingQueue(parentCtx context.Context, chainID *
 			continue
 		}
 
-		cq.bumpTX(gCtx, tx)
+		cq.bumpTX(gCtx, tx, broadcast[i])
 	}
 	cq.updateOldTxStatuses(gCtx)
 
@@ -143,10 +146,16 @@ func (c *chainQueue) storeAndSubmit(ctx context.Context, calls []w3types.Caller,
 	wg.Wait()
 }
 
+// bumpTX bumps ogTx if its bump interval has elapsed and adds it to the reprocess queue.
+// lowerNoncesBroadcast is whether every lower nonce of the signer has been broadcast, see simulateAndQueue.
 // nolint: cyclop
-func (c *chainQueue) bumpTX(parentCtx context.Context, ogTx db.TX) {
+func (c *chainQueue) bumpTX(parentCtx context.Context, ogTx db.TX, lowerNoncesBroadcast bool) {
 	c.g.Go(func() (err error) {
 		if !c.isBumpIntervalElapsed(ogTx) {
+			// txes that haven't been broadcast yet are simulated before the first broadcast.
+			if ogTx.Status == db.Stored {
+				return c.simulateAndQueue(parentCtx, ogTx, ogTx, lowerNoncesBroadcast)
+			}
 			c.addToReprocessQueue(ogTx)
 			return nil
 		}
@@ -176,30 +185,28 @@ func (c *chainQueue) bumpTX(parentCtx context.Context, ogTx db.TX) {
 			return fmt.Errorf("could not set gas price: %w", err)
 		}
 
-		switch ogTx.Type() {
-		case types.LegacyTxType:
-			tx = types.NewTx(&types.LegacyTx{
-				Nonce:    tx.Nonce(),
-				GasPrice: transactor.GasPrice,
-				Gas:      transactor.GasLimit,
-				To:       tx.To(),
-				Value:    tx.Value(),
-				Data:     tx.Data(),
-			})
-		case types.DynamicFeeTxType:
-			tx = types.NewTx(&types.DynamicFeeTx{
-				ChainID:   tx.ChainId(),
-				Nonce:     tx.Nonce(),
-				GasTipCap: tx.GasTipCap(),
-				GasFeeCap: tx.GasFeeCap(),
-				Gas:       transactor.GasLimit,
-				To:        tx.To(),
-				Value:     tx.Value(),
-				Data:      tx.Data(),
-			})
-		default:
-			return fmt.Errorf("unknown tx type: %v", ogTx.Type())
+		tx, err = newBumpedTX(tx, transactor)
+		if err != nil {
+			return err
+		}
+
+		cappedTx, err := c.capTotalFee(ctx, c.client, c.chainID, transactor.From, tx)
+		if errors.Is(err, ErrMaxTotalFeeExceeded) {
+			// the bump would cost more than allowed, keep waiting on the current tx.
+			span.AddEvent("max total fee exceeded", trace.WithAttributes(attribute.String("error", err.Error())))
+			c.addToReprocessQueue(ogTx)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("could not cap total fee: %w", err)
 		}
+		if cappedTx != tx && isReplacementUnderpriced(ogTx.Transaction, cappedTx) {
+			// the capped bump would be rejected as underpriced, keep waiting on the current tx.
+			span.AddEvent("capped bump is underpriced")
+			c.addToReprocessQueue(ogTx)
+			return nil
+		}
+		tx = cappedTx
 
 		tx, err = transactor.Signer(transactor.From, tx)
 		if err != nil {
@@ -208,16 +215,42 @@ func (c *chainQueue) bumpTX(parentCtx context.Context, ogTx db.TX) {
 
 		span.AddEvent("add to reprocess queue", trace.WithAttributes(txToAttributes(tx, ogTx.UUID)...))
 
-		c.addToReprocessQueue(db.TX{
+		return c.simulateAndQueue(ctx, ogTx, db.TX{
 			UUID:        ogTx.UUID,
 			Transaction: tx,
 			Status:      db.Stored,
-		})
-
-		return nil
+		}, lowerNoncesBroadcast)
 	})
 }
 
+// newBumpedTX rebuilds tx with the gas limit and gas price of the transactor.
+func newBumpedTX(tx *types.Transaction, transactor *bind.TransactOpts) (*types.Transaction, error) {
+	switch tx.Type() {
+	case types.LegacyTxType:
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: transactor.GasPrice,
+			Gas:      transactor.GasLimit,
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		}), nil
+	case types.DynamicFeeTxType:
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:   tx.ChainId(),
+			Nonce:     tx.Nonce(),
+			GasTipCap: transactor.GasTipCap,
+			GasFeeCap: transactor.GasFeeCap,
+			Gas:       transactor.GasLimit,
+			To:        tx.To(),
+			Value:     tx.Value(),
+			Data:      tx.Data(),
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown tx type: %v", tx.Type())
+	}
+}
+
 // addToReprocessQueue adds a tx to the reprocess queue.
 func (c *chainQueue) addToReprocessQueue(tx db.TX) {
 	c.reprocessQueueMux.Lock()
@@ -31,6 +31,13 @@ type ChainConfig struct {
 	DoNotBatch bool `yaml:"skip_batching"`
 	// MaxGasPrice is the maximum gas price to use for transactions
 	MaxGasPrice *big.Int `yaml:"max_gas_price"`
+	// MaxTotalFee is the maximum total fee of a transaction in wei, including the l1 data fee on rollups.
+	// if this is nil, only MaxGasPrice is applied.
+	MaxTotalFee *big.Int `yaml:"max_total_fee"`
+	// FeeModel is the model used to estimate the fee of a transaction.
+	// it is one of london, legacy, op_stack or arbitrum. If this is empty, london is used on chains that support
+	// EIP1559 and legacy otherwise.
+	FeeModel string `yaml:"fee_model"`
 	// BaseGasPrice is the gas price that will be used if 0 is returned from the gas price oracle
 	BaseGasPrice *big.Int `yaml:"base_gas_price"`
 	// BumpIntervalSeconds is the number of seconds to wait before bumping a transaction
@@ -47,6 +54,17 @@ type ChainConfig struct {
 	DynamicGasEstimate bool `yaml:"dynamic_gas_estimate"`
 	// SupportsEIP1559 is whether or not this chain supports EIP1559
 	SupportsEIP1559 bool `yaml:"supports_eip_1559"`
+	// Simulate is whether or not to simulate transactions against pending state before they're broadcast or bumped.
+	// transactions that would revert are not sent.
+	Simulate bool `yaml:"simulate"`
+	// LaneSelection is the policy used by a submitter pool to pick the lane of a transaction.
+	// it is one of round_robin, least_pending or sticky. If this is empty, round_robin will be used.
+	LaneSelection string `yaml:"lane_selection"`
+	// LaneMinBalance is the balance below which a submitter pool tops up a lane from the funding key.
+	// if this is nil, lanes are not topped up.
+	LaneMinBalance *big.Int `yaml:"lane_min_balance"`
+	// LaneTopUpAmount is the amount sent to a lane when it is topped up. If this is nil, LaneMinBalance will be used.
+	LaneTopUpAmount *big.Int `yaml:"lane_top_up_amount"`
 }
 
 const (
@@ -63,6 +81,26 @@ type ChainConfig struct {
 	DefaultGasEstimate = uint64(1200000)
 )
 
+const (
+	// RoundRobinLaneSelection sends transactions from each lane in turn.
+	RoundRobinLaneSelection = "round_robin"
+	// LeastPendingLaneSelection sends transactions from the lane with the fewest pending transactions.
+	LeastPendingLaneSelection = "least_pending"
+	// StickyLaneSelection sends transactions with the same key from the same lane.
+	StickyLaneSelection = "sticky"
+)
+
+const (
+	// LondonFeeModel prices transactions at the base fee plus the tip, capped at the fee cap.
+	LondonFeeModel = "london"
+	// LegacyFeeModel prices transactions at their gas price.
+	LegacyFeeModel = "legacy"
+	// OPStackFeeModel adds the l1 data fee reported by the GasPriceOracle predeploy to the london fee.
+	OPStackFeeModel = "op_stack"
+	// ArbitrumFeeModel adds the l1 gas reported by the NodeInterface precompile to the london fee.
+	ArbitrumFeeModel = "arbitrum"
+)
+
 // DefaultMaxPrice is the default max price of a tx.
 var DefaultMaxPrice = big.NewInt(500 * params.GWei)
 
@@ -109,6 +147,30 @@ func (c *Config) GetMaxGasPrice(chainID int) (maxPrice *big.Int) {
 	return
 }
 
+// GetMaxTotalFee returns the maximum total fee of a transaction, or nil if the total fee isn't capped.
+func (c *Config) GetMaxTotalFee(chainID int) *big.Int {
+	chainConfig, ok := c.Chains[chainID]
+	if ok && chainConfig.MaxTotalFee != nil {
+		return chainConfig.MaxTotalFee
+	}
+	return c.MaxTotalFee
+}
+
+// GetFeeModel returns the model used to estimate the fee of a transaction.
+func (c *Config) GetFeeModel(chainID int) string {
+	chainConfig, ok := c.Chains[chainID]
+	if ok && chainConfig.FeeModel != "" {
+		return chainConfig.FeeModel
+	}
+	if c.FeeModel != "" {
+		return c.FeeModel
+	}
+	if c.SupportsEIP1559(chainID) {
+		return LondonFeeModel
+	}
+	return LegacyFeeModel
+}
+
 // GetBaseGasPrice returns the maximum gas price to use for transactions.
 func (c *Config) GetBaseGasPrice(chainID int) (basePrice *big.Int) {
 	basePrice = c.BaseGasPrice
@@ -211,6 +273,48 @@ func (c *Config) SupportsEIP1559(chainID int) bool {
 	return c.ChainConfig.SupportsEIP1559
 }
 
+// GetSimulate returns whether or not to simulate transactions before they're broadcast or bumped.
+func (c *Config) GetSimulate(chainID int) bool {
+	chainConfig, ok := c.Chains[chainID]
+	if ok {
+		return chainConfig.Simulate
+	}
+	return c.ChainConfig.Simulate
+}
+
+// GetLaneSelection returns the policy used by a submitter pool to pick the lane of a transaction.
+func (c *Config) GetLaneSelection(chainID int) string {
+	chainConfig, ok := c.Chains[chainID]
+	if ok && chainConfig.LaneSelection != "" {
+		return chainConfig.LaneSelection
+	}
+	if c.LaneSelection != "" {
+		return c.LaneSelection
+	}
+	return RoundRobinLaneSelection
+}
+
+// GetLaneMinBalance returns the balance below which a lane is topped up, or nil if lanes aren't topped up.
+func (c *Config) GetLaneMinBalance(chainID int) *big.Int {
+	chainConfig, ok := c.Chains[chainID]
+	if ok && chainConfig.LaneMinBalance != nil {
+		return chainConfig.LaneMinBalance
+	}
+	return c.LaneMinBalance
+}
+
+// GetLaneTopUpAmount returns the amount sent to a lane when it is topped up.
+func (c *Config) GetLaneTopUpAmount(chainID int) *big.Int {
+	chainConfig, ok := c.Chains[chainID]
+	if ok && chainConfig.LaneTopUpAmount != nil {
+		return chainConfig.LaneTopUpAmount
+	}
+	if c.LaneTopUpAmount != nil {
+		return c.LaneTopUpAmount
+	}
+	return c.GetLaneMinBalance(chainID)
+}
+
 // SetGlobalMaxGasPrice is a helper function that sets the global gas price.
 func (c *Config) SetGlobalMaxGasPrice(maxPrice *big.Int) {
 	c.MaxGasPrice = maxPrice
@@ -53,6 +53,19 @@ func TestGetters(t *testing.T) {
 		assert.Equal(t, big.NewInt(250*params.GWei), cfg.GetMaxGasPrice(3))
 		assert.Equal(t, big.NewInt(250*params.GWei), cfg.GetMaxGasPrice(4)) // Nonexistent chain, should use global config value
 	})
+
+	t.Run("GetFeeModel", func(t *testing.T) {
+		feeCfg := config.Config{
+			Chains: map[int]config.ChainConfig{
+				1:  {SupportsEIP1559: true},
+				10: {SupportsEIP1559: true, FeeModel: config.OPStackFeeModel},
+			},
+		}
+		assert.Equal(t, config.LondonFeeModel, feeCfg.GetFeeModel(1))
+		assert.Equal(t, config.OPStackFeeModel, feeCfg.GetFeeModel(10))
+		assert.Equal(t, config.LegacyFeeModel, feeCfg.GetFeeModel(2))
+		assert.Nil(t, feeCfg.GetMaxTotalFee(1))
+	})
 }
 
 func TestGlobalConfig(t *testing.T) {
@@ -62,7 +75,12 @@ func TestGlobalConfig(t *testing.T) {
 gas_estimate: 1000
 is_l2: true
 dynamic_gas_estimate: true
-supports_eip_1559: true`
+supports_eip_1559: true
+simulate: true
+lane_selection: least_pending
+lane_min_balance: 1000000000000000000
+max_total_fee: 10000000000000000
+fee_model: op_stack`
 	var cfg config.Config
 	err := yaml.Unmarshal([]byte(cfgStr), &cfg)
 	assert.NoError(t, err)
@@ -73,4 +91,11 @@ func TestGlobalConfig(t *testing.T) {
 	assert.Equal(t, true, cfg.IsL2(0))
 	assert.Equal(t, true, cfg.DynamicGasEstimate)
 	assert.Equal(t, true, cfg.SupportsEIP1559(0))
+	assert.Equal(t, true, cfg.GetSimulate(0))
+	assert.Equal(t, config.LeastPendingLaneSelection, cfg.GetLaneSelection(0))
+	assert.Equal(t, big.NewInt(params.Ether), cfg.GetLaneMinBalance(0))
+	// the top up amount defaults to the min balance
+	assert.Equal(t, big.NewInt(params.Ether), cfg.GetLaneTopUpAmount(0))
+	assert.Equal(t, big.NewInt(10_000_000*params.GWei), cfg.GetMaxTotalFee(0))
+	assert.Equal(t, config.OPStackFeeModel, cfg.GetFeeModel(0))
 }
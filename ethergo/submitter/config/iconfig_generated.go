@@ -15,6 +15,10 @@ type IConfig interface {
 	GetBatch(chainID int) bool
 	// GetMaxGasPrice returns the maximum gas price to use for transactions.
 	GetMaxGasPrice(chainID int) (maxPrice *big.Int)
+	// GetMaxTotalFee returns the maximum total fee of a transaction, or nil if the total fee isn't capped.
+	GetMaxTotalFee(chainID int) *big.Int
+	// GetFeeModel returns the model used to estimate the fee of a transaction.
+	GetFeeModel(chainID int) string
 	// GetBaseGasPrice returns the maximum gas price to use for transactions.
 	GetBaseGasPrice(chainID int) (basePrice *big.Int)
 	// GetBumpInterval returns the number of seconds to wait before bumping a transaction
@@ -33,6 +37,14 @@ type IConfig interface {
 	GetDynamicGasEstimate(chainID int) bool
 	// SupportsEIP1559 returns whether or not this chain supports EIP1559.
 	SupportsEIP1559(chainID int) bool
+	// GetSimulate returns whether or not to simulate transactions before they're broadcast or bumped.
+	GetSimulate(chainID int) bool
+	// GetLaneSelection returns the policy used by a submitter pool to pick the lane of a transaction.
+	GetLaneSelection(chainID int) string
+	// GetLaneMinBalance returns the balance below which a lane is topped up, or nil if lanes aren't topped up.
+	GetLaneMinBalance(chainID int) *big.Int
+	// GetLaneTopUpAmount returns the amount sent to a lane when it is topped up.
+	GetLaneTopUpAmount(chainID int) *big.Int
 	// SetGlobalMaxGasPrice is a helper function that sets the global gas price.
 	SetGlobalMaxGasPrice(maxPrice *big.Int)
 	// SetBaseGasPrice is a helper function that sets the base gas price.
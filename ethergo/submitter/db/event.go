@@ -0,0 +1,29 @@
+package db
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TXEvent is a change in the status of a tx. One is recorded each time PutTXS stores a new tx or changes the status of
+// an existing one.
+type TXEvent struct {
+	// ID is the position of the event. IDs increase in the order events are recorded.
+	ID uint64
+	// TXHash is the hash of the tx
+	TXHash common.Hash
+	// From is the sender of the tx
+	From common.Address
+	// ChainID is the chain id of the tx
+	ChainID *big.Int
+	// Nonce is the nonce of the tx
+	Nonce uint64
+	// Status is the status of the tx after the change
+	Status Status
+	// IsNewAttempt is true if the tx was stored for a nonce that already had an attempt, e.g. when it's bumped.
+	IsNewAttempt bool
+	// CreatedAt is the time the event was recorded
+	CreatedAt time.Time
+}
@@ -134,6 +134,29 @@ func (_m *Service) GetNonceStatus(ctx context.Context, fromAddress common.Addres
 	return r0, r1
 }
 
+// GetTXEvents provides a mock function with given fields: ctx, fromAddress, chainID, nonce, afterID, limit
+func (_m *Service) GetTXEvents(ctx context.Context, fromAddress common.Address, chainID *big.Int, nonce *uint64, afterID uint64, limit int) ([]db.TXEvent, error) {
+	ret := _m.Called(ctx, fromAddress, chainID, nonce, afterID, limit)
+
+	var r0 []db.TXEvent
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, *uint64, uint64, int) []db.TXEvent); ok {
+		r0 = rf(ctx, fromAddress, chainID, nonce, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.TXEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int, *uint64, uint64, int) error); ok {
+		r1 = rf(ctx, fromAddress, chainID, nonce, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTXS provides a mock function with given fields: ctx, fromAddress, chainID, statuses
 func (_m *Service) GetTXS(ctx context.Context, fromAddress common.Address, chainID *big.Int, statuses ...db.Status) ([]db.TX, error) {
 	_va := make([]interface{}, len(statuses))
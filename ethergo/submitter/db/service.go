@@ -35,6 +35,9 @@ type Service interface {
 	GetNonceStatus(ctx context.Context, fromAddress common.Address, chainID *big.Int, nonce uint64) (status Status, err error)
 	// GetNonceAttemptsByStatus gets all txs for a given address and chain id with a given status and nonce.
 	GetNonceAttemptsByStatus(ctx context.Context, fromAddress common.Address, chainID *big.Int, nonce uint64, matchStatuses ...Status) (txs []TX, err error)
+	// GetTXEvents gets up to limit events for a given address recorded after the event with id afterID, oldest first.
+	// chainID and nonce are optional filters.
+	GetTXEvents(ctx context.Context, fromAddress common.Address, chainID *big.Int, nonce *uint64, afterID uint64, limit int) (events []TXEvent, err error)
 }
 
 // TransactionFunc is a function that can be passed to DBTransaction.
@@ -50,6 +53,9 @@ type Service interface {
 // additionally, due to the GetMaxNoncestatus function, statuses are currently assumed to be in order.
 // if you need to modify this functionality, please update that function. to reflect that the highest status
 // isno longer the expected end status.
+//
+// Cancelled, Withdrawn and Reverted are the exception: they only mark attempts whose nonce was taken over by another tx
+// and are ignored by GetNonceStatus.
 const (
 	// Pending is the status of a tx that has not been processed yet.
 	Pending Status = iota + 1 // Pending
@@ -65,9 +71,20 @@ type Service interface {
 	Replaced // Replaced
 	// Confirmed is the status of a tx that has been confirmed.
 	Confirmed // Confirmed
+	// Cancelled is the status of a tx that was withdrawn before confirming because its nonce was cancelled.
+	Cancelled // Cancelled
+	// Withdrawn is the status of a tx that was withdrawn before confirming because a different call was submitted for its nonce.
+	Withdrawn // Withdrawn
+	// Reverted is the status of a tx that was not sent because it reverted in simulation. Its nonce is used by a cancellation.
+	Reverted // Reverted
 )
 
-var allStatusTypes = []Status{Pending, Stored, Submitted, FailedSubmit, ReplacedOrConfirmed, Replaced, Confirmed}
+var allStatusTypes = []Status{Pending, Stored, Submitted, FailedSubmit, ReplacedOrConfirmed, Replaced, Confirmed, Cancelled, Withdrawn, Reverted}
+
+// IsSuperseded returns true if the tx was withdrawn by a cancellation or replacement of its nonce.
+func (s Status) IsSuperseded() bool {
+	return s == Cancelled || s == Withdrawn || s == Reverted
+}
 
 // AllStatusTypes returns all status types.
 // it is exported for testing purposes
@@ -19,6 +19,8 @@ type TX struct {
 	creationTime time.Time
 	// Status is the status of the transaction
 	Status Status
+	// RevertReason is the decoded reason the transaction reverted in simulation, if its status is Reverted.
+	RevertReason string
 }
 
 // NewTX creates a new TX for use in the db package.
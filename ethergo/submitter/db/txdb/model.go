@@ -20,6 +20,7 @@ func init() {
 	fromFieldName = namer.GetConsistentName("From")
 	idFieldName = namer.GetConsistentName("ID")
 	uuidFieldName = namer.GetConsistentName("UUID")
+	revertReasonFieldName = namer.GetConsistentName("RevertReason")
 }
 
 var (
@@ -39,6 +40,8 @@ func init() {
 	idFieldName string
 	// uuidFieldName is the field name of the uuid.
 	uuidFieldName string
+	// revertReasonFieldName is the field name of the revert reason.
+	revertReasonFieldName string
 )
 
 // ETHTX contains a raw evm transaction that is unsigned.
@@ -60,11 +63,33 @@ type ETHTX struct {
 	RawTx []byte `gorm:"column:raw_tx"`
 	// Status is the status of the transaction
 	Status db.Status `gorm:"column:status;index"`
+	// RevertReason is the decoded revert reason of a transaction that reverted in simulation
+	RevertReason string `gorm:"column:revert_reason"`
+}
+
+// ETHTXEvent is a change in the status of an ETHTX. Events are append only so they can be replayed.
+type ETHTXEvent struct {
+	// ID is the position of the event
+	ID uint64 `gorm:"column:id;primaryKey;autoIncrement:true"`
+	// CreatedAt is the time the event was recorded
+	CreatedAt time.Time
+	// TXHash is the hash of the transaction
+	TXHash string `gorm:"column:tx_hash;index;size:256"`
+	// From is the sender of the transaction
+	From string `gorm:"column:from;index"`
+	// ChainID is the chain id the transaction hash will be sent on
+	ChainID uint64 `gorm:"column:chain_id;index"`
+	// Nonce is the nonce of the raw evm tx
+	Nonce uint64 `gorm:"column:nonce;index"`
+	// Status is the status of the transaction after the change
+	Status db.Status `gorm:"column:status"`
+	// IsNewAttempt is true if the transaction was stored for a nonce that already had an attempt
+	IsNewAttempt bool `gorm:"column:is_new_attempt"`
 }
 
 // GetAllModels gets all models to migrate
 // see: https://medium.com/@SaifAbid/slice-interfaces-8c78f8b6345d for an explanation of why we can't do this at initialization time
 func GetAllModels() (allModels []interface{}) {
-	allModels = []interface{}{&ETHTX{}}
+	allModels = []interface{}{&ETHTX{}, &ETHTXEvent{}}
 	return allModels
 }
@@ -86,8 +86,9 @@ func convertTXS(ethTxes []ETHTX) (txs []db.TX, err error) {
 		}
 
 		retTX := db.TX{
-			Transaction: &marshalledTx,
-			Status:      dbTX.Status,
+			Transaction:  &marshalledTx,
+			Status:       dbTX.Status,
+			RevertReason: dbTX.RevertReason,
 		}
 
 		// this is fine since we're an implementing db package
@@ -281,31 +282,161 @@ func (s Store) PutTXS(ctx context.Context, txs ...db.TX) error {
 		}
 
 		toInsert = append(toInsert, &ETHTX{
-			From:    msg.From.String(),
-			ChainID: tx.ChainId().Uint64(),
-			Nonce:   tx.Nonce(),
-			RawTx:   marshalledTX,
-			TXHash:  tx.Hash().String(),
-			Status:  tx.Status,
+			From:         msg.From.String(),
+			ChainID:      tx.ChainId().Uint64(),
+			Nonce:        tx.Nonce(),
+			RawTx:        marshalledTX,
+			TXHash:       tx.Hash().String(),
+			Status:       tx.Status,
+			RevertReason: tx.RevertReason,
 		})
 	}
 
-	dbTX := s.DB().WithContext(ctx).
-		Clauses(clause.OnConflict{
-			Columns: []clause.Column{{Name: txHashFieldName}},
-			DoUpdates: clause.AssignmentColumns([]string{
-				statusFieldName,
-			}),
+	//nolint: wrapcheck
+	return s.DB().WithContext(ctx).Transaction(func(dbTX *gorm.DB) error {
+		// events are computed against the stored txs before they're overwritten.
+		events, err := newTXEvents(dbTX, toInsert)
+		if err != nil {
+			return fmt.Errorf("could not get tx events: %w", err)
+		}
+
+		tx := dbTX.
+			Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: txHashFieldName}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					statusFieldName,
+					revertReasonFieldName,
+				}),
+			}).
+			Create(toInsert)
+
+		if tx.Error != nil {
+			return fmt.Errorf("could not store tx: %w", tx.Error)
+		}
+
+		if len(events) == 0 {
+			return nil
+		}
+
+		tx = dbTX.Create(events)
+		if tx.Error != nil {
+			return fmt.Errorf("could not store tx events: %w", tx.Error)
+		}
+		return nil
+	})
+}
+
+// nonceKey identifies a nonce of a sender on a chain.
+type nonceKey struct {
+	from    string
+	chainID uint64
+	nonce   uint64
+}
+
+// newTXEvents creates an event for each tx that is new or whose status changes when toInsert is stored.
+func newTXEvents(dbTX *gorm.DB, toInsert []*ETHTX) ([]*ETHTXEvent, error) {
+	type senderKey struct {
+		from    string
+		chainID uint64
+	}
+
+	nonces := make(map[senderKey][]uint64)
+	for _, tx := range toInsert {
+		key := senderKey{from: tx.From, chainID: tx.ChainID}
+		nonces[key] = append(nonces[key], tx.Nonce)
+	}
+
+	statuses := make(map[string]db.Status)
+	attempts := make(map[nonceKey]int)
+
+	for key, keyNonces := range nonces {
+		var existing []ETHTX
+		tx := dbTX.Model(&ETHTX{}).
+			Select(fmt.Sprintf("%s, %s, %s", txHashFieldName, statusFieldName, nonceFieldName)).
+			Where(ETHTX{
+				From:    key.from,
+				ChainID: key.chainID,
+			}).
+			Where(fmt.Sprintf("%s IN ?", nonceFieldName), keyNonces).
+			Find(&existing)
+		if tx.Error != nil {
+			return nil, fmt.Errorf("could not get existing txs: %w", tx.Error)
+		}
+
+		for _, existingTX := range existing {
+			statuses[existingTX.TXHash] = existingTX.Status
+			attempts[nonceKey{from: key.from, chainID: key.chainID, nonce: existingTX.Nonce}]++
+		}
+	}
+
+	var events []*ETHTXEvent
+	for _, tx := range toInsert {
+		status, ok := statuses[tx.TXHash]
+		if ok && status == tx.Status {
+			continue
+		}
+
+		key := nonceKey{from: tx.From, chainID: tx.ChainID, nonce: tx.Nonce}
+		events = append(events, &ETHTXEvent{
+			TXHash:       tx.TXHash,
+			From:         tx.From,
+			ChainID:      tx.ChainID,
+			Nonce:        tx.Nonce,
+			Status:       tx.Status,
+			IsNewAttempt: !ok && attempts[key] > 0,
+		})
+
+		// later txs in the same batch are compared against this one.
+		if !ok {
+			attempts[key]++
+		}
+		statuses[tx.TXHash] = tx.Status
+	}
+
+	return events, nil
+}
+
+// GetTXEvents gets up to limit events for a given address recorded after the event with id afterID, oldest first.
+func (s *Store) GetTXEvents(ctx context.Context, fromAddress common.Address, chainID *big.Int, nonce *uint64, afterID uint64, limit int) (events []db.TXEvent, err error) {
+	var dbEvents []ETHTXEvent
+
+	query := s.DB().WithContext(ctx).Model(&ETHTXEvent{}).
+		Where(ETHTXEvent{
+			From: fromAddress.String(),
 		}).
-		Create(toInsert)
+		Where(fmt.Sprintf("%s > ?", idFieldName), afterID)
 
-	if dbTX.Error != nil {
-		return fmt.Errorf("could not store tx: %w", dbTX.Error)
+	if chainID != nil {
+		query = query.Where(fmt.Sprintf("%s = ?", chainIDFieldName), chainID.Uint64())
 	}
-	return nil
+
+	if nonce != nil {
+		query = query.Where(fmt.Sprintf("%s = ?", nonceFieldName), *nonce)
+	}
+
+	dbTx := query.Order(fmt.Sprintf("%s asc", idFieldName)).Limit(limit).Find(&dbEvents)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not get tx events: %w", dbTx.Error)
+	}
+
+	for _, event := range dbEvents {
+		events = append(events, db.TXEvent{
+			ID:           event.ID,
+			TXHash:       common.HexToHash(event.TXHash),
+			From:         common.HexToAddress(event.From),
+			ChainID:      new(big.Int).SetUint64(event.ChainID),
+			Nonce:        event.Nonce,
+			Status:       event.Status,
+			IsNewAttempt: event.IsNewAttempt,
+			CreatedAt:    event.CreatedAt,
+		})
+	}
+
+	return events, nil
 }
 
 // GetNonceStatus gets the max nonce for the given address and chain id.
+// attempts superseded by a cancellation or replacement are not taken into account.
 func (s *Store) GetNonceStatus(ctx context.Context, fromAddress common.Address, chainID *big.Int, nonce uint64) (status db.Status, err error) {
 	var maxStatus sql.NullInt32
 
@@ -315,7 +446,8 @@ func (s *Store) GetNonceStatus(ctx context.Context, fromAddress common.Address,
 		From:    fromAddress.String(),
 		ChainID: chainID.Uint64(),
 		Nonce:   nonce,
-	}).Scan(&maxStatus)
+	}).Where(fmt.Sprintf("%s NOT IN ?", statusFieldName), statusToArgs(db.Cancelled, db.Withdrawn, db.Reverted)).
+		Scan(&maxStatus)
 
 	if dbTx.Error != nil {
 		return 0, fmt.Errorf("could not get nonce for chain id: %w", dbTx.Error)
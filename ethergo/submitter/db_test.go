@@ -131,7 +131,12 @@ func (t *TXSubmitterDBSuite) TestGetNonceStatus() {
 		acct := simulatedBackend.GetFundedAccount(t.GetTestContext(), big.NewInt(params.Ether))
 		mockTx := mocks.MockTx(t.GetTestContext(), t.T(), simulatedBackend, acct, types.LegacyTxType)
 
+		var expectedStatus db.Status
 		for i, status := range db.AllStatusTypes() {
+			// superseded attempts don't count towards the nonce status.
+			if !status.IsSuperseded() {
+				expectedStatus = status
+			}
 			copiedTX, err := util.CopyTX(mockTx, util.WithGasPrice(big.NewInt(int64(i))))
 			t.Require().NoError(err)
 
@@ -150,7 +155,7 @@ func (t *TXSubmitterDBSuite) TestGetNonceStatus() {
 			nonceStatus, err := dbs.GetNonceStatus(t.GetTestContext(), msg.From, simulatedBackend.GetBigChainID(), mockTx.Nonce())
 			t.Require().NoError(err)
 
-			t.Require().Equal(status, nonceStatus)
+			t.Require().Equal(expectedStatus, nonceStatus)
 
 			txs, err := dbs.GetNonceAttemptsByStatus(t.GetTestContext(), msg.From, simulatedBackend.GetBigChainID(), mockTx.Nonce(), status)
 			t.Require().NoError(err)
@@ -159,3 +164,62 @@ func (t *TXSubmitterDBSuite) TestGetNonceStatus() {
 		}
 	})
 }
+
+func (t *TXSubmitterDBSuite) TestGetTXEvents() {
+	t.RunOnAllDBs(func(dbs db.Service) {
+		simulatedBackend := simulated.NewSimulatedBackend(t.GetTestContext(), t.T())
+		acct := simulatedBackend.GetFundedAccount(t.GetTestContext(), big.NewInt(params.Ether))
+		mockTx := mocks.MockTx(t.GetTestContext(), t.T(), simulatedBackend, acct, types.LegacyTxType)
+		chainID := simulatedBackend.GetBigChainID()
+
+		bumpedTx, err := util.CopyTX(mockTx, util.WithGasPrice(new(big.Int).Add(mockTx.GasPrice(), big.NewInt(1))))
+		t.Require().NoError(err)
+		bumpedTx, err = types.SignTx(bumpedTx, simulatedBackend.Signer(), acct.PrivateKey)
+		t.Require().NoError(err)
+
+		puts := []db.TX{
+			db.NewTX(mockTx, db.Stored, uuid.New().String()),
+			// storing the same status again doesn't record an event
+			db.NewTX(mockTx, db.Stored, uuid.New().String()),
+			db.NewTX(mockTx, db.Submitted, uuid.New().String()),
+			db.NewTX(bumpedTx, db.Stored, uuid.New().String()),
+		}
+		for _, tx := range puts {
+			err = dbs.PutTXS(t.GetTestContext(), tx)
+			t.Require().NoError(err)
+		}
+
+		events, err := dbs.GetTXEvents(t.GetTestContext(), acct.Address, chainID, nil, 0, 10)
+		t.Require().NoError(err)
+		t.Require().Len(events, 3)
+
+		t.Equal(mockTx.Hash(), events[0].TXHash)
+		t.Equal(db.Stored, events[0].Status)
+		t.False(events[0].IsNewAttempt)
+
+		t.Equal(mockTx.Hash(), events[1].TXHash)
+		t.Equal(db.Submitted, events[1].Status)
+		t.False(events[1].IsNewAttempt)
+
+		t.Equal(bumpedTx.Hash(), events[2].TXHash)
+		t.Equal(db.Stored, events[2].Status)
+		t.True(events[2].IsNewAttempt)
+
+		for _, event := range events {
+			t.Equal(acct.Address, event.From)
+			t.Equal(mockTx.Nonce(), event.Nonce)
+			t.Equal(chainID, event.ChainID, testsuite.BigIntComparer())
+		}
+
+		// events can be resumed after an id
+		events, err = dbs.GetTXEvents(t.GetTestContext(), acct.Address, chainID, nil, events[0].ID, 1)
+		t.Require().NoError(err)
+		t.Require().Len(events, 1)
+		t.Equal(db.Submitted, events[0].Status)
+
+		otherNonce := mockTx.Nonce() + 1
+		events, err = dbs.GetTXEvents(t.GetTestContext(), acct.Address, chainID, &otherNonce, 0, 10)
+		t.Require().NoError(err)
+		t.Empty(events)
+	})
+}
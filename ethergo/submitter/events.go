@@ -0,0 +1,181 @@
+package submitter
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/core"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db"
+)
+
+// EventType is the type of a submission event.
+//
+//go:generate go run golang.org/x/tools/cmd/stringer -type=EventType -linecomment
+type EventType uint8
+
+const (
+	// StoredEvent is emitted when a submission is stored, before its first broadcast.
+	StoredEvent EventType = iota + 1 // stored
+	// SubmittedEvent is emitted when a tx is broadcast.
+	SubmittedEvent // submitted
+	// BumpedEvent is emitted when a new tx is created for a nonce that already had one, e.g. at a bumped gas price.
+	BumpedEvent // bumped
+	// ReplacedEvent is emitted when a tx is superseded by a cancellation or replacement.
+	ReplacedEvent // replaced
+	// ConfirmedEvent is emitted when a tx confirms.
+	ConfirmedEvent // confirmed
+	// FailedEvent is emitted when a tx fails to broadcast or reverts in simulation.
+	FailedEvent // failed
+)
+
+const (
+	// eventPollInterval is how often the db is checked for new events.
+	eventPollInterval = time.Second
+	// eventBatchSize is the maximum number of events read from the db at once.
+	eventBatchSize = 100
+)
+
+// Event is a change in the state of a submission.
+type Event struct {
+	// ID is the position of the event. It can be passed to Subscribe to resume after this event.
+	ID uint64
+	// Type is the type of the event.
+	Type EventType
+	// ChainID is the chain id of the submission.
+	ChainID *big.Int
+	// Nonce is the nonce of the submission.
+	Nonce uint64
+	// TxHash is the hash of the tx the event is for. A nonce can have several txs over its lifetime.
+	TxHash common.Hash
+	// Status is the status of the tx in the submitter db after the event.
+	Status db.Status
+	// Time is the time the event was recorded.
+	Time time.Time
+}
+
+// IsFinal returns true if the submission is complete, either because a tx for its nonce confirmed or because it
+// reverted in simulation.
+func (e Event) IsFinal() bool {
+	return e.Type == ConfirmedEvent || e.Status == db.Reverted
+}
+
+// newEvent converts a db event to a submission event. Intermediate statuses that don't change the state of the
+// submission are skipped.
+func newEvent(txEvent db.TXEvent) (_ Event, ok bool) {
+	event := Event{
+		ID:      txEvent.ID,
+		ChainID: core.CopyBigInt(txEvent.ChainID),
+		Nonce:   txEvent.Nonce,
+		TxHash:  txEvent.TXHash,
+		Status:  txEvent.Status,
+		Time:    txEvent.CreatedAt,
+	}
+
+	switch txEvent.Status {
+	case db.Stored:
+		event.Type = StoredEvent
+		if txEvent.IsNewAttempt {
+			event.Type = BumpedEvent
+		}
+	case db.Submitted:
+		event.Type = SubmittedEvent
+	case db.FailedSubmit, db.Reverted:
+		event.Type = FailedEvent
+	case db.Cancelled, db.Withdrawn:
+		event.Type = ReplacedEvent
+	case db.Confirmed:
+		event.Type = ConfirmedEvent
+	case db.Pending, db.ReplacedOrConfirmed, db.Replaced:
+		return Event{}, false
+	default:
+		return Event{}, false
+	}
+
+	return event, true
+}
+
+func (t *txSubmitterImpl) Subscribe(ctx context.Context, afterID uint64) <-chan Event {
+	events := make(chan Event, eventBatchSize)
+
+	go func() {
+		defer close(events)
+		t.streamEvents(ctx, nil, nil, afterID, func(event Event) bool {
+			select {
+			case <-ctx.Done():
+				return false
+			case events <- event:
+				return true
+			}
+		})
+	}()
+
+	return events
+}
+
+func (t *txSubmitterImpl) SubscribeSubmission(ctx context.Context, chainID *big.Int, nonce uint64) <-chan Event {
+	events := make(chan Event, eventBatchSize)
+
+	go func() {
+		defer close(events)
+		t.streamEvents(ctx, chainID, &nonce, 0, func(event Event) bool {
+			select {
+			case <-ctx.Done():
+				return false
+			case events <- event:
+				return !event.IsFinal()
+			}
+		})
+	}()
+
+	return events
+}
+
+func (t *txSubmitterImpl) OnComplete(ctx context.Context, chainID *big.Int, nonce uint64, callback func(Event)) {
+	go func() {
+		t.streamEvents(ctx, chainID, &nonce, 0, func(event Event) bool {
+			if !event.IsFinal() {
+				return true
+			}
+
+			callback(event)
+			return false
+		})
+	}()
+}
+
+// streamEvents passes the events recorded after afterID to handle, oldest first, until handle returns false or
+// ctx is cancelled. The db is polled for new events, so events recorded before a restart are delivered too.
+func (t *txSubmitterImpl) streamEvents(ctx context.Context, chainID *big.Int, nonce *uint64, afterID uint64, handle func(Event) bool) {
+	for {
+		txEvents, err := t.db.GetTXEvents(ctx, t.signer.Address(), chainID, nonce, afterID, eventBatchSize)
+		if err != nil {
+			logger.Warnf("could not get tx events: %v", err)
+		}
+
+		for _, txEvent := range txEvents {
+			afterID = txEvent.ID
+
+			event, ok := newEvent(txEvent)
+			if !ok {
+				continue
+			}
+
+			if !handle(event) {
+				return
+			}
+		}
+
+		// a full batch means there are likely more events waiting.
+		if len(txEvents) == eventBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(eventPollInterval):
+		}
+	}
+}
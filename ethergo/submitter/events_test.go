@@ -0,0 +1,85 @@
+package submitter_test
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/ethergo/example"
+	"github.com/synapsecns/sanguine/ethergo/example/counter"
+	"github.com/synapsecns/sanguine/ethergo/manager"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/ethergo/submitter/config"
+)
+
+func (s *SubmitterSuite) TestSubscribeSubmission() {
+	_, cntr := manager.GetContract[*counter.CounterRef](s.GetTestContext(), s.T(),
+		s.deployer, s.testBackends[0], example.CounterType)
+
+	cfg := &config.Config{}
+	chainID := s.testBackends[0].GetBigChainID()
+
+	ts := submitter.NewTestTransactionSubmitter(s.metrics, s.signer, s, s.store, cfg)
+	nonce, err := ts.SubmitTransaction(s.GetTestContext(), chainID, func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		tx, err = cntr.IncrementCounter(transactor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to increment counter: %w", err)
+		}
+
+		return tx, nil
+	})
+	s.Require().NoError(err)
+
+	completed := make(chan submitter.Event, 1)
+	ts.OnComplete(s.GetTestContext(), chainID, nonce, func(event submitter.Event) {
+		completed <- event
+	})
+
+	go func() {
+		err = ts.Start(s.GetTestContext())
+		s.Require().NoError(err)
+	}()
+
+	// the channel is closed after the final event.
+	var events []submitter.Event
+	for event := range ts.SubscribeSubmission(s.GetTestContext(), chainID, nonce) {
+		s.Equal(nonce, event.Nonce)
+		events = append(events, event)
+	}
+	s.Require().NotEmpty(events)
+
+	s.Equal(submitter.StoredEvent, events[0].Type)
+	final := events[len(events)-1]
+	s.Equal(submitter.ConfirmedEvent, final.Type)
+	s.True(final.IsFinal())
+
+	var submitted bool
+	for _, event := range events {
+		if event.Type == submitter.SubmittedEvent {
+			submitted = true
+		}
+	}
+	s.True(submitted)
+
+	status, err := ts.GetSubmissionStatus(s.GetTestContext(), chainID, nonce)
+	s.Require().NoError(err)
+	s.Equal(status.TxHash(), final.TxHash)
+
+	completedEvent := <-completed
+	s.Equal(final.ID, completedEvent.ID)
+
+	// a new subscriber replays the stored events, so events aren't lost across restarts.
+	restarted := submitter.NewTestTransactionSubmitter(s.metrics, s.signer, s, s.store, cfg)
+	replayed := restarted.Subscribe(s.GetTestContext(), events[0].ID-1)
+	for _, expected := range events {
+		var event submitter.Event
+		// events of other nonces may be interleaved.
+		for event = range replayed {
+			if event.Nonce == nonce && event.ChainID.Cmp(chainID) == 0 {
+				break
+			}
+		}
+		s.Equal(expected.ID, event.ID)
+		s.Equal(expected.Type, event.Type)
+	}
+}
@@ -43,6 +43,21 @@ func SortTxes(txs []db.TX) map[uint64][]db.TX {
 	return sortTxesByChainID(txs)
 }
 
+// LowerNoncesBroadcast exports lowerNoncesBroadcast for testing.
+func LowerNoncesBroadcast(currentNonce uint64, txs []db.TX) []bool {
+	return lowerNoncesBroadcast(currentNonce, txs)
+}
+
+// IsReplacementUnderpriced exports isReplacementUnderpriced for testing.
+func IsReplacementUnderpriced(prevTx, tx *types.Transaction) bool {
+	return isReplacementUnderpriced(prevTx, tx)
+}
+
+// NewBumpedTX exports newBumpedTX for testing.
+func NewBumpedTX(tx *types.Transaction, transactor *bind.TransactOpts) (*types.Transaction, error) {
+	return newBumpedTX(tx, transactor)
+}
+
 // GroupTxesByNonce exports groupTxesByNonce for testing.
 func GroupTxesByNonce(txs []db.TX) map[uint64][]db.TX {
 	return groupTxesByNonce(txs)
@@ -74,8 +89,8 @@ func GroupTxesByNonce(txs []db.TX) map[uint64][]db.TX {
 )
 
 // NewTestTransactionSubmitter wraps TestTransactionSubmitter in a TransactionSubmitter interface.
-func NewTestTransactionSubmitter(metrics metrics.Handler, signer signer.Signer, fetcher ClientFetcher, db db.Service, config *config.Config) TestTransactionSubmitter {
-	txSubmitter := NewTransactionSubmitter(metrics, signer, fetcher, db, config)
+func NewTestTransactionSubmitter(metrics metrics.Handler, signer signer.Signer, fetcher ClientFetcher, db db.Service, config *config.Config, opts ...Option) TestTransactionSubmitter {
+	txSubmitter := NewTransactionSubmitter(metrics, signer, fetcher, db, config, opts...)
 	//nolint: forcetypeassert
 	return txSubmitter.(TestTransactionSubmitter)
 }
@@ -92,6 +107,8 @@ type TestTransactionSubmitter interface {
 	GetNonce(parentCtx context.Context, chainID *big.Int, address common.Address) (_ uint64, err error)
 	// CheckAndSetConfirmation exports checkAndSetConfirmation for testing.
 	CheckAndSetConfirmation(ctx context.Context, chainClient client.EVM, txes []db.TX) error
+	// DecodeRevert exports decodeRevert for testing.
+	DecodeRevert(data []byte) string
 }
 
 // SetGasPrice exports setGasPrice for testing.
@@ -114,3 +131,8 @@ func (t *txSubmitterImpl) GetNonce(parentCtx context.Context, chainID *big.Int,
 func (t *txSubmitterImpl) CheckAndSetConfirmation(ctx context.Context, chainClient client.EVM, txes []db.TX) error {
 	return t.checkAndSetConfirmation(ctx, chainClient, txes)
 }
+
+// DecodeRevert exports decodeRevert for testing.
+func (t *txSubmitterImpl) DecodeRevert(data []byte) string {
+	return t.decodeRevert(data)
+}
@@ -0,0 +1,303 @@
+package submitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/core"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/submitter/config"
+	"github.com/synapsecns/sanguine/ethergo/util"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	// gasPriceOracleAddress is the address of the GasPriceOracle predeploy on op stack chains.
+	gasPriceOracleAddress = common.HexToAddress("0x420000000000000000000000000000000000000F")
+	// nodeInterfaceAddress is the address of the NodeInterface precompile on arbitrum.
+	nodeInterfaceAddress = common.HexToAddress("0x00000000000000000000000000000000000000C8")
+)
+
+const (
+	gasPriceOracleABI = `[{"inputs":[{"internalType":"bytes","name":"_data","type":"bytes"}],"name":"getL1Fee","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+	nodeInterfaceABI  = `[{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"bool","name":"contractCreation","type":"bool"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"gasEstimateComponents","outputs":[{"internalType":"uint64","name":"gasEstimate","type":"uint64"},{"internalType":"uint64","name":"gasEstimateForL1","type":"uint64"},{"internalType":"uint256","name":"baseFee","type":"uint256"},{"internalType":"uint256","name":"l1BaseFeeEstimate","type":"uint256"}],"stateMutability":"payable","type":"function"}]`
+)
+
+// ErrMaxTotalFeeExceeded is returned when the l1 data fee of a transaction exceeds the max total fee by itself.
+var ErrMaxTotalFeeExceeded = errors.New("l1 data fee exceeds max total fee")
+
+// FeeEstimate is the estimated cost of a transaction.
+type FeeEstimate struct {
+	// GasLimit is the gas used to execute the tx, excluding gas charged for l1 data.
+	GasLimit uint64
+	// L1Gas is the gas charged for l1 data at the l2 gas price. This is only set on arbitrum.
+	L1Gas uint64
+	// GasPrice is the expected price per unit of gas.
+	GasPrice *big.Int
+	// MaxGasPrice is the most the tx can pay per unit of gas. For dynamic fee txs, this is the fee cap.
+	MaxGasPrice *big.Int
+	// L1Fee is the fee charged for l1 data on top of gas. This is only set on op stack chains.
+	L1Fee *big.Int
+}
+
+// TotalFee returns the expected fee of the tx.
+func (f FeeEstimate) TotalFee() *big.Int {
+	return f.fee(f.GasPrice)
+}
+
+// MaxFee returns the most the tx can cost at its current pricing.
+func (f FeeEstimate) MaxFee() *big.Int {
+	return f.fee(f.MaxGasPrice)
+}
+
+// DataFee returns the part of the expected fee paid for l1 data.
+func (f FeeEstimate) DataFee() *big.Int {
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(f.L1Gas), f.GasPrice)
+	return fee.Add(fee, f.L1Fee)
+}
+
+func (f FeeEstimate) fee(gasPrice *big.Int) *big.Int {
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(f.GasLimit+f.L1Gas), gasPrice)
+	return fee.Add(fee, f.L1Fee)
+}
+
+// FeeEstimator estimates the fee of a transaction on a chain.
+type FeeEstimator interface {
+	// EstimateFee estimates the fee of tx when sent from from. The l1 data fee depends on the size of the encoded tx,
+	// so tx should be signed or carry placeholder signature values.
+	EstimateFee(ctx context.Context, client client.EVM, from common.Address, tx *types.Transaction) (*FeeEstimate, error)
+}
+
+// NewFeeEstimator creates the fee estimator for the fee model of a chain.
+func NewFeeEstimator(cfg config.IConfig, chainID int) (FeeEstimator, error) {
+	switch feeModel := cfg.GetFeeModel(chainID); feeModel {
+	case config.LondonFeeModel:
+		return londonFeeEstimator{}, nil
+	case config.LegacyFeeModel:
+		return legacyFeeEstimator{}, nil
+	case config.OPStackFeeModel:
+		return opStackFeeEstimator{}, nil
+	case config.ArbitrumFeeModel:
+		return arbitrumFeeEstimator{}, nil
+	default:
+		return nil, fmt.Errorf("unknown fee model %s for chain %d", feeModel, chainID)
+	}
+}
+
+// legacyFeeEstimator prices the tx at its gas price.
+type legacyFeeEstimator struct{}
+
+func (legacyFeeEstimator) EstimateFee(_ context.Context, _ client.EVM, _ common.Address, tx *types.Transaction) (*FeeEstimate, error) {
+	return &FeeEstimate{
+		GasLimit:    tx.Gas(),
+		GasPrice:    core.CopyBigInt(tx.GasPrice()),
+		MaxGasPrice: core.CopyBigInt(tx.GasPrice()),
+		L1Fee:       big.NewInt(0),
+	}, nil
+}
+
+// londonFeeEstimator prices the tx at the base fee of the latest block plus the tip, capped at the fee cap.
+type londonFeeEstimator struct{}
+
+func (londonFeeEstimator) EstimateFee(ctx context.Context, client client.EVM, _ common.Address, tx *types.Transaction) (*FeeEstimate, error) {
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not get header: %w", err)
+	}
+
+	gasPrice := core.CopyBigInt(tx.GasPrice())
+	if header.BaseFee != nil && tx.Type() == types.DynamicFeeTxType {
+		gasPrice = new(big.Int).Add(header.BaseFee, tx.GasTipCap())
+		if gasPrice.Cmp(tx.GasFeeCap()) > 0 {
+			gasPrice = core.CopyBigInt(tx.GasFeeCap())
+		}
+	}
+
+	return &FeeEstimate{
+		GasLimit:    tx.Gas(),
+		GasPrice:    gasPrice,
+		MaxGasPrice: core.CopyBigInt(tx.GasFeeCap()),
+		L1Fee:       big.NewInt(0),
+	}, nil
+}
+
+// opStackFeeEstimator adds the l1 data fee from GasPriceOracle.getL1Fee to the london fee.
+type opStackFeeEstimator struct{}
+
+func (opStackFeeEstimator) EstimateFee(ctx context.Context, client client.EVM, from common.Address, tx *types.Transaction) (*FeeEstimate, error) {
+	estimate, err := londonFeeEstimator{}.EstimateFee(ctx, client, from, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(gasPriceOracleABI))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse gas price oracle abi: %w", err)
+	}
+
+	encodedTx, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("could not encode tx: %w", err)
+	}
+
+	callData, err := parsed.Pack("getL1Fee", encodedTx)
+	if err != nil {
+		return nil, fmt.Errorf("could not pack getL1Fee: %w", err)
+	}
+
+	res, err := client.CallContract(ctx, ethereum.CallMsg{
+		To:   &gasPriceOracleAddress,
+		Data: callData,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not get l1 fee: %w", err)
+	}
+
+	out, err := parsed.Unpack("getL1Fee", res)
+	if err != nil {
+		return nil, fmt.Errorf("could not unpack l1 fee: %w", err)
+	}
+
+	estimate.L1Fee = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	return estimate, nil
+}
+
+// arbitrumFeeEstimator adds the l1 gas from NodeInterface.gasEstimateComponents to the london fee.
+// arbitrum charges l1 data as gas, so it's paid at the l2 gas price and has to fit in the gas limit.
+type arbitrumFeeEstimator struct{}
+
+func (arbitrumFeeEstimator) EstimateFee(ctx context.Context, client client.EVM, from common.Address, tx *types.Transaction) (*FeeEstimate, error) {
+	estimate, err := londonFeeEstimator{}.EstimateFee(ctx, client, from, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(nodeInterfaceABI))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse node interface abi: %w", err)
+	}
+
+	var to common.Address
+	if tx.To() != nil {
+		to = *tx.To()
+	}
+
+	callData, err := parsed.Pack("gasEstimateComponents", to, tx.To() == nil, tx.Data())
+	if err != nil {
+		return nil, fmt.Errorf("could not pack gasEstimateComponents: %w", err)
+	}
+
+	res, err := client.CallContract(ctx, ethereum.CallMsg{
+		From:  from,
+		To:    &nodeInterfaceAddress,
+		Value: tx.Value(),
+		Data:  callData,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not get gas estimate components: %w", err)
+	}
+
+	out, err := parsed.Unpack("gasEstimateComponents", res)
+	if err != nil {
+		return nil, fmt.Errorf("could not unpack gas estimate components: %w", err)
+	}
+
+	gasEstimate := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	gasEstimateForL1 := *abi.ConvertType(out[1], new(uint64)).(*uint64)
+
+	// the tx is charged for its gas limit at most, which already has to cover the l1 gas.
+	totalGas := tx.Gas()
+	if gasEstimate > totalGas {
+		totalGas = gasEstimate
+	}
+	if gasEstimateForL1 > totalGas {
+		gasEstimateForL1 = totalGas
+	}
+
+	estimate.L1Gas = gasEstimateForL1
+	estimate.GasLimit = totalGas - gasEstimateForL1
+	return estimate, nil
+}
+
+// capTotalFee lowers the gas price of tx so its max fee fits under the max total fee of the chain. The l1 data fee
+// can't be lowered by repricing, so ErrMaxTotalFeeExceeded is returned if it exceeds the cap by itself.
+// if the fee can't be estimated, tx is returned as is and only the max gas price applies.
+func (t *txSubmitterImpl) capTotalFee(parentCtx context.Context, chainClient client.EVM, chainID *big.Int, from common.Address, tx *types.Transaction) (_ *types.Transaction, err error) {
+	maxTotalFee := t.config.GetMaxTotalFee(int(chainID.Uint64()))
+	if maxTotalFee == nil {
+		return tx, nil
+	}
+
+	ctx, span := t.metrics.Tracer().Start(parentCtx, "submitter.capTotalFee", trace.WithAttributes(
+		attribute.Stringer("chainID", chainID),
+		attribute.String("max_total_fee", maxTotalFee.String()),
+	))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	estimator, err := NewFeeEstimator(t.config, int(chainID.Uint64()))
+	if err != nil {
+		return nil, err
+	}
+
+	estimate, err := estimator.EstimateFee(ctx, chainClient, from, tx)
+	if err != nil {
+		span.AddEvent("could not estimate fee", trace.WithAttributes(attribute.String("error", err.Error())))
+		return tx, nil
+	}
+
+	span.SetAttributes(
+		attribute.String("max_fee", estimate.MaxFee().String()),
+		attribute.String("l1_fee", estimate.L1Fee.String()),
+	)
+
+	gas := estimate.GasLimit + estimate.L1Gas
+	if estimate.MaxFee().Cmp(maxTotalFee) <= 0 || gas == 0 {
+		return tx, nil
+	}
+
+	budget := new(big.Int).Sub(maxTotalFee, estimate.L1Fee)
+	if budget.Sign() <= 0 {
+		return nil, fmt.Errorf("l1 fee %s is over %s: %w", estimate.L1Fee, maxTotalFee, ErrMaxTotalFeeExceeded)
+	}
+
+	maxGasPrice := budget.Div(budget, new(big.Int).SetUint64(gas))
+	span.SetAttributes(attribute.String("max_gas_price", maxGasPrice.String()))
+
+	if tx.Type() == types.LegacyTxType {
+		//nolint: wrapcheck
+		return util.CopyTX(tx, util.WithGasPrice(maxGasPrice))
+	}
+
+	gasTipCap := core.CopyBigInt(tx.GasTipCap())
+	if gasTipCap.Cmp(maxGasPrice) > 0 {
+		gasTipCap = core.CopyBigInt(maxGasPrice)
+	}
+
+	//nolint: wrapcheck
+	return util.CopyTX(tx, util.WithGasFeeCap(maxGasPrice), util.WithGasTipCap(gasTipCap))
+}
+
+// minReplacementBumpPercentage is how far above the tx it replaces nodes require a replacement to be priced.
+const minReplacementBumpPercentage = 10
+
+// isReplacementUnderpriced returns true if nodes would reject tx as a replacement of prevTx, i.e. if its gas tip cap or
+// gas fee cap isn't at least minReplacementBumpPercentage above prevTx's. For legacy txs both are the gas price.
+func isReplacementUnderpriced(prevTx, tx *types.Transaction) bool {
+	minPrice := func(price *big.Int) *big.Int {
+		bumped := new(big.Int).Mul(price, big.NewInt(100+minReplacementBumpPercentage))
+		return bumped.Div(bumped, big.NewInt(100))
+	}
+
+	return tx.GasFeeCap().Cmp(minPrice(prevTx.GasFeeCap())) < 0 || tx.GasTipCap().Cmp(minPrice(prevTx.GasTipCap())) < 0
+}
@@ -0,0 +1,93 @@
+package submitter_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/example"
+	"github.com/synapsecns/sanguine/ethergo/example/counter"
+	"github.com/synapsecns/sanguine/ethergo/manager"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/ethergo/submitter/config"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db"
+)
+
+func (s *SubmitterSuite) TestFeeEstimate() {
+	estimate := submitter.FeeEstimate{
+		GasLimit:    100_000,
+		L1Gas:       50_000,
+		GasPrice:    big.NewInt(params.GWei),
+		MaxGasPrice: big.NewInt(2 * params.GWei),
+		L1Fee:       big.NewInt(params.GWei),
+	}
+
+	s.Equal(big.NewInt(150_001*params.GWei), estimate.TotalFee())
+	s.Equal(big.NewInt(300_001*params.GWei), estimate.MaxFee())
+	s.Equal(big.NewInt(50_001*params.GWei), estimate.DataFee())
+
+	cfg := &config.Config{
+		Chains: map[int]config.ChainConfig{
+			1: {FeeModel: "unknown"},
+		},
+	}
+	_, err := submitter.NewFeeEstimator(cfg, 1)
+	s.Require().Error(err)
+
+	_, err = submitter.NewFeeEstimator(cfg, 2)
+	s.Require().NoError(err)
+}
+
+func TestIsReplacementUnderpriced(t *testing.T) {
+	legacyTX := func(gasPrice int64) *types.Transaction {
+		return types.NewTx(&types.LegacyTx{GasPrice: big.NewInt(gasPrice)})
+	}
+	dynamicTX := func(gasTipCap, gasFeeCap int64) *types.Transaction {
+		return types.NewTx(&types.DynamicFeeTx{GasTipCap: big.NewInt(gasTipCap), GasFeeCap: big.NewInt(gasFeeCap)})
+	}
+
+	assert.False(t, submitter.IsReplacementUnderpriced(legacyTX(100), legacyTX(110)))
+	assert.True(t, submitter.IsReplacementUnderpriced(legacyTX(100), legacyTX(109)))
+
+	assert.False(t, submitter.IsReplacementUnderpriced(dynamicTX(10, 100), dynamicTX(11, 110)))
+	// both the tip and the fee cap need to be bumped
+	assert.True(t, submitter.IsReplacementUnderpriced(dynamicTX(10, 100), dynamicTX(10, 200)))
+	assert.True(t, submitter.IsReplacementUnderpriced(dynamicTX(10, 100), dynamicTX(20, 100)))
+}
+
+func (s *SubmitterSuite) TestMaxTotalFee() {
+	_, cntr := manager.GetContract[*counter.CounterRef](s.GetTestContext(), s.T(),
+		s.deployer, s.testBackends[0], example.CounterType)
+
+	const gasEstimate = 100_000
+	// 10 wei per gas is well below the gas price of the backend.
+	maxTotalFee := big.NewInt(gasEstimate * 10)
+
+	cfg := &config.Config{}
+	cfg.GasEstimate = gasEstimate
+	cfg.MaxTotalFee = maxTotalFee
+	chainID := s.testBackends[0].GetBigChainID()
+
+	ts := submitter.NewTestTransactionSubmitter(s.metrics, s.signer, s, s.store, cfg)
+	_, err := ts.SubmitTransaction(s.GetTestContext(), chainID, func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		tx, err = cntr.IncrementCounter(transactor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to increment counter: %w", err)
+		}
+
+		return tx, nil
+	})
+	s.Require().NoError(err)
+
+	txs, err := s.store.GetTXS(s.GetTestContext(), s.signer.Address(), chainID, db.Stored)
+	s.Require().NoError(err)
+	s.Require().Len(txs, 1)
+
+	// the gas price is lowered so the whole tx fits under the max total fee.
+	maxFee := new(big.Int).Mul(txs[0].GasPrice(), new(big.Int).SetUint64(txs[0].Gas()))
+	s.Equal(maxTotalFee, maxFee)
+}
@@ -0,0 +1,340 @@
+package submitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer"
+	"github.com/synapsecns/sanguine/ethergo/submitter/config"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+)
+
+// Pool submits transactions from a pool of signers per chain. Each signer is a lane with its own nonce sequence
+// and bumping, so a stuck transaction only blocks the transactions behind it in the same lane.
+type Pool interface {
+	// Start starts the submitters of every lane and tops up lane balances from the funding key.
+	Start(ctx context.Context) error
+	// SubmitTransaction submits a transaction from the lane picked by the chain's lane selection policy.
+	// key is used by the sticky policy to send related transactions from the same lane, it is ignored otherwise.
+	// the lane address and the nonce are both needed to track the transaction.
+	SubmitTransaction(ctx context.Context, chainID *big.Int, key string, call ContractCallType) (lane common.Address, nonce uint64, err error)
+	// GetSubmissionStatus returns the status of a transaction submitted from a lane.
+	GetSubmissionStatus(ctx context.Context, chainID *big.Int, lane common.Address, nonce uint64) (status SubmissionStatus, err error)
+	// CancelTransaction cancels a pending transaction of a lane.
+	CancelTransaction(ctx context.Context, chainID *big.Int, lane common.Address, nonce uint64) error
+	// ReplaceTransaction replaces a pending transaction of a lane with a new call.
+	ReplaceTransaction(ctx context.Context, chainID *big.Int, lane common.Address, nonce uint64, call ContractCallType) error
+	// Lanes returns the lane addresses of a chain.
+	Lanes(chainID *big.Int) []common.Address
+}
+
+// ErrUnknownLane is returned when a lane is not part of the pool for a chain.
+var ErrUnknownLane = errors.New("unknown lane")
+
+// topUpInterval is how often lane balances are checked.
+const topUpInterval = time.Minute
+
+type poolImpl struct {
+	metrics metrics.Handler
+	fetcher ClientFetcher
+	config  config.IConfig
+	// submitters are the submitters of each signer, keyed by address.
+	submitters map[common.Address]*txSubmitterImpl
+	// chainLanes are the lane addresses of each chain.
+	chainLanes map[int][]common.Address
+	// funder submits lane top ups. It is nil if no funding key is set.
+	funder *txSubmitterImpl
+	// mux protects nextLane and topUps.
+	mux sync.Mutex
+	// nextLane is the index of the next lane of each chain for round robin selection.
+	nextLane map[int]int
+	// topUps are the funder nonces of the last top up of each lane, so a lane isn't topped up twice.
+	topUps map[laneKey]uint64
+}
+
+// laneKey identifies a lane on a chain.
+type laneKey struct {
+	chainID int
+	lane    common.Address
+}
+
+// NewPool creates a submitter pool from the signers of each chain. A signer can be a lane on more than one chain.
+// If funder is set, lanes whose balance falls below the chain's min lane balance are topped up from it.
+func NewPool(metrics metrics.Handler, fetcher ClientFetcher, db db.Service, config config.IConfig, funder signer.Signer, lanes map[int][]signer.Signer, opts ...Option) (Pool, error) {
+	pool := &poolImpl{
+		metrics:    metrics,
+		fetcher:    fetcher,
+		config:     config,
+		submitters: make(map[common.Address]*txSubmitterImpl),
+		chainLanes: make(map[int][]common.Address),
+		nextLane:   make(map[int]int),
+		topUps:     make(map[laneKey]uint64),
+	}
+
+	for chainID, signers := range lanes {
+		if len(signers) == 0 {
+			return nil, fmt.Errorf("no lanes for chain %d", chainID)
+		}
+
+		for _, laneSigner := range signers {
+			if _, ok := pool.submitters[laneSigner.Address()]; !ok {
+				pool.submitters[laneSigner.Address()] = newTxSubmitter(metrics, laneSigner, fetcher, db, config, opts...)
+			}
+			pool.chainLanes[chainID] = append(pool.chainLanes[chainID], laneSigner.Address())
+		}
+	}
+
+	if funder != nil {
+		// a funder that is also a lane shares its submitter, so its nonces are tracked in one place.
+		pool.funder = pool.submitters[funder.Address()]
+		if pool.funder == nil {
+			pool.funder = newTxSubmitter(metrics, funder, fetcher, db, config, opts...)
+		}
+	}
+
+	return pool, nil
+}
+
+func (p *poolImpl) Start(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+
+	started := make(map[common.Address]bool)
+	for address, laneSubmitter := range p.submitters {
+		laneSubmitter := laneSubmitter
+		started[address] = true
+		g.Go(func() error {
+			return laneSubmitter.Start(ctx)
+		})
+	}
+
+	if p.funder != nil {
+		if !started[p.funder.signer.Address()] {
+			g.Go(func() error {
+				return p.funder.Start(ctx)
+			})
+		}
+
+		g.Go(func() error {
+			for {
+				p.topUpLanes(ctx)
+
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-time.After(topUpInterval):
+				}
+			}
+		})
+	}
+
+	err := g.Wait()
+	if err != nil {
+		return fmt.Errorf("could not run pool: %w", err)
+	}
+	return nil
+}
+
+func (p *poolImpl) SubmitTransaction(parentCtx context.Context, chainID *big.Int, key string, call ContractCallType) (lane common.Address, nonce uint64, err error) {
+	ctx, span := p.metrics.Tracer().Start(parentCtx, "pool.SubmitTransaction", trace.WithAttributes(
+		attribute.Stringer("chainID", chainID),
+		attribute.String("key", key),
+	))
+
+	defer func() {
+		span.SetAttributes(attribute.Stringer("lane", lane))
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	lane, err = p.selectLane(ctx, chainID, key)
+	if err != nil {
+		return common.Address{}, 0, fmt.Errorf("could not select lane: %w", err)
+	}
+
+	nonce, err = p.submitters[lane].SubmitTransaction(ctx, chainID, call)
+	if err != nil {
+		return common.Address{}, 0, fmt.Errorf("could not submit transaction from %s: %w", lane, err)
+	}
+
+	return lane, nonce, nil
+}
+
+func (p *poolImpl) GetSubmissionStatus(ctx context.Context, chainID *big.Int, lane common.Address, nonce uint64) (status SubmissionStatus, err error) {
+	laneSubmitter, err := p.getLane(chainID, lane)
+	if err != nil {
+		return nil, err
+	}
+
+	return laneSubmitter.GetSubmissionStatus(ctx, chainID, nonce)
+}
+
+func (p *poolImpl) CancelTransaction(ctx context.Context, chainID *big.Int, lane common.Address, nonce uint64) error {
+	laneSubmitter, err := p.getLane(chainID, lane)
+	if err != nil {
+		return err
+	}
+
+	return laneSubmitter.CancelTransaction(ctx, chainID, nonce)
+}
+
+func (p *poolImpl) ReplaceTransaction(ctx context.Context, chainID *big.Int, lane common.Address, nonce uint64, call ContractCallType) error {
+	laneSubmitter, err := p.getLane(chainID, lane)
+	if err != nil {
+		return err
+	}
+
+	return laneSubmitter.ReplaceTransaction(ctx, chainID, nonce, call)
+}
+
+func (p *poolImpl) Lanes(chainID *big.Int) []common.Address {
+	return append([]common.Address{}, p.chainLanes[int(chainID.Uint64())]...)
+}
+
+// getLane gets the submitter of a lane on a chain.
+func (p *poolImpl) getLane(chainID *big.Int, lane common.Address) (*txSubmitterImpl, error) {
+	for _, address := range p.chainLanes[int(chainID.Uint64())] {
+		if address == lane {
+			return p.submitters[lane], nil
+		}
+	}
+	return nil, fmt.Errorf("could not get lane %s on chain %s: %w", lane, chainID, ErrUnknownLane)
+}
+
+// selectLane picks the lane of a transaction using the chain's lane selection policy.
+func (p *poolImpl) selectLane(ctx context.Context, chainID *big.Int, key string) (common.Address, error) {
+	chainLanes := p.chainLanes[int(chainID.Uint64())]
+	if len(chainLanes) == 0 {
+		return common.Address{}, fmt.Errorf("no lanes for chain %s", chainID)
+	}
+
+	switch policy := p.config.GetLaneSelection(int(chainID.Uint64())); policy {
+	case config.RoundRobinLaneSelection:
+		p.mux.Lock()
+		defer p.mux.Unlock()
+
+		index := p.nextLane[int(chainID.Uint64())] % len(chainLanes)
+		p.nextLane[int(chainID.Uint64())] = index + 1
+		return chainLanes[index], nil
+	case config.LeastPendingLaneSelection:
+		return p.leastPendingLane(ctx, chainID, chainLanes)
+	case config.StickyLaneSelection:
+		return chainLanes[stickyLaneIndex(key, len(chainLanes))], nil
+	default:
+		return common.Address{}, fmt.Errorf("unknown lane selection policy: %s", policy)
+	}
+}
+
+// leastPendingLane gets the lane with the fewest pending transactions. Ties go to the first lane.
+func (p *poolImpl) leastPendingLane(ctx context.Context, chainID *big.Int, chainLanes []common.Address) (common.Address, error) {
+	var lane common.Address
+	leastPending := -1
+	for _, address := range chainLanes {
+		pending, err := p.submitters[address].db.GetTXS(ctx, address, chainID, db.Stored, db.Pending, db.FailedSubmit, db.Submitted)
+		if err != nil {
+			return common.Address{}, fmt.Errorf("could not get pending txs of %s: %w", address, err)
+		}
+
+		if leastPending == -1 || len(pending) < leastPending {
+			lane = address
+			leastPending = len(pending)
+		}
+	}
+	return lane, nil
+}
+
+// stickyLaneIndex maps a key to a lane index. It is stable across restarts as long as the lanes don't change.
+func stickyLaneIndex(key string, laneCount int) int {
+	hasher := fnv.New32a()
+	_, _ = hasher.Write([]byte(key))
+	return int(hasher.Sum32() % uint32(laneCount))
+}
+
+// topUpLanes sends the top up amount to every lane whose balance is below the chain's min lane balance.
+// a lane isn't topped up again while its last top up is pending.
+func (p *poolImpl) topUpLanes(parentCtx context.Context) {
+	ctx, span := p.metrics.Tracer().Start(parentCtx, "pool.topUpLanes")
+	defer span.End()
+
+	for chainID, chainLanes := range p.chainLanes {
+		minBalance := p.config.GetLaneMinBalance(chainID)
+		if minBalance == nil {
+			continue
+		}
+
+		for _, lane := range chainLanes {
+			err := p.topUpLane(ctx, big.NewInt(int64(chainID)), lane, minBalance)
+			if err != nil {
+				span.AddEvent("could not top up lane", trace.WithAttributes(
+					attribute.String("error", err.Error()),
+					attribute.Int(metrics.ChainID, chainID),
+					attribute.Stringer("lane", lane),
+				))
+			}
+		}
+	}
+}
+
+func (p *poolImpl) topUpLane(ctx context.Context, chainID *big.Int, lane common.Address, minBalance *big.Int) error {
+	// the funder can't top itself up.
+	if lane == p.funder.signer.Address() {
+		return nil
+	}
+
+	key := laneKey{chainID: int(chainID.Uint64()), lane: lane}
+
+	p.mux.Lock()
+	lastTopUp, ok := p.topUps[key]
+	p.mux.Unlock()
+
+	if ok {
+		status, err := p.funder.GetSubmissionStatus(ctx, chainID, lastTopUp)
+		if err != nil {
+			return fmt.Errorf("could not get top up status: %w", err)
+		}
+		if status.State() == Pending || status.State() == Confirming {
+			return nil
+		}
+	}
+
+	chainClient, err := p.fetcher.GetClient(ctx, chainID)
+	if err != nil {
+		return fmt.Errorf("could not get client: %w", err)
+	}
+
+	balance, err := chainClient.BalanceAt(ctx, lane, nil)
+	if err != nil {
+		return fmt.Errorf("could not get balance: %w", err)
+	}
+
+	if balance.Cmp(minBalance) >= 0 {
+		return nil
+	}
+
+	amount := p.config.GetLaneTopUpAmount(int(chainID.Uint64()))
+	nonce, err := p.funder.SubmitTransaction(ctx, chainID, func(transactor *bind.TransactOpts) (*types.Transaction, error) {
+		tx := newTransferTx(chainID, transactor.Nonce.Uint64(), lane, amount, transactor.GasPrice, transactor.GasTipCap, transactor.GasFeeCap)
+		return transactor.Signer(transactor.From, tx)
+	})
+	if err != nil {
+		return fmt.Errorf("could not submit top up: %w", err)
+	}
+
+	p.mux.Lock()
+	p.topUps[key] = nonce
+	p.mux.Unlock()
+
+	return nil
+}
+
+var _ Pool = &poolImpl{}
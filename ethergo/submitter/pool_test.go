@@ -0,0 +1,142 @@
+package submitter_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/synapsecns/sanguine/ethergo/example"
+	"github.com/synapsecns/sanguine/ethergo/example/counter"
+	"github.com/synapsecns/sanguine/ethergo/manager"
+	"github.com/synapsecns/sanguine/ethergo/mocks"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer/localsigner"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/ethergo/submitter/config"
+)
+
+// newLaneSigners creates unfunded signers to use as lanes.
+func (s *SubmitterSuite) newLaneSigners(count int) []signer.Signer {
+	signers := make([]signer.Signer, count)
+	for i := range signers {
+		signers[i] = localsigner.NewSigner(mocks.MockAccount(s.T()).PrivateKey)
+	}
+	return signers
+}
+
+func (s *SubmitterSuite) TestPoolLaneSelection() {
+	_, cntr := manager.GetContract[*counter.CounterRef](s.GetTestContext(), s.T(),
+		s.deployer, s.testBackends[0], example.CounterType)
+
+	chainID := s.testBackends[0].GetBigChainID()
+	incrementCounter := func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		tx, err = cntr.IncrementCounter(transactor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to increment counter: %w", err)
+		}
+
+		return tx, nil
+	}
+
+	cfg := &config.Config{}
+	pool, err := submitter.NewPool(s.metrics, s, s.store, cfg, nil, map[int][]signer.Signer{
+		int(chainID.Uint64()): s.newLaneSigners(3),
+	})
+	s.Require().NoError(err)
+
+	lanes := pool.Lanes(chainID)
+	s.Require().Len(lanes, 3)
+
+	// round robin uses each lane in turn
+	for i := 0; i < 6; i++ {
+		lane, nonce, err := pool.SubmitTransaction(s.GetTestContext(), chainID, "", incrementCounter)
+		s.Require().NoError(err)
+		s.Equal(lanes[i%3], lane)
+		// nonces are tracked per lane
+		s.Equal(uint64(i/3), nonce)
+	}
+
+	// sticky sends every transaction with the same key from the same lane
+	cfg.LaneSelection = config.StickyLaneSelection
+	stickyLane, _, err := pool.SubmitTransaction(s.GetTestContext(), chainID, "quote-1", incrementCounter)
+	s.Require().NoError(err)
+	for i := 0; i < 3; i++ {
+		lane, _, err := pool.SubmitTransaction(s.GetTestContext(), chainID, "quote-1", incrementCounter)
+		s.Require().NoError(err)
+		s.Equal(stickyLane, lane)
+	}
+
+	// least pending picks the lanes the sticky transactions didn't use
+	cfg.LaneSelection = config.LeastPendingLaneSelection
+	lane, _, err := pool.SubmitTransaction(s.GetTestContext(), chainID, "", incrementCounter)
+	s.Require().NoError(err)
+	s.NotEqual(stickyLane, lane)
+
+	_, err = pool.GetSubmissionStatus(s.GetTestContext(), chainID, common.Address{}, 0)
+	s.Require().ErrorIs(err, submitter.ErrUnknownLane)
+}
+
+func (s *SubmitterSuite) TestPoolTopUp() {
+	_, cntr := manager.GetContract[*counter.CounterRef](s.GetTestContext(), s.T(),
+		s.deployer, s.testBackends[0], example.CounterType)
+
+	chainID := s.testBackends[0].GetBigChainID()
+
+	ogCounter, err := cntr.GetCount(&bind.CallOpts{
+		Context: s.GetTestContext(),
+	})
+	s.Require().NoError(err)
+
+	cfg := &config.Config{}
+	cfg.LaneMinBalance = big.NewInt(params.Ether)
+	// the lanes are unfunded, so they can only send once they're topped up by the funder.
+	pool, err := submitter.NewPool(s.metrics, s, s.store, cfg, s.signer, map[int][]signer.Signer{
+		int(chainID.Uint64()): s.newLaneSigners(2),
+	})
+	s.Require().NoError(err)
+
+	type submission struct {
+		lane  common.Address
+		nonce uint64
+	}
+	var submissions []submission
+	for i := 0; i < 2; i++ {
+		lane, nonce, err := pool.SubmitTransaction(s.GetTestContext(), chainID, "", func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+			tx, err = cntr.IncrementCounter(transactor)
+			if err != nil {
+				return nil, fmt.Errorf("failed to increment counter: %w", err)
+			}
+
+			return tx, nil
+		})
+		s.Require().NoError(err)
+		submissions = append(submissions, submission{lane: lane, nonce: nonce})
+	}
+	s.NotEqual(submissions[0].lane, submissions[1].lane)
+
+	go func() {
+		err = pool.Start(s.GetTestContext())
+		s.Require().NoError(err)
+	}()
+
+	s.Eventually(func() bool {
+		for _, sub := range submissions {
+			status, err := pool.GetSubmissionStatus(s.GetTestContext(), chainID, sub.lane, sub.nonce)
+			s.Require().NoError(err)
+
+			if status.State() != submitter.Confirmed {
+				return false
+			}
+		}
+		return true
+	})
+
+	currentCounter, err := cntr.GetCount(&bind.CallOpts{
+		Context: s.GetTestContext(),
+	})
+	s.Require().NoError(err)
+	s.Equal(ogCounter.Uint64()+2, currentCounter.Uint64())
+}
@@ -175,7 +175,10 @@ func (t *txSubmitterImpl) checkAndSetConfirmation(ctx context.Context, chainClie
 
 		for i := range callErr {
 			if callErr[i] != nil {
-				txes[i].Status = db.Replaced
+				// keep the reason a withdrawn attempt never landed.
+				if !txes[i].Status.IsSuperseded() {
+					txes[i].Status = db.Replaced
+				}
 			} else {
 				foundSuccessfulTX = true
 				txes[i].Status = db.Confirmed
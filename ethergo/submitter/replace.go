@@ -0,0 +1,187 @@
+package submitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/google/uuid"
+	"github.com/synapsecns/sanguine/core"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db"
+	"github.com/synapsecns/sanguine/ethergo/util"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ErrNotPending is returned when a transaction can't be cancelled or replaced because it is not pending anymore.
+var ErrNotPending = errors.New("transaction is not pending")
+
+func (t *txSubmitterImpl) CancelTransaction(parentCtx context.Context, chainID *big.Int, nonce uint64) (err error) {
+	ctx, span := t.metrics.Tracer().Start(parentCtx, "submitter.CancelTransaction", trace.WithAttributes(
+		attribute.Stringer("chainID", chainID),
+		attribute.Int64("nonce", int64(nonce)),
+	))
+
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	return t.replaceNonce(ctx, chainID, nonce, db.Cancelled, func(transactor *bind.TransactOpts) (*types.Transaction, error) {
+		tx := newCancellationTx(chainID, transactor.Nonce.Uint64(), transactor.From, transactor.GasPrice, transactor.GasTipCap, transactor.GasFeeCap)
+		return transactor.Signer(transactor.From, tx)
+	})
+}
+
+func (t *txSubmitterImpl) ReplaceTransaction(parentCtx context.Context, chainID *big.Int, nonce uint64, call ContractCallType) (err error) {
+	ctx, span := t.metrics.Tracer().Start(parentCtx, "submitter.ReplaceTransaction", trace.WithAttributes(
+		attribute.Stringer("chainID", chainID),
+		attribute.Int64("nonce", int64(nonce)),
+	))
+
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	return t.replaceNonce(ctx, chainID, nonce, db.Withdrawn, call)
+}
+
+// replaceNonce swaps the pending attempts of a nonce for the tx created by call. The new tx is priced as a bump of
+// the latest attempt so it can replace it in the mempool, the old attempts are marked with supersededStatus
+// so they're no longer bumped.
+// nolint: cyclop
+func (t *txSubmitterImpl) replaceNonce(ctx context.Context, chainID *big.Int, nonce uint64, supersededStatus db.Status, call ContractCallType) error {
+	chainClient, err := t.fetcher.GetClient(ctx, chainID)
+	if err != nil {
+		return fmt.Errorf("could not get client: %w", err)
+	}
+
+	// hold the nonce lock so a concurrent submission can't read the nonce while it's being replaced.
+	locker := t.nonceMux.Lock(chainID)
+	defer locker.Unlock()
+
+	attempts, err := t.db.GetNonceAttemptsByStatus(ctx, t.signer.Address(), chainID, nonce, db.Stored, db.Pending, db.FailedSubmit, db.Submitted)
+	if err != nil {
+		return fmt.Errorf("could not get nonce attempts: %w", err)
+	}
+
+	if len(attempts) == 0 {
+		return fmt.Errorf("could not replace nonce %d on chain %s: %w", nonce, chainID, ErrNotPending)
+	}
+
+	// the latest attempt is the one the queue would bump next.
+	prevTx := attempts[0]
+	for _, attempt := range attempts[1:] {
+		if attempt.CreationTime().After(prevTx.CreationTime()) {
+			prevTx = attempt
+		}
+	}
+
+	parentTransactor, err := t.signer.GetTransactor(ctx, core.CopyBigInt(chainID))
+	if err != nil {
+		return fmt.Errorf("could not get transactor: %w", err)
+	}
+
+	transactor := copyTransactOpts(parentTransactor)
+	transactor.NoSend = true
+	transactor.Nonce = new(big.Int).SetUint64(nonce)
+
+	err = t.setGasPrice(ctx, chainClient, transactor, chainID, prevTx.Transaction)
+	if err != nil {
+		return fmt.Errorf("could not set gas price: %w", err)
+	}
+	if !t.config.GetDynamicGasEstimate(int(chainID.Uint64())) {
+		transactor.GasLimit = t.config.GetGasEstimate(int(chainID.Uint64()))
+	}
+
+	transactor.Signer = func(address common.Address, transaction *types.Transaction) (*types.Transaction, error) {
+		txType := transaction.Type()
+		if t.config.SupportsEIP1559(int(chainID.Uint64())) {
+			txType = types.DynamicFeeTxType
+		}
+
+		// the call can't change the nonce that's being replaced.
+		transaction, err := util.CopyTX(transaction, util.WithNonce(nonce), util.WithTxType(txType))
+		if err != nil {
+			return nil, fmt.Errorf("could not copy tx: %w", err)
+		}
+
+		transaction, err = t.capTotalFee(ctx, chainClient, chainID, address, transaction)
+		if err != nil {
+			return nil, fmt.Errorf("could not cap total fee: %w", err)
+		}
+
+		//nolint: wrapcheck
+		return parentTransactor.Signer(address, transaction)
+	}
+
+	tx, err := call(transactor)
+	if err != nil {
+		return fmt.Errorf("could not call contract: %w", err)
+	}
+
+	for i := range attempts {
+		attempts[i].Status = supersededStatus
+	}
+
+	// the new tx is stored last so it's picked up as the latest attempt for the nonce.
+	err = t.db.DBTransaction(ctx, func(ctx context.Context, svc db.Service) error {
+		err := svc.PutTXS(ctx, attempts...)
+		if err != nil {
+			return fmt.Errorf("could not mark attempts as %s: %w", supersededStatus, err)
+		}
+
+		err = svc.PutTXS(ctx, db.NewTX(tx, db.Stored, uuid.New().String()))
+		if err != nil {
+			return fmt.Errorf("could not store transaction: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not replace nonce %d: %w", nonce, err)
+	}
+
+	t.triggerProcessQueue(ctx)
+
+	return nil
+}
+
+// newCancellationTx creates an unsigned zero value transfer from the signer to itself. It is a dynamic fee tx if
+// gasFeeCap is set, otherwise a legacy tx priced at gasPrice.
+func newCancellationTx(chainID *big.Int, nonce uint64, signer common.Address, gasPrice, gasTipCap, gasFeeCap *big.Int) *types.Transaction {
+	return newTransferTx(chainID, nonce, signer, big.NewInt(0), gasPrice, gasTipCap, gasFeeCap)
+}
+
+// newTransferTx creates an unsigned transfer of value to an address. It is a dynamic fee tx if gasFeeCap is set,
+// otherwise a legacy tx priced at gasPrice.
+func newTransferTx(chainID *big.Int, nonce uint64, to common.Address, value, gasPrice, gasTipCap, gasFeeCap *big.Int) *types.Transaction {
+	if gasFeeCap != nil {
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:   core.CopyBigInt(chainID),
+			Nonce:     nonce,
+			GasTipCap: core.CopyBigInt(gasTipCap),
+			GasFeeCap: core.CopyBigInt(gasFeeCap),
+			Gas:       params.TxGas,
+			To:        &to,
+			Value:     core.CopyBigInt(value),
+		})
+	}
+
+	return types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: core.CopyBigInt(gasPrice),
+		Gas:      params.TxGas,
+		To:       &to,
+		Value:    core.CopyBigInt(value),
+	})
+}
+
+// isCancellation returns true if the tx is a cancellation, i.e. a zero value transfer from the signer to itself.
+func isCancellation(tx *types.Transaction, signer common.Address) bool {
+	return tx.To() != nil && *tx.To() == signer && tx.Value().Sign() == 0 && len(tx.Data()) == 0
+}
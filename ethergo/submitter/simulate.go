@@ -0,0 +1,173 @@
+package submitter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/google/uuid"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db"
+	"github.com/synapsecns/sanguine/ethergo/util"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	// errorSelector is the selector of Error(string), used by require and revert with a message.
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	// panicSelector is the selector of Panic(uint256), used by failing asserts, overflows, etc.
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// simulate runs the tx against pending state. It returns the decoded revert reason if the tx reverts.
+// other errors, e.g. the rpc being unavailable, are not treated as reverts.
+func (c *chainQueue) simulate(parentCtx context.Context, tx *types.Transaction) (reason string, reverted bool) {
+	ctx, span := c.metrics.Tracer().Start(parentCtx, "chainPendingQueue.simulate", trace.WithAttributes(attribute.Stringer(metrics.TxHash, tx.Hash())))
+	defer func() {
+		span.SetAttributes(attribute.Bool("reverted", reverted), attribute.String("reason", reason))
+		span.End()
+	}()
+
+	call, err := util.TxToCall(tx)
+	if err != nil {
+		span.AddEvent("could not convert tx to call", trace.WithAttributes(attribute.String("error", err.Error())))
+		return "", false
+	}
+
+	_, err = c.client.PendingCallContract(ctx, *call)
+	if err == nil {
+		return "", false
+	}
+
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if data, ok := revertData(dataErr); ok {
+			return c.decodeRevert(data), true
+		}
+	}
+
+	if strings.Contains(err.Error(), "execution reverted") {
+		return err.Error(), true
+	}
+
+	span.AddEvent("could not simulate tx", trace.WithAttributes(attribute.String("error", err.Error())))
+	return "", false
+}
+
+// revertData gets the revert data from an rpc error.
+func revertData(dataErr rpc.DataError) ([]byte, bool) {
+	hexData, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return nil, false
+	}
+
+	data, err := hexutil.Decode(hexData)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// decodeRevert decodes revert data into a readable reason. Custom errors are decoded with the registered abis.
+func (t *txSubmitterImpl) decodeRevert(data []byte) string {
+	if len(data) < 4 {
+		return "reverted without data"
+	}
+
+	selector := data[:4]
+	switch {
+	case bytes.Equal(selector, errorSelector):
+		reason, err := abi.UnpackRevert(data)
+		if err == nil {
+			return reason
+		}
+	case bytes.Equal(selector, panicSelector):
+		if len(data) == 36 {
+			return fmt.Sprintf("panic: 0x%x", new(big.Int).SetBytes(data[4:]))
+		}
+	}
+
+	for _, parsed := range t.errorABIs {
+		for _, abiErr := range parsed.Errors {
+			if !bytes.Equal(abiErr.ID[:4], selector) {
+				continue
+			}
+
+			args, err := abiErr.Unpack(data)
+			if err != nil {
+				continue
+			}
+			return fmt.Sprintf("%s%v", abiErr.Name, args)
+		}
+	}
+
+	return fmt.Sprintf("custom error: %s", hexutil.Encode(data))
+}
+
+// simulateAndQueue adds tx, the next attempt for ogTx's nonce, to the reprocess queue. If simulation is enabled and tx
+// would revert, ogTx is marked as reverted and a cancellation takes over the nonce so later nonces aren't blocked.
+//
+// Simulation runs against pending state, so a tx that depends on a lower nonce that hasn't been broadcast yet
+// (e.g. a transfer after an approval) can revert in simulation and still succeed. Reverts are only final once
+// every lower nonce has been broadcast.
+func (c *chainQueue) simulateAndQueue(ctx context.Context, ogTx db.TX, tx db.TX, lowerNoncesBroadcast bool) error {
+	if !c.config.GetSimulate(c.chainIDInt()) || !lowerNoncesBroadcast || isCancellation(tx.Transaction, c.signer.Address()) {
+		c.addToReprocessQueue(tx)
+		return nil
+	}
+
+	reason, reverted := c.simulate(ctx, tx.Transaction)
+	if !reverted {
+		c.addToReprocessQueue(tx)
+		return nil
+	}
+
+	transactor, err := c.signer.GetTransactor(ctx, c.chainID)
+	if err != nil {
+		return fmt.Errorf("could not get transactor: %w", err)
+	}
+
+	// the cancellation is priced like the tx it takes the place of.
+	var gasTipCap, gasFeeCap *big.Int
+	if tx.Type() == types.DynamicFeeTxType {
+		gasTipCap, gasFeeCap = tx.GasTipCap(), tx.GasFeeCap()
+	}
+
+	cancellation, err := transactor.Signer(transactor.From, newCancellationTx(c.chainID, tx.Nonce(), transactor.From, tx.GasPrice(), gasTipCap, gasFeeCap))
+	if err != nil {
+		return fmt.Errorf("could not sign cancellation: %w", err)
+	}
+
+	ogTx.Status = db.Reverted
+	ogTx.RevertReason = reason
+	cancellationTX := db.NewTX(cancellation, db.Stored, uuid.New().String())
+
+	// both are stored together so the nonce is never left without a pending tx.
+	err = c.db.DBTransaction(ctx, func(ctx context.Context, svc db.Service) error {
+		err := svc.PutTXS(ctx, ogTx)
+		if err != nil {
+			return fmt.Errorf("could not mark tx as reverted: %w", err)
+		}
+
+		err = svc.PutTXS(ctx, cancellationTX)
+		if err != nil {
+			return fmt.Errorf("could not store cancellation: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not store reverted tx %s: %w", ogTx.Hash(), err)
+	}
+
+	c.addToReprocessQueue(cancellationTX)
+	return nil
+}
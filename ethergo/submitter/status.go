@@ -18,21 +18,31 @@
 	Confirming // confirming
 	// Confirmed indicates that the submission is confirmed and txhash data is available.
 	Confirmed // confirmed
+	// Cancelled indicates that the submission was cancelled. The nonce was used by a cancellation tx, whose
+	// txhash is available.
+	Cancelled // cancelled
+	// Reverted indicates that the submission was not sent because it reverted in simulation. The revert reason
+	// is available.
+	Reverted // reverted
 )
 
 // SubmissionStatus is the status of a submission.
 type SubmissionStatus interface {
 	// State is the state of the submission.
 	State() SubmissionState
-	// HasTx indicates whether the submission has a transaction.
+	// HasTx indicates whether the submission has a transaction. This is only true once the submitted call is confirmed.
 	HasTx() bool
-	// TxHash is the hash of the transaction. This will be the zero hash if HasTx is false.
+	// TxHash is the hash of the transaction. This will be the zero hash unless the state is Confirmed, or Cancelled in
+	// which case it's the hash of the cancellation tx.
 	TxHash() common.Hash
+	// RevertReason is the decoded revert reason. This will be empty unless the state is Reverted.
+	RevertReason() string
 }
 
 type submissionStatusImpl struct {
-	state  SubmissionState
-	txHash common.Hash
+	state        SubmissionState
+	txHash       common.Hash
+	revertReason string
 }
 
 func (s submissionStatusImpl) State() SubmissionState {
@@ -47,4 +57,8 @@ func (s submissionStatusImpl) TxHash() common.Hash {
 	return s.txHash
 }
 
+func (s submissionStatusImpl) RevertReason() string {
+	return s.revertReason
+}
+
 var _ SubmissionStatus = &submissionStatusImpl{}
@@ -14,6 +14,7 @@
 	"github.com/google/uuid"
 	"github.com/puzpuzpuz/xsync/v2"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -45,6 +46,21 @@ type TransactionSubmitter interface {
 	SubmitTransaction(ctx context.Context, chainID *big.Int, call ContractCallType) (nonce uint64, err error)
 	// GetSubmissionStatus returns the status of a transaction and any metadata associated with it if it is complete.
 	GetSubmissionStatus(ctx context.Context, chainID *big.Int, nonce uint64) (status SubmissionStatus, err error)
+	// CancelTransaction cancels a pending transaction by replacing it with a zero value transfer to the signer
+	// at a bumped gas price. If the original transaction lands first, the submission will still confirm.
+	CancelTransaction(ctx context.Context, chainID *big.Int, nonce uint64) error
+	// ReplaceTransaction replaces a pending transaction with a new call for the same nonce at a bumped gas price.
+	ReplaceTransaction(ctx context.Context, chainID *big.Int, nonce uint64, call ContractCallType) error
+	// Subscribe streams the events of every submission, starting after the event with id afterID.
+	// Events are replayed from the db, so passing the id of the last event handled resumes the stream across restarts.
+	// The channel is closed when ctx is cancelled.
+	Subscribe(ctx context.Context, afterID uint64) <-chan Event
+	// SubscribeSubmission streams the events of a single submission from its first event.
+	// The channel is closed after the final event or when ctx is cancelled.
+	SubscribeSubmission(ctx context.Context, chainID *big.Int, nonce uint64) <-chan Event
+	// OnComplete calls callback with the final event of a submission once it completes.
+	// The callback is not called if ctx is cancelled first.
+	OnComplete(ctx context.Context, chainID *big.Int, nonce uint64, callback func(Event))
 }
 
 // txSubmitterImpl is the implementation of the transaction submitter.
@@ -71,6 +87,8 @@ type txSubmitterImpl struct {
 	lastGasBlockCache *xsync.MapOf[int, *types.Header]
 	// config is the config for the transaction submitter.
 	config config.IConfig
+	// errorABIs are the abis used to decode custom errors when a simulation reverts.
+	errorABIs []*abi.ABI
 }
 
 // ClientFetcher is the interface for fetching a chain client.
@@ -80,9 +98,23 @@ type ClientFetcher interface {
 	GetClient(ctx context.Context, chainID *big.Int) (client.EVM, error)
 }
 
+// Option is an option for the transaction submitter.
+type Option func(*txSubmitterImpl)
+
+// WithErrorABIs registers abis whose custom errors are decoded when a transaction reverts in simulation.
+func WithErrorABIs(abis ...*abi.ABI) Option {
+	return func(t *txSubmitterImpl) {
+		t.errorABIs = append(t.errorABIs, abis...)
+	}
+}
+
 // NewTransactionSubmitter creates a new transaction submitter.
-func NewTransactionSubmitter(metrics metrics.Handler, signer signer.Signer, fetcher ClientFetcher, db db.Service, config config.IConfig) TransactionSubmitter {
-	return &txSubmitterImpl{
+func NewTransactionSubmitter(metrics metrics.Handler, signer signer.Signer, fetcher ClientFetcher, db db.Service, config config.IConfig, opts ...Option) TransactionSubmitter {
+	return newTxSubmitter(metrics, signer, fetcher, db, config, opts...)
+}
+
+func newTxSubmitter(metrics metrics.Handler, signer signer.Signer, fetcher ClientFetcher, db db.Service, config config.IConfig, opts ...Option) *txSubmitterImpl {
+	txSubmitter := &txSubmitterImpl{
 		db:                db,
 		config:            config,
 		metrics:           metrics,
@@ -93,6 +125,12 @@ func NewTransactionSubmitter(metrics metrics.Handler, signer signer.Signer, fetc
 		retryNow:          make(chan bool, 1),
 		lastGasBlockCache: xsync.NewIntegerMapOf[int, *types.Header](),
 	}
+
+	for _, opt := range opts {
+		opt(txSubmitter)
+	}
+
+	return txSubmitter
 }
 
 // GetRetryInterval returns the retry interval for the transaction submitter.
@@ -131,12 +169,7 @@ func (t *txSubmitterImpl) GetSubmissionStatus(ctx context.Context, chainID *big.
 		return nil, fmt.Errorf("could not get nonce status: %w", err)
 	}
 
-	if nonceStatus == db.ReplacedOrConfirmed {
-		return submissionStatusImpl{
-			state: Confirming,
-		}, nil
-	}
-
+	var confirmedTX db.TX
 	if nonceStatus == db.Confirmed {
 		txs, err := t.db.GetNonceAttemptsByStatus(ctx, t.signer.Address(), chainID, nonce, db.Confirmed)
 		if err != nil {
@@ -147,15 +180,43 @@ func (t *txSubmitterImpl) GetSubmissionStatus(ctx context.Context, chainID *big.
 			return nil, fmt.Errorf("unexpected error: no transactions found for nonce %d", nonce)
 		}
 
+		confirmedTX = txs[0]
+		if !isCancellation(confirmedTX.Transaction, t.signer.Address()) {
+			return submissionStatusImpl{
+				state:  Confirmed,
+				txHash: confirmedTX.Hash(),
+			}, nil
+		}
+	}
+
+	// a nonce that reverted in simulation is filled by a cancellation, so the revert is reported instead.
+	reverted, err := t.db.GetNonceAttemptsByStatus(ctx, t.signer.Address(), chainID, nonce, db.Reverted)
+	if err != nil {
+		return nil, fmt.Errorf("could not get nonce attempts by status: %w", err)
+	}
+
+	if len(reverted) > 0 {
 		return submissionStatusImpl{
-			state:  Confirmed,
-			txHash: txs[0].Hash(),
+			state:        Reverted,
+			revertReason: reverted[0].RevertReason,
 		}, nil
 	}
 
-	return submissionStatusImpl{
-		state: Pending,
-	}, nil
+	switch nonceStatus {
+	case db.Confirmed:
+		return submissionStatusImpl{
+			state:  Cancelled,
+			txHash: confirmedTX.Hash(),
+		}, nil
+	case db.ReplacedOrConfirmed:
+		return submissionStatusImpl{
+			state: Confirming,
+		}, nil
+	default:
+		return submissionStatusImpl{
+			state: Pending,
+		}, nil
+	}
 }
 
 func (t *txSubmitterImpl) getNonce(parentCtx context.Context, chainID *big.Int, address common.Address) (_ uint64, err error) {
@@ -318,6 +379,11 @@ func (t *txSubmitterImpl) SubmitTransaction(parentCtx context.Context, chainID *
 			return nil, fmt.Errorf("could not copy tx: %w", err)
 		}
 
+		transaction, err = t.capTotalFee(ctx, chainClient, chainID, address, transaction)
+		if err != nil {
+			return nil, fmt.Errorf("could not cap total fee: %w", err)
+		}
+
 		//nolint: wrapcheck
 		return parentTransactor.Signer(address, transaction)
 	}
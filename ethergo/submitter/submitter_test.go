@@ -3,10 +3,14 @@
 import (
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/mock"
 	"github.com/synapsecns/sanguine/core/testsuite"
 	clientMocks "github.com/synapsecns/sanguine/ethergo/client/mocks"
@@ -300,3 +304,226 @@ func (s *SubmitterSuite) TestCheckAndSetConfirmationSingleTx() {
 		}
 	}
 }
+
+func (s *SubmitterSuite) TestCancelTransaction() {
+	_, cntr := manager.GetContract[*counter.CounterRef](s.GetTestContext(), s.T(),
+		s.deployer, s.testBackends[0], example.CounterType)
+
+	cfg := &config.Config{}
+	chainID := s.testBackends[0].GetBigChainID()
+
+	ogCounter, err := cntr.GetCount(&bind.CallOpts{
+		Context: s.GetTestContext(),
+	})
+	s.Require().NoError(err)
+
+	ts := submitter.NewTestTransactionSubmitter(s.metrics, s.signer, s, s.store, cfg)
+	nonce, err := ts.SubmitTransaction(s.GetTestContext(), chainID, func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		tx, err = cntr.IncrementCounter(transactor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to increment counter: %w", err)
+		}
+
+		return tx, nil
+	})
+	s.Require().NoError(err)
+
+	err = ts.CancelTransaction(s.GetTestContext(), chainID, nonce)
+	s.Require().NoError(err)
+
+	// the original tx should be kept for auditing
+	cancelled, err := s.store.GetNonceAttemptsByStatus(s.GetTestContext(), s.signer.Address(), chainID, nonce, db.Cancelled)
+	s.Require().NoError(err)
+	s.Require().Len(cancelled, 1)
+
+	txs, err := s.store.GetTXS(s.GetTestContext(), s.signer.Address(), chainID, db.Stored)
+	s.Require().NoError(err)
+	s.Require().Len(txs, 1)
+	s.Equal(s.signer.Address(), *txs[0].To())
+	s.Equal(uint64(0), txs[0].Value().Uint64())
+	s.Empty(txs[0].Data())
+
+	go func() {
+		err = ts.Start(s.GetTestContext())
+		s.Require().NoError(err)
+	}()
+
+	s.Eventually(func() bool {
+		status, err := ts.GetSubmissionStatus(s.GetTestContext(), chainID, nonce)
+		s.Require().NoError(err)
+
+		return status.State() == submitter.Cancelled
+	})
+
+	// the cancellation tx is available, but the submitted call never landed
+	status, err := ts.GetSubmissionStatus(s.GetTestContext(), chainID, nonce)
+	s.Require().NoError(err)
+	s.False(status.HasTx())
+	s.NotEqual(common.Hash{}, status.TxHash())
+
+	currentCounter, err := cntr.GetCount(&bind.CallOpts{
+		Context: s.GetTestContext(),
+	})
+	s.Require().NoError(err)
+	s.Equal(ogCounter.Uint64(), currentCounter.Uint64())
+
+	// a confirmed nonce can't be cancelled again
+	err = ts.CancelTransaction(s.GetTestContext(), chainID, nonce)
+	s.Require().ErrorIs(err, submitter.ErrNotPending)
+}
+
+func (s *SubmitterSuite) TestReplaceTransaction() {
+	_, cntr := manager.GetContract[*counter.CounterRef](s.GetTestContext(), s.T(),
+		s.deployer, s.testBackends[0], example.CounterType)
+
+	cfg := &config.Config{}
+	chainID := s.testBackends[0].GetBigChainID()
+
+	ogCounter, err := cntr.GetCount(&bind.CallOpts{
+		Context: s.GetTestContext(),
+	})
+	s.Require().NoError(err)
+
+	ts := submitter.NewTestTransactionSubmitter(s.metrics, s.signer, s, s.store, cfg)
+	nonce, err := ts.SubmitTransaction(s.GetTestContext(), chainID, func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		tx, err = cntr.IncrementCounter(transactor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to increment counter: %w", err)
+		}
+
+		return tx, nil
+	})
+	s.Require().NoError(err)
+
+	err = ts.ReplaceTransaction(s.GetTestContext(), chainID, nonce, func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		tx, err = cntr.DecrementCounter(transactor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrement counter: %w", err)
+		}
+
+		return tx, nil
+	})
+	s.Require().NoError(err)
+
+	withdrawn, err := s.store.GetNonceAttemptsByStatus(s.GetTestContext(), s.signer.Address(), chainID, nonce, db.Withdrawn)
+	s.Require().NoError(err)
+	s.Require().Len(withdrawn, 1)
+
+	go func() {
+		err = ts.Start(s.GetTestContext())
+		s.Require().NoError(err)
+	}()
+
+	// only the replacement should land
+	s.Eventually(func() bool {
+		currentCounter, err := cntr.GetCount(&bind.CallOpts{
+			Context: s.GetTestContext(),
+		})
+		s.Require().NoError(err)
+
+		return currentCounter.Int64() == ogCounter.Int64()-1
+	})
+}
+
+const deadlineErrorABI = `[{"type":"error","name":"DeadlineExceeded","inputs":[{"name":"deadline","type":"uint256"}]}]`
+
+func (s *SubmitterSuite) TestDecodeRevert() {
+	parsed, err := abi.JSON(strings.NewReader(deadlineErrorABI))
+	s.Require().NoError(err)
+
+	cfg := &config.Config{}
+	ts := submitter.NewTestTransactionSubmitter(s.metrics, s.signer, s, s.store, cfg, submitter.WithErrorABIs(&parsed))
+
+	stringType, err := abi.NewType("string", "", nil)
+	s.Require().NoError(err)
+	uintType, err := abi.NewType("uint256", "", nil)
+	s.Require().NoError(err)
+
+	// Error(string)
+	reason, err := abi.Arguments{{Type: stringType}}.Pack("relay already filled")
+	s.Require().NoError(err)
+	s.Equal("relay already filled", ts.DecodeRevert(append(crypto.Keccak256([]byte("Error(string)"))[:4], reason...)))
+
+	// Panic(uint256)
+	code, err := abi.Arguments{{Type: uintType}}.Pack(big.NewInt(0x11))
+	s.Require().NoError(err)
+	s.Equal("panic: 0x11", ts.DecodeRevert(append(crypto.Keccak256([]byte("Panic(uint256)"))[:4], code...)))
+
+	// registered custom error
+	deadline, err := abi.Arguments{{Type: uintType}}.Pack(big.NewInt(5))
+	s.Require().NoError(err)
+	s.Equal("DeadlineExceeded[5]", ts.DecodeRevert(append(parsed.Errors["DeadlineExceeded"].ID.Bytes()[:4], deadline...)))
+
+	// unknown custom error
+	s.Equal("custom error: 0xdeadbeef", ts.DecodeRevert(common.FromHex("0xdeadbeef")))
+	s.Equal("reverted without data", ts.DecodeRevert(nil))
+}
+
+func (s *SubmitterSuite) TestSimulateRevert() {
+	_, cntr := manager.GetContract[*counter.CounterRef](s.GetTestContext(), s.T(),
+		s.deployer, s.testBackends[0], example.CounterType)
+
+	cfg := &config.Config{}
+	cfg.Simulate = true
+	chainID := s.testBackends[0].GetBigChainID()
+
+	ogCounter, err := cntr.GetCount(&bind.CallOpts{
+		Context: s.GetTestContext(),
+	})
+	s.Require().NoError(err)
+
+	ts := submitter.NewTestTransactionSubmitter(s.metrics, s.signer, s, s.store, cfg)
+	// the counter has no fallback, so an unknown selector reverts.
+	revertingNonce, err := ts.SubmitTransaction(s.GetTestContext(), chainID, func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		to := cntr.Address()
+		tx, err = transactor.Signer(transactor.From, types.NewTx(&types.LegacyTx{
+			Nonce:    transactor.Nonce.Uint64(),
+			GasPrice: transactor.GasPrice,
+			Gas:      transactor.GasLimit,
+			To:       &to,
+			Value:    big.NewInt(0),
+			Data:     common.FromHex("0xdeadbeef"),
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign tx: %w", err)
+		}
+
+		return tx, nil
+	})
+	s.Require().NoError(err)
+
+	// make sure the reverting tx doesn't block the next nonce
+	nonce, err := ts.SubmitTransaction(s.GetTestContext(), chainID, func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		tx, err = cntr.IncrementCounter(transactor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to increment counter: %w", err)
+		}
+
+		return tx, nil
+	})
+	s.Require().NoError(err)
+
+	go func() {
+		err = ts.Start(s.GetTestContext())
+		s.Require().NoError(err)
+	}()
+
+	s.Eventually(func() bool {
+		status, err := ts.GetSubmissionStatus(s.GetTestContext(), chainID, nonce)
+		s.Require().NoError(err)
+
+		return status.State() == submitter.Confirmed
+	})
+
+	status, err := ts.GetSubmissionStatus(s.GetTestContext(), chainID, revertingNonce)
+	s.Require().NoError(err)
+	s.Equal(submitter.Reverted, status.State())
+	s.NotEmpty(status.RevertReason())
+	s.False(status.HasTx())
+
+	currentCounter, err := cntr.GetCount(&bind.CallOpts{
+		Context: s.GetTestContext(),
+	})
+	s.Require().NoError(err)
+	s.Equal(ogCounter.Uint64()+1, currentCounter.Uint64())
+}
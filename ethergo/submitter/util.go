@@ -141,3 +141,26 @@ func groupTxesByNonce(txs []db.TX) map[uint64][]db.TX {
 
 	return txesByNonce
 }
+
+// lowerNoncesBroadcast returns, for each tx, whether every nonce from currentNonce up to the tx's nonce has been broadcast.
+// txs that depend on an earlier tx of the same signer can only be simulated once it has been broadcast.
+// txs must be sorted by nonce.
+func lowerNoncesBroadcast(currentNonce uint64, txs []db.TX) []bool {
+	broadcast := make(map[uint64]bool)
+	for _, tx := range txs {
+		if tx.Status == db.Submitted {
+			broadcast[tx.Nonce()] = true
+		}
+	}
+
+	res := make([]bool, len(txs))
+	allBroadcast := true
+	nextNonce := currentNonce
+	for i, tx := range txs {
+		for ; allBroadcast && nextNonce < tx.Nonce(); nextNonce++ {
+			allBroadcast = broadcast[nextNonce]
+		}
+		res[i] = allBroadcast
+	}
+	return res
+}
@@ -277,6 +277,53 @@ func (s *SubmitterSuite) TestGroupTxesByNonce() {
 	}
 }
 
+func TestLowerNoncesBroadcast(t *testing.T) {
+	newTX := func(nonce uint64, status db.Status) db.TX {
+		return db.TX{
+			Transaction: types.NewTx(&types.LegacyTx{Nonce: nonce}),
+			Status:      status,
+		}
+	}
+
+	// the first tx only depends on confirmed nonces
+	txes := []db.TX{newTX(5, db.Stored), newTX(6, db.Stored)}
+	assert.DeepEqual(t, []bool{true, false}, submitter.LowerNoncesBroadcast(5, txes))
+
+	// broadcast txes don't block later nonces
+	txes = []db.TX{newTX(5, db.Submitted), newTX(6, db.Submitted), newTX(7, db.Stored), newTX(8, db.Stored)}
+	assert.DeepEqual(t, []bool{true, true, true, false}, submitter.LowerNoncesBroadcast(5, txes))
+
+	// a missing nonce blocks everything after it
+	txes = []db.TX{newTX(5, db.Submitted), newTX(7, db.Stored)}
+	assert.DeepEqual(t, []bool{true, false}, submitter.LowerNoncesBroadcast(5, txes))
+
+	// so does a tx that failed to submit
+	txes = []db.TX{newTX(5, db.FailedSubmit), newTX(6, db.Stored)}
+	assert.DeepEqual(t, []bool{true, false}, submitter.LowerNoncesBroadcast(5, txes))
+}
+
+func TestNewBumpedTX(t *testing.T) {
+	transactor := &bind.TransactOpts{
+		GasPrice:  big.NewInt(30),
+		GasTipCap: big.NewInt(20),
+		GasFeeCap: big.NewInt(200),
+		GasLimit:  50_000,
+	}
+
+	legacyTX, err := submitter.NewBumpedTX(types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(10), Gas: 21_000}), transactor)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(1), legacyTX.Nonce())
+	assert.Equal(t, int64(30), legacyTX.GasPrice().Int64())
+	assert.Equal(t, uint64(50_000), legacyTX.Gas())
+
+	// dynamic fee txs are priced from the bumped transactor, not the tx being replaced
+	dynamicTX, err := submitter.NewBumpedTX(types.NewTx(&types.DynamicFeeTx{ChainID: big.NewInt(1), Nonce: 1, GasTipCap: big.NewInt(10), GasFeeCap: big.NewInt(100), Gas: 21_000}), transactor)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(20), dynamicTX.GasTipCap().Int64())
+	assert.Equal(t, int64(200), dynamicTX.GasFeeCap().Int64())
+	assert.Equal(t, uint64(50_000), dynamicTX.Gas())
+}
+
 func makeAttrMap(tx *types.Transaction, UUID string) map[string]attribute.Value {
 	mapAttr := make(map[string]attribute.Value)
 	attr := submitter.TxToAttributes(tx, UUID)
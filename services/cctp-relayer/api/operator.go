@@ -0,0 +1,362 @@
+package api
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	submitterDB "github.com/synapsecns/sanguine/ethergo/submitter/db"
+	db2 "github.com/synapsecns/sanguine/services/cctp-relayer/db"
+	relayTypes "github.com/synapsecns/sanguine/services/cctp-relayer/types"
+	"golang.org/x/exp/slices"
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultPageSize is the number of messages listed per page if no page size is given.
+	defaultPageSize = 50
+	// maxPageSize is the largest page of messages that can be listed.
+	maxPageSize = 500
+)
+
+// TimelineEntry is a state transition of a message.
+type TimelineEntry struct {
+	State     string `json:"state"`
+	Timestamp int64  `json:"timestamp"`
+	TxHash    string `json:"tx_hash,omitempty"`
+	Nonce     int    `json:"nonce"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// SubmitterAttempt is an attempt by the tx submitter to land the destination transaction.
+type SubmitterAttempt struct {
+	TxHash string `json:"tx_hash"`
+	Status string `json:"status"`
+}
+
+// SubmitterResult is the tx submitter's view of the destination transaction.
+type SubmitterResult struct {
+	Nonce    uint64             `json:"nonce"`
+	Status   string             `json:"status"`
+	Attempts []SubmitterAttempt `json:"attempts"`
+}
+
+// MessageTimelineResult is the result of a successful /messages/:hash request.
+type MessageTimelineResult struct {
+	Message   MessageResult    `json:"message"`
+	Timeline  []TimelineEntry  `json:"timeline"`
+	Submitter *SubmitterResult `json:"submitter,omitempty"`
+}
+
+// StatsResult is the result of a successful /stats request.
+type StatsResult struct {
+	// States is the number of messages in each state.
+	States map[string]int64 `json:"states"`
+	// Chains is the number of messages in each state by destination chain.
+	Chains map[uint32]map[string]int64 `json:"chains"`
+}
+
+// operatorAction is a manual state change an operator can make to a message.
+type operatorAction struct {
+	name string
+	// from are the states the action can be taken from.
+	from []relayTypes.MessageState
+	// to is the state the message is moved to.
+	to relayTypes.MessageState
+}
+
+var (
+	// retryAction resubmits the destination transaction using the stored attestation.
+	retryAction = operatorAction{
+		name: "retry",
+		from: []relayTypes.MessageState{relayTypes.Attested, relayTypes.Submitted, relayTypes.Parked, relayTypes.Skipped},
+		to:   relayTypes.Attested,
+	}
+	// skipAction stops the relayer from relaying the message. Submitted messages can't be skipped since their
+	// transaction could still land.
+	skipAction = operatorAction{
+		name: "skip",
+		from: []relayTypes.MessageState{relayTypes.Pending, relayTypes.Attested, relayTypes.Parked},
+		to:   relayTypes.Skipped,
+	}
+	// requeueAction refetches the attestation and relays the message from the start.
+	requeueAction = operatorAction{
+		name: "requeue",
+		from: []relayTypes.MessageState{relayTypes.Pending, relayTypes.Attested, relayTypes.Submitted, relayTypes.Parked, relayTypes.Skipped},
+		to:   relayTypes.Pending,
+	}
+)
+
+// OperatorAuthMiddleware checks the request has the operator token as a bearer token.
+// Operator endpoints are disabled if no token is configured.
+func (r RelayerAPIServer) OperatorAuthMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if r.operatorToken == "" {
+			encodeError(ctx, http.StatusForbidden, errors.New("operator api is disabled"))
+			ctx.Abort()
+			return
+		}
+
+		token := strings.TrimPrefix(ctx.GetHeader("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(r.operatorToken)) != 1 {
+			encodeError(ctx, http.StatusUnauthorized, errors.New("invalid operator token"))
+			ctx.Abort()
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// GetMessages handles the /messages endpoint.
+// Messages can be filtered by state (comma separated), origin and destination chain, and min_age in seconds
+// since their last state change.
+func (r RelayerAPIServer) GetMessages(ctx *gin.Context) {
+	filter, err := getMessageFilterParams(ctx)
+	if err != nil {
+		encodeError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	page, pageSize, err := getPageParams(ctx, defaultPageSize, maxPageSize)
+	if err != nil {
+		encodeError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	messages, err := r.db.GetMessages(ctx, filter, page, pageSize)
+	if err != nil {
+		encodeError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	results := make([]MessageResult, len(messages))
+	for i, msg := range messages {
+		results[i] = toMessageResult(msg)
+	}
+	ctx.JSON(http.StatusOK, RelayerResponse{
+		Success: true,
+		Result:  results,
+	})
+}
+
+// GetMessage handles the /messages/:hash endpoint, returning a message and its timeline.
+func (r RelayerAPIServer) GetMessage(ctx *gin.Context) {
+	msg, ok := r.getMessage(ctx)
+	if !ok {
+		return
+	}
+
+	events, err := r.db.GetMessageEvents(ctx, msg.MessageHash)
+	if err != nil {
+		encodeError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	result := MessageTimelineResult{
+		Message:  toMessageResult(*msg),
+		Timeline: make([]TimelineEntry, len(events)),
+	}
+	submitted := false
+	for i, event := range events {
+		result.Timeline[i] = TimelineEntry{
+			State:     event.State.String(),
+			Timestamp: event.CreatedAt.Unix(),
+			TxHash:    event.TxHash,
+			Nonce:     event.Nonce,
+			Reason:    event.Reason,
+		}
+		submitted = submitted || event.State == relayTypes.Submitted
+	}
+
+	// only look up the submitter status for messages this relayer submitted.
+	if submitted && r.signerAddress != nil {
+		result.Submitter, err = r.getSubmitterResult(ctx, msg)
+		if err != nil {
+			encodeError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, RelayerResponse{
+		Success: true,
+		Result:  result,
+	})
+}
+
+func (r RelayerAPIServer) getSubmitterResult(ctx *gin.Context, msg *relayTypes.Message) (*SubmitterResult, error) {
+	chainID := big.NewInt(int64(msg.DestChainID))
+	nonce := uint64(msg.DestNonce)
+
+	status, err := r.db.SubmitterDB().GetNonceStatus(ctx, *r.signerAddress, chainID, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("could not get nonce status: %w", err)
+	}
+
+	attempts, err := r.db.SubmitterDB().GetNonceAttemptsByStatus(ctx, *r.signerAddress, chainID, nonce, submitterDB.AllStatusTypes()...)
+	if err != nil {
+		return nil, fmt.Errorf("could not get nonce attempts: %w", err)
+	}
+
+	result := &SubmitterResult{
+		Nonce:    nonce,
+		Status:   status.String(),
+		Attempts: make([]SubmitterAttempt, len(attempts)),
+	}
+	for i, attempt := range attempts {
+		result.Attempts[i] = SubmitterAttempt{
+			TxHash: attempt.Hash().String(),
+			Status: attempt.Status.String(),
+		}
+	}
+	return result, nil
+}
+
+// GetStats handles the /stats endpoint.
+func (r RelayerAPIServer) GetStats(ctx *gin.Context) {
+	counts, err := r.db.GetMessageCounts(ctx)
+	if err != nil {
+		encodeError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	result := StatsResult{
+		States: make(map[string]int64),
+		Chains: make(map[uint32]map[string]int64),
+	}
+	for _, count := range counts {
+		state := count.State.String()
+		result.States[state] += count.Count
+		if _, ok := result.Chains[count.DestChainID]; !ok {
+			result.Chains[count.DestChainID] = make(map[string]int64)
+		}
+		result.Chains[count.DestChainID][state] += count.Count
+	}
+
+	ctx.JSON(http.StatusOK, RelayerResponse{
+		Success: true,
+		Result:  result,
+	})
+}
+
+// PutRetryMessage handles the /messages/:hash/retry endpoint.
+// The destination transaction is resubmitted using the stored attestation.
+func (r RelayerAPIServer) PutRetryMessage(ctx *gin.Context) {
+	r.applyOperatorAction(ctx, retryAction)
+}
+
+// PutSkipMessage handles the /messages/:hash/skip endpoint.
+// The relayer stops trying to relay the message.
+func (r RelayerAPIServer) PutSkipMessage(ctx *gin.Context) {
+	r.applyOperatorAction(ctx, skipAction)
+}
+
+// PutRequeueMessage handles the /messages/:hash/requeue endpoint.
+// The attestation is refetched and the message is relayed from the start.
+func (r RelayerAPIServer) PutRequeueMessage(ctx *gin.Context) {
+	r.applyOperatorAction(ctx, requeueAction)
+}
+
+func (r RelayerAPIServer) applyOperatorAction(ctx *gin.Context, action operatorAction) {
+	msg, ok := r.getMessage(ctx)
+	if !ok {
+		return
+	}
+
+	if !slices.Contains(action.from, msg.State) {
+		encodeError(ctx, http.StatusBadRequest, fmt.Errorf("cannot %s a message in state %s", action.name, msg.State))
+		return
+	}
+	if action.to == relayTypes.Attested && len(msg.Attestation) == 0 {
+		encodeError(ctx, http.StatusBadRequest, fmt.Errorf("cannot %s a message without an attestation", action.name))
+		return
+	}
+	if action.name == retryAction.name && msg.State == relayTypes.Submitted {
+		err := r.checkSubmissionFailed(ctx, msg)
+		if err != nil {
+			encodeError(ctx, http.StatusBadRequest, fmt.Errorf("cannot %s a submitted message: %w", action.name, err))
+			return
+		}
+	}
+
+	// the relayer may have moved the message on since it was read, so it's only updated from the same states.
+	err := r.db.UpdateMessageState(ctx, msg.MessageHash, action.to, fmt.Sprintf("operator %s", action.name), action.from...)
+	if errors.Is(err, db2.ErrMessageStateChanged) {
+		encodeError(ctx, http.StatusConflict, fmt.Errorf("cannot %s message %s, its state changed from %s", action.name, msg.MessageHash, msg.State))
+		return
+	}
+	if err != nil {
+		encodeError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, RelayerResponse{
+		Success: true,
+		Result:  fmt.Sprintf("Message %s moved from %s to %s", msg.MessageHash, msg.State, action.to),
+	})
+}
+
+// checkSubmissionFailed checks the submitter gave up on the nonce the message was submitted with, since retrying
+// submits it again under a new nonce and the original transaction could otherwise still land.
+func (r RelayerAPIServer) checkSubmissionFailed(ctx *gin.Context, msg *relayTypes.Message) error {
+	if r.signerAddress == nil {
+		return errors.New("the submitter status is unknown")
+	}
+
+	status, err := r.db.SubmitterDB().GetNonceStatus(ctx, *r.signerAddress, big.NewInt(int64(msg.DestChainID)), uint64(msg.DestNonce))
+	if err != nil {
+		return fmt.Errorf("could not get nonce status: %w", err)
+	}
+	if status != submitterDB.FailedSubmit && status != submitterDB.Replaced {
+		return fmt.Errorf("its transaction is %s, it can only be retried once it failed or was replaced", status)
+	}
+	return nil
+}
+
+// getMessage gets the message for the hash path param, writing an error response if it can't be found.
+func (r RelayerAPIServer) getMessage(ctx *gin.Context) (*relayTypes.Message, bool) {
+	msg, err := r.db.GetMessageByHash(ctx, getMessageHashParam(ctx))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		encodeError(ctx, http.StatusNotFound, fmt.Errorf("no message found for hash %s", getMessageHashParam(ctx)))
+		return nil, false
+	}
+	if err != nil {
+		encodeError(ctx, http.StatusInternalServerError, err)
+		return nil, false
+	}
+	return msg, true
+}
+
+func getMessageFilterParams(ctx *gin.Context) (filter db2.MessageFilter, err error) {
+	if rawStates := ctx.Query(stateParamName); rawStates != "" {
+		for _, rawState := range strings.Split(rawStates, ",") {
+			state, ok := relayTypes.MessageStateFromString(rawState)
+			if !ok {
+				return filter, fmt.Errorf("unknown state: %s", rawState)
+			}
+			filter.States = append(filter.States, state)
+		}
+	}
+
+	filter.OriginChainID, err = getOptionalUint32Param(ctx, originParamName)
+	if err != nil {
+		return filter, err
+	}
+	filter.DestChainID, err = getOptionalUint32Param(ctx, destinationParamName)
+	if err != nil {
+		return filter, err
+	}
+
+	minAge, err := getOptionalUint32Param(ctx, minAgeParamName)
+	if err != nil {
+		return filter, err
+	}
+	if minAge > 0 {
+		filter.UpdatedBefore = time.Now().Add(-time.Duration(minAge) * time.Second)
+	}
+	return filter, nil
+}
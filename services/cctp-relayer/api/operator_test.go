@@ -0,0 +1,209 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/phayes/freeport"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/api"
+	relayTypes "github.com/synapsecns/sanguine/services/cctp-relayer/types"
+)
+
+const testOperatorToken = "operator-token"
+
+// startOperatorServer starts a server with the operator endpoints enabled and waits for it to serve.
+func (s *RelayerAPISuite) startOperatorServer() (baseURL string) {
+	port, err := freeport.GetFreePort()
+	s.Require().NoError(err)
+
+	server := api.NewRelayerAPIServer(uint16(port), "localhost", s.testStore, make(chan *api.RelayRequest, 1000), api.WithOperatorToken(testOperatorToken))
+	ctx, cancel := context.WithCancel(s.GetTestContext())
+	s.T().Cleanup(cancel)
+	//nolint:errcheck
+	go server.Start(ctx)
+
+	baseURL = fmt.Sprintf("http://localhost:%d", port)
+	s.Eventually(func() bool {
+		resp, err := s.doRequest(http.MethodGet, baseURL+"/stats", "", nil)
+		return err == nil && resp.StatusCode == http.StatusOK
+	})
+	return baseURL
+}
+
+// doRequest makes a request, decoding the response into result if it is non-nil.
+//
+//nolint:wrapcheck
+func (s *RelayerAPISuite) doRequest(method, reqURL, token string, result interface{}) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(s.GetTestContext(), method, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	client := &http.Client{
+		Timeout: 5 * time.Second,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if result != nil {
+		err = json.Unmarshal(body, &api.RelayerResponse{Result: result})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (s *RelayerAPISuite) TestListMessages() {
+	baseURL := s.startOperatorServer()
+
+	pendingMsg := s.mockMessage(10, relayTypes.Pending)
+	attestedMsg := s.mockMessage(10, relayTypes.Attested)
+	otherChainMsg := s.mockMessage(20, relayTypes.Pending)
+	for _, msg := range []relayTypes.Message{pendingMsg, attestedMsg, otherChainMsg} {
+		err := s.testStore.StoreMessage(s.GetTestContext(), msg)
+		s.Nil(err)
+	}
+
+	var messages []api.MessageResult
+	resp, err := s.doRequest(http.MethodGet, baseURL+"/messages?origin=10", "", &messages)
+	s.Nil(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Len(messages, 2)
+
+	messages = nil
+	resp, err = s.doRequest(http.MethodGet, baseURL+"/messages?state=Pending&origin=10", "", &messages)
+	s.Nil(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Len(messages, 1)
+	s.Equal(pendingMsg.MessageHash, messages[0].MessageHash)
+
+	messages = nil
+	resp, err = s.doRequest(http.MethodGet, baseURL+"/messages?state=Pending,Attested&page_size=1&page=2", "", &messages)
+	s.Nil(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Len(messages, 1)
+
+	// nothing has been stuck for an hour
+	messages = nil
+	resp, err = s.doRequest(http.MethodGet, baseURL+"/messages?min_age=3600", "", &messages)
+	s.Nil(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Empty(messages)
+
+	resp, err = s.doRequest(http.MethodGet, baseURL+"/messages?state=Stuck", "", nil)
+	s.Nil(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	var stats api.StatsResult
+	resp, err = s.doRequest(http.MethodGet, baseURL+"/stats", "", &stats)
+	s.Nil(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Equal(int64(2), stats.States[relayTypes.Pending.String()])
+	s.Equal(int64(1), stats.States[relayTypes.Attested.String()])
+	s.Equal(int64(1), stats.Chains[pendingMsg.DestChainID][relayTypes.Attested.String()])
+}
+
+func (s *RelayerAPISuite) TestOperatorActions() {
+	baseURL := s.startOperatorServer()
+
+	msg := s.mockMessage(1, relayTypes.Pending)
+	err := s.testStore.StoreMessage(s.GetTestContext(), msg)
+	s.Nil(err)
+	msg.State = relayTypes.Attested
+	err = s.testStore.StoreMessage(s.GetTestContext(), msg)
+	s.Nil(err)
+	messageURL := fmt.Sprintf("%s/messages/%s", baseURL, msg.MessageHash)
+
+	// operator actions require the token
+	resp, err := s.doRequest(http.MethodPut, messageURL+"/skip", "", nil)
+	s.Nil(err)
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+	resp, err = s.doRequest(http.MethodPut, messageURL+"/skip", "wrong-token", nil)
+	s.Nil(err)
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+
+	resp, err = s.doRequest(http.MethodPut, messageURL+"/skip", testOperatorToken, nil)
+	s.Nil(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	// skipped messages can't be skipped again, but can be retried
+	resp, err = s.doRequest(http.MethodPut, messageURL+"/skip", testOperatorToken, nil)
+	s.Nil(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+	resp, err = s.doRequest(http.MethodPut, messageURL+"/retry", testOperatorToken, nil)
+	s.Nil(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.doRequest(http.MethodPut, messageURL+"/requeue", testOperatorToken, nil)
+	s.Nil(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	var timeline api.MessageTimelineResult
+	resp, err = s.doRequest(http.MethodGet, messageURL, "", &timeline)
+	s.Nil(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Equal(relayTypes.Pending.String(), timeline.Message.State)
+	s.Nil(timeline.Submitter)
+
+	var states, reasons []string
+	for _, entry := range timeline.Timeline {
+		states = append(states, entry.State)
+		reasons = append(reasons, entry.Reason)
+	}
+	s.Equal([]string{"Pending", "Attested", "Skipped", "Attested", "Pending"}, states)
+	s.Equal([]string{"", "", "operator skip", "operator retry", "operator requeue"}, reasons)
+	s.Equal(msg.OriginTxHash, timeline.Timeline[0].TxHash)
+
+	resp, err = s.doRequest(http.MethodGet, fmt.Sprintf("%s/messages/%s", baseURL, s.mockMessage(1, relayTypes.Pending).MessageHash), "", nil)
+	s.Nil(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+
+	// submitted messages can't be retried unless the submitter gave up on their transaction
+	submittedMsg := s.mockMessage(1, relayTypes.Submitted)
+	err = s.testStore.StoreMessage(s.GetTestContext(), submittedMsg)
+	s.Nil(err)
+	resp, err = s.doRequest(http.MethodPut, fmt.Sprintf("%s/messages/%s/retry", baseURL, submittedMsg.MessageHash), testOperatorToken, nil)
+	s.Nil(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+	// or skipped, since their transaction could still land
+	resp, err = s.doRequest(http.MethodPut, fmt.Sprintf("%s/messages/%s/skip", baseURL, submittedMsg.MessageHash), testOperatorToken, nil)
+	s.Nil(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *RelayerAPISuite) TestOperatorDisabled() {
+	port, err := freeport.GetFreePort()
+	s.Nil(err)
+
+	server := api.NewRelayerAPIServer(uint16(port), "localhost", s.testStore, make(chan *api.RelayRequest, 1000))
+	ctx, cancel := context.WithCancel(s.GetTestContext())
+	defer cancel()
+	//nolint:errcheck
+	go server.Start(ctx)
+
+	msg := s.mockMessage(1, relayTypes.Pending)
+	err = s.testStore.StoreMessage(s.GetTestContext(), msg)
+	s.Nil(err)
+
+	s.Eventually(func() bool {
+		resp, err := s.doRequest(http.MethodPut, fmt.Sprintf("http://localhost:%d/messages/%s/skip", port, msg.MessageHash), "", nil)
+		return err == nil && resp.StatusCode == http.StatusForbidden
+	})
+}
@@ -18,6 +18,11 @@ func getRawParam(name string, ctx *gin.Context) (string, error) {
 
 const originParamName = "origin"
 const hashParamName = "hash"
+const destinationParamName = "destination"
+const stateParamName = "state"
+const minAgeParamName = "min_age"
+const pageParamName = "page"
+const pageSizeParamName = "page_size"
 
 func getOriginParam(ctx *gin.Context) (uint32, error) {
 	rawValue, err := getRawParam(originParamName, ctx)
@@ -39,3 +44,45 @@ func getHashParam(ctx *gin.Context) (string, error) {
 	value = common.HexToHash(value).String()
 	return value, nil
 }
+
+// getMessageHashParam gets the message hash path param.
+func getMessageHashParam(ctx *gin.Context) string {
+	return common.HexToHash(ctx.Param(hashParamName)).String()
+}
+
+// getOptionalUint32Param gets an optional numeric query param, returning 0 if it is not set.
+func getOptionalUint32Param(ctx *gin.Context, name string) (uint32, error) {
+	rawValue := ctx.Query(name)
+	if rawValue == "" {
+		return 0, nil
+	}
+	value, err := strconv.ParseUint(rawValue, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse %s: %s", name, rawValue)
+	}
+	return uint32(value), nil
+}
+
+func getPageParams(ctx *gin.Context, defaultPageSize, maxPageSize int) (page, pageSize int, err error) {
+	page, pageSize = 1, defaultPageSize
+
+	rawPage, err := getOptionalUint32Param(ctx, pageParamName)
+	if err != nil {
+		return 0, 0, err
+	}
+	if rawPage > 0 {
+		page = int(rawPage)
+	}
+
+	rawPageSize, err := getOptionalUint32Param(ctx, pageSizeParamName)
+	if err != nil {
+		return 0, 0, err
+	}
+	if rawPageSize > 0 {
+		pageSize = int(rawPageSize)
+	}
+	if pageSize > maxPageSize {
+		return 0, 0, fmt.Errorf("page size must be at most %d", maxPageSize)
+	}
+	return page, pageSize, nil
+}
@@ -10,6 +10,7 @@
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
 	db2 "github.com/synapsecns/sanguine/services/cctp-relayer/db"
+	relayTypes "github.com/synapsecns/sanguine/services/cctp-relayer/types"
 	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
 )
@@ -20,16 +21,41 @@ type RelayerAPIServer struct {
 	host             string
 	db               db2.CCTPRelayerDB
 	relayRequestChan chan *RelayRequest
+	// operatorToken is the bearer token required for operator actions.
+	operatorToken string
+	// signerAddress is the relayer's signer address, used to look up destination txes in the submitter db.
+	signerAddress *common.Address
+}
+
+// Option configures a RelayerAPIServer.
+type Option func(*RelayerAPIServer)
+
+// WithOperatorToken enables the operator endpoints, authenticated by the given bearer token.
+func WithOperatorToken(token string) Option {
+	return func(r *RelayerAPIServer) {
+		r.operatorToken = token
+	}
+}
+
+// WithSignerAddress sets the relayer's signer address so message timelines include the submitter status.
+func WithSignerAddress(signerAddress common.Address) Option {
+	return func(r *RelayerAPIServer) {
+		r.signerAddress = &signerAddress
+	}
 }
 
 // NewRelayerAPIServer creates a new RelayerAPIServer.
-func NewRelayerAPIServer(port uint16, host string, db db2.CCTPRelayerDB, relayRequestChan chan *RelayRequest) *RelayerAPIServer {
-	return &RelayerAPIServer{
+func NewRelayerAPIServer(port uint16, host string, db db2.CCTPRelayerDB, relayRequestChan chan *RelayRequest, opts ...Option) *RelayerAPIServer {
+	server := &RelayerAPIServer{
 		port:             port,
 		host:             host,
 		db:               db,
 		relayRequestChan: relayRequestChan,
 	}
+	for _, opt := range opts {
+		opt(server)
+	}
+	return server
 }
 
 // Start starts the RelayerAPIServer.
@@ -38,6 +64,31 @@ func (r RelayerAPIServer) Start(ctx context.Context) error {
 	engine.GET("/tx", func(ctx *gin.Context) {
 		r.GetTx(ctx)
 	})
+	engine.GET("/messages", func(ctx *gin.Context) {
+		r.GetMessages(ctx)
+	})
+	engine.GET("/messages/:hash", func(ctx *gin.Context) {
+		r.GetMessage(ctx)
+	})
+	engine.GET("/stats", func(ctx *gin.Context) {
+		r.GetStats(ctx)
+	})
+
+	engine.PUT("/force_relay", r.OperatorAuthMiddleware(), func(ctx *gin.Context) {
+		r.PutForceRelay(ctx)
+	})
+
+	operatorGroup := engine.Group("/messages/:hash")
+	operatorGroup.Use(r.OperatorAuthMiddleware())
+	operatorGroup.PUT("/retry", func(ctx *gin.Context) {
+		r.PutRetryMessage(ctx)
+	})
+	operatorGroup.PUT("/skip", func(ctx *gin.Context) {
+		r.PutSkipMessage(ctx)
+	})
+	operatorGroup.PUT("/requeue", func(ctx *gin.Context) {
+		r.PutRequeueMessage(ctx)
+	})
 	server := &http.Server{
 		Addr:              fmt.Sprintf(":%d", r.port),
 		ReadHeaderTimeout: 5 * time.Second,
@@ -102,14 +153,7 @@ func (r RelayerAPIServer) GetTx(ctx *gin.Context) {
 		// return if found
 		resp := RelayerResponse{
 			Success: true,
-			Result: MessageResult{
-				OriginHash:      hash,
-				DestinationHash: msg.DestTxHash,
-				Origin:          msg.OriginChainID,
-				Destination:     msg.DestChainID,
-				RequestID:       msg.RequestID,
-				State:           msg.State.String(),
-			},
+			Result:  toMessageResult(*msg),
 		}
 		ctx.JSON(http.StatusOK, resp)
 		return
@@ -130,6 +174,39 @@ func (r RelayerAPIServer) GetTx(ctx *gin.Context) {
 	encodeError(ctx, http.StatusInternalServerError, err)
 }
 
+// PutForceRelay handles the /force_relay endpoint.
+// The message sent in the given origin transaction is relayed on the next retry regardless of
+// profitability and the destination chain's gas budget.
+func (r RelayerAPIServer) PutForceRelay(ctx *gin.Context) {
+	hash, err := getHashParam(ctx)
+	if err != nil {
+		encodeError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	msg, err := r.db.GetMessageByOriginHash(ctx, common.HexToHash(hash))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		encodeError(ctx, http.StatusNotFound, fmt.Errorf("no message found for origin hash %s", hash))
+		return
+	}
+	if err != nil {
+		encodeError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = r.db.SetForceRelay(ctx, msg.MessageHash)
+	if err != nil {
+		encodeError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := RelayerResponse{
+		Success: true,
+		Result:  fmt.Sprintf("Message %s will be force relayed", msg.MessageHash),
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
 // MessageResult is the result of a successful /tx request.
 type MessageResult struct {
 	OriginHash      string `json:"origin_hash"`
@@ -138,6 +215,19 @@ type MessageResult struct {
 	Destination     uint32 `json:"destination"`
 	RequestID       string `json:"request_id"`
 	State           string `json:"state"`
+	MessageHash     string `json:"message_hash"`
+}
+
+func toMessageResult(msg relayTypes.Message) MessageResult {
+	return MessageResult{
+		OriginHash:      msg.OriginTxHash,
+		DestinationHash: msg.DestTxHash,
+		Origin:          msg.OriginChainID,
+		Destination:     msg.DestChainID,
+		RequestID:       msg.RequestID,
+		State:           msg.State.String(),
+		MessageHash:     msg.MessageHash,
+	}
 }
 
 // RelayerResponse is a wrapper struct for a relayer API response.
@@ -199,3 +199,39 @@ func (s *RelayerAPISuite) TestBadRequest() {
 	expectedReason := "required parameter 'origin' is missing"
 	s.Equal(expectedReason, reason)
 }
+
+func (s *RelayerAPISuite) TestForceRelay() {
+	baseURL := s.startOperatorServer()
+
+	// store parked tx
+	msg := s.mockMessage(1, relayTypes.Attested)
+	err := s.testStore.StoreMessage(s.GetTestContext(), msg)
+	s.Nil(err)
+	msg.State = relayTypes.Parked
+	err = s.testStore.StoreMessage(s.GetTestContext(), msg)
+	s.Nil(err)
+
+	// force relays require the operator token
+	resp, err := s.doRequest(http.MethodPut, fmt.Sprintf("%s/force_relay?hash=%s", baseURL, msg.OriginTxHash), "", nil)
+	s.Nil(err)
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+
+	storedMsg, err := s.testStore.GetMessageByOriginHash(s.GetTestContext(), common.HexToHash(msg.OriginTxHash))
+	s.Nil(err)
+	s.False(storedMsg.ForceRelay)
+
+	// force relay the tx
+	resp, err = s.doRequest(http.MethodPut, fmt.Sprintf("%s/force_relay?hash=%s", baseURL, msg.OriginTxHash), testOperatorToken, nil)
+	s.Nil(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	storedMsg, err = s.testStore.GetMessageByOriginHash(s.GetTestContext(), common.HexToHash(msg.OriginTxHash))
+	s.Nil(err)
+	s.True(storedMsg.ForceRelay)
+	s.Equal(relayTypes.Parked, storedMsg.State)
+
+	// unknown txes should 404
+	resp, err = s.doRequest(http.MethodPut, fmt.Sprintf("%s/force_relay?hash=%s", baseURL, mocks.NewMockHash(s.T())), testOperatorToken, nil)
+	s.Nil(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
@@ -3,30 +3,74 @@
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"golang.org/x/time/rate"
 )
 
 // CircleAPI is a wrapper for Circle's REST API.
 type CircleAPI struct {
 	client  *http.Client
 	baseURL string
+	// limiter keeps requests under the api rate limit.
+	limiter *rate.Limiter
 }
 
 const circleAttestationURL = "https://iris-api.circle.com/v1/attestations"
 
+// DefaultRequestsPerSecond is the default request rate to the attestation api.
+// Circle blocks clients that exceed 35 requests per second, so this leaves plenty of headroom.
+const DefaultRequestsPerSecond = 10
+
+// defaultRateLimitBackoff is how long to back off when rate limited without a Retry-After header.
+// Circle blocks rate limited clients for 5 minutes.
+const defaultRateLimitBackoff = 5 * time.Minute
+
+const attestationStatusComplete = "complete"
+
+// ErrAttestationNotReady is returned when the attestation for a message is not available yet.
+var ErrAttestationNotReady = errors.New("attestation not ready")
+
+// RateLimitError is returned when the attestation api is rate limiting requests.
+type RateLimitError struct {
+	// RetryAfter is how long to wait before making another request.
+	RetryAfter time.Duration
+}
+
+func (r RateLimitError) Error() string {
+	return fmt.Sprintf("rate limited, retry after %s", r.RetryAfter)
+}
+
+// CircleAPIOption configures a CircleAPI.
+type CircleAPIOption func(*CircleAPI)
+
+// WithRequestsPerSecond sets the maximum request rate to the api.
+func WithRequestsPerSecond(requestsPerSecond float64) CircleAPIOption {
+	return func(c *CircleAPI) {
+		c.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), 1)
+	}
+}
+
 // NewCircleAPI creates a new CircleAPI.
-func NewCircleAPI(url string) CircleAPI {
+func NewCircleAPI(url string, opts ...CircleAPIOption) CircleAPI {
 	if url == "" {
 		url = circleAttestationURL
 	}
-	return CircleAPI{
+	c := CircleAPI{
 		client:  &http.Client{},
 		baseURL: url,
+		limiter: rate.NewLimiter(DefaultRequestsPerSecond, 1),
+	}
+	for _, opt := range opts {
+		opt(&c)
 	}
+	return c
 }
 
 type circleAttestationResponse struct {
@@ -35,7 +79,14 @@ type circleAttestationResponse struct {
 }
 
 // GetAttestation is a wrapper for GET /attestations/{txHash}.
+// ErrAttestationNotReady is returned if the message hasn't been attested yet and a RateLimitError
+// is returned if the api is rate limiting requests.
 func (c CircleAPI) GetAttestation(ctx context.Context, txHash string) (attestation []byte, err error) {
+	err = c.limiter.Wait(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not wait for rate limiter: %w", err)
+	}
+
 	url := fmt.Sprintf("%s/%s", c.baseURL, txHash)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
@@ -50,7 +101,14 @@ func (c CircleAPI) GetAttestation(ctx context.Context, txHash string) (attestati
 	//nolint:errcheck
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		// circle returns a 404 until it has observed the message
+		return nil, ErrAttestationNotReady
+	case http.StatusTooManyRequests:
+		return nil, RateLimitError{RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"))}
+	default:
 		err = fmt.Errorf("received non-200 status code: %d", resp.StatusCode)
 		return
 	}
@@ -66,7 +124,10 @@ func (c CircleAPI) GetAttestation(ctx context.Context, txHash string) (attestati
 		return nil, fmt.Errorf("could not unmarshal body: %w", err)
 	}
 
-	// TODO: check status
+	if attestationResp.Status != attestationStatusComplete {
+		return nil, fmt.Errorf("%w: status is %s", ErrAttestationNotReady, attestationResp.Status)
+	}
+
 	attestation, err = hexutil.Decode(attestationResp.Attestation)
 	if err != nil {
 		return nil, fmt.Errorf("could not decode signature: %w", err)
@@ -74,4 +135,13 @@ func (c CircleAPI) GetAttestation(ctx context.Context, txHash string) (attestati
 	return attestation, nil
 }
 
+// parseRetryAfter parses a Retry-After header given in seconds.
+func parseRetryAfter(header string) time.Duration {
+	seconds, err := strconv.Atoi(header)
+	if err != nil || seconds <= 0 {
+		return defaultRateLimitBackoff
+	}
+	return time.Duration(seconds) * time.Second
+}
+
 var _ CCTPAPI = &CircleAPI{}
@@ -0,0 +1,92 @@
+package attestation_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/attestation"
+)
+
+func (a *AttestationSuite) TestCircleAPI() {
+	attestationBytes := []byte("attestation")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/complete":
+			_, _ = fmt.Fprintf(w, `{"attestation": "%s", "status": "complete"}`, hexutil.Encode(attestationBytes))
+		case "/pending":
+			_, _ = fmt.Fprint(w, `{"attestation": "PENDING", "status": "pending_confirmations"}`)
+		case "/limited":
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	api := attestation.NewCircleAPI(server.URL)
+
+	res, err := api.GetAttestation(a.GetTestContext(), "complete")
+	Nil(a.T(), err)
+	Equal(a.T(), attestationBytes, res)
+
+	_, err = api.GetAttestation(a.GetTestContext(), "pending")
+	True(a.T(), errors.Is(err, attestation.ErrAttestationNotReady))
+
+	_, err = api.GetAttestation(a.GetTestContext(), "missing")
+	True(a.T(), errors.Is(err, attestation.ErrAttestationNotReady))
+
+	_, err = api.GetAttestation(a.GetTestContext(), "limited")
+	var rateLimitErr attestation.RateLimitError
+	True(a.T(), errors.As(err, &rateLimitErr))
+	Equal(a.T(), 30*time.Second, rateLimitErr.RetryAfter)
+}
+
+func (a *AttestationSuite) TestFailoverAPI() {
+	attestationBytes := []byte("attestation")
+
+	primaryCalls := 0
+	primary := attestation.NewMockCircleAPI()
+	primary.SetGetAttestation(func(context.Context, string) ([]byte, error) {
+		primaryCalls++
+		return nil, attestation.RateLimitError{RetryAfter: time.Minute}
+	})
+
+	secondary := attestation.NewMockCircleAPI()
+	secondary.SetGetAttestation(func(context.Context, string) ([]byte, error) {
+		return attestationBytes, nil
+	})
+
+	// the rate limited api should be skipped until its limit has passed.
+	api := attestation.NewFailoverAPI(primary, secondary)
+	for i := 0; i < 3; i++ {
+		res, err := api.GetAttestation(a.GetTestContext(), "hash")
+		Nil(a.T(), err)
+		Equal(a.T(), attestationBytes, res)
+	}
+	Equal(a.T(), 1, primaryCalls)
+
+	// once every api is rate limited, the rate limit should be surfaced.
+	secondary.SetGetAttestation(func(context.Context, string) ([]byte, error) {
+		return nil, attestation.RateLimitError{RetryAfter: time.Hour}
+	})
+	api = attestation.NewFailoverAPI(primary, secondary)
+	_, err := api.GetAttestation(a.GetTestContext(), "hash")
+	var rateLimitErr attestation.RateLimitError
+	True(a.T(), errors.As(err, &rateLimitErr))
+	LessOrEqual(a.T(), rateLimitErr.RetryAfter, time.Minute)
+
+	// other errors are joined.
+	secondary.SetGetAttestation(func(context.Context, string) ([]byte, error) {
+		return nil, attestation.ErrAttestationNotReady
+	})
+	api = attestation.NewFailoverAPI(secondary)
+	_, err = api.GetAttestation(a.GetTestContext(), "hash")
+	True(a.T(), errors.Is(err, attestation.ErrAttestationNotReady))
+}
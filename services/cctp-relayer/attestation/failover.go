@@ -0,0 +1,90 @@
+package attestation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// FailoverAPI fetches attestations from a list of attestation apis, trying each in order.
+// APIs that rate limit a request are skipped until their rate limit has passed.
+type FailoverAPI struct {
+	apis []CCTPAPI
+	mux  sync.Mutex
+	// limitedUntil is the time each api can be used again after being rate limited.
+	limitedUntil []time.Time
+	// now is used to get the current time, it can be overridden for testing.
+	now func() time.Time
+}
+
+// NewFailoverAPI creates a new FailoverAPI.
+func NewFailoverAPI(apis ...CCTPAPI) *FailoverAPI {
+	return &FailoverAPI{
+		apis:         apis,
+		limitedUntil: make([]time.Time, len(apis)),
+		now:          time.Now,
+	}
+}
+
+// GetAttestation gets the attestation from the first api that returns one.
+// If every api is rate limited, a RateLimitError for the earliest time one can be used again is returned.
+func (f *FailoverAPI) GetAttestation(ctx context.Context, messageHash string) ([]byte, error) {
+	if len(f.apis) == 0 {
+		return nil, errors.New("no attestation apis configured")
+	}
+
+	var errs []error
+	var nextAvailable time.Time
+	attempted := false
+	for i, api := range f.apis {
+		if until := f.getLimitedUntil(i); f.now().Before(until) {
+			nextAvailable = earliest(nextAvailable, until)
+			continue
+		}
+
+		attestation, err := api.GetAttestation(ctx, messageHash)
+		if err == nil {
+			return attestation, nil
+		}
+
+		var rateLimitErr RateLimitError
+		if errors.As(err, &rateLimitErr) {
+			until := f.now().Add(rateLimitErr.RetryAfter)
+			f.setLimitedUntil(i, until)
+			nextAvailable = earliest(nextAvailable, until)
+			continue
+		}
+
+		attempted = true
+		errs = append(errs, fmt.Errorf("attestation api %d: %w", i, err))
+	}
+
+	if !attempted {
+		return nil, RateLimitError{RetryAfter: nextAvailable.Sub(f.now())}
+	}
+	return nil, errors.Join(errs...)
+}
+
+func (f *FailoverAPI) getLimitedUntil(i int) time.Time {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	return f.limitedUntil[i]
+}
+
+func (f *FailoverAPI) setLimitedUntil(i int, until time.Time) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	f.limitedUntil[i] = until
+}
+
+// earliest returns the earlier of two times, treating the zero time as unset.
+func earliest(a, b time.Time) time.Time {
+	if a.IsZero() || b.Before(a) {
+		return b
+	}
+	return a
+}
+
+var _ CCTPAPI = &FailoverAPI{}
@@ -0,0 +1,234 @@
+package attestation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gin-gonic/gin"
+	"github.com/ipfs/go-log"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/contracts/mockmessagetransmitter"
+	pbscribe "github.com/synapsecns/sanguine/services/scribe/grpc/types/types/v1"
+	"golang.org/x/sync/errgroup"
+)
+
+var logger = log.Logger("attestation")
+
+// recoveryIDOffset is added to the recovery id of each signature, since the message transmitter expects v to be 27 or 28.
+const recoveryIDOffset = 27
+
+// LocalAttesterChain is a chain the LocalAttester watches for messages.
+type LocalAttesterChain struct {
+	// ChainID is the chain id.
+	ChainID uint32
+	// MessageTransmitter is the address of the message transmitter on the chain.
+	MessageTransmitter common.Address
+}
+
+// LocalAttester is a stand-in for Circle's attestation service for chains Circle doesn't support (e.g. testnets
+// and local devnets). It watches MessageSent events through scribe, signs each message with the attester keys
+// and serves the attestations in the same shape as Circle's api, so it can be used as a CircleAPI url.
+type LocalAttester struct {
+	signers    []signer.Signer
+	grpcClient pbscribe.ScribeServiceClient
+	chains     []LocalAttesterChain
+	port       uint16
+	// attestationsMux protects attestations.
+	attestationsMux sync.RWMutex
+	// attestations maps message hashes to attestations.
+	attestations map[common.Hash][]byte
+}
+
+// NewLocalAttester creates a new LocalAttester. Signers are sorted by address, since the message transmitter
+// requires attester signatures in increasing address order.
+func NewLocalAttester(signers []signer.Signer, grpcClient pbscribe.ScribeServiceClient, chains []LocalAttesterChain, port uint16) *LocalAttester {
+	sorted := make([]signer.Signer, len(signers))
+	copy(sorted, signers)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Address().Bytes(), sorted[j].Address().Bytes()) < 0
+	})
+
+	return &LocalAttester{
+		signers:      sorted,
+		grpcClient:   grpcClient,
+		chains:       chains,
+		port:         port,
+		attestations: make(map[common.Hash][]byte),
+	}
+}
+
+// Start watches every chain for messages and serves attestations until the context is canceled.
+func (l *LocalAttester) Start(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+
+	for _, chain := range l.chains {
+		chain := chain
+		g.Go(func() error {
+			return l.streamLogs(ctx, chain)
+		})
+	}
+
+	g.Go(func() error {
+		return l.serve(ctx)
+	})
+
+	err := g.Wait()
+	if err != nil {
+		return fmt.Errorf("error in local attester: %w", err)
+	}
+	return nil
+}
+
+// streamLogs attests to every message sent on the chain.
+func (l *LocalAttester) streamLogs(ctx context.Context, chain LocalAttesterChain) error {
+	stream, err := l.grpcClient.StreamLogs(ctx, &pbscribe.StreamLogsRequest{
+		Filter: &pbscribe.LogFilter{
+			ContractAddress: &pbscribe.NullableString{Kind: &pbscribe.NullableString_Data{Data: chain.MessageTransmitter.String()}},
+			ChainId:         chain.ChainID,
+		},
+		FromBlock: "0",
+		ToBlock:   "latest",
+	})
+	if err != nil {
+		return fmt.Errorf("could not stream logs: %w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context done: %w", ctx.Err())
+		default:
+			response, err := stream.Recv()
+			if err != nil {
+				return fmt.Errorf("could not receive: %w", err)
+			}
+
+			err = l.HandleLog(ctx, response.Log.ToLog())
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// HandleLog attests to the message in a MessageSent log. Other logs are ignored.
+func (l *LocalAttester) HandleLog(ctx context.Context, log *types.Log) error {
+	if log == nil || len(log.Topics) == 0 || log.Topics[0] != mockmessagetransmitter.MessageSentTopic {
+		return nil
+	}
+
+	parser, err := mockmessagetransmitter.NewMessageTransmitterEventsFilterer(log.Address, nil)
+	if err != nil {
+		return fmt.Errorf("could not create event parser: %w", err)
+	}
+
+	messageSent, err := parser.ParseMessageSent(*log)
+	if err != nil {
+		return fmt.Errorf("could not parse message sent: %w", err)
+	}
+
+	attestation, err := l.Attest(ctx, messageSent.Message)
+	if err != nil {
+		return fmt.Errorf("could not attest to message: %w", err)
+	}
+
+	messageHash := crypto.Keccak256Hash(messageSent.Message)
+	l.attestationsMux.Lock()
+	l.attestations[messageHash] = attestation
+	l.attestationsMux.Unlock()
+
+	logger.Infof("attested to message %s", messageHash)
+	return nil
+}
+
+// Attest signs the keccak256 hash of the message with every attester and returns the concatenated signatures.
+func (l *LocalAttester) Attest(ctx context.Context, message []byte) ([]byte, error) {
+	var attestation []byte
+	for _, attester := range l.signers {
+		sig, err := attester.SignMessage(ctx, message, true)
+		if err != nil {
+			return nil, fmt.Errorf("could not sign message with %s: %w", attester.Address(), err)
+		}
+
+		encoded := signer.Encode(sig)
+		encoded[crypto.RecoveryIDOffset] += recoveryIDOffset
+		attestation = append(attestation, encoded...)
+	}
+	return attestation, nil
+}
+
+// GetAttestation gets a stored attestation by message hash.
+func (l *LocalAttester) GetAttestation(_ context.Context, messageHash string) ([]byte, error) {
+	l.attestationsMux.RLock()
+	defer l.attestationsMux.RUnlock()
+
+	attestation, ok := l.attestations[common.HexToHash(messageHash)]
+	if !ok {
+		return nil, ErrAttestationNotReady
+	}
+	return attestation, nil
+}
+
+// Handler returns the http handler serving attestations at /v1/attestations/{messageHash}.
+func (l *LocalAttester) Handler() http.Handler {
+	engine := gin.New()
+	engine.GET("/v1/attestations/:hash", func(c *gin.Context) {
+		attestation, err := l.GetAttestation(c, c.Param("hash"))
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Message hash not found"})
+			return
+		}
+		c.JSON(http.StatusOK, circleAttestationResponse{
+			Attestation: hexutil.Encode(attestation),
+			Status:      attestationStatusComplete,
+		})
+	})
+	return engine
+}
+
+func (l *LocalAttester) serve(ctx context.Context) error {
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", l.port),
+		ReadHeaderTimeout: 5 * time.Second,
+		Handler:           l.Handler(),
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		err := server.ListenAndServe()
+		if err != nil {
+			return fmt.Errorf("stopped serving: %w", err)
+		}
+		return nil
+	})
+
+	g.Go(func() error {
+		// shutdown server if parent context is canceled
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		err := server.Shutdown(shutdownCtx)
+		if err != nil {
+			return fmt.Errorf("error during server shutdown: %w", err)
+		}
+		return nil
+	})
+
+	err := g.Wait()
+	if err != nil {
+		return fmt.Errorf("error while serving: %w", err)
+	}
+	return nil
+}
+
+var _ CCTPAPI = &LocalAttester{}
@@ -0,0 +1,66 @@
+package attestation_test
+
+import (
+	"bytes"
+	"net/http/httptest"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer/localsigner"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/attestation"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/contracts/mockmessagetransmitter"
+)
+
+func (a *AttestationSuite) TestLocalAttester() {
+	signers := make([]signer.Signer, 3)
+	for i := range signers {
+		key, err := crypto.GenerateKey()
+		Nil(a.T(), err)
+		signers[i] = localsigner.NewSigner(key)
+	}
+
+	localAttester := attestation.NewLocalAttester(signers, nil, nil, 0)
+
+	message := []byte("cctp message")
+	parsedABI, err := mockmessagetransmitter.MockMessageTransmitterMetaData.GetAbi()
+	Nil(a.T(), err)
+	data, err := parsedABI.Events["MessageSent"].Inputs.Pack(message)
+	Nil(a.T(), err)
+
+	err = localAttester.HandleLog(a.GetTestContext(), &types.Log{
+		Address: common.BigToAddress(common.Big1),
+		Topics:  []common.Hash{mockmessagetransmitter.MessageSentTopic},
+		Data:    data,
+	})
+	Nil(a.T(), err)
+
+	// attestations should be served in the same shape as circle's api.
+	server := httptest.NewServer(localAttester.Handler())
+	defer server.Close()
+	api := attestation.NewCircleAPI(server.URL + "/v1/attestations")
+
+	messageHash := crypto.Keccak256Hash(message)
+	attestationBytes, err := api.GetAttestation(a.GetTestContext(), messageHash.String())
+	Nil(a.T(), err)
+	Len(a.T(), attestationBytes, len(signers)*crypto.SignatureLength)
+
+	// signatures should be from each attester in increasing address order.
+	var lastAddress common.Address
+	for i := range signers {
+		sig := common.CopyBytes(attestationBytes[i*crypto.SignatureLength : (i+1)*crypto.SignatureLength])
+		True(a.T(), sig[crypto.RecoveryIDOffset] == 27 || sig[crypto.RecoveryIDOffset] == 28)
+		sig[crypto.RecoveryIDOffset] -= 27
+
+		pubKey, err := crypto.SigToPub(messageHash.Bytes(), sig)
+		Nil(a.T(), err)
+		recovered := crypto.PubkeyToAddress(*pubKey)
+		Equal(a.T(), 1, bytes.Compare(recovered.Bytes(), lastAddress.Bytes()))
+		lastAddress = recovered
+	}
+
+	_, err = api.GetAttestation(a.GetTestContext(), common.Hash{}.String())
+	ErrorIs(a.T(), err, attestation.ErrAttestationNotReady)
+}
@@ -0,0 +1,26 @@
+package attestation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/synapsecns/sanguine/core/testsuite"
+)
+
+// AttestationSuite is the attestation test suite.
+type AttestationSuite struct {
+	*testsuite.TestSuite
+}
+
+// NewAttestationSuite creates a new attestation test suite.
+func NewAttestationSuite(tb testing.TB) *AttestationSuite {
+	tb.Helper()
+	return &AttestationSuite{
+		TestSuite: testsuite.NewTestSuite(tb),
+	}
+}
+
+// TestAttestationSuite runs the attestation test suite.
+func TestAttestationSuite(t *testing.T) {
+	suite.Run(t, NewAttestationSuite(t))
+}
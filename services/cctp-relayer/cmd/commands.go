@@ -3,6 +3,13 @@
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+	signerConfig "github.com/synapsecns/sanguine/ethergo/signer/config"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer"
+	pbscribe "github.com/synapsecns/sanguine/services/scribe/grpc/types/types/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
 	"github.com/synapsecns/sanguine/core/commandline"
 
 	"github.com/synapsecns/sanguine/core"
@@ -80,7 +87,15 @@
 
 		scribeClient := client.NewRemoteScribe(uint16(c.Uint(scribePortFlag.Name)), c.String(scribeURL.Name), metricsProvider).ScribeClient
 		omnirpcClient := omniClient.NewOmnirpcClient(cfg.BaseOmnirpcURL, metricsProvider, omniClient.WithCaptureReqRes())
-		attAPI := attestation.NewCircleAPI(c.String(cfg.CircleAPIURl))
+		var attestationOpts []attestation.CircleAPIOption
+		if cfg.AttestationRequestsPerSecond > 0 {
+			attestationOpts = append(attestationOpts, attestation.WithRequestsPerSecond(cfg.AttestationRequestsPerSecond))
+		}
+		attestationAPIs := []attestation.CCTPAPI{attestation.NewCircleAPI(cfg.CircleAPIURl, attestationOpts...)}
+		for _, attestationURL := range cfg.FallbackAttestationURLs {
+			attestationAPIs = append(attestationAPIs, attestation.NewCircleAPI(attestationURL, attestationOpts...))
+		}
+		attAPI := attestation.NewFailoverAPI(attestationAPIs...)
 
 		cctpRelayer, err := relayer.NewCCTPRelayer(c.Context, cfg, store, scribeClient, omnirpcClient, metricsProvider, attAPI)
 		if err != nil {
@@ -94,3 +109,52 @@
 		return nil
 	},
 }
+
+// attesterCommand runs a local attester.
+var attesterCommand = &cli.Command{
+	Name:        "attester",
+	Description: "run a local attester that signs cctp messages in place of circle's attestation service",
+	Flags:       []cli.Flag{configFlag, scribePortFlag, scribeURL, &commandline.LogLevel},
+	Action: func(c *cli.Context) (err error) {
+		commandline.SetLogLevel(c)
+		cfg, err := config.DecodeAttesterConfig(core.ExpandOrReturnPath(c.String(configFlag.Name)))
+		if err != nil {
+			return fmt.Errorf("could not read config file: %w", err)
+		}
+
+		_, err = cfg.IsValid(c.Context)
+		if err != nil {
+			return fmt.Errorf("could not decode config file: %w", err)
+		}
+
+		signers := make([]signer.Signer, len(cfg.Attesters))
+		for i, attesterCfg := range cfg.Attesters {
+			signers[i], err = signerConfig.SignerFromConfig(c.Context, attesterCfg)
+			if err != nil {
+				return fmt.Errorf("could not get attester %d: %w", i, err)
+			}
+		}
+
+		chains := make([]attestation.LocalAttesterChain, len(cfg.Chains))
+		for i, chain := range cfg.Chains {
+			chains[i] = attestation.LocalAttesterChain{
+				ChainID:            chain.ChainID,
+				MessageTransmitter: common.HexToAddress(chain.MessageTransmitterAddress),
+			}
+		}
+
+		conn, err := grpc.DialContext(c.Context, fmt.Sprintf("%s:%d", c.String(scribeURL.Name), c.Uint(scribePortFlag.Name)),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if err != nil {
+			return fmt.Errorf("could not dial grpc: %w", err)
+		}
+
+		localAttester := attestation.NewLocalAttester(signers, pbscribe.NewScribeServiceClient(conn), chains, cfg.Port)
+		err = localAttester.Start(c.Context)
+		if err != nil {
+			return fmt.Errorf("could not run local attester: %w", err)
+		}
+		return nil
+	},
+}
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jftuga/ellipsis"
+	"github.com/richardwilkes/toolbox/collection"
+	ethConfig "github.com/synapsecns/sanguine/ethergo/signer/config"
+	"gopkg.in/yaml.v2"
+)
+
+// AttesterConfig is used to configure a local attester.
+type AttesterConfig struct {
+	// Port is the port attestations are served on.
+	Port uint16 `yaml:"port"`
+	// Chains are the chains to attest to messages on.
+	Chains []AttesterChainConfig `yaml:"chains"`
+	// Attesters are the attester signers. Each message is signed by every attester.
+	Attesters []ethConfig.SignerConfig `yaml:"attesters"`
+}
+
+// AttesterChainConfig defines the config for a chain watched by the local attester.
+type AttesterChainConfig struct {
+	// ChainID is the ID of the chain.
+	ChainID uint32 `yaml:"chain_id"`
+	// MessageTransmitterAddress is the address of the MessageTransmitter contract.
+	MessageTransmitterAddress string `yaml:"message_transmitter_address"`
+}
+
+// IsValid makes sure the attester config is valid.
+func (a AttesterConfig) IsValid(ctx context.Context) (ok bool, err error) {
+	if len(a.Attesters) == 0 {
+		return false, fmt.Errorf("at least one attester is required")
+	}
+
+	for i, attester := range a.Attesters {
+		if ok, err = attester.IsValid(ctx); !ok {
+			return false, fmt.Errorf("attester %d is invalid: %w", i, err)
+		}
+	}
+
+	intSet := collection.Set[uint32]{}
+	for _, chain := range a.Chains {
+		if intSet.Contains(chain.ChainID) {
+			return false, fmt.Errorf("chain id %d appears twice: %s", chain.ChainID, "duplicate chain id")
+		}
+		intSet.Add(chain.ChainID)
+
+		if !common.IsHexAddress(chain.MessageTransmitterAddress) {
+			return false, fmt.Errorf("invalid address %s: %s", chain.MessageTransmitterAddress, "invalid address")
+		}
+	}
+
+	return true, nil
+}
+
+// DecodeAttesterConfig parses in an attester config from a file.
+func DecodeAttesterConfig(filePath string) (cfg AttesterConfig, err error) {
+	input, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return AttesterConfig{}, fmt.Errorf("failed to read file: %w", err)
+	}
+	err = yaml.Unmarshal(input, &cfg)
+	if err != nil {
+		return AttesterConfig{}, fmt.Errorf("could not unmarshall config %s: %w", ellipsis.Shorten(string(input), 30), err)
+	}
+	return cfg, nil
+}
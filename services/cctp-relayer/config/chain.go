@@ -13,6 +13,15 @@ type ChainConfig struct {
 	ChainID uint32 `yaml:"chain_id"`
 	// SynapseCCTPAddress is the address of the SynapseCCTP contract.
 	SynapseCCTPAddress string `yaml:"synapse_cctp_address"`
+	// NativeTokenPriceUSD is the usd price of the chain's gas token. If set, messages are only relayed to this
+	// chain when the relayer fee covers the estimated gas cost. It is the fallback if NativeTokenCoinID is set.
+	NativeTokenPriceUSD float64 `yaml:"native_token_price_usd"`
+	// NativeTokenCoinID is the defillama coin id of the chain's gas token, e.g. coingecko:ethereum. If set, messages
+	// are only relayed to this chain when the relayer fee covers the estimated gas cost at the token's current price.
+	NativeTokenCoinID string `yaml:"native_token_coin_id"`
+	// DailyGasBudget is the max amount of gas token (in ether units) to spend relaying to this chain per utc day.
+	// A budget of 0 is unlimited.
+	DailyGasBudget float64 `yaml:"daily_gas_budget"`
 }
 
 // GetSynapseCCTPAddress returns the SynapseCCTP address.
@@ -23,6 +32,16 @@ func (c ChainConfig) GetSynapseCCTPAddress() common.Address {
 // ChainConfigs contains an array of ChainConfigs.
 type ChainConfigs []ChainConfig
 
+// GetChain gets the config for a chain.
+func (c ChainConfigs) GetChain(chainID uint32) (ChainConfig, bool) {
+	for _, cfg := range c {
+		if cfg.ChainID == chainID {
+			return cfg, true
+		}
+	}
+	return ChainConfig{}, false
+}
+
 // IsValid validates the chain config by asserting no two chains appear twice.
 func (c ChainConfigs) IsValid(_ context.Context) (ok bool, err error) {
 	intSet := collection.Set[uint32]{}
@@ -36,6 +55,10 @@ func (c ChainConfigs) IsValid(_ context.Context) (ok bool, err error) {
 		if !common.IsHexAddress(cfg.SynapseCCTPAddress) {
 			return false, fmt.Errorf("invalid address %s: %s", cfg.SynapseCCTPAddress, "invalid address")
 		}
+
+		if cfg.NativeTokenPriceUSD < 0 || cfg.DailyGasBudget < 0 {
+			return false, fmt.Errorf("chain %d: native token price and daily gas budget cannot be negative", cfg.ChainID)
+		}
 	}
 
 	return true, nil
@@ -21,10 +21,22 @@ type Config struct {
 	Port uint16 `yaml:"port"`
 	// Host is the RelayerAPIServer host
 	Host string `yaml:"host"`
+	// OperatorAPIToken is the bearer token for the RelayerAPIServer's operator endpoints (retry, skip and requeue).
+	// The operator endpoints are disabled if this is empty.
+	OperatorAPIToken string `yaml:"operator_api_token"`
 	// CircleAPIURl is the URL for the Circle API
 	CircleAPIURl string `yaml:"circle_api_url"`
+	// FallbackAttestationURLs are attestation api urls to fail over to, in order, when the Circle API
+	// errors or rate limits the relayer. These can point at a LocalAttester.
+	FallbackAttestationURLs []string `yaml:"fallback_attestation_urls"`
+	// AttestationRequestsPerSecond is the max request rate to each attestation api.
+	// Defaults to attestation.DefaultRequestsPerSecond.
+	AttestationRequestsPerSecond float64 `yaml:"attestation_requests_per_second"`
 	// Chains stores all chain information
 	Chains ChainConfigs `yaml:"chains"`
+	// PriceAPIURL is the url of the defillama coins api used to price the chains' gas tokens.
+	// Defaults to https://coins.llama.fi.
+	PriceAPIURL string `yaml:"price_api_url"`
 	// BaseOmnirpcURL is the base url for omnirpc.
 	// The format is "https://omnirpc.url/". Notice the lack of "confirmations" on the URL
 	// in comparison to what `Scribe` uses.
@@ -63,6 +75,16 @@ func (c *Config) IsValid(ctx context.Context) (ok bool, err error) {
 		return false, fmt.Errorf("unbonded signer is invalid: %w", err)
 	}
 
+	for _, attestationURL := range c.FallbackAttestationURLs {
+		if _, err := fasturl.ParseURL(attestationURL); err != nil {
+			return false, fmt.Errorf("fallback attestation url %s is invalid: %w", attestationURL, err)
+		}
+	}
+
+	if c.AttestationRequestsPerSecond < 0 {
+		return false, fmt.Errorf("attestation requests per second cannot be negative")
+	}
+
 	return true, nil
 }
 
@@ -2,6 +2,9 @@
 
 import (
 	"context"
+	"errors"
+	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	submitterDB "github.com/synapsecns/sanguine/ethergo/submitter/db"
@@ -9,6 +12,9 @@
 	"github.com/synapsecns/sanguine/services/cctp-relayer/types"
 )
 
+// ErrMessageStateChanged is returned when a message is no longer in the state it was expected to be updated from.
+var ErrMessageStateChanged = errors.New("message state changed")
+
 // CCTPRelayerDBReader is the interface for reading from the database.
 type CCTPRelayerDBReader interface {
 	// GetLastBlockNumber gets the last block number that had a message for the respective origin chain in the database.
@@ -19,12 +25,41 @@ type CCTPRelayerDBReader interface {
 	GetMessageByOriginHash(ctx context.Context, originHash common.Hash) (*types.Message, error)
 	// GetMessageByRequestID gets a message by its request id.
 	GetMessageByRequestID(ctx context.Context, requestID string) (*types.Message, error)
+	// GetMessageByHash gets a message by its message hash.
+	GetMessageByHash(ctx context.Context, messageHash string) (*types.Message, error)
+	// GetMessages gets a page of messages matching the filter, most recent first. Pages start at 1.
+	GetMessages(ctx context.Context, filter MessageFilter, page, pageSize int) ([]types.Message, error)
+	// GetMessageEvents gets the state transitions of a message in the order they happened.
+	GetMessageEvents(ctx context.Context, messageHash string) ([]types.MessageEvent, error)
+	// GetMessageCounts gets the number of messages in each state for each destination chain.
+	GetMessageCounts(ctx context.Context) ([]types.MessageCount, error)
+	// GetGasSpent gets the estimated gas spent relaying messages on a chain on the utc day of the given time.
+	GetGasSpent(ctx context.Context, chainID uint32, day time.Time) (*big.Int, error)
 }
 
 // CCTPRelayerDBWriter is the interface for writing to the database.
 type CCTPRelayerDBWriter interface {
 	// StoreMessage stores a message in the database.
 	StoreMessage(ctx context.Context, message types.Message) error
+	// UpdateMessageState updates the state of a message and records the reason in its timeline. If from is given the
+	// message is only updated if it's still in one of those states, ErrMessageStateChanged is returned otherwise.
+	UpdateMessageState(ctx context.Context, messageHash string, state types.MessageState, reason string, from ...types.MessageState) error
+	// SetForceRelay marks a message to be relayed regardless of profitability and gas budgets.
+	SetForceRelay(ctx context.Context, messageHash string) error
+	// AddGasSpent adds to the estimated gas spent relaying messages on a chain on the utc day of the given time.
+	AddGasSpent(ctx context.Context, chainID uint32, day time.Time, amount *big.Int) error
+}
+
+// MessageFilter filters messages when listing them. Zero values match anything.
+type MessageFilter struct {
+	// States are the states to match.
+	States []types.MessageState
+	// OriginChainID is the origin chain to match.
+	OriginChainID uint32
+	// DestChainID is the destination chain to match.
+	DestChainID uint32
+	// UpdatedBefore matches messages whose latest state change was before this time.
+	UpdatedBefore time.Time
 }
 
 // CCTPRelayerDB is the interface for the database service.
@@ -1,13 +1,16 @@
 package db_test
 
 import (
+	"math/big"
 	"strings"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/synapsecns/sanguine/ethergo/mocks"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/db"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/types"
+	"gorm.io/gorm"
 )
 
 func (d *DBSuite) mockMessage(originChainID, destinationChainID, blockNumber uint32) types.Message {
@@ -128,3 +131,186 @@ func (d *DBSuite) TestUpsertStoreMessage() {
 		d.Equal(fetchedMessage.State, types.Attested)
 	})
 }
+
+func (d *DBSuite) TestParkAndForceRelay() {
+	d.RunOnAllDBs(func(testDB db.CCTPRelayerDB) {
+		message := d.mockMessage(gofakeit.Uint32(), gofakeit.Uint32(), gofakeit.Uint32())
+		message.State = types.Attested
+		err := testDB.StoreMessage(d.GetTestContext(), message)
+		d.Nil(err)
+
+		message.State = types.Parked
+		err = testDB.StoreMessage(d.GetTestContext(), message)
+		d.Nil(err)
+
+		err = testDB.SetForceRelay(d.GetTestContext(), message.MessageHash)
+		d.Nil(err)
+
+		fetchedMessage, err := testDB.GetMessageByRequestID(d.GetTestContext(), message.RequestID)
+		d.Nil(err)
+		d.Equal(types.Parked, fetchedMessage.State)
+		d.True(fetchedMessage.ForceRelay)
+
+		// parking the message again shouldn't reset the override.
+		err = testDB.StoreMessage(d.GetTestContext(), message)
+		d.Nil(err)
+		fetchedMessage, err = testDB.GetMessageByRequestID(d.GetTestContext(), message.RequestID)
+		d.Nil(err)
+		d.True(fetchedMessage.ForceRelay)
+
+		err = testDB.SetForceRelay(d.GetTestContext(), mocks.NewMockHash(d.T()).String())
+		d.ErrorIs(err, gorm.ErrRecordNotFound)
+	})
+}
+
+func (d *DBSuite) TestGasSpent() {
+	d.RunOnAllDBs(func(testDB db.CCTPRelayerDB) {
+		chainID := gofakeit.Uint32()
+		today := time.Now()
+		yesterday := today.Add(-24 * time.Hour)
+
+		spent, err := testDB.GetGasSpent(d.GetTestContext(), chainID, today)
+		d.Nil(err)
+		d.Equal(uint64(0), spent.Uint64())
+
+		// amounts larger than an int64 should be stored without overflow.
+		largeAmount, ok := new(big.Int).SetString("10000000000000000000", 10)
+		d.True(ok)
+		err = testDB.AddGasSpent(d.GetTestContext(), chainID, today, largeAmount)
+		d.Nil(err)
+		err = testDB.AddGasSpent(d.GetTestContext(), chainID, today, big.NewInt(5))
+		d.Nil(err)
+		err = testDB.AddGasSpent(d.GetTestContext(), chainID, yesterday, big.NewInt(7))
+		d.Nil(err)
+
+		spent, err = testDB.GetGasSpent(d.GetTestContext(), chainID, today)
+		d.Nil(err)
+		d.Equal("10000000000000000005", spent.String())
+
+		spent, err = testDB.GetGasSpent(d.GetTestContext(), chainID, yesterday)
+		d.Nil(err)
+		d.Equal(uint64(7), spent.Uint64())
+
+		spent, err = testDB.GetGasSpent(d.GetTestContext(), chainID+1, today)
+		d.Nil(err)
+		d.Equal(uint64(0), spent.Uint64())
+	})
+}
+
+func (d *DBSuite) TestMessageTimeline() {
+	d.RunOnAllDBs(func(testDB db.CCTPRelayerDB) {
+		message := d.mockMessage(gofakeit.Uint32(), gofakeit.Uint32(), gofakeit.Uint32())
+		message.State = types.Pending
+		err := testDB.StoreMessage(d.GetTestContext(), message)
+		d.Nil(err)
+
+		// storing a pending message twice shouldn't add to the timeline.
+		err = testDB.StoreMessage(d.GetTestContext(), message)
+		d.Nil(err)
+
+		message.State = types.Attested
+		err = testDB.StoreMessage(d.GetTestContext(), message)
+		d.Nil(err)
+
+		err = testDB.UpdateMessageState(d.GetTestContext(), message.MessageHash, types.Parked, "gas too high")
+		d.Nil(err)
+
+		message.State = types.Submitted
+		message.DestNonce = 3
+		err = testDB.StoreMessage(d.GetTestContext(), message)
+		d.Nil(err)
+
+		message.State = types.Complete
+		err = testDB.StoreMessage(d.GetTestContext(), message)
+		d.Nil(err)
+		err = testDB.StoreMessage(d.GetTestContext(), message)
+		d.Nil(err)
+
+		events, err := testDB.GetMessageEvents(d.GetTestContext(), message.MessageHash)
+		d.Nil(err)
+		d.Len(events, 5)
+
+		expectedStates := []types.MessageState{types.Pending, types.Attested, types.Parked, types.Submitted, types.Complete}
+		for i, event := range events {
+			d.Equal(expectedStates[i], event.State)
+			d.False(event.CreatedAt.IsZero())
+		}
+		d.Equal(message.OriginTxHash, events[0].TxHash)
+		d.Equal("gas too high", events[2].Reason)
+		d.Equal(message.DestTxHash, events[3].TxHash)
+		d.Equal(3, events[3].Nonce)
+
+		err = testDB.UpdateMessageState(d.GetTestContext(), mocks.NewMockHash(d.T()).String(), types.Skipped, "")
+		d.ErrorIs(err, gorm.ErrRecordNotFound)
+		err = testDB.UpdateMessageState(d.GetTestContext(), mocks.NewMockHash(d.T()).String(), types.Skipped, "", types.Pending)
+		d.ErrorIs(err, gorm.ErrRecordNotFound)
+
+		// a message that moved on from the expected states isn't updated.
+		err = testDB.UpdateMessageState(d.GetTestContext(), message.MessageHash, types.Skipped, "operator skip", types.Pending, types.Attested)
+		d.ErrorIs(err, db.ErrMessageStateChanged)
+		stored, err := testDB.GetMessageByHash(d.GetTestContext(), message.MessageHash)
+		d.Nil(err)
+		d.Equal(types.Complete, stored.State)
+
+		err = testDB.UpdateMessageState(d.GetTestContext(), message.MessageHash, types.Pending, "operator requeue", types.Complete)
+		d.Nil(err)
+		stored, err = testDB.GetMessageByHash(d.GetTestContext(), message.MessageHash)
+		d.Nil(err)
+		d.Equal(types.Pending, stored.State)
+	})
+}
+
+func (d *DBSuite) TestGetMessages() {
+	d.RunOnAllDBs(func(testDB db.CCTPRelayerDB) {
+		originChainID := gofakeit.Uint32()
+		destChainID := originChainID + 1
+
+		var messages []types.Message
+		for i := 0; i < 5; i++ {
+			message := d.mockMessage(originChainID, destChainID, uint32(i))
+			err := testDB.StoreMessage(d.GetTestContext(), message)
+			d.Nil(err)
+			messages = append(messages, message)
+		}
+		err := testDB.UpdateMessageState(d.GetTestContext(), messages[0].MessageHash, types.Skipped, "")
+		d.Nil(err)
+
+		// pages are ordered by block number descending.
+		filter := db.MessageFilter{OriginChainID: originChainID, States: []types.MessageState{types.Pending}}
+		page, err := testDB.GetMessages(d.GetTestContext(), filter, 1, 3)
+		d.Nil(err)
+		d.Len(page, 3)
+		d.Equal(messages[4].MessageHash, page[0].MessageHash)
+
+		page, err = testDB.GetMessages(d.GetTestContext(), filter, 2, 3)
+		d.Nil(err)
+		d.Len(page, 1)
+		d.Equal(messages[1].MessageHash, page[0].MessageHash)
+
+		page, err = testDB.GetMessages(d.GetTestContext(), db.MessageFilter{DestChainID: destChainID, States: []types.MessageState{types.Skipped}}, 1, 10)
+		d.Nil(err)
+		d.Len(page, 1)
+
+		// messages updated before the cutoff
+		page, err = testDB.GetMessages(d.GetTestContext(), db.MessageFilter{OriginChainID: originChainID, UpdatedBefore: time.Now().Add(time.Hour)}, 1, 10)
+		d.Nil(err)
+		d.Len(page, 5)
+		page, err = testDB.GetMessages(d.GetTestContext(), db.MessageFilter{OriginChainID: originChainID, UpdatedBefore: time.Now().Add(-time.Hour)}, 1, 10)
+		d.Nil(err)
+		d.Empty(page)
+
+		counts, err := testDB.GetMessageCounts(d.GetTestContext())
+		d.Nil(err)
+		countByState := make(map[types.MessageState]int64)
+		for _, count := range counts {
+			if count.DestChainID == destChainID {
+				countByState[count.State] = count.Count
+			}
+		}
+		d.Equal(map[types.MessageState]int64{types.Pending: 4, types.Skipped: 1}, countByState)
+
+		fetched, err := testDB.GetMessageByHash(d.GetTestContext(), messages[2].MessageHash)
+		d.Nil(err)
+		d.Equal(messages[2].OriginTxHash, fetched.OriginTxHash)
+	})
+}
@@ -36,7 +36,7 @@ func (s Store) SubmitterDB() submitterDB.Service {
 // see: https://medium.com/@SaifAbid/slice-interfaces-8c78f8b6345d for an explanation of why we can't do this at initialization time
 func GetAllModels() (allModels []interface{}) {
 	allModels = append(allModels, txdb.GetAllModels()...)
-	allModels = append(allModels, &types.Message{})
+	allModels = append(allModels, &types.Message{}, &types.MessageEvent{}, &GasSpend{})
 	return allModels
 }
 
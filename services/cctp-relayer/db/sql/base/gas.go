@@ -0,0 +1,80 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// dayFormat is the format used to key gas spend by utc day.
+const dayFormat = "2006-01-02"
+
+// GasSpend is the estimated gas spent relaying messages on a chain on a utc day.
+type GasSpend struct {
+	// ChainID is the chain the gas was spent on.
+	ChainID uint32 `gorm:"column:chain_id;primaryKey"`
+	// Day is the utc day the gas was spent on, formatted as YYYY-MM-DD.
+	Day string `gorm:"column:day;primaryKey"`
+	// Spent is the amount of gas token spent in wei. This is stored as a string since it can overflow an int64.
+	Spent string `gorm:"column:spent"`
+}
+
+// GetGasSpent gets the estimated gas spent on a chain on the utc day of the given time.
+func (s Store) GetGasSpent(ctx context.Context, chainID uint32, day time.Time) (*big.Int, error) {
+	spent, err := getGasSpent(s.DB().WithContext(ctx), chainID, day)
+	if err != nil {
+		return nil, err
+	}
+	return spent, nil
+}
+
+// AddGasSpent adds to the estimated gas spent on a chain on the utc day of the given time.
+func (s Store) AddGasSpent(ctx context.Context, chainID uint32, day time.Time, amount *big.Int) error {
+	err := s.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		spent, err := getGasSpent(tx, chainID, day)
+		if err != nil {
+			return err
+		}
+
+		dbTx := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: ChainIDFieldName}, {Name: DayFieldName}},
+			DoUpdates: clause.AssignmentColumns([]string{SpentFieldName}),
+		}).Create(&GasSpend{
+			ChainID: chainID,
+			Day:     day.UTC().Format(dayFormat),
+			Spent:   new(big.Int).Add(spent, amount).String(),
+		})
+		if dbTx.Error != nil {
+			return fmt.Errorf("failed to store gas spent: %w", dbTx.Error)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not add gas spent: %w", err)
+	}
+	return nil
+}
+
+func getGasSpent(tx *gorm.DB, chainID uint32, day time.Time) (*big.Int, error) {
+	var gasSpend GasSpend
+	dbTx := tx.Model(&GasSpend{}).
+		Where(fmt.Sprintf("%s = ? AND %s = ?", ChainIDFieldName, DayFieldName), chainID, day.UTC().Format(dayFormat)).
+		First(&gasSpend)
+	if errors.Is(dbTx.Error, gorm.ErrRecordNotFound) {
+		return big.NewInt(0), nil
+	}
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get gas spent: %w", dbTx.Error)
+	}
+
+	spent, ok := new(big.Int).SetString(gasSpend.Spent, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid gas spent %s", gasSpend.Spent)
+	}
+	return spent, nil
+}
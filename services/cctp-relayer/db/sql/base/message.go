@@ -4,10 +4,13 @@
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"github.com/synapsecns/sanguine/services/cctp-relayer/db"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/types"
 )
 
@@ -58,6 +61,7 @@ func (s Store) StoreMessage(ctx context.Context, msg types.Message) error {
 			Columns: []clause.Column{{Name: MessageHashFieldName}},
 			DoUpdates: clause.AssignmentColumns([]string{
 				StateFieldName,
+				StateUpdatedAtFieldName,
 				AttestationFieldName,
 			}),
 		}
@@ -67,6 +71,7 @@ func (s Store) StoreMessage(ctx context.Context, msg types.Message) error {
 			DoUpdates: clause.AssignmentColumns([]string{
 				DestTxHashFieldName,
 				StateFieldName,
+				StateUpdatedAtFieldName,
 				NonceFieldName,
 			}),
 		}
@@ -76,14 +81,110 @@ func (s Store) StoreMessage(ctx context.Context, msg types.Message) error {
 			DoUpdates: clause.AssignmentColumns([]string{
 				DestTxHashFieldName,
 				StateFieldName,
+				StateUpdatedAtFieldName,
 			}),
 		}
+	case types.Parked, types.Skipped:
+		clauses = clause.OnConflict{
+			Columns: []clause.Column{{Name: MessageHashFieldName}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				StateFieldName,
+				StateUpdatedAtFieldName,
+			}),
+		}
+	}
+
+	msg.StateUpdatedAt = time.Now()
+	err := s.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		dbTx := tx.Clauses(clauses).Create(&msg)
+		if dbTx.Error != nil {
+			return fmt.Errorf("failed to store message: %w", dbTx.Error)
+		}
+
+		// nothing was stored if the message already existed
+		if dbTx.RowsAffected == 0 {
+			return nil
+		}
+
+		txHash := msg.OriginTxHash
+		if msg.State == types.Submitted || msg.State == types.Complete {
+			txHash = msg.DestTxHash
+		}
+		return storeMessageEvent(tx, types.MessageEvent{
+			MessageHash: msg.MessageHash,
+			State:       msg.State,
+			TxHash:      txHash,
+			Nonce:       msg.DestNonce,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("could not store message: %w", err)
 	}
+	return nil
+}
 
-	dbTx := s.DB().WithContext(ctx).Clauses(clauses).Create(&msg)
+// UpdateMessageState updates the state of a message and records the reason in its timeline.
+// gorm.ErrRecordNotFound is returned if the message doesn't exist, db.ErrMessageStateChanged if from is given and the
+// message is no longer in one of those states.
+func (s Store) UpdateMessageState(ctx context.Context, messageHash string, state types.MessageState, reason string, from ...types.MessageState) error {
+	err := s.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&types.Message{}).
+			Where(fmt.Sprintf("%s = ?", MessageHashFieldName), messageHash)
+		if len(from) > 0 {
+			query = query.Where(fmt.Sprintf("%s IN ?", StateFieldName), from)
+		}
+		dbTx := query.Updates(map[string]interface{}{
+			StateFieldName:          state,
+			StateUpdatedAtFieldName: time.Now(),
+		})
+		if dbTx.Error != nil {
+			return fmt.Errorf("failed to update message state: %w", dbTx.Error)
+		}
+		if dbTx.RowsAffected == 0 {
+			var count int64
+			dbTx = tx.Model(&types.Message{}).
+				Where(fmt.Sprintf("%s = ?", MessageHashFieldName), messageHash).
+				Count(&count)
+			if dbTx.Error != nil {
+				return fmt.Errorf("failed to get message: %w", dbTx.Error)
+			}
+			if count == 0 {
+				return fmt.Errorf("could not find message %s: %w", messageHash, gorm.ErrRecordNotFound)
+			}
+			return fmt.Errorf("could not update message %s: %w", messageHash, db.ErrMessageStateChanged)
+		}
 
+		return storeMessageEvent(tx, types.MessageEvent{
+			MessageHash: messageHash,
+			State:       state,
+			Reason:      reason,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("could not update message state: %w", err)
+	}
+	return nil
+}
+
+// storeMessageEvent records a state transition, skipping it if it repeats the latest transition.
+func storeMessageEvent(tx *gorm.DB, event types.MessageEvent) error {
+	var latest types.MessageEvent
+	dbTx := tx.Model(&types.MessageEvent{}).
+		Where(fmt.Sprintf("%s = ?", MessageHashFieldName), event.MessageHash).
+		Order(fmt.Sprintf("%s DESC", IDFieldName)).
+		Limit(1).
+		Find(&latest)
+	if dbTx.Error != nil {
+		return fmt.Errorf("failed to get latest message event: %w", dbTx.Error)
+	}
+	if dbTx.RowsAffected > 0 && latest.State == event.State && latest.TxHash == event.TxHash && latest.Reason == event.Reason {
+		return nil
+	}
+
+	event.CreatedAt = time.Now()
+	dbTx = tx.Create(&event)
 	if dbTx.Error != nil {
-		return fmt.Errorf("failed to store message: %w", dbTx.Error)
+		return fmt.Errorf("failed to store message event: %w", dbTx.Error)
 	}
 	return nil
 }
@@ -138,3 +239,103 @@ func (s Store) GetMessageByRequestID(ctx context.Context, requestID string) (*ty
 
 	return &message, nil
 }
+
+// SetForceRelay marks a message to be relayed regardless of profitability and gas budgets.
+// gorm.ErrRecordNotFound is returned if the message doesn't exist.
+func (s Store) SetForceRelay(ctx context.Context, messageHash string) error {
+	dbTx := s.DB().WithContext(ctx).
+		Model(&types.Message{}).
+		Where(fmt.Sprintf("%s = ?", MessageHashFieldName), messageHash).
+		Update(ForceRelayFieldName, true)
+	if dbTx.Error != nil {
+		return fmt.Errorf("failed to set force relay: %w", dbTx.Error)
+	}
+	if dbTx.RowsAffected == 0 {
+		return fmt.Errorf("could not find message %s: %w", messageHash, gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// GetMessageByHash gets a message by its message hash.
+func (s Store) GetMessageByHash(ctx context.Context, messageHash string) (*types.Message, error) {
+	var message types.Message
+
+	dbTx := s.DB().WithContext(ctx).
+		Model(&types.Message{}).
+		Where(fmt.Sprintf("%s = ?", MessageHashFieldName), messageHash).
+		First(&message)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get message by message hash: %w", dbTx.Error)
+	}
+
+	return &message, nil
+}
+
+// GetMessages gets a page of messages matching the filter, most recent first. Pages start at 1.
+func (s Store) GetMessages(ctx context.Context, filter db.MessageFilter, page, pageSize int) ([]types.Message, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	query := s.DB().WithContext(ctx).Model(&types.Message{})
+	if len(filter.States) > 0 {
+		stateArgs := make([]int, len(filter.States))
+		for i := range filter.States {
+			stateArgs[i] = int(filter.States[i])
+		}
+		query = query.Where(fmt.Sprintf("%s IN ?", StateFieldName), stateArgs)
+	}
+	if filter.OriginChainID != 0 {
+		query = query.Where(fmt.Sprintf("%s = ?", OriginChainIDFieldName), filter.OriginChainID)
+	}
+	if filter.DestChainID != 0 {
+		query = query.Where(fmt.Sprintf("%s = ?", DestChainIDFieldName), filter.DestChainID)
+	}
+	if !filter.UpdatedBefore.IsZero() {
+		query = query.Where(fmt.Sprintf("%s < ?", StateUpdatedAtFieldName), filter.UpdatedBefore)
+	}
+
+	var messages []types.Message
+	dbTx := query.
+		Order(fmt.Sprintf("%s DESC, %s", BlockNumberFieldName, MessageHashFieldName)).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&messages)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get messages: %w", dbTx.Error)
+	}
+
+	return messages, nil
+}
+
+// GetMessageEvents gets the state transitions of a message in the order they happened.
+func (s Store) GetMessageEvents(ctx context.Context, messageHash string) ([]types.MessageEvent, error) {
+	var events []types.MessageEvent
+
+	dbTx := s.DB().WithContext(ctx).
+		Model(&types.MessageEvent{}).
+		Where(fmt.Sprintf("%s = ?", MessageHashFieldName), messageHash).
+		Order(IDFieldName).
+		Find(&events)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get message events: %w", dbTx.Error)
+	}
+
+	return events, nil
+}
+
+// GetMessageCounts gets the number of messages in each state for each destination chain.
+func (s Store) GetMessageCounts(ctx context.Context) ([]types.MessageCount, error) {
+	var counts []types.MessageCount
+
+	dbTx := s.DB().WithContext(ctx).
+		Model(&types.Message{}).
+		Select(fmt.Sprintf("%s, %s, COUNT(*) AS count", DestChainIDFieldName, StateFieldName)).
+		Group(fmt.Sprintf("%s, %s", DestChainIDFieldName, StateFieldName)).
+		Scan(&counts)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get message counts: %w", dbTx.Error)
+	}
+
+	return counts, nil
+}
@@ -22,6 +22,13 @@ func init() {
 	RequestIDFieldName = namer.GetConsistentName("RequestID")
 	BlockNumberFieldName = namer.GetConsistentName("BlockNumber")
 	StateFieldName = namer.GetConsistentName("State")
+	ForceRelayFieldName = namer.GetConsistentName("ForceRelay")
+	ChainIDFieldName = namer.GetConsistentName("ChainID")
+	DayFieldName = namer.GetConsistentName("Day")
+	SpentFieldName = namer.GetConsistentName("Spent")
+	IDFieldName = namer.GetConsistentName("ID")
+	CreatedAtFieldName = namer.GetConsistentName("CreatedAt")
+	StateUpdatedAtFieldName = namer.GetConsistentName("StateUpdatedAt")
 }
 
 var (
@@ -51,4 +58,18 @@ func init() {
 	BlockNumberFieldName string
 	// StateFieldName gets the state field name.
 	StateFieldName string
+	// ForceRelayFieldName gets the force relay field name.
+	ForceRelayFieldName string
+	// ChainIDFieldName gets the gas spend chain id field name.
+	ChainIDFieldName string
+	// DayFieldName gets the gas spend day field name.
+	DayFieldName string
+	// SpentFieldName gets the gas spend amount field name.
+	SpentFieldName string
+	// IDFieldName gets the message event id field name.
+	IDFieldName string
+	// CreatedAtFieldName gets the message event created at field name.
+	CreatedAtFieldName string
+	// StateUpdatedAtFieldName gets the message state updated at field name.
+	StateUpdatedAtFieldName string
 )
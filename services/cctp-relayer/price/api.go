@@ -0,0 +1,11 @@
+package price
+
+import (
+	"context"
+)
+
+// Source gets token prices in usd.
+type Source interface {
+	// GetPriceUSD gets the current usd price of a coin.
+	GetPriceUSD(ctx context.Context, coinID string) (float64, error)
+}
@@ -0,0 +1,2 @@
+// Package price contains gas token price handling for the relayer.
+package price
@@ -0,0 +1,96 @@
+package price
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LlamaAPI is a Source backed by the defillama coins api. Prices are cached for the cache ttl.
+type LlamaAPI struct {
+	client   *http.Client
+	baseURL  string
+	cacheTTL time.Duration
+	// mux protects prices.
+	mux sync.Mutex
+	// prices maps coin id -> last fetched price.
+	prices map[string]cachedPrice
+}
+
+type cachedPrice struct {
+	price     float64
+	fetchedAt time.Time
+}
+
+const llamaCoinsURL = "https://coins.llama.fi"
+
+// DefaultCacheTTL is how long a fetched price is used before fetching it again.
+const DefaultCacheTTL = time.Minute
+
+// NewLlamaAPI creates a new LlamaAPI.
+func NewLlamaAPI(url string, cacheTTL time.Duration) *LlamaAPI {
+	if url == "" {
+		url = llamaCoinsURL
+	}
+	return &LlamaAPI{
+		client:   &http.Client{},
+		baseURL:  strings.TrimSuffix(url, "/"),
+		cacheTTL: cacheTTL,
+		prices:   make(map[string]cachedPrice),
+	}
+}
+
+type llamaPriceResponse struct {
+	Coins map[string]struct {
+		Price float64 `json:"price"`
+	} `json:"coins"`
+}
+
+// GetPriceUSD gets the current usd price of a defillama coin id, e.g. coingecko:ethereum.
+func (l *LlamaAPI) GetPriceUSD(ctx context.Context, coinID string) (float64, error) {
+	l.mux.Lock()
+	cached, ok := l.prices[coinID]
+	l.mux.Unlock()
+	if ok && time.Since(cached.fetchedAt) < l.cacheTTL {
+		return cached.price, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/prices/current/%s", l.baseURL, coinID), nil)
+	if err != nil {
+		return 0, fmt.Errorf("could not create request: %w", err)
+	}
+
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("could not get price: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("expected status code 200, got %d", resp.StatusCode)
+	}
+
+	var result llamaPriceResponse
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return 0, fmt.Errorf("could not decode price response: %w", err)
+	}
+
+	coin, ok := result.Coins[coinID]
+	if !ok || coin.Price <= 0 {
+		return 0, fmt.Errorf("no price found for %s", coinID)
+	}
+
+	l.mux.Lock()
+	l.prices[coinID] = cachedPrice{price: coin.Price, fetchedAt: time.Now()}
+	l.mux.Unlock()
+	return coin.Price, nil
+}
+
+var _ Source = &LlamaAPI{}
@@ -0,0 +1,50 @@
+package price_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/price"
+)
+
+func (p *PriceSuite) TestLlamaAPI() {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		switch r.URL.Path {
+		case "/prices/current/coingecko:ethereum":
+			_, _ = w.Write([]byte(`{"coins": {"coingecko:ethereum": {"price": 2000}}}`))
+		case "/prices/current/coingecko:missing":
+			_, _ = w.Write([]byte(`{"coins": {}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	api := price.NewLlamaAPI(server.URL+"/", time.Hour)
+
+	res, err := api.GetPriceUSD(p.GetTestContext(), "coingecko:ethereum")
+	Nil(p.T(), err)
+	Equal(p.T(), float64(2000), res)
+
+	// prices are cached
+	res, err = api.GetPriceUSD(p.GetTestContext(), "coingecko:ethereum")
+	Nil(p.T(), err)
+	Equal(p.T(), float64(2000), res)
+	Equal(p.T(), int32(1), atomic.LoadInt32(&requests))
+
+	_, err = api.GetPriceUSD(p.GetTestContext(), "coingecko:missing")
+	NotNil(p.T(), err)
+
+	// expired prices are fetched again
+	api = price.NewLlamaAPI(server.URL, 0)
+	for i := 0; i < 2; i++ {
+		_, err = api.GetPriceUSD(p.GetTestContext(), "coingecko:ethereum")
+		Nil(p.T(), err)
+	}
+	Equal(p.T(), int32(4), atomic.LoadInt32(&requests))
+}
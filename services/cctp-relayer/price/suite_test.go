@@ -0,0 +1,26 @@
+package price_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/synapsecns/sanguine/core/testsuite"
+)
+
+// PriceSuite is the price test suite.
+type PriceSuite struct {
+	*testsuite.TestSuite
+}
+
+// NewPriceSuite creates a new price test suite.
+func NewPriceSuite(tb testing.TB) *PriceSuite {
+	tb.Helper()
+	return &PriceSuite{
+		TestSuite: testsuite.NewTestSuite(tb),
+	}
+}
+
+// TestPriceSuite runs the price test suite.
+func TestPriceSuite(t *testing.T) {
+	suite.Run(t, NewPriceSuite(t))
+}
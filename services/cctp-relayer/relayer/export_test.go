@@ -2,10 +2,13 @@
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/config"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/contracts/cctp"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/price"
 	relayTypes "github.com/synapsecns/sanguine/services/cctp-relayer/types"
 	omniClient "github.com/synapsecns/sanguine/services/omnirpc/client"
 )
@@ -39,3 +42,23 @@ func (c *CCTPRelayer) SubmitReceiveCircleToken(parentCtx context.Context, msg *r
 func (c *CCTPRelayer) SetOmnirpcClient(client omniClient.RPCClient) {
 	c.omnirpcClient = client
 }
+
+// DecodeBaseRequest wraps decodeBaseRequest for testing.
+func DecodeBaseRequest(requestVersion uint32, formattedRequest []byte) (originDomain uint32, burnToken common.Address, amount *big.Int, err error) {
+	return decodeBaseRequest(requestVersion, formattedRequest)
+}
+
+// WeiToUSDC wraps weiToUSDC for testing.
+func WeiToUSDC(wei *big.Int, nativeTokenPriceUSD float64) *big.Int {
+	return weiToUSDC(wei, nativeTokenPriceUSD)
+}
+
+// RelayParkReason wraps relayParkReason for testing.
+func RelayParkReason(chainCfg config.ChainConfig, nativeTokenPriceUSD float64, gasCost, relayerFee, spent *big.Int) string {
+	return relayParkReason(chainCfg, nativeTokenPriceUSD, gasCost, relayerFee, spent)
+}
+
+// GetNativeTokenPrice wraps getNativeTokenPrice for testing.
+func GetNativeTokenPrice(ctx context.Context, priceSource price.Source, chainCfg config.ChainConfig) (float64, error) {
+	return getNativeTokenPrice(ctx, priceSource, chainCfg)
+}
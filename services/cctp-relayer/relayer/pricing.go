@@ -0,0 +1,262 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/config"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/contracts/cctp"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/price"
+	relayTypes "github.com/synapsecns/sanguine/services/cctp-relayer/types"
+)
+
+// feeDenominator is the denominator SynapseCCTP uses for the protocol fee.
+var feeDenominator = big.NewInt(1e10)
+
+// usdcUnit is one usdc in its smallest denomination.
+var usdcUnit = big.NewFloat(1e6)
+
+// requestVersionSwap is the SynapseCCTP request version for bridge+swap requests.
+const requestVersionSwap = 1
+
+var (
+	// synapseCCTPABI is used to encode receiveCircleToken calls for gas estimation.
+	synapseCCTPABI *abi.ABI
+	// baseRequestArgs are the fields of a SynapseCCTP base request:
+	// abi.encode(originDomain, nonce, originBurnToken, amount, recipient).
+	baseRequestArgs abi.Arguments
+	// swapRequestArgs are the fields of a SynapseCCTP swap request: abi.encode(baseRequest, swapParams).
+	swapRequestArgs abi.Arguments
+)
+
+func init() {
+	var err error
+	synapseCCTPABI, err = cctp.SynapseCCTPMetaData.GetAbi()
+	if err != nil {
+		panic(fmt.Errorf("could not parse synapse cctp abi: %w", err))
+	}
+
+	newType := func(t string) abi.Type {
+		parsed, err := abi.NewType(t, "", nil)
+		if err != nil {
+			panic(fmt.Errorf("could not create abi type %s: %w", t, err))
+		}
+		return parsed
+	}
+	baseRequestArgs = abi.Arguments{
+		{Type: newType("uint32")},
+		{Type: newType("uint64")},
+		{Type: newType("address")},
+		{Type: newType("uint256")},
+		{Type: newType("address")},
+	}
+	swapRequestArgs = abi.Arguments{
+		{Type: newType("bytes")},
+		{Type: newType("bytes")},
+	}
+}
+
+// decodeBaseRequest decodes the origin domain, origin burn token and amount from a formatted request.
+func decodeBaseRequest(requestVersion uint32, formattedRequest []byte) (originDomain uint32, burnToken common.Address, amount *big.Int, err error) {
+	baseRequest := formattedRequest
+	if requestVersion == requestVersionSwap {
+		swapRequest, err := swapRequestArgs.Unpack(formattedRequest)
+		if err != nil {
+			return 0, common.Address{}, nil, fmt.Errorf("could not decode swap request: %w", err)
+		}
+		//nolint: forcetypeassert
+		baseRequest = swapRequest[0].([]byte)
+	}
+
+	fields, err := baseRequestArgs.Unpack(baseRequest)
+	if err != nil {
+		return 0, common.Address{}, nil, fmt.Errorf("could not decode base request: %w", err)
+	}
+	//nolint: forcetypeassert
+	return fields[0].(uint32), fields[2].(common.Address), fields[3].(*big.Int), nil
+}
+
+// checkRelay checks whether a message should be relayed now. If the message should be parked, a reason is returned.
+// The estimated gas cost is returned so it can be counted against the daily budget; it is nil if the destination
+// chain has neither a native token price nor a gas budget configured, in which case no checks are made.
+func (c *CCTPRelayer) checkRelay(ctx context.Context, msg *relayTypes.Message, chainCfg config.ChainConfig, contract *cctp.SynapseCCTP) (gasCost *big.Int, parkReason string, err error) {
+	checkFee := chainCfg.NativeTokenPriceUSD > 0 || chainCfg.NativeTokenCoinID != ""
+	if !checkFee && chainCfg.DailyGasBudget == 0 {
+		return nil, "", nil
+	}
+
+	// forced relays skip the checks, so they're relayed even if the cost can't be estimated.
+	if msg.ForceRelay {
+		gasCost, err = c.estimateRelayGasCost(ctx, msg, chainCfg.GetSynapseCCTPAddress(), contract)
+		if err != nil {
+			logger.Warnf("could not estimate gas cost of forced relay %s: %v", msg.MessageHash, err)
+			return nil, "", nil
+		}
+		return gasCost, "", nil
+	}
+
+	gasCost, err = c.estimateRelayGasCost(ctx, msg, chainCfg.GetSynapseCCTPAddress(), contract)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not estimate gas cost: %w", err)
+	}
+
+	var relayerFee, spent *big.Int
+	var nativeTokenPriceUSD float64
+	if checkFee {
+		nativeTokenPriceUSD, err = getNativeTokenPrice(ctx, c.priceSource, chainCfg)
+		if err != nil {
+			return nil, "", fmt.Errorf("could not get native token price: %w", err)
+		}
+
+		relayerFee, err = c.getRelayerFee(ctx, msg, contract)
+		if err != nil {
+			return nil, "", fmt.Errorf("could not get relayer fee: %w", err)
+		}
+	}
+
+	if chainCfg.DailyGasBudget > 0 {
+		spent, err = c.db.GetGasSpent(ctx, msg.DestChainID, time.Now())
+		if err != nil {
+			return nil, "", fmt.Errorf("could not get gas spent: %w", err)
+		}
+	}
+
+	return gasCost, relayParkReason(chainCfg, nativeTokenPriceUSD, gasCost, relayerFee, spent), nil
+}
+
+// getNativeTokenPrice gets the usd price of a chain's gas token from the price source, falling back to the
+// configured price if the chain has no coin id or the price source fails.
+func getNativeTokenPrice(ctx context.Context, priceSource price.Source, chainCfg config.ChainConfig) (float64, error) {
+	if chainCfg.NativeTokenCoinID == "" {
+		return chainCfg.NativeTokenPriceUSD, nil
+	}
+
+	nativeTokenPrice, err := priceSource.GetPriceUSD(ctx, chainCfg.NativeTokenCoinID)
+	if err == nil {
+		return nativeTokenPrice, nil
+	}
+	if chainCfg.NativeTokenPriceUSD == 0 {
+		return 0, fmt.Errorf("could not get price of %s: %w", chainCfg.NativeTokenCoinID, err)
+	}
+
+	logger.Warnf("could not get price of %s, using the configured price: %v", chainCfg.NativeTokenCoinID, err)
+	return chainCfg.NativeTokenPriceUSD, nil
+}
+
+// relayParkReason returns why a relay costing gasCost should be parked, or an empty string if it should be relayed.
+// relayerFee is only checked if the gas token has a price and spent only if the chain has a daily gas budget.
+func relayParkReason(chainCfg config.ChainConfig, nativeTokenPriceUSD float64, gasCost, relayerFee, spent *big.Int) string {
+	if nativeTokenPriceUSD > 0 {
+		usdcCost := weiToUSDC(gasCost, nativeTokenPriceUSD)
+		if relayerFee.Cmp(usdcCost) < 0 {
+			return fmt.Sprintf("relayer fee %s is less than gas cost %s (usdc)", relayerFee, usdcCost)
+		}
+	}
+
+	if chainCfg.DailyGasBudget > 0 {
+		budget := etherToWei(chainCfg.DailyGasBudget)
+		if new(big.Int).Add(spent, gasCost).Cmp(budget) > 0 {
+			return fmt.Sprintf("daily gas budget %s is spent (%s spent, relay costs %s)", budget, spent, gasCost)
+		}
+	}
+
+	return ""
+}
+
+// estimateRelayGasCost estimates the cost of receiveCircleToken in wei, including the gas airdrop sent with it.
+func (c *CCTPRelayer) estimateRelayGasCost(ctx context.Context, msg *relayTypes.Message, contractAddress common.Address, contract *cctp.SynapseCCTP) (*big.Int, error) {
+	chainClient, err := c.omnirpcClient.GetConfirmationsClient(ctx, int(msg.DestChainID), 1)
+	if err != nil {
+		return nil, fmt.Errorf("could not get client: %w", err)
+	}
+
+	gasAmount, err := contract.ChainGasAmount(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("could not get chain gas amount: %w", err)
+	}
+
+	calldata, err := synapseCCTPABI.Pack("receiveCircleToken", msg.Message, msg.Attestation, msg.RequestVersion, msg.FormattedRequest)
+	if err != nil {
+		return nil, fmt.Errorf("could not pack receive circle token: %w", err)
+	}
+
+	gasLimit, err := chainClient.EstimateGas(ctx, ethereum.CallMsg{
+		From:  c.signerAddress,
+		To:    &contractAddress,
+		Value: gasAmount,
+		Data:  calldata,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not estimate gas: %w", err)
+	}
+
+	gasPrice, err := chainClient.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get gas price: %w", err)
+	}
+
+	gasCost := new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), gasPrice)
+	return gasCost.Add(gasCost, gasAmount), nil
+}
+
+// getRelayerFee gets the share of the message's bridge fee paid to the relayer, in usdc.
+func (c *CCTPRelayer) getRelayerFee(ctx context.Context, msg *relayTypes.Message, contract *cctp.SynapseCCTP) (*big.Int, error) {
+	opts := &bind.CallOpts{Context: ctx}
+
+	// the protocol keeps the whole fee if the relayer hasn't set a fee collector.
+	feeCollector, err := contract.RelayerFeeCollectors(opts, c.signerAddress)
+	if err != nil {
+		return nil, fmt.Errorf("could not get relayer fee collector: %w", err)
+	}
+	if feeCollector == (common.Address{}) {
+		return big.NewInt(0), nil
+	}
+
+	originDomain, burnToken, amount, err := decodeBaseRequest(msg.RequestVersion, msg.FormattedRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := contract.GetLocalToken(opts, originDomain, burnToken)
+	if err != nil {
+		return nil, fmt.Errorf("could not get local token: %w", err)
+	}
+
+	fee, err := contract.CalculateFeeAmount(opts, token, amount, msg.RequestVersion == requestVersionSwap)
+	if err != nil {
+		return nil, fmt.Errorf("could not calculate fee amount: %w", err)
+	}
+
+	protocolFee, err := contract.ProtocolFee(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not get protocol fee: %w", err)
+	}
+
+	protocolShare := new(big.Int).Div(new(big.Int).Mul(fee, protocolFee), feeDenominator)
+	return fee.Sub(fee, protocolShare), nil
+}
+
+// weiToUSDC converts an amount of gas token in wei to usdc using the gas token's usd price.
+func weiToUSDC(wei *big.Int, nativeTokenPriceUSD float64) *big.Int {
+	usdc := new(big.Float).SetInt(wei)
+	usdc.Mul(usdc, big.NewFloat(nativeTokenPriceUSD))
+	usdc.Quo(usdc, big.NewFloat(params.Ether))
+	usdc.Mul(usdc, usdcUnit)
+
+	result, _ := usdc.Int(nil)
+	return result
+}
+
+// etherToWei converts an amount of gas token in ether units to wei.
+func etherToWei(ether float64) *big.Int {
+	wei := new(big.Float).Mul(big.NewFloat(ether), big.NewFloat(params.Ether))
+	result, _ := wei.Int(nil)
+	return result
+}
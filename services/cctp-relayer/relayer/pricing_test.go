@@ -0,0 +1,117 @@
+package relayer_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/config"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/relayer"
+)
+
+func mustNewType(t *testing.T, typ string) abi.Type {
+	t.Helper()
+	parsed, err := abi.NewType(typ, "", nil)
+	Nil(t, err)
+	return parsed
+}
+
+func TestDecodeBaseRequest(t *testing.T) {
+	baseArgs := abi.Arguments{
+		{Type: mustNewType(t, "uint32")},
+		{Type: mustNewType(t, "uint64")},
+		{Type: mustNewType(t, "address")},
+		{Type: mustNewType(t, "uint256")},
+		{Type: mustNewType(t, "address")},
+	}
+	swapArgs := abi.Arguments{
+		{Type: mustNewType(t, "bytes")},
+		{Type: mustNewType(t, "bytes")},
+	}
+
+	burnToken := common.BigToAddress(big.NewInt(1234))
+	baseRequest, err := baseArgs.Pack(uint32(3), uint64(9), burnToken, big.NewInt(1000), common.BigToAddress(big.NewInt(5678)))
+	Nil(t, err)
+	swapRequest, err := swapArgs.Pack(baseRequest, make([]byte, 4*32))
+	Nil(t, err)
+
+	for requestVersion, formattedRequest := range [][]byte{baseRequest, swapRequest} {
+		originDomain, decodedToken, amount, err := relayer.DecodeBaseRequest(uint32(requestVersion), formattedRequest)
+		Nil(t, err)
+		Equal(t, uint32(3), originDomain)
+		Equal(t, burnToken, decodedToken)
+		Equal(t, uint64(1000), amount.Uint64())
+	}
+
+	_, _, _, err = relayer.DecodeBaseRequest(0, []byte{1, 2, 3})
+	NotNil(t, err)
+}
+
+func TestWeiToUSDC(t *testing.T) {
+	// 0.001 eth at $2000 is $2.
+	milliEther := new(big.Int).Div(big.NewInt(params.Ether), big.NewInt(1000))
+	Equal(t, uint64(2e6), relayer.WeiToUSDC(milliEther, 2000).Uint64())
+	Equal(t, uint64(0), relayer.WeiToUSDC(big.NewInt(0), 2000).Uint64())
+}
+
+func TestRelayParkReason(t *testing.T) {
+	milliEther := new(big.Int).Div(big.NewInt(params.Ether), big.NewInt(1000))
+	usdc := func(amount int64) *big.Int {
+		return big.NewInt(amount * 1e6)
+	}
+
+	// 0.001 eth at $2000 costs $2 to relay.
+	Empty(t, relayer.RelayParkReason(config.ChainConfig{}, 2000, milliEther, usdc(2), nil))
+	Empty(t, relayer.RelayParkReason(config.ChainConfig{}, 2000, milliEther, usdc(3), nil))
+	Contains(t, relayer.RelayParkReason(config.ChainConfig{}, 2000, milliEther, usdc(1), nil), "less than gas cost")
+
+	// a 0.01 eth budget fits 10 relays
+	budgetCfg := config.ChainConfig{DailyGasBudget: 0.01}
+	Empty(t, relayer.RelayParkReason(budgetCfg, 0, milliEther, nil, big.NewInt(0)))
+	Empty(t, relayer.RelayParkReason(budgetCfg, 0, milliEther, nil, new(big.Int).Mul(milliEther, big.NewInt(9))))
+	Contains(t, relayer.RelayParkReason(budgetCfg, 0, milliEther, nil, new(big.Int).Mul(milliEther, big.NewInt(10))), "budget")
+
+	// the fee is checked before the budget
+	Contains(t, relayer.RelayParkReason(budgetCfg, 2000, milliEther, usdc(1), big.NewInt(0)), "less than gas cost")
+	Contains(t, relayer.RelayParkReason(budgetCfg, 2000, milliEther, usdc(2), new(big.Int).Mul(milliEther, big.NewInt(10))), "budget")
+	Empty(t, relayer.RelayParkReason(budgetCfg, 2000, milliEther, usdc(2), big.NewInt(0)))
+}
+
+// testPriceSource is a price.Source with fixed prices.
+type testPriceSource map[string]float64
+
+func (p testPriceSource) GetPriceUSD(_ context.Context, coinID string) (float64, error) {
+	coinPrice, ok := p[coinID]
+	if !ok {
+		return 0, fmt.Errorf("no price for %s", coinID)
+	}
+	return coinPrice, nil
+}
+
+func TestGetNativeTokenPrice(t *testing.T) {
+	ctx := context.Background()
+	priceSource := testPriceSource{"coingecko:ethereum": 3000}
+
+	// the configured price is used without a coin id
+	nativeTokenPrice, err := relayer.GetNativeTokenPrice(ctx, priceSource, config.ChainConfig{NativeTokenPriceUSD: 2000})
+	Nil(t, err)
+	Equal(t, float64(2000), nativeTokenPrice)
+
+	// the price source is preferred over the configured price
+	nativeTokenPrice, err = relayer.GetNativeTokenPrice(ctx, priceSource, config.ChainConfig{NativeTokenPriceUSD: 2000, NativeTokenCoinID: "coingecko:ethereum"})
+	Nil(t, err)
+	Equal(t, float64(3000), nativeTokenPrice)
+
+	// the configured price is the fallback if the price source fails
+	nativeTokenPrice, err = relayer.GetNativeTokenPrice(ctx, priceSource, config.ChainConfig{NativeTokenPriceUSD: 2000, NativeTokenCoinID: "coingecko:avalanche-2"})
+	Nil(t, err)
+	Equal(t, float64(2000), nativeTokenPrice)
+
+	_, err = relayer.GetNativeTokenPrice(ctx, priceSource, config.ChainConfig{NativeTokenCoinID: "coingecko:avalanche-2"})
+	NotNil(t, err)
+}
@@ -23,6 +23,7 @@
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/contracts/cctp"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/contracts/mockmessagetransmitter"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/price"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
@@ -80,6 +81,17 @@ type CCTPRelayer struct {
 	retryNow chan bool
 	// retryOnce is used to return 0 for the first retry. timer
 	retryOnce sync.Once
+	// attestationLimitMux protects attestationLimitedUntil.
+	attestationLimitMux sync.Mutex
+	// attestationLimitedUntil is when the attestation api can be queried again after rate limiting the relayer.
+	attestationLimitedUntil time.Time
+	// signerAddress is the address of the relayer signer.
+	signerAddress common.Address
+	// gasBudgetMuxes maps chain ID -> lock serializing submissions to the chain if it has a daily gas budget,
+	// so concurrent workers can't overspend it.
+	gasBudgetMuxes map[uint32]*sync.Mutex
+	// priceSource gets the prices of the chains' gas tokens.
+	priceSource price.Source
 }
 
 // NewCCTPRelayer creates a new CCTPRelayer.
@@ -107,7 +119,9 @@ func NewCCTPRelayer(ctx context.Context, cfg config.Config, store db2.CCTPRelaye
 	// Build chainListeners and bound contracts.
 	chainListeners := make(map[uint32]*chainListener)
 	boundSynapseCCTPs := make(map[uint32]*cctp.SynapseCCTP)
+	gasBudgetMuxes := make(map[uint32]*sync.Mutex)
 	for _, chain := range cfg.Chains {
+		gasBudgetMuxes[chain.ChainID] = &sync.Mutex{}
 		chainListeners[chain.ChainID] = &chainListener{
 			chainID:         chain.ChainID,
 			closeConnection: make(chan bool, 1),
@@ -132,7 +146,10 @@ func NewCCTPRelayer(ctx context.Context, cfg config.Config, store db2.CCTPRelaye
 	txSubmitter := submitter.NewTransactionSubmitter(handler, signer, omniRPCClient, store.SubmitterDB(), &cfg.SubmitterConfig)
 
 	relayerRequestChan := make(chan *api.RelayRequest, 1000)
-	relayerAPI := api.NewRelayerAPIServer(cfg.Port, cfg.Host, store, relayerRequestChan)
+	relayerAPI := api.NewRelayerAPIServer(cfg.Port, cfg.Host, store, relayerRequestChan,
+		api.WithOperatorToken(cfg.OperatorAPIToken),
+		api.WithSignerAddress(signer.Address()),
+	)
 
 	return &CCTPRelayer{
 		cfg:               cfg,
@@ -149,6 +166,9 @@ func NewCCTPRelayer(ctx context.Context, cfg config.Config, store db2.CCTPRelaye
 		attestationAPI:    attestationAPI,
 		txSubmitter:       txSubmitter,
 		boundSynapseCCTPs: boundSynapseCCTPs,
+		signerAddress:     signer.Address(),
+		gasBudgetMuxes:    gasBudgetMuxes,
+		priceSource:       price.NewLlamaAPI(cfg.PriceAPIURL, price.DefaultCacheTTL),
 	}, nil
 }
 
@@ -210,7 +230,7 @@ func (c *CCTPRelayer) processQueue(parentCtx context.Context) (err error) {
 		metrics.EndSpanWithErr(span, err)
 	}()
 
-	attestations, err := c.db.GetMessagesByState(ctx, relayTypes.Pending, relayTypes.Attested)
+	attestations, err := c.db.GetMessagesByState(ctx, relayTypes.Pending, relayTypes.Attested, relayTypes.Parked)
 	if err != nil {
 		return fmt.Errorf("could not get pending messages: %w", err)
 	}
@@ -270,6 +290,12 @@ func (c *CCTPRelayer) processMessage(parentCtx context.Context, msg *relayTypes.
 		metrics.EndSpanWithErr(span, err)
 	}()
 
+	// the queue is a snapshot, an operator may have moved the message since.
+	current, err := c.isCurrentState(ctx, msg)
+	if err != nil || !current {
+		return err
+	}
+
 	if msg.State == relayTypes.Pending {
 		msg, err = c.fetchAttestation(ctx, msg)
 		if err != nil {
@@ -277,8 +303,13 @@ func (c *CCTPRelayer) processMessage(parentCtx context.Context, msg *relayTypes.
 		}
 	}
 
-	if msg.State == relayTypes.Attested {
-		err := c.submitReceiveCircleToken(ctx, msg)
+	if msg.State == relayTypes.Attested || msg.State == relayTypes.Parked {
+		current, err := c.isCurrentState(ctx, msg)
+		if err != nil || !current {
+			return err
+		}
+
+		err = c.submitReceiveCircleToken(ctx, msg)
 		if err != nil {
 			return fmt.Errorf("could not submit receive circle token: %w", err)
 		}
@@ -287,6 +318,19 @@ func (c *CCTPRelayer) processMessage(parentCtx context.Context, msg *relayTypes.
 	return nil
 }
 
+// isCurrentState checks the message is still in the state it was queued in.
+func (c *CCTPRelayer) isCurrentState(ctx context.Context, msg *relayTypes.Message) (bool, error) {
+	stored, err := c.db.GetMessageByHash(ctx, msg.MessageHash)
+	if err != nil {
+		return false, fmt.Errorf("could not get message: %w", err)
+	}
+	if stored.State != msg.State {
+		logger.Infof("message %s moved from %s to %s, not processing it", msg.MessageHash, msg.State, stored.State)
+		return false, nil
+	}
+	return true, nil
+}
+
 // Run starts the CCTPRelayer.
 func (c *CCTPRelayer) Run(parentCtx context.Context) error {
 	g, ctx := errgroup.WithContext(parentCtx)
@@ -650,13 +694,29 @@ func (c *CCTPRelayer) fetchAttestation(parentCtx context.Context, msg *relayType
 		metrics.EndSpanWithErr(span, err)
 	}()
 
+	// don't poll the attestation api while it's rate limiting us, since requests extend the block.
+	if limitedUntil := c.getAttestationLimitedUntil(); time.Now().Before(limitedUntil) {
+		return nil, attestation.RateLimitError{RetryAfter: time.Until(limitedUntil)}
+	}
+
+	var rateLimitErr attestation.RateLimitError
+	rateLimited := false
 	err = retry.WithBackoff(ctx, func(ctx context.Context) (err error) {
 		msg.Attestation, err = c.attestationAPI.GetAttestation(ctx, msg.MessageHash)
+		if errors.As(err, &rateLimitErr) {
+			// stop retrying, further requests will fail until the rate limit has passed.
+			rateLimited = true
+			return nil
+		}
 		return
 	}, retry.WithMax(time.Duration(c.cfg.HTTPBackoffMaxElapsedTimeMs)*time.Millisecond))
 	if err != nil {
 		return
 	}
+	if rateLimited {
+		c.setAttestationLimitedUntil(time.Now().Add(rateLimitErr.RetryAfter))
+		return nil, rateLimitErr
+	}
 
 	// Store the attested message.
 	msg.State = relayTypes.Attested
@@ -668,6 +728,20 @@ func (c *CCTPRelayer) fetchAttestation(parentCtx context.Context, msg *relayType
 	return msg, nil
 }
 
+func (c *CCTPRelayer) getAttestationLimitedUntil() time.Time {
+	c.attestationLimitMux.Lock()
+	defer c.attestationLimitMux.Unlock()
+	return c.attestationLimitedUntil
+}
+
+func (c *CCTPRelayer) setAttestationLimitedUntil(until time.Time) {
+	c.attestationLimitMux.Lock()
+	defer c.attestationLimitMux.Unlock()
+	if until.After(c.attestationLimitedUntil) {
+		c.attestationLimitedUntil = until
+	}
+}
+
 func (c *CCTPRelayer) submitReceiveCircleToken(parentCtx context.Context, msg *relayTypes.Message) (err error) {
 	ctx, span := c.handler.Tracer().Start(parentCtx, "submitReceiveCircleToken", trace.WithAttributes(
 		attribute.String(MessageHash, msg.MessageHash),
@@ -705,6 +779,35 @@ func (c *CCTPRelayer) submitReceiveCircleToken(parentCtx context.Context, msg *r
 	}
 	// end: functionalization
 
+	chainCfg, ok := c.cfg.Chains.GetChain(msg.DestChainID)
+	if !ok {
+		return fmt.Errorf("could not find config for destination chain %d", msg.DestChainID)
+	}
+	if chainCfg.DailyGasBudget > 0 {
+		gasBudgetMux := c.gasBudgetMuxes[msg.DestChainID]
+		gasBudgetMux.Lock()
+		defer gasBudgetMux.Unlock()
+	}
+
+	gasCost, parkReason, err := c.checkRelay(ctx, msg, chainCfg, contract)
+	if err != nil {
+		return fmt.Errorf("could not check relay: %w", err)
+	}
+	if parkReason != "" {
+		span.SetAttributes(attribute.String("park_reason", parkReason))
+		if msg.State == relayTypes.Parked {
+			return nil
+		}
+
+		logger.Infof("parking message %s: %s", msg.MessageHash, parkReason)
+		msg.State = relayTypes.Parked
+		err = c.db.UpdateMessageState(ctx, msg.MessageHash, relayTypes.Parked, parkReason)
+		if err != nil {
+			return fmt.Errorf("could not store parked message: %w", err)
+		}
+		return nil
+	}
+
 	var nonce uint64
 	var destTxHash common.Hash
 	nonce, err = c.txSubmitter.SubmitTransaction(ctx, big.NewInt(int64(msg.DestChainID)), func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
@@ -736,5 +839,12 @@ func (c *CCTPRelayer) submitReceiveCircleToken(parentCtx context.Context, msg *r
 	if err != nil {
 		return fmt.Errorf("could not store completed message: %w", err)
 	}
+
+	if gasCost != nil {
+		err = c.db.AddGasSpent(ctx, msg.DestChainID, time.Now(), gasCost)
+		if err != nil {
+			return fmt.Errorf("could not add gas spent: %w", err)
+		}
+	}
 	return nil
 }
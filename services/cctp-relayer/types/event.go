@@ -0,0 +1,31 @@
+package types
+
+import "time"
+
+// MessageEvent records a state transition of a message, used to build its timeline.
+type MessageEvent struct {
+	// ID is the auto incrementing id of the event.
+	ID uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+	// MessageHash is the hash of the message the event belongs to.
+	MessageHash string `gorm:"column:message_hash;index"`
+	// State is the state the message transitioned to.
+	State MessageState `gorm:"column:state"`
+	// TxHash is the origin tx hash for pending messages and the destination tx hash for submitted and completed messages.
+	TxHash string `gorm:"column:tx_hash"`
+	// Nonce is the destination nonce for submitted messages.
+	Nonce int `gorm:"column:nonce"`
+	// Reason explains the transition, e.g. why a message was parked or which operator action caused it.
+	Reason string `gorm:"column:reason"`
+	// CreatedAt is when the transition happened.
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+// MessageCount is the number of messages to a destination chain in a state.
+type MessageCount struct {
+	// DestChainID is the destination chain id.
+	DestChainID uint32 `gorm:"column:dest_chain_id"`
+	// State is the message state.
+	State MessageState `gorm:"column:state"`
+	// Count is the number of messages.
+	Count int64 `gorm:"column:count"`
+}
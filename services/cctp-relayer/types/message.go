@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 // Message is the information about a message parsed by the CCTPRelayer.
 type Message struct {
 	// Hash of USDC burn transaction
@@ -28,4 +30,8 @@ type Message struct {
 	BlockNumber uint64 `gorm:"column:block_number"`
 	// State is the state of the message.
 	State MessageState `gorm:"column:state"`
+	// ForceRelay skips the profitability and gas budget checks when relaying the message.
+	ForceRelay bool `gorm:"column:force_relay"`
+	// StateUpdatedAt is when the state of the message last changed.
+	StateUpdatedAt time.Time `gorm:"column:state_updated_at;index"`
 }
@@ -12,6 +12,12 @@
 	Submitted
 	// Complete indicates the USDC transfer has been completed on the destination chain.
 	Complete
+	// Parked indicates the USDC transfer is attested but relaying it was deferred, either because the relayer fee
+	// doesn't cover the destination gas cost or because the destination chain's daily gas budget is spent.
+	// Parked messages are retried until they pass these checks or are force relayed.
+	Parked
+	// Skipped indicates an operator chose not to relay the USDC transfer. Skipped messages are never retried.
+	Skipped
 )
 
 func (m MessageState) String() string {
@@ -24,6 +30,20 @@ func (m MessageState) String() string {
 		return "Submitted"
 	case Complete:
 		return "Complete"
+	case Parked:
+		return "Parked"
+	case Skipped:
+		return "Skipped"
 	}
 	return ""
 }
+
+// MessageStateFromString parses a message state from its string representation.
+func MessageStateFromString(state string) (MessageState, bool) {
+	for m := Pending; m <= Skipped; m++ {
+		if m.String() == state {
+			return m, true
+		}
+	}
+	return 0, false
+}
@@ -338,7 +338,7 @@ func (b *BackfillSuite) TestBackfill() {
 	Nil(b.T(), err)
 	tokenDataService, err := tokendata.NewTokenDataService(bcf, tokenSymbolToIDs)
 	Nil(b.T(), err)
-	tokenPriceService, err := tokenprice.NewPriceDataService()
+	tokenPriceService, err := tokenprice.NewPriceDataService(b.db)
 	Nil(b.T(), err)
 
 	bp, err := parser.NewBridgeParser(b.db, bridgeContract.Address(), tokenDataService, b.consumerFetcher, tokenPriceService, false)
@@ -381,8 +381,8 @@ func (b *BackfillSuite) TestBackfill() {
 	Nil(b.T(), err)
 
 	// Test the first chain in the config file
-	chainBackfiller := backfill.NewChainBackfiller(b.db, bp, spMap, mbp, cp, f, chainConfigs[0])
-	chainBackfillerV1 := backfill.NewChainBackfiller(b.db, bpv1, spMap, mbp, cp, f, chainConfigsV1[0])
+	chainBackfiller := backfill.NewChainBackfiller(b.db, bp, spMap, mbp, cp, nil, f, chainConfigs[0])
+	chainBackfillerV1 := backfill.NewChainBackfiller(b.db, bpv1, spMap, mbp, cp, nil, f, chainConfigsV1[0])
 
 	// Backfill the blocks
 	var count int64
@@ -28,7 +28,7 @@ func Start(args []string, buildInfo config.BuildInfo) {
 	}
 
 	// commands
-	app.Commands = cli.Commands{infoCommand, serverCommand, backfillCommand, livefillCommand}
+	app.Commands = cli.Commands{infoCommand, serverCommand, backfillCommand, livefillCommand, prefillPricesCommand}
 	shellCommand := commandline.GenerateShellCommand(app.Commands)
 	app.Commands = append(app.Commands, shellCommand)
 	app.Action = shellCommand.Action
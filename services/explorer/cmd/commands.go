@@ -16,6 +16,7 @@
 	serverconfig "github.com/synapsecns/sanguine/services/explorer/config/server"
 	"github.com/synapsecns/sanguine/services/explorer/node"
 	"github.com/urfave/cli/v2"
+	"time"
 )
 
 //go:embed cmd.md
@@ -43,6 +44,12 @@
 	Value:    "",
 	Required: false,
 }
+var dbTypeFlag = &cli.StringFlag{
+	Name:  "db-type",
+	Usage: "--db-type <clickhouse>, <sqlite> or <postgres>, sqlite takes a directory as the address and postgres a dsn",
+	Value: "clickhouse",
+}
+
 var configFlag = &cli.StringFlag{
 	Name:      "config",
 	Usage:     "--config /Users/synapsecns/config.yaml",
@@ -74,14 +81,14 @@
 var backfillCommand = &cli.Command{
 	Name:        "backfill",
 	Description: "backfills up to a block and then halts",
-	Flags:       []cli.Flag{configFlag, clickhouseAddressFlag},
+	Flags:       []cli.Flag{configFlag, clickhouseAddressFlag, dbTypeFlag},
 	Action: func(c *cli.Context) error {
 		decodeConfig, err := indexerconfig.DecodeConfig(core.ExpandOrReturnPath(c.String(configFlag.Name)))
 		if err != nil {
 			return fmt.Errorf("could not decode config: %w", err)
 
 		}
-		db, err := api.InitDB(c.Context, c.String(clickhouseAddressFlag.Name), false, metrics.Get())
+		db, err := api.InitDB(c.Context, c.String(dbTypeFlag.Name), c.String(clickhouseAddressFlag.Name), false, metrics.Get())
 		if err != nil {
 			return fmt.Errorf("could not initialize database: %w", err)
 		}
@@ -109,14 +116,14 @@
 var livefillCommand = &cli.Command{
 	Name:        "livefill",
 	Description: "livefills explorer",
-	Flags:       []cli.Flag{configFlag, clickhouseAddressFlag},
+	Flags:       []cli.Flag{configFlag, clickhouseAddressFlag, dbTypeFlag},
 	Action: func(c *cli.Context) error {
 		decodeConfig, err := indexerconfig.DecodeConfig(core.ExpandOrReturnPath(c.String(configFlag.Name)))
 		if err != nil {
 			return fmt.Errorf("could not decode config: %w", err)
 
 		}
-		db, err := api.InitDB(c.Context, c.String(clickhouseAddressFlag.Name), false, metrics.Get())
+		db, err := api.InitDB(c.Context, c.String(dbTypeFlag.Name), c.String(clickhouseAddressFlag.Name), false, metrics.Get())
 		if err != nil {
 			return fmt.Errorf("could not initialize database: %w", err)
 		}
@@ -140,6 +147,57 @@
 	},
 }
 
+var fromFlag = &cli.StringFlag{
+	Name:     "from",
+	Usage:    "--from 2023-01-01",
+	Required: true,
+}
+
+var toFlag = &cli.StringFlag{
+	Name:  "to",
+	Usage: "--to 2023-12-31, defaults to today",
+}
+
+var tokensFlag = &cli.StringSliceFlag{
+	Name:  "tokens",
+	Usage: "--tokens ethereum,usd-coin coin gecko ids to prefill, defaults to every known token",
+}
+
+var prefillPricesCommand = &cli.Command{
+	Name:        "prefill-prices",
+	Description: "fills the token price cache for a date range and recomputes missing usd values",
+	Flags:       []cli.Flag{configFlag, clickhouseAddressFlag, dbTypeFlag, fromFlag, toFlag, tokensFlag},
+	Action: func(c *cli.Context) error {
+		decodeConfig, err := indexerconfig.DecodeConfig(core.ExpandOrReturnPath(c.String(configFlag.Name)))
+		if err != nil {
+			return fmt.Errorf("could not decode config: %w", err)
+		}
+		from, err := time.Parse(time.DateOnly, c.String(fromFlag.Name))
+		if err != nil {
+			return fmt.Errorf("could not parse from date: %w", err)
+		}
+		to := time.Now().UTC()
+		if c.String(toFlag.Name) != "" {
+			to, err = time.Parse(time.DateOnly, c.String(toFlag.Name))
+			if err != nil {
+				return fmt.Errorf("could not parse to date: %w", err)
+			}
+		}
+		if to.Before(from) {
+			return fmt.Errorf("to date %s is before from date %s", to.Format(time.DateOnly), from.Format(time.DateOnly))
+		}
+		db, err := api.InitDB(c.Context, c.String(dbTypeFlag.Name), c.String(clickhouseAddressFlag.Name), false, metrics.Get())
+		if err != nil {
+			return fmt.Errorf("could not initialize database: %w", err)
+		}
+		err = node.PrefillPrices(c.Context, db, decodeConfig.PriceProviders, c.StringSlice(tokensFlag.Name), from, to)
+		if err != nil {
+			return fmt.Errorf("could not prefill prices: %w", err)
+		}
+		return nil
+	},
+}
+
 func init() {
 	portFlag.Value = uint(freeport.GetPort())
 }
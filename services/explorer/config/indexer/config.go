@@ -27,10 +27,12 @@
 	MetaSwapContractType
 	// CCTPContractType is the ContractType for the cctp contract.
 	CCTPContractType
+	// FastBridgeContractType is the ContractType for the rfq fastbridge contract.
+	FastBridgeContractType
 )
 
 func (c ContractType) String() string {
-	return [...]string{"bridge", "swap", "messagebus", "metaswap", "cctp"}[c]
+	return [...]string{"bridge", "swap", "messagebus", "metaswap", "cctp", "fastbridge"}[c]
 }
 
 // ContractTypeFromString converts a string (intended to be from parsed config) into the ContractType type.
@@ -46,6 +48,8 @@ func ContractTypeFromString(s string) (ContractType, error) {
 		return MetaSwapContractType, nil
 	case "cctp":
 		return CCTPContractType, nil
+	case "fastbridge":
+		return FastBridgeContractType, nil
 	default:
 		return -1, fmt.Errorf("unknown contract type: %s", s)
 	}
@@ -65,6 +69,17 @@ type Config struct {
 	BridgeConfigChainID uint32 `yaml:"bridge_config_chain_id"`
 	// Chains stores the chain configurations.
 	Chains []ChainConfig `yaml:"chains"`
+	// PriceProviders are the historical token price providers, in order of preference. Defaults to defillama.
+	PriceProviders []config.PriceProviderConfig `yaml:"price_providers"`
+	// NotifyURL is the notify endpoint of an explorer server that stored bridge events are posted to, so they are
+	// streamed to its subscriptions. Events are not posted if it is unset.
+	NotifyURL string `yaml:"notify_url"`
+	// NotifyToken is the bearer token sent to the notify endpoint.
+	NotifyToken string `yaml:"notify_token"`
+	// RecomputeInterval is the interval in seconds at which the usd values of the events of the last week that were
+	// stored without a price are recomputed while livefilling. They are only recomputed by the prefill-prices command
+	// if it is unset.
+	RecomputeInterval int `yaml:"recompute_interval"`
 }
 
 // ChainConfig is the configuration for a chain.
@@ -118,6 +133,12 @@ func (c *Config) IsValid() error {
 			return fmt.Errorf("chain with ID %d is invalid: %w", chain.ChainID, err)
 		}
 	}
+	for _, provider := range c.PriceProviders {
+		err := provider.IsValid()
+		if err != nil {
+			return fmt.Errorf("price provider %s is invalid: %w", provider.Type, err)
+		}
+	}
 
 	return nil
 }
@@ -0,0 +1,36 @@
+package config
+
+import "fmt"
+
+// PriceProviderConfig configures a historical token price provider. Providers are tried in the order they are
+// configured until one of them has a price.
+type PriceProviderConfig struct {
+	// Type is the type of the provider, one of defillama, coingecko or static.
+	Type string `yaml:"type"`
+	// URL is the base url of a coingecko (or coingecko compatible) api.
+	URL string `yaml:"url"`
+	// APIKey is the api key sent to a coingecko api, if any.
+	APIKey string `yaml:"api_key"`
+	// Path is the path of the yaml price file of a static provider.
+	Path string `yaml:"path"`
+}
+
+// IsValid validates the price provider config.
+func (p PriceProviderConfig) IsValid() error {
+	switch p.Type {
+	case "defillama":
+		return nil
+	case "coingecko":
+		if p.URL == "" {
+			return fmt.Errorf("url, %w", ErrRequiredGlobalField)
+		}
+		return nil
+	case "static":
+		if p.Path == "" {
+			return fmt.Errorf("path, %w", ErrRequiredGlobalField)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown price provider type: %s", p.Type)
+	}
+}
@@ -4,6 +4,7 @@
 import (
 	"fmt"
 	"github.com/richardwilkes/toolbox/collection"
+	"github.com/synapsecns/sanguine/core/dbcommon"
 	"github.com/synapsecns/sanguine/services/explorer/config"
 	"os"
 	"path/filepath"
@@ -16,7 +17,9 @@
 type Config struct {
 	// HTTPPort is the http port for the api
 	HTTPPort uint16 `yaml:"http_port"`
-	// DBAddress is the address of the database
+	// DBType is the type of the database, clickhouse, sqlite or postgres. Defaults to clickhouse.
+	DBType string `yaml:"db_type"`
+	// DBAddress is the address of the database, the directory of a sqlite database or the dsn of a postgres database.
 	DBAddress string `yaml:"db_address"`
 	// HydrateCache is a flag for enabling cache hydration.
 	HydrateCache bool `yaml:"hydrate_cache"`
@@ -32,6 +35,19 @@ type Config struct {
 	SwapTopicHash string `yaml:"swap_topic_hash"`
 	// Chains stores the chain configurations.
 	Chains map[uint32]ChainConfig `yaml:"chains"`
+	// PriceProviders are the historical token price providers, in order of preference. Defaults to defillama.
+	PriceProviders []config.PriceProviderConfig `yaml:"price_providers"`
+	// NotifyToken is the bearer token indexers use to post stored bridge events to the server's subscriptions. The
+	// notify endpoint is disabled if it is unset.
+	NotifyToken string `yaml:"notify_token"`
+}
+
+// GetDBType gets the database type, clickhouse if unset.
+func (c *Config) GetDBType() string {
+	if c.DBType == "" {
+		return dbcommon.Clickhouse.String()
+	}
+	return c.DBType
 }
 
 // ChainConfig is the config for each chain in the server config.
@@ -56,6 +72,8 @@ type ContractsConfig struct {
 	CCTP string `yaml:"cctp"`
 	// Bridge is the URL of the Scribe server.
 	Bridge string `yaml:"bridge"`
+	// FastBridge is the address of the rfq fastbridge contract
+	FastBridge string `yaml:"fastbridge"`
 }
 
 // IsValid makes sure the config is valid.
@@ -83,6 +101,12 @@ func (c *Config) IsValid() error {
 		}
 		intSet.Add(chain.ChainID)
 	}
+	for _, provider := range c.PriceProviders {
+		err := provider.IsValid()
+		if err != nil {
+			return fmt.Errorf("price provider %s is invalid: %w", provider.Type, err)
+		}
+	}
 
 	return nil
 }
@@ -108,7 +132,7 @@ func (c *ChainConfig) IsValid() error {
 
 // IsValid checks if the entered ContractsConfig is valid.
 func (c ContractsConfig) IsValid() error {
-	if c.CCTP == "" && c.Bridge == "" {
+	if c.CCTP == "" && c.Bridge == "" && c.FastBridge == "" {
 		return fmt.Errorf("one contract must be specified on each contract config")
 	}
 	return nil
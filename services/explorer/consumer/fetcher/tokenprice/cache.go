@@ -2,54 +2,131 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	lru "github.com/hashicorp/golang-lru/v2"
-	"github.com/synapsecns/sanguine/services/explorer/consumer/fetcher"
 	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"golang.org/x/sync/singleflight"
 )
 
-// Service provides price data about tokens using either a cache or defillama
-// cache keys sare always ${coin gecko id}_${timestamp}.
+// Service provides price data about tokens using either a cache or the price providers.
+// cache keys are always ${coin gecko id}_${day}.
 type Service interface {
-	// GetPriceData attempts to get price data from the cache otherwise it requests defillama
+	// GetPriceData attempts to get price data from the caches otherwise it requests the price providers.
 	GetPriceData(context.Context, int, string) *float64
 }
 
+// PriceStore persists daily token prices so they survive restarts. It is implemented by the consumer db.
+type PriceStore interface {
+	// GetTokenPrice gets the cached price of a token for a day, or nil if it has not been cached.
+	GetTokenPrice(ctx context.Context, coinGeckoID string, day uint64) (*sql.TokenPrice, error)
+	// StoreTokenPrices stores daily token prices.
+	StoreTokenPrices(ctx context.Context, prices []sql.TokenPrice) error
+}
+
 const cacheSize = 5000
 
+const (
+	noTokenID = "NO_TOKEN"
+	noPrice   = "NO_PRICE"
+)
+
 type tokenPriceServiceImpl struct {
 	// tokenCache is the cache of the token prices
 	tokenPriceCache *lru.TwoQueueCache[string, float64]
+	// store is the persistent price cache, nil if prices are only cached in memory.
+	store PriceStore
+	// providers are the price providers, in order of preference.
+	providers []PriceProvider
+	// requests dedupes concurrent requests for the same price.
+	requests singleflight.Group
 }
 
-// NewPriceDataService creates a new token price data service.
-func NewPriceDataService() (Service, error) {
+// NewPriceDataService creates a new token price data service. Prices are cached in memory and, if a store is
+// given, persisted per token and day. Providers are tried in order, defillama is used if none are given.
+func NewPriceDataService(store PriceStore, providers ...PriceProvider) (Service, error) {
 	cache, err := lru.New2Q[string, float64](cacheSize)
 	if err != nil {
 		return nil, fmt.Errorf("could not create token price data cache: %w", err)
 	}
+	if len(providers) == 0 {
+		providers = []PriceProvider{NewDefiLlamaProvider()}
+	}
 
 	return &tokenPriceServiceImpl{
 		tokenPriceCache: cache,
+		store:           store,
+		providers:       providers,
 	}, nil
 }
 
 func (t *tokenPriceServiceImpl) GetPriceData(parentCtx context.Context, timestamp int, coinGeckoID string) *float64 {
+	if coinGeckoID == noTokenID || coinGeckoID == noPrice {
+		// if there is no data on the token, the amount returned will be 1:1 (price will be same as the amount of token
+		// and the token  symbol will say "no symbol"
+		zero := float64(0)
+		return &zero
+	}
+
 	ctx, cancel := context.WithTimeout(parentCtx, 3*time.Minute)
 	defer cancel()
-	key := fmt.Sprintf("%s_%d", coinGeckoID, timestamp)
-
-	// truncate key to save requests
-	key = key[:len(key)-3]
+	day := startOfDay(timestamp)
+	key := fmt.Sprintf("%s_%d", coinGeckoID, day.Unix())
 
 	if data, ok := t.tokenPriceCache.Get(key); ok {
 		return &data
 	}
-	tokenPrice := fetcher.GetDefiLlamaData(ctx, timestamp, coinGeckoID)
-	if tokenPrice == nil {
+	res, err, _ := t.requests.Do(key, func() (interface{}, error) {
+		return t.fetchPrice(ctx, coinGeckoID, day)
+	})
+	if err != nil {
+		logger.Warnf("could not get token price: %v", err)
 		return nil
 	}
-	t.tokenPriceCache.Add(key, *tokenPrice)
+	tokenPrice, _ := res.(float64)
+	t.tokenPriceCache.Add(key, tokenPrice)
+
+	return &tokenPrice
+}
+
+// fetchPrice gets a price from the persistent cache, or from the first provider that has it.
+func (t *tokenPriceServiceImpl) fetchPrice(ctx context.Context, coinGeckoID string, day time.Time) (float64, error) {
+	if t.store != nil {
+		cached, err := t.store.GetTokenPrice(ctx, coinGeckoID, uint64(day.Unix()))
+		if err != nil {
+			logger.Warnf("could not read cached price of %s: %v", coinGeckoID, err)
+		} else if cached != nil {
+			return cached.Price, nil
+		}
+	}
+
+	var errs error
+	for _, provider := range t.providers {
+		price, err := provider.GetPrice(ctx, coinGeckoID, day)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		if t.store != nil {
+			err = t.store.StoreTokenPrices(ctx, []sql.TokenPrice{{
+				InsertTime:  uint64(time.Now().UnixNano()),
+				CoinGeckoID: coinGeckoID,
+				Day:         uint64(day.Unix()),
+				Price:       price,
+				Source:      provider.Name(),
+			}})
+			if err != nil {
+				logger.Warnf("could not cache price of %s: %v", coinGeckoID, err)
+			}
+		}
+		return price, nil
+	}
+	return 0, fmt.Errorf("could not get price of %s on %s: %w", coinGeckoID, day.Format(time.DateOnly), errs)
+}
 
-	return tokenPrice
+// startOfDay gets the start (00:00 UTC) of the day of a unix timestamp.
+func startOfDay(timestamp int) time.Time {
+	return time.Unix(int64(timestamp), 0).UTC().Truncate(24 * time.Hour)
 }
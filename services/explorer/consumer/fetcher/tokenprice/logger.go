@@ -0,0 +1,5 @@
+package tokenprice
+
+import "github.com/ipfs/go-log"
+
+var logger = log.Logger("explorer-tokenprice")
@@ -0,0 +1,25 @@
+package tokenprice
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Prefill gets the price of each token for every day from the start of from up to and including to, so the prices
+// are in the persistent cache before events are parsed. It returns the number of prices that could not be found.
+func Prefill(ctx context.Context, service Service, coinGeckoIDs []string, from, to time.Time) (int, error) {
+	missing := 0
+	for day := startOfDay(int(from.Unix())); !day.After(to); day = day.Add(24 * time.Hour) {
+		for _, coinGeckoID := range coinGeckoIDs {
+			if ctx.Err() != nil {
+				return missing, fmt.Errorf("could not prefill prices: %w", ctx.Err())
+			}
+			if service.GetPriceData(ctx, int(day.Unix()), coinGeckoID) == nil {
+				logger.Warnf("no price for %s on %s", coinGeckoID, day.Format(time.DateOnly))
+				missing++
+			}
+		}
+	}
+	return missing, nil
+}
@@ -0,0 +1,182 @@
+package tokenprice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/synapsecns/sanguine/services/explorer/config"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/fetcher"
+	"gopkg.in/yaml.v2"
+)
+
+// ErrNoPrice is returned by a provider that has no price for a token on a day.
+var ErrNoPrice = errors.New("no price available")
+
+// PriceProvider provides the historical usd price of a token.
+type PriceProvider interface {
+	// Name is the name of the provider, it is stored as the source of cached prices.
+	Name() string
+	// GetPrice gets the usd price of a token, by coin gecko id, on the day starting at the given time.
+	GetPrice(ctx context.Context, coinGeckoID string, day time.Time) (float64, error)
+}
+
+// NewProviders creates the price providers from their configs. Defillama is used if no providers are configured.
+func NewProviders(configs []config.PriceProviderConfig) ([]PriceProvider, error) {
+	if len(configs) == 0 {
+		return []PriceProvider{NewDefiLlamaProvider()}, nil
+	}
+
+	providers := make([]PriceProvider, len(configs))
+	for i, cfg := range configs {
+		switch cfg.Type {
+		case "defillama":
+			providers[i] = NewDefiLlamaProvider()
+		case "coingecko":
+			providers[i] = NewCoinGeckoProvider(cfg.URL, cfg.APIKey)
+		case "static":
+			provider, err := NewStaticProviderFromFile(cfg.Path)
+			if err != nil {
+				return nil, err
+			}
+			providers[i] = provider
+		default:
+			return nil, fmt.Errorf("unknown price provider type: %s", cfg.Type)
+		}
+	}
+	return providers, nil
+}
+
+type defiLlamaProvider struct{}
+
+// NewDefiLlamaProvider creates a provider that requests historical prices from defillama.
+func NewDefiLlamaProvider() PriceProvider {
+	return defiLlamaProvider{}
+}
+
+func (defiLlamaProvider) Name() string {
+	return "defillama"
+}
+
+func (defiLlamaProvider) GetPrice(ctx context.Context, coinGeckoID string, day time.Time) (float64, error) {
+	price := fetcher.GetDefiLlamaData(ctx, int(day.Unix()), coinGeckoID)
+	if price == nil {
+		return 0, fmt.Errorf("could not get defillama price for %s on %s: %w", coinGeckoID, day.Format(time.DateOnly), ErrNoPrice)
+	}
+	return *price, nil
+}
+
+type coinGeckoProvider struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+// NewCoinGeckoProvider creates a provider for an api shaped like coingecko's /coins/{id}/history endpoint. The api key,
+// if any, is sent as a pro api key.
+func NewCoinGeckoProvider(baseURL, apiKey string) PriceProvider {
+	return &coinGeckoProvider{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		apiKey:  apiKey,
+		client: &http.Client{
+			Timeout: 10 * time.Second,
+			Transport: &http.Transport{
+				ResponseHeaderTimeout: 10 * time.Second,
+			},
+		},
+	}
+}
+
+func (c *coinGeckoProvider) Name() string {
+	return "coingecko"
+}
+
+// coinGeckoHistory is the part of the coingecko history response holding the price.
+type coinGeckoHistory struct {
+	MarketData struct {
+		CurrentPrice map[string]float64 `json:"current_price"`
+	} `json:"market_data"`
+}
+
+func (c *coinGeckoProvider) GetPrice(ctx context.Context, coinGeckoID string, day time.Time) (float64, error) {
+	historyURL := fmt.Sprintf("%s/coins/%s/history?date=%s&localization=false", c.baseURL, url.PathEscape(coinGeckoID), day.UTC().Format("02-01-2006"))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, historyURL, nil)
+	if err != nil {
+		return 0, fmt.Errorf("could not create coingecko request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("x-cg-pro-api-key", c.apiKey)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("could not get coingecko price for %s: %w", coinGeckoID, err)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("could not get coingecko price for %s: status %d", coinGeckoID, res.StatusCode)
+	}
+
+	var history coinGeckoHistory
+	err = json.NewDecoder(res.Body).Decode(&history)
+	if err != nil {
+		return 0, fmt.Errorf("could not decode coingecko price for %s: %w", coinGeckoID, err)
+	}
+	price, ok := history.MarketData.CurrentPrice["usd"]
+	if !ok {
+		return 0, fmt.Errorf("coingecko has no price for %s on %s: %w", coinGeckoID, day.Format(time.DateOnly), ErrNoPrice)
+	}
+	return price, nil
+}
+
+// staticDefaultKey is the key of a static price that applies to every day.
+const staticDefaultKey = "default"
+
+type staticProvider struct {
+	prices map[string]map[string]float64
+}
+
+// NewStaticProvider creates a provider from fixed prices, keyed by coin gecko id and then by day (yyyy-mm-dd). A
+// price under the "default" key is used for days without a price of their own, e.g. for stablecoins.
+func NewStaticProvider(prices map[string]map[string]float64) PriceProvider {
+	return &staticProvider{prices: prices}
+}
+
+// NewStaticProviderFromFile creates a static provider from a yaml file in the format taken by NewStaticProvider.
+func NewStaticProviderFromFile(path string) (PriceProvider, error) {
+	input, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("could not read static prices: %w", err)
+	}
+	var prices map[string]map[string]float64
+	err = yaml.Unmarshal(input, &prices)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshall static prices: %w", err)
+	}
+	return NewStaticProvider(prices), nil
+}
+
+func (s *staticProvider) Name() string {
+	return "static"
+}
+
+func (s *staticProvider) GetPrice(_ context.Context, coinGeckoID string, day time.Time) (float64, error) {
+	prices := s.prices[coinGeckoID]
+	if price, ok := prices[day.UTC().Format(time.DateOnly)]; ok {
+		return price, nil
+	}
+	if price, ok := prices[staticDefaultKey]; ok {
+		return price, nil
+	}
+	return 0, fmt.Errorf("no static price for %s on %s: %w", coinGeckoID, day.Format(time.DateOnly), ErrNoPrice)
+}
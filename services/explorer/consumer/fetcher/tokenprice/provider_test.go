@@ -0,0 +1,66 @@
+package tokenprice_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/fetcher/tokenprice"
+)
+
+// 2023-11-14 22:13:20 UTC.
+const testTimestamp = 1700000000
+
+func (t *TokenDataSuite) TestPriceProviderFallback() {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		Equal(t.T(), "14-11-2023", r.URL.Query().Get("date"))
+		Equal(t.T(), "key", r.Header.Get("x-cg-pro-api-key"))
+		if r.URL.Path != "/coins/usd-coin/history" {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"market_data":{"current_price":{"usd":1.01}}}`))
+	}))
+	defer server.Close()
+
+	static := tokenprice.NewStaticProvider(map[string]map[string]float64{
+		"dai": {"default": 1, "2023-11-14": 0.99},
+	})
+	service, err := tokenprice.NewPriceDataService(nil, tokenprice.NewCoinGeckoProvider(server.URL, "key"), static)
+	Nil(t.T(), err)
+
+	price := service.GetPriceData(t.GetTestContext(), testTimestamp, "usd-coin")
+	NotNil(t.T(), price)
+	Equal(t.T(), 1.01, *price)
+
+	// prices are cached per day.
+	price = service.GetPriceData(t.GetTestContext(), testTimestamp+60, "usd-coin")
+	NotNil(t.T(), price)
+	Equal(t.T(), int64(1), requests.Load())
+
+	// the static provider is used when coingecko is throttled.
+	price = service.GetPriceData(t.GetTestContext(), testTimestamp, "dai")
+	NotNil(t.T(), price)
+	Equal(t.T(), 0.99, *price)
+
+	Nil(t.T(), service.GetPriceData(t.GetTestContext(), testTimestamp, "unknown"))
+}
+
+func (t *TokenDataSuite) TestPrefill() {
+	static := tokenprice.NewStaticProvider(map[string]map[string]float64{
+		"usd-coin": {"default": 1},
+		"dai":      {"2023-11-14": 0.99},
+	})
+	service, err := tokenprice.NewPriceDataService(nil, static)
+	Nil(t.T(), err)
+
+	from := time.Unix(testTimestamp, 0)
+	missing, err := tokenprice.Prefill(t.GetTestContext(), service, []string{"usd-coin", "dai"}, from, from.Add(48*time.Hour))
+	Nil(t.T(), err)
+	// dai has no price on the last two days.
+	Equal(t.T(), 2, missing)
+}
@@ -0,0 +1,370 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/jpillora/backoff"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/fetcher"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/fetcher/tokenprice"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/parser/tokendata"
+	"github.com/synapsecns/sanguine/services/explorer/contracts/fastbridge"
+	"github.com/synapsecns/sanguine/services/explorer/db"
+	model "github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"github.com/synapsecns/sanguine/services/explorer/static"
+	bridgeTypes "github.com/synapsecns/sanguine/services/explorer/types/bridge"
+	fastBridgeTypes "github.com/synapsecns/sanguine/services/explorer/types/fastbridge"
+)
+
+// FastBridgeParser parses rfq fastbridge logs.
+type FastBridgeParser struct {
+	// consumerDB is the database to store parsed data in
+	consumerDB db.ConsumerDB
+	// Filterer is the fastbridge Filterer we use to parse events
+	Filterer *fastbridge.FastBridgeFilterer
+	// fastBridgeAddress is the address of the fastbridge contract
+	fastBridgeAddress common.Address
+	// consumerFetcher is the Fetcher for sender and timestamp
+	consumerFetcher fetcher.ScribeFetcher
+	// tokenDataService contains the token data service/cache
+	tokenDataService tokendata.Service
+	// tokenPriceService contains the token price service/cache
+	tokenPriceService tokenprice.Service
+	// coinGeckoIDs is the mapping of token id to coin gecko ID
+	coinGeckoIDs map[string]string
+	// fromAPI is true if the parser is being called from the API.
+	fromAPI bool
+}
+
+// nativeTokenAddress is the address fastbridge uses for the chain's gas token.
+const nativeTokenAddress = "0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE"
+
+const nativeTokenID = "ETH"
+const nativeTokenDecimals = 18
+
+// NewFastBridgeParser creates a new parser for a fastbridge event.
+func NewFastBridgeParser(consumerDB db.ConsumerDB, fastBridgeAddress common.Address, consumerFetcher fetcher.ScribeFetcher, tokenDataService tokendata.Service, tokenPriceService tokenprice.Service, fromAPI bool) (*FastBridgeParser, error) {
+	filterer, err := fastbridge.NewFastBridgeFilterer(fastBridgeAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create %T: %w", fastbridge.FastBridgeFilterer{}, err)
+	}
+
+	idCoinGeckoIDs, err := ParseYaml(static.GetTokenIDToCoingekoConfig())
+	if err != nil {
+		return nil, fmt.Errorf("could not open yaml file: %w", err)
+	}
+
+	return &FastBridgeParser{
+		consumerDB:        consumerDB,
+		Filterer:          filterer,
+		fastBridgeAddress: fastBridgeAddress,
+		consumerFetcher:   consumerFetcher,
+		tokenDataService:  tokenDataService,
+		tokenPriceService: tokenPriceService,
+		coinGeckoIDs:      idCoinGeckoIDs,
+		fromAPI:           fromAPI,
+	}, nil
+}
+
+// ParserType returns the type of parser.
+func (f *FastBridgeParser) ParserType() string {
+	return "fastbridge"
+}
+
+// ParseLog log converts an eth log to a fastbridge event type.
+//
+// nolint:cyclop
+func (f *FastBridgeParser) ParseLog(log ethTypes.Log, chainID uint32) (*model.FastBridgeEvent, fastBridgeTypes.EventLog, error) {
+	logTopic := log.Topics[0]
+	iFace, err := func(log ethTypes.Log) (fastBridgeTypes.EventLog, error) {
+		switch logTopic {
+		case fastbridge.Topic(fastBridgeTypes.BridgeRequestedEvent):
+			iFace, err := f.Filterer.ParseBridgeRequested(log)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse bridge requested: %w", err)
+			}
+			return iFace, nil
+		case fastbridge.Topic(fastBridgeTypes.BridgeRelayedEvent):
+			iFace, err := f.Filterer.ParseBridgeRelayed(log)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse bridge relayed: %w", err)
+			}
+			return iFace, nil
+		case fastbridge.Topic(fastBridgeTypes.BridgeProofProvidedEvent):
+			iFace, err := f.Filterer.ParseBridgeProofProvided(log)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse bridge proof provided: %w", err)
+			}
+			return iFace, nil
+		case fastbridge.Topic(fastBridgeTypes.BridgeDepositClaimedEvent):
+			iFace, err := f.Filterer.ParseBridgeDepositClaimed(log)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse bridge deposit claimed: %w", err)
+			}
+			return iFace, nil
+		case fastbridge.Topic(fastBridgeTypes.BridgeDepositRefundedEvent):
+			iFace, err := f.Filterer.ParseBridgeDepositRefunded(log)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse bridge deposit refunded: %w", err)
+			}
+			return iFace, nil
+		case fastbridge.Topic(fastBridgeTypes.BridgeProofDisputedEvent):
+			iFace, err := f.Filterer.ParseBridgeProofDisputed(log)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse bridge proof disputed: %w", err)
+			}
+			return iFace, nil
+
+		default:
+			logger.Warnf("ErrUnknownTopic in fastbridge: %s %s chain: %d address: %s", log.TxHash, logTopic.String(), chainID, log.Address.Hex())
+
+			return nil, fmt.Errorf(ErrUnknownTopic)
+		}
+	}(log)
+
+	if err != nil {
+		// Switch failed.
+
+		return nil, nil, err
+	}
+	if iFace == nil {
+		// Unknown topic.
+		return nil, nil, fmt.Errorf("unknwn topic")
+	}
+
+	// Populate fastbridge event type so following operations can mature the event data.
+	fastBridgeEvent, err := eventToFastBridgeEvent(iFace, chainID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not convert fastbridge event: %w", err)
+	}
+	return fastBridgeEvent, iFace, nil
+}
+
+// MatureLogs takes a fastbridge event and adds data to them.
+func (f *FastBridgeParser) MatureLogs(ctx context.Context, fastBridgeEvent *model.FastBridgeEvent, iFace fastBridgeTypes.EventLog, chainID uint32) (interface{}, error) {
+	// Get timestamp from consumer
+	timeStamp, err := f.consumerFetcher.FetchBlockTime(ctx, int(chainID), int(iFace.GetBlockNumber()))
+	if err != nil {
+		return nil, fmt.Errorf("could not get block time: %w", err)
+	}
+
+	// If we have a timestamp, populate the following attributes of fastBridgeEvent.
+	timeStampBig := uint64(*timeStamp)
+	fastBridgeEvent.TimeStamp = &timeStampBig
+
+	// Proofs and disputes don't move tokens, so there is nothing to price.
+	token, amount := eventTokenAndAmount(fastBridgeEvent)
+	if token != nil {
+		err = f.applyTokenData(ctx, fastBridgeEvent, chainID, *token, amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Only requests and relays are bridge events, the rest of the lifecycle is stored in the fastbridge table.
+	if fastBridgeEvent.EventType != fastBridgeTypes.BridgeRequestedEvent.Int() && fastBridgeEvent.EventType != fastBridgeTypes.BridgeRelayedEvent.Int() {
+		return fastBridgeEvent, nil
+	}
+
+	bridgeEvent := fastBridgeEventToBridgeEvent(*fastBridgeEvent)
+	if f.fromAPI {
+		return bridgeEvent, nil
+	}
+	err = f.storeBridgeEvent(ctx, bridgeEvent)
+	if err != nil {
+		logger.Errorf("could not store fastbridge event into bridge database: %v", err)
+	}
+
+	return fastBridgeEvent, nil
+}
+
+// Parse parses the fastbridge logs.
+//
+// nolint:dupl
+func (f *FastBridgeParser) Parse(ctx context.Context, log ethTypes.Log, chainID uint32) (interface{}, error) {
+	fastBridgeEvent, iFace, err := f.ParseLog(log, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse fastbridge event: %w", err)
+	}
+	bridgeEventInterface, err := f.MatureLogs(ctx, fastBridgeEvent, iFace, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not mature fastbridge event: %w", err)
+	}
+	return bridgeEventInterface, nil
+}
+
+// applyTokenData sets the token's symbol and decimals and the USD values of the fastbridge event.
+func (f *FastBridgeParser) applyTokenData(ctx context.Context, fastBridgeEvent *model.FastBridgeEvent, chainID uint32, token string, amount *big.Int) error {
+	tokenID := nativeTokenID
+	decimals := uint8(nativeTokenDecimals)
+	if common.HexToAddress(token) != common.HexToAddress(nativeTokenAddress) {
+		tokenData, err := f.tokenDataService.GetTokenData(ctx, chainID, common.HexToAddress(token))
+		if err != nil {
+			logger.Errorf("could not get token data: %v", err)
+			return fmt.Errorf("could not get token data: %w", err)
+		}
+		if tokenData.TokenID() == fetcher.NoTokenID {
+			logger.Warnf("FASTBRIDGE - no token id for token %s chain: %d txhash %s", token, chainID, fastBridgeEvent.TxHash)
+			return nil
+		}
+		tokenID = tokenData.TokenID()
+		decimals = tokenData.Decimals()
+	}
+
+	fastBridgeEvent.TokenSymbol = ToNullString(&tokenID)
+	fastBridgeEvent.TokenDecimal = &decimals
+
+	coinGeckoID := f.coinGeckoIDs[tokenID]
+	if coinGeckoID == "" || coinGeckoID == noTokenID || coinGeckoID == noPrice {
+		logger.Warnf("FASTBRIDGE - EMPTY TOKEN ID: %s, TokenID: %s", coinGeckoID, tokenID)
+		return nil
+	}
+
+	tokenPrice := f.tokenPriceService.GetPriceData(ctx, int(*fastBridgeEvent.TimeStamp), coinGeckoID)
+	if tokenPrice == nil {
+		// the usd values are left empty and recomputed once the price is available.
+		logger.Warnf("FASTBRIDGE could not get token price for coingeckotoken:  %s chain: %d txhash %s %d", coinGeckoID, chainID, fastBridgeEvent.TxHash, *fastBridgeEvent.TimeStamp)
+		return nil
+	}
+
+	if amount != nil {
+		fastBridgeEvent.AmountUSD = GetAmountUSD(amount, decimals, tokenPrice)
+	}
+	if fastBridgeEvent.Fee != nil {
+		fastBridgeEvent.FeeUSD = GetAmountUSD(fastBridgeEvent.Fee, decimals, tokenPrice)
+	}
+	return nil
+}
+
+// eventTokenAndAmount returns the token and amount that moved on the chain of the event.
+func eventTokenAndAmount(fastBridgeEvent *model.FastBridgeEvent) (*string, *big.Int) {
+	if fastBridgeEvent.EventType == fastBridgeTypes.BridgeRelayedEvent.Int() {
+		if !fastBridgeEvent.DestinationToken.Valid {
+			return nil, nil
+		}
+		return &fastBridgeEvent.DestinationToken.String, fastBridgeEvent.DestinationAmount
+	}
+	if !fastBridgeEvent.OriginToken.Valid {
+		return nil, nil
+	}
+	return &fastBridgeEvent.OriginToken.String, fastBridgeEvent.OriginAmount
+}
+
+// eventToFastBridgeEvent converts a fastbridge log to a fastbridge event.
+// Requests carry the encoded bridge transaction, which is decoded for the recipient and the protocol fee.
+func eventToFastBridgeEvent(event fastBridgeTypes.EventLog, chainID uint32) (*model.FastBridgeEvent, error) {
+	transactionID := event.GetTransactionID()
+
+	fastBridgeEvent := model.FastBridgeEvent{
+		InsertTime:      uint64(time.Now().UnixNano()),
+		ChainID:         chainID,
+		TxHash:          event.GetTxHash().String(),
+		ContractAddress: event.GetContractAddress().String(),
+		BlockNumber:     event.GetBlockNumber(),
+		EventType:       event.GetEventType().Int(),
+		EventIndex:      event.GetEventIndex(),
+		TransactionID:   common.Bytes2Hex(transactionID[:]),
+
+		OriginChainID:      event.GetOriginChainID(),
+		DestinationChainID: event.GetDestinationChainID(),
+		Sender:             ToNullString(event.GetSender()),
+		Recipient:          ToNullString(event.GetRecipient()),
+		Relayer:            ToNullString(event.GetRelayer()),
+		OriginToken:        ToNullString(event.GetOriginToken()),
+		DestinationToken:   ToNullString(event.GetDestinationToken()),
+		OriginAmount:       event.GetOriginAmount(),
+		DestinationAmount:  event.GetDestinationAmount(),
+		ChainGasAmount:     event.GetChainGasAmount(),
+	}
+
+	// The origin chain of events emitted on origin is the chain they were emitted on.
+	if fastBridgeEvent.OriginChainID == nil && event.GetEventType() != fastBridgeTypes.BridgeRelayedEvent {
+		fastBridgeEvent.OriginChainID = big.NewInt(int64(chainID))
+	}
+	if fastBridgeEvent.DestinationChainID == nil && event.GetEventType() == fastBridgeTypes.BridgeRelayedEvent {
+		fastBridgeEvent.DestinationChainID = big.NewInt(int64(chainID))
+	}
+
+	if event.GetRequest() != nil {
+		bridgeTransaction, err := fastbridge.DecodeBridgeTransaction(*event.GetRequest())
+		if err != nil {
+			return nil, fmt.Errorf("could not decode bridge request: %w", err)
+		}
+		recipient := bridgeTransaction.DestRecipient.String()
+		fastBridgeEvent.Recipient = ToNullString(&recipient)
+		fastBridgeEvent.Fee = bridgeTransaction.OriginFeeAmount
+	}
+
+	return &fastBridgeEvent, nil
+}
+
+// fastBridgeEventToBridgeEvent converts a request or relay to a bridge event.
+// Origin and destination are paired by transaction id, stored as the destination kappa on origin and the kappa on destination.
+func fastBridgeEventToBridgeEvent(fastBridgeEvent model.FastBridgeEvent) model.BridgeEvent {
+	bridgeType := bridgeTypes.RFQBridgeRequestedEvent
+	token := fastBridgeEvent.OriginToken.String
+	amount := fastBridgeEvent.OriginAmount
+	destinationChainID := fastBridgeEvent.DestinationChainID
+
+	destinationKappa := fastBridgeEvent.TransactionID
+	var kappa *string
+	if fastBridgeEvent.EventType == fastBridgeTypes.BridgeRelayedEvent.Int() {
+		bridgeType = bridgeTypes.RFQBridgeRelayedEvent
+		token = fastBridgeEvent.DestinationToken.String
+		amount = fastBridgeEvent.DestinationAmount
+		destinationChainID = nil
+		destinationKappa = ""
+		kappa = &fastBridgeEvent.TransactionID
+	}
+	return model.BridgeEvent{
+		InsertTime:       fastBridgeEvent.InsertTime,
+		ContractAddress:  fastBridgeEvent.ContractAddress,
+		ChainID:          fastBridgeEvent.ChainID,
+		EventType:        bridgeType.Int(),
+		BlockNumber:      fastBridgeEvent.BlockNumber,
+		TxHash:           fastBridgeEvent.TxHash,
+		Token:            token,
+		Amount:           amount,
+		EventIndex:       fastBridgeEvent.EventIndex,
+		DestinationKappa: destinationKappa,
+		Sender:           fastBridgeEvent.Sender.String,
+
+		Recipient:          fastBridgeEvent.Recipient,
+		DestinationChainID: destinationChainID,
+		Fee:                fastBridgeEvent.Fee,
+		Kappa:              ToNullString(kappa),
+		AmountUSD:          fastBridgeEvent.AmountUSD,
+		FeeUSD:             fastBridgeEvent.FeeUSD,
+		TokenDecimal:       fastBridgeEvent.TokenDecimal,
+		TokenSymbol:        fastBridgeEvent.TokenSymbol,
+		TimeStamp:          fastBridgeEvent.TimeStamp,
+	}
+}
+
+func (f *FastBridgeParser) storeBridgeEvent(ctx context.Context, bridgeEvent model.BridgeEvent) error {
+	b := &backoff.Backoff{
+		Factor: 2,
+		Jitter: true,
+		Min:    1 * time.Second,
+		Max:    300 * time.Second,
+	}
+
+	timeout := time.Duration(0)
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w while retrying store fastbridge converted bridge event", ctx.Err())
+		case <-time.After(timeout):
+			err := f.consumerDB.StoreEvent(ctx, &bridgeEvent)
+			if err != nil {
+				timeout = b.Duration()
+				continue
+			}
+			return nil
+		}
+	}
+}
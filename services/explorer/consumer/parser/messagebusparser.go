@@ -16,6 +16,14 @@
 	messageBusTypes "github.com/synapsecns/sanguine/services/explorer/types/messagebus"
 )
 
+// messageBusFeeTokens are the coin gecko ids of the gas tokens message bus fees are priced in, by chain. Fees on
+// other chains have no usd value.
+var messageBusFeeTokens = map[uint32]string{
+	8217:       "klay-token",    // Klaytn
+	53935:      "defi-kingdoms", // DFK
+	1666600000: "harmony",       // Harmony
+}
+
 // MessageBusParser parses messagebus logs.
 type MessageBusParser struct {
 	// consumerDB is the database to store parsed data in
@@ -142,32 +150,12 @@ func (m *MessageBusParser) Parse(ctx context.Context, log ethTypes.Log, chainID
 	timeStampBig := uint64(*timeStamp)
 	messageEvent.TimeStamp = &timeStampBig
 
-	if messageEvent.Fee != nil {
-		switch messageEvent.ChainID {
-		case 8217: // Klaytn
-			coinGeckoID := "klay-token"
-			feeValue, err := m.getFeeValue(ctx, messageEvent, coinGeckoID)
-			if err != nil {
-				return nil, err
-			}
-			messageEvent.FeeUSD = feeValue
-		case 53935: // DFK
-			coinGeckoID := "defi-kingdoms"
-			feeValue, err := m.getFeeValue(ctx, messageEvent, coinGeckoID)
-			if err != nil {
-				return nil, err
-			}
-			messageEvent.FeeUSD = feeValue
-		case 1666600000: // Harmony
-			coinGeckoID := "harmony"
-			feeValue, err := m.getFeeValue(ctx, messageEvent, coinGeckoID)
-			if err != nil {
-				return nil, err
-			}
-			messageEvent.FeeUSD = feeValue
-		default:
-			// pass
+	if coinGeckoID, ok := messageBusFeeTokens[messageEvent.ChainID]; ok && messageEvent.Fee != nil {
+		feeValue, err := m.getFeeValue(ctx, messageEvent, coinGeckoID)
+		if err != nil {
+			return nil, err
 		}
+		messageEvent.FeeUSD = feeValue
 	}
 	return messageEvent, nil
 }
@@ -175,7 +163,9 @@ func (m *MessageBusParser) Parse(ctx context.Context, log ethTypes.Log, chainID
 func (m *MessageBusParser) getFeeValue(ctx context.Context, messageEvent model.MessageBusEvent, coinGeckoID string) (*float64, error) {
 	tokenPrice := m.tokenPriceService.GetPriceData(ctx, int(*messageEvent.TimeStamp), coinGeckoID)
 	if tokenPrice == nil {
-		return nil, fmt.Errorf("MESSAGEBUS could not get token price for coingeckotoken:  %s chain: %d txhash %s %d", coinGeckoID, messageEvent.ChainID, messageEvent.TxHash, messageEvent.TimeStamp)
+		// the fee is stored without a usd value rather than failing the event.
+		logger.Warnf("MESSAGEBUS could not get token price for coingeckotoken:  %s chain: %d txhash %s %d", coinGeckoID, messageEvent.ChainID, messageEvent.TxHash, *messageEvent.TimeStamp)
+		return nil, nil
 	}
 	price := GetAmountUSD(messageEvent.Fee, 18, tokenPrice)
 	if price != nil {
@@ -0,0 +1,190 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/fetcher/tokenprice"
+	"github.com/synapsecns/sanguine/services/explorer/db"
+	model "github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"github.com/synapsecns/sanguine/services/explorer/pubsub"
+	"github.com/synapsecns/sanguine/services/explorer/static"
+)
+
+// missingUSDQuery selects the latest version of the events of a table, in a time range, that match a condition.
+const missingUSDQuery = "SELECT * FROM (SELECT * FROM %s WHERE timestamp >= %d AND timestamp < %d ORDER BY insert_time DESC LIMIT 1 BY %s) WHERE %s"
+
+// embeddedMissingUSDQuery is missingUSDQuery for sqlite and postgres, which keep a single version of each event.
+const embeddedMissingUSDQuery = "SELECT * FROM %s WHERE timestamp >= %d AND timestamp < %d AND %s"
+
+const eventKey = "chain_id, contract_address, event_type, block_number, event_index, tx_hash"
+
+const fastBridgeEventKey = "tx_hash, contract_address, block_number, event_type, event_index, transaction_id"
+
+// bridgeMissingUSD matches bridge and fastbridge events stored without a usd amount.
+const bridgeMissingUSD = "(amount_usd IS NULL OR amount_usd = 0) AND token_symbol != ''"
+
+// swapMissingPrice matches swap events with a token stored without a price, by db type.
+var swapMissingPrice = map[dbcommon.DBType]string{
+	dbcommon.Clickhouse: "arrayMin(mapValues(token_price)) = 0",
+	dbcommon.Sqlite:     "mapMin(token_price) = 0",
+	dbcommon.Postgres:   "(SELECT MIN(CAST(value AS DOUBLE PRECISION)) FROM jsonb_each_text(token_price)) = 0",
+}
+
+// RecomputeUSD fills in the usd values of bridge, fastbridge, swap and message bus events between startTime and
+// endTime that were stored without them, because no token price was available when they were parsed. The events are
+// stored again with a newer insert time so they replace the incomplete rows, bypassing any publishing db since the
+// events were already published when they were first stored. It returns the number of events that were updated.
+//
+// nolint:cyclop
+func RecomputeUSD(ctx context.Context, consumerDB db.ConsumerDB, tokenPriceService tokenprice.Service, startTime, endTime uint64) (int, error) {
+	coinGeckoIDs, err := ParseYaml(static.GetTokenIDToCoingekoConfig())
+	if err != nil {
+		return 0, fmt.Errorf("could not open yaml file: %w", err)
+	}
+	insertTime := uint64(time.Now().UnixNano())
+	var updated []interface{}
+	missingUSD := func(table, key, condition string) string {
+		if consumerDB.DBType() != dbcommon.Clickhouse {
+			return fmt.Sprintf(embeddedMissingUSDQuery, table, startTime, endTime, condition)
+		}
+		return fmt.Sprintf(missingUSDQuery, table, startTime, endTime, key, condition)
+	}
+
+	bridgeEvents, err := consumerDB.GetBridgeEvents(ctx, missingUSD("bridge_events", eventKey, bridgeMissingUSD))
+	if err != nil {
+		return 0, fmt.Errorf("could not get bridge events without usd values: %w", err)
+	}
+	for i := range bridgeEvents {
+		bridgeEvent := &bridgeEvents[i]
+		if bridgeEvent.Amount == nil || bridgeEvent.Amount.Sign() == 0 || bridgeEvent.TokenDecimal == nil {
+			continue
+		}
+		tokenPrice := recomputePrice(ctx, tokenPriceService, coinGeckoIDs[bridgeEvent.TokenSymbol.String], bridgeEvent.TimeStamp)
+		if tokenPrice == nil {
+			continue
+		}
+		bridgeEvent.AmountUSD = GetAmountUSD(bridgeEvent.Amount, *bridgeEvent.TokenDecimal, tokenPrice)
+		if bridgeEvent.Fee != nil {
+			bridgeEvent.FeeUSD = GetAmountUSD(bridgeEvent.Fee, *bridgeEvent.TokenDecimal, tokenPrice)
+		}
+		bridgeEvent.InsertTime = insertTime
+		updated = append(updated, bridgeEvent)
+	}
+
+	fastBridgeEvents, err := consumerDB.GetFastBridgeEvents(ctx, missingUSD("fast_bridge_events", fastBridgeEventKey, bridgeMissingUSD))
+	if err != nil {
+		return 0, fmt.Errorf("could not get fastbridge events without usd values: %w", err)
+	}
+	for i := range fastBridgeEvents {
+		fastBridgeEvent := &fastBridgeEvents[i]
+		_, amount := eventTokenAndAmount(fastBridgeEvent)
+		if amount == nil || amount.Sign() == 0 || fastBridgeEvent.TokenDecimal == nil {
+			continue
+		}
+		tokenPrice := recomputePrice(ctx, tokenPriceService, coinGeckoIDs[fastBridgeEvent.TokenSymbol.String], fastBridgeEvent.TimeStamp)
+		if tokenPrice == nil {
+			continue
+		}
+		fastBridgeEvent.AmountUSD = GetAmountUSD(amount, *fastBridgeEvent.TokenDecimal, tokenPrice)
+		if fastBridgeEvent.Fee != nil {
+			fastBridgeEvent.FeeUSD = GetAmountUSD(fastBridgeEvent.Fee, *fastBridgeEvent.TokenDecimal, tokenPrice)
+		}
+		fastBridgeEvent.InsertTime = insertTime
+		updated = append(updated, fastBridgeEvent)
+	}
+
+	swapEvents, err := consumerDB.GetSwapEvents(ctx, missingUSD("swap_events", eventKey, swapMissingPrice[consumerDB.DBType()]))
+	if err != nil {
+		return 0, fmt.Errorf("could not get swap events without usd values: %w", err)
+	}
+	for i := range swapEvents {
+		swapEvent := &swapEvents[i]
+		if !recomputeSwapUSD(ctx, tokenPriceService, swapEvent) {
+			continue
+		}
+		swapEvent.InsertTime = insertTime
+		// swap and message bus events are stored by value.
+		updated = append(updated, *swapEvent)
+	}
+
+	for chainID := range messageBusFeeTokens {
+		messageBusEvents, err := consumerDB.GetMessageBusEvents(ctx, missingUSD("message_bus_events", eventKey, fmt.Sprintf("fee_usd IS NULL AND chain_id = %d", chainID)))
+		if err != nil {
+			return 0, fmt.Errorf("could not get message bus events without usd values: %w", err)
+		}
+		for i := range messageBusEvents {
+			messageBusEvent := &messageBusEvents[i]
+			if messageBusEvent.Fee == nil {
+				continue
+			}
+			tokenPrice := recomputePrice(ctx, tokenPriceService, messageBusFeeTokens[chainID], messageBusEvent.TimeStamp)
+			if tokenPrice == nil {
+				continue
+			}
+			messageBusEvent.FeeUSD = GetAmountUSD(messageBusEvent.Fee, 18, tokenPrice)
+			messageBusEvent.InsertTime = insertTime
+			updated = append(updated, *messageBusEvent)
+		}
+	}
+
+	if len(updated) == 0 {
+		return 0, nil
+	}
+	err = pubsub.Unwrap(consumerDB).StoreEvents(ctx, updated)
+	if err != nil {
+		return 0, fmt.Errorf("could not store recomputed events: %w", err)
+	}
+	return len(updated), nil
+}
+
+// recomputeSwapUSD prices the tokens of a swap event that were stored without a price and recomputes their usd
+// amounts and fees, the way the swap parser computes them. It returns false if no token could be priced.
+func recomputeSwapUSD(ctx context.Context, tokenPriceService tokenprice.Service, swapEvent *model.SwapEvent) bool {
+	repriced := false
+	for tokenIndex, price := range swapEvent.TokenPrice {
+		if price != 0 || swapEvent.TimeStamp == nil || !swapTokenHasPrice(swapEvent.TokenCoinGeckoID[tokenIndex], *swapEvent.TimeStamp) {
+			continue
+		}
+		tokenPrice := recomputePrice(ctx, tokenPriceService, swapEvent.TokenCoinGeckoID[tokenIndex], swapEvent.TimeStamp)
+		if tokenPrice == nil {
+			continue
+		}
+		decimals := swapEvent.TokenDecimal[tokenIndex]
+		swapEvent.TokenPrice[tokenIndex] = *tokenPrice
+		if amount, ok := new(big.Int).SetString(swapEvent.Amount[tokenIndex], 10); ok {
+			swapEvent.AmountUSD = setUSD(swapEvent.AmountUSD, tokenIndex, GetAmountUSD(amount, decimals, tokenPrice))
+		}
+		if fee, ok := new(big.Int).SetString(swapEvent.Fee[tokenIndex], 10); ok {
+			swapEvent.FeeUSD = setUSD(swapEvent.FeeUSD, tokenIndex, GetAmountUSD(fee, decimals, tokenPrice))
+		}
+		if adminFee, ok := new(big.Int).SetString(swapEvent.AdminFee[tokenIndex], 10); ok {
+			swapEvent.AdminFeeUSD = setUSD(swapEvent.AdminFeeUSD, tokenIndex, GetAmountUSD(adminFee, decimals, tokenPrice))
+		}
+		repriced = true
+	}
+	return repriced
+}
+
+// setUSD sets the usd value of a token index, creating the map if the event was stored without one.
+func setUSD(values map[uint8]float64, tokenIndex uint8, value *float64) map[uint8]float64 {
+	if value == nil {
+		return values
+	}
+	if values == nil {
+		values = make(map[uint8]float64)
+	}
+	values[tokenIndex] = *value
+	return values
+}
+
+// recomputePrice gets the price of a coin gecko id at a timestamp, or nil if the token has no price.
+func recomputePrice(ctx context.Context, tokenPriceService tokenprice.Service, coinGeckoID string, timeStamp *uint64) *float64 {
+	if coinGeckoID == "" || coinGeckoID == noTokenID || coinGeckoID == noPrice || timeStamp == nil {
+		return nil
+	}
+	return tokenPriceService.GetPriceData(ctx, int(*timeStamp), coinGeckoID)
+}
@@ -0,0 +1,80 @@
+package parser_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/parser"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+)
+
+type fixedPriceService struct {
+	price float64
+}
+
+func (f fixedPriceService) GetPriceData(context.Context, int, string) *float64 {
+	return &f.price
+}
+
+func TestRecomputeUSD(t *testing.T) {
+	ctx := context.Background()
+	store, err := sql.OpenGormSqlite(ctx, t.TempDir(), false, metrics.NewNullHandler())
+	Nil(t, err)
+
+	timestamp := uint64(1700000000)
+	swap := sql.SwapEvent{
+		InsertTime:       1,
+		ChainID:          1,
+		ContractAddress:  "0xpool",
+		BlockNumber:      10,
+		TxHash:           "0xswap",
+		Amount:           map[uint8]string{0: "1000000", 1: "3000000"},
+		Fee:              map[uint8]string{1: "1000000"},
+		AdminFee:         map[uint8]string{1: "500000"},
+		AmountUSD:        map[uint8]float64{0: 1},
+		TokenPrice:       map[uint8]float64{0: 1, 1: 0},
+		TokenDecimal:     map[uint8]uint8{0: 6, 1: 6},
+		TokenCoinGeckoID: map[uint8]string{0: "usd-coin", 1: "usd-coin"},
+		SoldID:           big.NewInt(1),
+		BoughtID:         big.NewInt(0),
+		TimeStamp:        &timestamp,
+	}
+	messageBus := sql.MessageBusEvent{
+		InsertTime:         1,
+		ChainID:            53935,
+		ContractAddress:    "0xmessagebus",
+		BlockNumber:        10,
+		TxHash:             "0xmessage",
+		Fee:                big.NewInt(1e18),
+		SourceChainID:      big.NewInt(53935),
+		DestinationChainID: big.NewInt(1),
+		TimeStamp:          &timestamp,
+	}
+	Nil(t, store.StoreEvents(ctx, []interface{}{swap, messageBus}))
+
+	updated, err := parser.RecomputeUSD(ctx, store, fixedPriceService{price: 2}, timestamp, timestamp+1)
+	Nil(t, err)
+	Equal(t, 2, updated)
+
+	swaps, err := store.GetSwapEvents(ctx, "SELECT * FROM swap_events")
+	Nil(t, err)
+	Len(t, swaps, 1)
+	Equal(t, map[uint8]float64{0: 1, 1: 2}, swaps[0].TokenPrice)
+	Equal(t, map[uint8]float64{0: 1, 1: 6}, swaps[0].AmountUSD)
+	Equal(t, map[uint8]float64{1: 2}, swaps[0].FeeUSD)
+	Equal(t, map[uint8]float64{1: 1}, swaps[0].AdminFeeUSD)
+
+	messages, err := store.GetMessageBusEvents(ctx, "SELECT * FROM message_bus_events")
+	Nil(t, err)
+	Len(t, messages, 1)
+	NotNil(t, messages[0].FeeUSD)
+	Equal(t, 2.0, *messages[0].FeeUSD)
+
+	// priced events are not recomputed again.
+	updated, err = parser.RecomputeUSD(ctx, store, fixedPriceService{price: 2}, timestamp, timestamp+1)
+	Nil(t, err)
+	Zero(t, updated)
+}
@@ -372,12 +372,14 @@ func (p *SwapParser) Parse(ctx context.Context, log ethTypes.Log, chainID uint32
 				coinGeckoID := p.coinGeckoIDs[tokenData.TokenID()]
 				tokenCoinGeckoIDsArr[tokenIndex] = coinGeckoID
 
-				if !(coinGeckoID == "xjewel" && *timeStamp < 1649030400) && !(coinGeckoID == "synapse-2" && *timeStamp < 1630281600) && !(coinGeckoID == "governance-ohm" && *timeStamp < 1638316800) && !(coinGeckoID == "highstreet" && *timeStamp < 1634263200) {
+				if swapTokenHasPrice(coinGeckoID, *timeStamp) {
 					tokenPrice := p.tokenPriceService.GetPriceData(groupCtx, int(*swapEvent.TimeStamp), coinGeckoID)
-					if (tokenPrice == nil) && coinGeckoID != noTokenID && coinGeckoID != noPrice {
-						return fmt.Errorf("SWAP could not get token price for coingeckotoken:  %s chain: %d txhash %s %d", coinGeckoID, chainID, swapEvent.TxHash, swapEvent.TimeStamp)
+					if tokenPrice == nil {
+						// the price is left at zero, bridges fall back to their own usd value in that case.
+						logger.Warnf("SWAP could not get token price for coingeckotoken:  %s chain: %d txhash %s %d", coinGeckoID, chainID, swapEvent.TxHash, *swapEvent.TimeStamp)
+					} else {
+						tokenPricesArr[tokenIndex] = *tokenPrice
 					}
-					tokenPricesArr[tokenIndex] = *tokenPrice
 				}
 
 				// TODO DELETE
@@ -472,6 +474,11 @@ func (p *SwapParser) Parse(ctx context.Context, log ethTypes.Log, chainID uint32
 	return swapEvent, nil
 }
 
+// swapTokenHasPrice is false for tokens swapped before they had a price.
+func swapTokenHasPrice(coinGeckoID string, timeStamp uint64) bool {
+	return !(coinGeckoID == "xjewel" && timeStamp < 1649030400) && !(coinGeckoID == "synapse-2" && timeStamp < 1630281600) && !(coinGeckoID == "governance-ohm" && timeStamp < 1638316800) && !(coinGeckoID == "highstreet" && timeStamp < 1634263200)
+}
+
 // convertFee gets the fee amount.
 func convertFee(amount *big.Int, decimal uint8, feeAmount uint64) (string, error) {
 	adjustedAmount := GetAdjustedAmount(amount, decimal)
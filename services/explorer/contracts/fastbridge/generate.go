@@ -0,0 +1,6 @@
+// Package fastbridge Go interface for contracts-rfq/.../FastBridge.sol
+package fastbridge
+
+//go:generate go run github.com/synapsecns/sanguine/tools/abigen generate --sol ../../../../packages/contracts-rfq/flattened/FastBridge.sol --pkg fastbridge --sol-version 0.8.20 --filename fastbridge --evm-version istanbul
+
+// ignore this line: go:generate cannot be the last line of a file
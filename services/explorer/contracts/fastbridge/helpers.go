@@ -0,0 +1,59 @@
+package fastbridge
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// FastBridgeRef is a reference to a deployed FastBridge contract.
+//
+//nolint:golint
+type FastBridgeRef struct {
+	*FastBridge
+	address common.Address
+}
+
+// Address is the contract address.
+func (f FastBridgeRef) Address() common.Address {
+	return f.address
+}
+
+// NewFastBridgeRef creates a new FastBridgeRef instance.
+//
+//nolint:golint
+func NewFastBridgeRef(address common.Address, backend bind.ContractBackend) (*FastBridgeRef, error) {
+	fastBridge, err := NewFastBridge(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &FastBridgeRef{
+		FastBridge: fastBridge,
+		address:    address,
+	}, nil
+}
+
+var _ vm.ContractRef = &FastBridgeRef{}
+
+// bridgeTransactionArgs are the abi arguments a bridge request is encoded with.
+var bridgeTransactionArgs abi.Arguments
+
+// DecodeBridgeTransaction decodes the request emitted in BridgeRequested without calling the contract.
+func DecodeBridgeTransaction(request []byte) (*IFastBridgeBridgeTransaction, error) {
+	out, err := bridgeTransactionArgs.Unpack(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not unpack bridge transaction: %w", err)
+	}
+	if len(out) != 1 {
+		return nil, fmt.Errorf("expected 1 bridge transaction, got %d", len(out))
+	}
+
+	bridgeTransaction, ok := abi.ConvertType(out[0], new(IFastBridgeBridgeTransaction)).(*IFastBridgeBridgeTransaction)
+	if !ok {
+		return nil, fmt.Errorf("could not convert bridge transaction")
+	}
+	return bridgeTransaction, nil
+}
@@ -0,0 +1,41 @@
+package fastbridge_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/explorer/contracts/fastbridge"
+)
+
+func TestDecodeBridgeTransaction(t *testing.T) {
+	parsedABI, err := abi.JSON(strings.NewReader(fastbridge.FastBridgeMetaData.ABI))
+	Nil(t, err)
+
+	bridgeTransaction := fastbridge.IFastBridgeBridgeTransaction{
+		OriginChainId:   42161,
+		DestChainId:     10,
+		OriginSender:    common.HexToAddress("0x1"),
+		DestRecipient:   common.HexToAddress("0x2"),
+		OriginToken:     common.HexToAddress("0x3"),
+		DestToken:       common.HexToAddress("0x4"),
+		OriginAmount:    big.NewInt(1000),
+		DestAmount:      big.NewInt(990),
+		OriginFeeAmount: big.NewInt(1),
+		SendChainGas:    true,
+		Deadline:        big.NewInt(1700000000),
+		Nonce:           big.NewInt(7),
+	}
+	request, err := parsedABI.Methods["getBridgeTransaction"].Outputs.Pack(bridgeTransaction)
+	Nil(t, err)
+
+	decoded, err := fastbridge.DecodeBridgeTransaction(request)
+	Nil(t, err)
+	Equal(t, bridgeTransaction, *decoded)
+
+	_, err = fastbridge.DecodeBridgeTransaction([]byte{1, 2, 3})
+	NotNil(t, err)
+}
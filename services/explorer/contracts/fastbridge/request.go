@@ -0,0 +1,539 @@
+package fastbridge
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/services/explorer/types/fastbridge"
+)
+
+// GetContractAddress gets the contract address the event occurred on.
+func (s FastBridgeBridgeRequested) GetContractAddress() common.Address {
+	return s.Raw.Address
+}
+
+// GetBlockNumber gets the block number for the event.
+func (s FastBridgeBridgeRequested) GetBlockNumber() uint64 {
+	return s.Raw.BlockNumber
+}
+
+// GetTxHash gets the unique identifier (txhash) for the event.
+func (s FastBridgeBridgeRequested) GetTxHash() common.Hash {
+	return s.Raw.TxHash
+}
+
+// GetEventType gets the event type for the event.
+func (s FastBridgeBridgeRequested) GetEventType() fastbridge.EventType {
+	return fastbridge.BridgeRequestedEvent
+}
+
+// GetEventIndex gets the event index for the event.
+func (s FastBridgeBridgeRequested) GetEventIndex() uint64 {
+	return uint64(s.Raw.Index)
+}
+
+// GetTransactionID gets the transaction id of the rfq bridge.
+func (s FastBridgeBridgeRequested) GetTransactionID() [32]byte {
+	return s.TransactionId
+}
+
+// GetOriginChainID gets the origin chain id of the rfq bridge.
+func (s FastBridgeBridgeRequested) GetOriginChainID() *big.Int {
+	return nil
+}
+
+// GetDestinationChainID gets the destination chain id of the rfq bridge.
+func (s FastBridgeBridgeRequested) GetDestinationChainID() *big.Int {
+	return big.NewInt(int64(s.DestChainId))
+}
+
+// GetSender gets the sender of the rfq bridge on origin.
+func (s FastBridgeBridgeRequested) GetSender() *string {
+	str := s.Sender.String()
+	return &str
+}
+
+// GetRecipient gets the recipient of the tokens.
+func (s FastBridgeBridgeRequested) GetRecipient() *string {
+	return nil
+}
+
+// GetRelayer gets the relayer of the rfq bridge.
+func (s FastBridgeBridgeRequested) GetRelayer() *string {
+	return nil
+}
+
+// GetOriginToken gets the address of the token on origin.
+func (s FastBridgeBridgeRequested) GetOriginToken() *string {
+	str := s.OriginToken.String()
+	return &str
+}
+
+// GetDestinationToken gets the address of the token on destination.
+func (s FastBridgeBridgeRequested) GetDestinationToken() *string {
+	str := s.DestToken.String()
+	return &str
+}
+
+// GetOriginAmount gets the amount of the origin token.
+func (s FastBridgeBridgeRequested) GetOriginAmount() *big.Int {
+	return s.OriginAmount
+}
+
+// GetDestinationAmount gets the amount of the destination token.
+func (s FastBridgeBridgeRequested) GetDestinationAmount() *big.Int {
+	return s.DestAmount
+}
+
+// GetChainGasAmount gets the amount of gas sent to the recipient on destination.
+func (s FastBridgeBridgeRequested) GetChainGasAmount() *big.Int {
+	return nil
+}
+
+// GetRequest gets the encoded bridge transaction of the request.
+func (s FastBridgeBridgeRequested) GetRequest() *[]byte {
+	return &s.Request
+}
+
+// GetContractAddress gets the contract address the event occurred on.
+func (s FastBridgeBridgeRelayed) GetContractAddress() common.Address {
+	return s.Raw.Address
+}
+
+// GetBlockNumber gets the block number for the event.
+func (s FastBridgeBridgeRelayed) GetBlockNumber() uint64 {
+	return s.Raw.BlockNumber
+}
+
+// GetTxHash gets the unique identifier (txhash) for the event.
+func (s FastBridgeBridgeRelayed) GetTxHash() common.Hash {
+	return s.Raw.TxHash
+}
+
+// GetEventType gets the event type for the event.
+func (s FastBridgeBridgeRelayed) GetEventType() fastbridge.EventType {
+	return fastbridge.BridgeRelayedEvent
+}
+
+// GetEventIndex gets the event index for the event.
+func (s FastBridgeBridgeRelayed) GetEventIndex() uint64 {
+	return uint64(s.Raw.Index)
+}
+
+// GetTransactionID gets the transaction id of the rfq bridge.
+func (s FastBridgeBridgeRelayed) GetTransactionID() [32]byte {
+	return s.TransactionId
+}
+
+// GetOriginChainID gets the origin chain id of the rfq bridge.
+func (s FastBridgeBridgeRelayed) GetOriginChainID() *big.Int {
+	return big.NewInt(int64(s.OriginChainId))
+}
+
+// GetDestinationChainID gets the destination chain id of the rfq bridge.
+func (s FastBridgeBridgeRelayed) GetDestinationChainID() *big.Int {
+	return nil
+}
+
+// GetSender gets the sender of the rfq bridge on origin.
+func (s FastBridgeBridgeRelayed) GetSender() *string {
+	return nil
+}
+
+// GetRecipient gets the recipient of the tokens.
+func (s FastBridgeBridgeRelayed) GetRecipient() *string {
+	str := s.To.String()
+	return &str
+}
+
+// GetRelayer gets the relayer of the rfq bridge.
+func (s FastBridgeBridgeRelayed) GetRelayer() *string {
+	str := s.Relayer.String()
+	return &str
+}
+
+// GetOriginToken gets the address of the token on origin.
+func (s FastBridgeBridgeRelayed) GetOriginToken() *string {
+	str := s.OriginToken.String()
+	return &str
+}
+
+// GetDestinationToken gets the address of the token on destination.
+func (s FastBridgeBridgeRelayed) GetDestinationToken() *string {
+	str := s.DestToken.String()
+	return &str
+}
+
+// GetOriginAmount gets the amount of the origin token.
+func (s FastBridgeBridgeRelayed) GetOriginAmount() *big.Int {
+	return s.OriginAmount
+}
+
+// GetDestinationAmount gets the amount of the destination token.
+func (s FastBridgeBridgeRelayed) GetDestinationAmount() *big.Int {
+	return s.DestAmount
+}
+
+// GetChainGasAmount gets the amount of gas sent to the recipient on destination.
+func (s FastBridgeBridgeRelayed) GetChainGasAmount() *big.Int {
+	return s.ChainGasAmount
+}
+
+// GetRequest gets the encoded bridge transaction of the request.
+func (s FastBridgeBridgeRelayed) GetRequest() *[]byte {
+	return nil
+}
+
+// GetContractAddress gets the contract address the event occurred on.
+func (s FastBridgeBridgeProofProvided) GetContractAddress() common.Address {
+	return s.Raw.Address
+}
+
+// GetBlockNumber gets the block number for the event.
+func (s FastBridgeBridgeProofProvided) GetBlockNumber() uint64 {
+	return s.Raw.BlockNumber
+}
+
+// GetTxHash gets the unique identifier (txhash) for the event.
+func (s FastBridgeBridgeProofProvided) GetTxHash() common.Hash {
+	return s.Raw.TxHash
+}
+
+// GetEventType gets the event type for the event.
+func (s FastBridgeBridgeProofProvided) GetEventType() fastbridge.EventType {
+	return fastbridge.BridgeProofProvidedEvent
+}
+
+// GetEventIndex gets the event index for the event.
+func (s FastBridgeBridgeProofProvided) GetEventIndex() uint64 {
+	return uint64(s.Raw.Index)
+}
+
+// GetTransactionID gets the transaction id of the rfq bridge.
+func (s FastBridgeBridgeProofProvided) GetTransactionID() [32]byte {
+	return s.TransactionId
+}
+
+// GetOriginChainID gets the origin chain id of the rfq bridge.
+func (s FastBridgeBridgeProofProvided) GetOriginChainID() *big.Int {
+	return nil
+}
+
+// GetDestinationChainID gets the destination chain id of the rfq bridge.
+func (s FastBridgeBridgeProofProvided) GetDestinationChainID() *big.Int {
+	return nil
+}
+
+// GetSender gets the sender of the rfq bridge on origin.
+func (s FastBridgeBridgeProofProvided) GetSender() *string {
+	return nil
+}
+
+// GetRecipient gets the recipient of the tokens.
+func (s FastBridgeBridgeProofProvided) GetRecipient() *string {
+	return nil
+}
+
+// GetRelayer gets the relayer of the rfq bridge.
+func (s FastBridgeBridgeProofProvided) GetRelayer() *string {
+	str := s.Relayer.String()
+	return &str
+}
+
+// GetOriginToken gets the address of the token on origin.
+func (s FastBridgeBridgeProofProvided) GetOriginToken() *string {
+	return nil
+}
+
+// GetDestinationToken gets the address of the token on destination.
+func (s FastBridgeBridgeProofProvided) GetDestinationToken() *string {
+	return nil
+}
+
+// GetOriginAmount gets the amount of the origin token.
+func (s FastBridgeBridgeProofProvided) GetOriginAmount() *big.Int {
+	return nil
+}
+
+// GetDestinationAmount gets the amount of the destination token.
+func (s FastBridgeBridgeProofProvided) GetDestinationAmount() *big.Int {
+	return nil
+}
+
+// GetChainGasAmount gets the amount of gas sent to the recipient on destination.
+func (s FastBridgeBridgeProofProvided) GetChainGasAmount() *big.Int {
+	return nil
+}
+
+// GetRequest gets the encoded bridge transaction of the request.
+func (s FastBridgeBridgeProofProvided) GetRequest() *[]byte {
+	return nil
+}
+
+// GetContractAddress gets the contract address the event occurred on.
+func (s FastBridgeBridgeDepositClaimed) GetContractAddress() common.Address {
+	return s.Raw.Address
+}
+
+// GetBlockNumber gets the block number for the event.
+func (s FastBridgeBridgeDepositClaimed) GetBlockNumber() uint64 {
+	return s.Raw.BlockNumber
+}
+
+// GetTxHash gets the unique identifier (txhash) for the event.
+func (s FastBridgeBridgeDepositClaimed) GetTxHash() common.Hash {
+	return s.Raw.TxHash
+}
+
+// GetEventType gets the event type for the event.
+func (s FastBridgeBridgeDepositClaimed) GetEventType() fastbridge.EventType {
+	return fastbridge.BridgeDepositClaimedEvent
+}
+
+// GetEventIndex gets the event index for the event.
+func (s FastBridgeBridgeDepositClaimed) GetEventIndex() uint64 {
+	return uint64(s.Raw.Index)
+}
+
+// GetTransactionID gets the transaction id of the rfq bridge.
+func (s FastBridgeBridgeDepositClaimed) GetTransactionID() [32]byte {
+	return s.TransactionId
+}
+
+// GetOriginChainID gets the origin chain id of the rfq bridge.
+func (s FastBridgeBridgeDepositClaimed) GetOriginChainID() *big.Int {
+	return nil
+}
+
+// GetDestinationChainID gets the destination chain id of the rfq bridge.
+func (s FastBridgeBridgeDepositClaimed) GetDestinationChainID() *big.Int {
+	return nil
+}
+
+// GetSender gets the sender of the rfq bridge on origin.
+func (s FastBridgeBridgeDepositClaimed) GetSender() *string {
+	return nil
+}
+
+// GetRecipient gets the recipient of the tokens.
+func (s FastBridgeBridgeDepositClaimed) GetRecipient() *string {
+	str := s.To.String()
+	return &str
+}
+
+// GetRelayer gets the relayer of the rfq bridge.
+func (s FastBridgeBridgeDepositClaimed) GetRelayer() *string {
+	str := s.Relayer.String()
+	return &str
+}
+
+// GetOriginToken gets the address of the token on origin.
+func (s FastBridgeBridgeDepositClaimed) GetOriginToken() *string {
+	str := s.Token.String()
+	return &str
+}
+
+// GetDestinationToken gets the address of the token on destination.
+func (s FastBridgeBridgeDepositClaimed) GetDestinationToken() *string {
+	return nil
+}
+
+// GetOriginAmount gets the amount of the origin token.
+func (s FastBridgeBridgeDepositClaimed) GetOriginAmount() *big.Int {
+	return s.Amount
+}
+
+// GetDestinationAmount gets the amount of the destination token.
+func (s FastBridgeBridgeDepositClaimed) GetDestinationAmount() *big.Int {
+	return nil
+}
+
+// GetChainGasAmount gets the amount of gas sent to the recipient on destination.
+func (s FastBridgeBridgeDepositClaimed) GetChainGasAmount() *big.Int {
+	return nil
+}
+
+// GetRequest gets the encoded bridge transaction of the request.
+func (s FastBridgeBridgeDepositClaimed) GetRequest() *[]byte {
+	return nil
+}
+
+// GetContractAddress gets the contract address the event occurred on.
+func (s FastBridgeBridgeDepositRefunded) GetContractAddress() common.Address {
+	return s.Raw.Address
+}
+
+// GetBlockNumber gets the block number for the event.
+func (s FastBridgeBridgeDepositRefunded) GetBlockNumber() uint64 {
+	return s.Raw.BlockNumber
+}
+
+// GetTxHash gets the unique identifier (txhash) for the event.
+func (s FastBridgeBridgeDepositRefunded) GetTxHash() common.Hash {
+	return s.Raw.TxHash
+}
+
+// GetEventType gets the event type for the event.
+func (s FastBridgeBridgeDepositRefunded) GetEventType() fastbridge.EventType {
+	return fastbridge.BridgeDepositRefundedEvent
+}
+
+// GetEventIndex gets the event index for the event.
+func (s FastBridgeBridgeDepositRefunded) GetEventIndex() uint64 {
+	return uint64(s.Raw.Index)
+}
+
+// GetTransactionID gets the transaction id of the rfq bridge.
+func (s FastBridgeBridgeDepositRefunded) GetTransactionID() [32]byte {
+	return s.TransactionId
+}
+
+// GetOriginChainID gets the origin chain id of the rfq bridge.
+func (s FastBridgeBridgeDepositRefunded) GetOriginChainID() *big.Int {
+	return nil
+}
+
+// GetDestinationChainID gets the destination chain id of the rfq bridge.
+func (s FastBridgeBridgeDepositRefunded) GetDestinationChainID() *big.Int {
+	return nil
+}
+
+// GetSender gets the sender of the rfq bridge on origin.
+func (s FastBridgeBridgeDepositRefunded) GetSender() *string {
+	return nil
+}
+
+// GetRecipient gets the recipient of the tokens.
+func (s FastBridgeBridgeDepositRefunded) GetRecipient() *string {
+	str := s.To.String()
+	return &str
+}
+
+// GetRelayer gets the relayer of the rfq bridge.
+func (s FastBridgeBridgeDepositRefunded) GetRelayer() *string {
+	return nil
+}
+
+// GetOriginToken gets the address of the token on origin.
+func (s FastBridgeBridgeDepositRefunded) GetOriginToken() *string {
+	str := s.Token.String()
+	return &str
+}
+
+// GetDestinationToken gets the address of the token on destination.
+func (s FastBridgeBridgeDepositRefunded) GetDestinationToken() *string {
+	return nil
+}
+
+// GetOriginAmount gets the amount of the origin token.
+func (s FastBridgeBridgeDepositRefunded) GetOriginAmount() *big.Int {
+	return s.Amount
+}
+
+// GetDestinationAmount gets the amount of the destination token.
+func (s FastBridgeBridgeDepositRefunded) GetDestinationAmount() *big.Int {
+	return nil
+}
+
+// GetChainGasAmount gets the amount of gas sent to the recipient on destination.
+func (s FastBridgeBridgeDepositRefunded) GetChainGasAmount() *big.Int {
+	return nil
+}
+
+// GetRequest gets the encoded bridge transaction of the request.
+func (s FastBridgeBridgeDepositRefunded) GetRequest() *[]byte {
+	return nil
+}
+
+// GetContractAddress gets the contract address the event occurred on.
+func (s FastBridgeBridgeProofDisputed) GetContractAddress() common.Address {
+	return s.Raw.Address
+}
+
+// GetBlockNumber gets the block number for the event.
+func (s FastBridgeBridgeProofDisputed) GetBlockNumber() uint64 {
+	return s.Raw.BlockNumber
+}
+
+// GetTxHash gets the unique identifier (txhash) for the event.
+func (s FastBridgeBridgeProofDisputed) GetTxHash() common.Hash {
+	return s.Raw.TxHash
+}
+
+// GetEventType gets the event type for the event.
+func (s FastBridgeBridgeProofDisputed) GetEventType() fastbridge.EventType {
+	return fastbridge.BridgeProofDisputedEvent
+}
+
+// GetEventIndex gets the event index for the event.
+func (s FastBridgeBridgeProofDisputed) GetEventIndex() uint64 {
+	return uint64(s.Raw.Index)
+}
+
+// GetTransactionID gets the transaction id of the rfq bridge.
+func (s FastBridgeBridgeProofDisputed) GetTransactionID() [32]byte {
+	return s.TransactionId
+}
+
+// GetOriginChainID gets the origin chain id of the rfq bridge.
+func (s FastBridgeBridgeProofDisputed) GetOriginChainID() *big.Int {
+	return nil
+}
+
+// GetDestinationChainID gets the destination chain id of the rfq bridge.
+func (s FastBridgeBridgeProofDisputed) GetDestinationChainID() *big.Int {
+	return nil
+}
+
+// GetSender gets the sender of the rfq bridge on origin.
+func (s FastBridgeBridgeProofDisputed) GetSender() *string {
+	return nil
+}
+
+// GetRecipient gets the recipient of the tokens.
+func (s FastBridgeBridgeProofDisputed) GetRecipient() *string {
+	return nil
+}
+
+// GetRelayer gets the relayer of the rfq bridge.
+func (s FastBridgeBridgeProofDisputed) GetRelayer() *string {
+	str := s.Relayer.String()
+	return &str
+}
+
+// GetOriginToken gets the address of the token on origin.
+func (s FastBridgeBridgeProofDisputed) GetOriginToken() *string {
+	return nil
+}
+
+// GetDestinationToken gets the address of the token on destination.
+func (s FastBridgeBridgeProofDisputed) GetDestinationToken() *string {
+	return nil
+}
+
+// GetOriginAmount gets the amount of the origin token.
+func (s FastBridgeBridgeProofDisputed) GetOriginAmount() *big.Int {
+	return nil
+}
+
+// GetDestinationAmount gets the amount of the destination token.
+func (s FastBridgeBridgeProofDisputed) GetDestinationAmount() *big.Int {
+	return nil
+}
+
+// GetChainGasAmount gets the amount of gas sent to the recipient on destination.
+func (s FastBridgeBridgeProofDisputed) GetChainGasAmount() *big.Int {
+	return nil
+}
+
+// GetRequest gets the encoded bridge transaction of the request.
+func (s FastBridgeBridgeProofDisputed) GetRequest() *[]byte {
+	return nil
+}
+
+var _ fastbridge.EventLog = &FastBridgeBridgeRequested{}
+var _ fastbridge.EventLog = &FastBridgeBridgeRelayed{}
+var _ fastbridge.EventLog = &FastBridgeBridgeProofProvided{}
+var _ fastbridge.EventLog = &FastBridgeBridgeDepositClaimed{}
+var _ fastbridge.EventLog = &FastBridgeBridgeDepositRefunded{}
+var _ fastbridge.EventLog = &FastBridgeBridgeProofDisputed{}
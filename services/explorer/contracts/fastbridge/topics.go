@@ -0,0 +1,78 @@
+package fastbridge
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/services/explorer/types/fastbridge"
+)
+
+func init() {
+	var err error
+	parsedFastBridge, err := abi.JSON(strings.NewReader(FastBridgeMetaData.ABI))
+	if err != nil {
+		panic(err)
+	}
+
+	BridgeRequestedTopic = parsedFastBridge.Events["BridgeRequested"].ID
+	BridgeRelayedTopic = parsedFastBridge.Events["BridgeRelayed"].ID
+	BridgeProofProvidedTopic = parsedFastBridge.Events["BridgeProofProvided"].ID
+	BridgeDepositClaimedTopic = parsedFastBridge.Events["BridgeDepositClaimed"].ID
+	BridgeDepositRefundedTopic = parsedFastBridge.Events["BridgeDepositRefunded"].ID
+	BridgeProofDisputedTopic = parsedFastBridge.Events["BridgeProofDisputed"].ID
+
+	bridgeTransactionArgs = parsedFastBridge.Methods["getBridgeTransaction"].Outputs
+}
+
+// BridgeRequestedTopic is the topic emitted when a user requests an rfq bridge.
+var BridgeRequestedTopic common.Hash
+
+// BridgeRelayedTopic is the topic emitted when a relayer completes an rfq bridge on destination.
+var BridgeRelayedTopic common.Hash
+
+// BridgeProofProvidedTopic is the topic emitted when a relayer proves a relay on origin.
+var BridgeProofProvidedTopic common.Hash
+
+// BridgeDepositClaimedTopic is the topic emitted when a relayer claims a deposit on origin.
+var BridgeDepositClaimedTopic common.Hash
+
+// BridgeDepositRefundedTopic is the topic emitted when a deposit is refunded on origin.
+var BridgeDepositRefundedTopic common.Hash
+
+// BridgeProofDisputedTopic is the topic emitted when a relayer's proof is disputed on origin.
+var BridgeProofDisputedTopic common.Hash
+
+// TopicMap maps events to topics.
+// this is returned as a function to assert immutability.
+func TopicMap() map[fastbridge.EventType]common.Hash {
+	return map[fastbridge.EventType]common.Hash{
+		fastbridge.BridgeRequestedEvent:       BridgeRequestedTopic,
+		fastbridge.BridgeRelayedEvent:         BridgeRelayedTopic,
+		fastbridge.BridgeProofProvidedEvent:   BridgeProofProvidedTopic,
+		fastbridge.BridgeDepositClaimedEvent:  BridgeDepositClaimedTopic,
+		fastbridge.BridgeDepositRefundedEvent: BridgeDepositRefundedTopic,
+		fastbridge.BridgeProofDisputedEvent:   BridgeProofDisputedTopic,
+	}
+}
+
+// EventTypeFromTopic gets the event type from the topic
+// returns nil if the topic is not found.
+func EventTypeFromTopic(ogTopic common.Hash) *fastbridge.EventType {
+	for eventType, topic := range TopicMap() {
+		if bytes.Equal(ogTopic.Bytes(), topic.Bytes()) {
+			return &eventType
+		}
+	}
+	return nil
+}
+
+// Topic gets the topic from the event type.
+func Topic(eventType fastbridge.EventType) common.Hash {
+	topicHash, ok := TopicMap()[fastbridge.EventType(eventType.Int())]
+	if !ok {
+		panic("unknown event")
+	}
+	return topicHash
+}
@@ -3,6 +3,7 @@
 import (
 	"context"
 	"github.com/benbjohnson/immutable"
+	"github.com/synapsecns/sanguine/core/dbcommon"
 
 	"github.com/synapsecns/sanguine/services/explorer/db/sql"
 	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/model"
@@ -24,6 +25,8 @@ type ConsumerDBWriter interface {
 	StoreTokenIndex(ctx context.Context, chainID uint32, tokenIndex uint8, tokenAddress string, contractAddress string) error
 	// StoreSwapFee stores the swap fee data.
 	StoreSwapFee(ctx context.Context, chainID uint32, timestamp uint64, contractAddress string, fee uint64, feeType string) error
+	// StoreTokenPrices stores daily token prices.
+	StoreTokenPrices(ctx context.Context, prices []sql.TokenPrice) error
 	// UNSAFE_DB gets the underlying gorm db. This is for testing only and not intended for use in production.
 	//
 	//nolint:golint
@@ -33,6 +36,8 @@ type ConsumerDBWriter interface {
 // ConsumerDBReader is the interface for reading events from the ConsumerDB.
 // nolint:interfacebloat
 type ConsumerDBReader interface {
+	// DBType gets the backend the queries are run against.
+	DBType() dbcommon.DBType
 	// GetUint64 gets a uint64 for a given query.
 	GetUint64(ctx context.Context, query string) (uint64, error)
 	// GetFloat64 gets a float64 out of the database
@@ -49,6 +54,12 @@ type ConsumerDBReader interface {
 	GetBridgeEvent(ctx context.Context, query string) (*sql.BridgeEvent, error)
 	// GetBridgeEvents returns a bridge event.
 	GetBridgeEvents(ctx context.Context, query string) ([]sql.BridgeEvent, error)
+	// GetFastBridgeEvents returns fastbridge events.
+	GetFastBridgeEvents(ctx context.Context, query string) ([]sql.FastBridgeEvent, error)
+	// GetSwapEvents returns swap events.
+	GetSwapEvents(ctx context.Context, query string) ([]sql.SwapEvent, error)
+	// GetMessageBusEvents returns message bus events.
+	GetMessageBusEvents(ctx context.Context, query string) ([]sql.MessageBusEvent, error)
 	// GetMVBridgeEvent returns a bridge event from the mv Table.
 	GetMVBridgeEvent(ctx context.Context, query string) (*sql.HybridBridgeEvent, error)
 	// GetAllBridgeEvents returns a bridge event.
@@ -77,6 +88,8 @@ type ConsumerDBReader interface {
 	GetPendingByChain(ctx context.Context) (res *immutable.Map[int, int], err error)
 	// GetBlockHeights gets the block heights for a given chain and contract type.
 	GetBlockHeights(ctx context.Context, query string, contractTypeMap map[string]model.ContractType) ([]*model.BlockHeight, error)
+	// GetTokenPrice gets the cached price of a token for a day, or nil if it has not been cached.
+	GetTokenPrice(ctx context.Context, coinGeckoID string, day uint64) (*sql.TokenPrice, error)
 }
 
 // ConsumerDB is the interface for the ConsumerDB.
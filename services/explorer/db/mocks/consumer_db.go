@@ -5,6 +5,8 @@
 import (
 	context "context"
 
+	dbcommon "github.com/synapsecns/sanguine/core/dbcommon"
+
 	gorm "gorm.io/gorm"
 
 	immutable "github.com/benbjohnson/immutable"
@@ -21,6 +23,20 @@ type ConsumerDB struct {
 	mock.Mock
 }
 
+// DBType provides a mock function with given fields:
+func (_m *ConsumerDB) DBType() dbcommon.DBType {
+	ret := _m.Called()
+
+	var r0 dbcommon.DBType
+	if rf, ok := ret.Get(0).(func() dbcommon.DBType); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(dbcommon.DBType)
+	}
+
+	return r0
+}
+
 // GetAddressChainRanking provides a mock function with given fields: ctx, query
 func (_m *ConsumerDB) GetAddressChainRanking(ctx context.Context, query string) ([]*model.AddressChainRanking, error) {
 	ret := _m.Called(ctx, query)
@@ -286,6 +302,29 @@ func (_m *ConsumerDB) GetDateResults(ctx context.Context, query string) ([]*mode
 	return r0, r1
 }
 
+// GetFastBridgeEvents provides a mock function with given fields: ctx, query
+func (_m *ConsumerDB) GetFastBridgeEvents(ctx context.Context, query string) ([]sql.FastBridgeEvent, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 []sql.FastBridgeEvent
+	if rf, ok := ret.Get(0).(func(context.Context, string) []sql.FastBridgeEvent); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sql.FastBridgeEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetFloat64 provides a mock function with given fields: ctx, query
 func (_m *ConsumerDB) GetFloat64(ctx context.Context, query string) (float64, error) {
 	ret := _m.Called(ctx, query)
@@ -374,6 +413,29 @@ func (_m *ConsumerDB) GetMVBridgeEvent(ctx context.Context, query string) (*sql.
 	return r0, r1
 }
 
+// GetMessageBusEvents provides a mock function with given fields: ctx, query
+func (_m *ConsumerDB) GetMessageBusEvents(ctx context.Context, query string) ([]sql.MessageBusEvent, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 []sql.MessageBusEvent
+	if rf, ok := ret.Get(0).(func(context.Context, string) []sql.MessageBusEvent); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sql.MessageBusEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPendingByChain provides a mock function with given fields: ctx
 func (_m *ConsumerDB) GetPendingByChain(ctx context.Context) (*immutable.Map[int, int], error) {
 	ret := _m.Called(ctx)
@@ -464,6 +526,29 @@ func (_m *ConsumerDB) GetStringArray(ctx context.Context, query string) ([]strin
 	return r0, r1
 }
 
+// GetSwapEvents provides a mock function with given fields: ctx, query
+func (_m *ConsumerDB) GetSwapEvents(ctx context.Context, query string) ([]sql.SwapEvent, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 []sql.SwapEvent
+	if rf, ok := ret.Get(0).(func(context.Context, string) []sql.SwapEvent); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sql.SwapEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTokenCounts provides a mock function with given fields: ctx, query
 func (_m *ConsumerDB) GetTokenCounts(ctx context.Context, query string) ([]*model.TokenCountResult, error) {
 	ret := _m.Called(ctx, query)
@@ -487,6 +572,29 @@ func (_m *ConsumerDB) GetTokenCounts(ctx context.Context, query string) ([]*mode
 	return r0, r1
 }
 
+// GetTokenPrice provides a mock function with given fields: ctx, coinGeckoID, day
+func (_m *ConsumerDB) GetTokenPrice(ctx context.Context, coinGeckoID string, day uint64) (*sql.TokenPrice, error) {
+	ret := _m.Called(ctx, coinGeckoID, day)
+
+	var r0 *sql.TokenPrice
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) *sql.TokenPrice); ok {
+		r0 = rf(ctx, coinGeckoID, day)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.TokenPrice)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64) error); ok {
+		r1 = rf(ctx, coinGeckoID, day)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTxCounts provides a mock function with given fields: ctx, query
 func (_m *ConsumerDB) GetTxCounts(ctx context.Context, query string) ([]*model.TransactionCountResult, error) {
 	ret := _m.Called(ctx, query)
@@ -601,6 +709,20 @@ func (_m *ConsumerDB) StoreTokenIndex(ctx context.Context, chainID uint32, token
 	return r0
 }
 
+// StoreTokenPrices provides a mock function with given fields: ctx, prices
+func (_m *ConsumerDB) StoreTokenPrices(ctx context.Context, prices []sql.TokenPrice) error {
+	ret := _m.Called(ctx, prices)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []sql.TokenPrice) error); ok {
+		r0 = rf(ctx, prices)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UNSAFE_DB provides a mock function with given fields:
 func (_m *ConsumerDB) UNSAFE_DB() *gorm.DB {
 	ret := _m.Called()
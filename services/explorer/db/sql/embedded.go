@@ -0,0 +1,280 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// embeddedBatchSize keeps batch inserts under sqlite's 999 bound variable limit.
+const embeddedBatchSize = 20
+
+// kappaBatchSize is the number of kappas the materialized view is refreshed for at once.
+const kappaBatchSize = 500
+
+// mvBridgeEvent is the mv_bridge_events table of the sqlite and postgres backends. On clickhouse the view is
+// maintained outside of the explorer, on the other backends the store refreshes it whenever bridge events are stored.
+type mvBridgeEvent struct {
+	HybridBridgeEvent
+	// InsertTime is the time the row was written to the view.
+	InsertTime uint64 `gorm:"column:insert_time"`
+}
+
+// TableName sets the table name of the view.
+func (mvBridgeEvent) TableName() string {
+	return "mv_bridge_events"
+}
+
+// uniqueKey is the clickhouse ReplacingMergeTree ordering key of a table. The sqlite and postgres backends enforce
+// it as a unique index and upsert on it, which gives the deduplication the clickhouse queries get from LIMIT 1 BY.
+type uniqueKey struct {
+	model   interface{}
+	table   string
+	columns string
+}
+
+var uniqueKeys = []uniqueKey{
+	{&BridgeEvent{}, "bridge_events", "event_index, block_number, event_type, tx_hash, chain_id, contract_address"},
+	{&SwapEvent{}, "swap_events", "event_index, block_number, event_type, tx_hash, chain_id, contract_address"},
+	{&MessageBusEvent{}, "message_bus_events", "event_index, block_number, event_type, tx_hash, chain_id, contract_address"},
+	{&CCTPEvent{}, "cctp_events", "tx_hash, contract_address, block_number, event_type, request_id"},
+	{&FastBridgeEvent{}, "fast_bridge_events", "tx_hash, contract_address, block_number, event_type, event_index, transaction_id"},
+	{&TokenPrice{}, "token_prices", "coin_gecko_id, day"},
+	{&mvBridgeEvent{}, "mv_bridge_events", "fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash"},
+}
+
+// bigIntColumns are the UInt256 columns of the stored models, which have no native type on sqlite or postgres.
+var bigIntColumns = sync.OnceValue(func() map[string]bool {
+	columns := make(map[string]bool)
+	for _, model := range []interface{}{&BridgeEvent{}, &SwapEvent{}, &MessageBusEvent{}, &CCTPEvent{}, &FastBridgeEvent{}, &HybridBridgeEvent{}} {
+		parsed, err := schema.Parse(model, &sync.Map{}, schema.NamingStrategy{})
+		if err != nil {
+			continue
+		}
+		for _, field := range parsed.Fields {
+			if field.DataType == "UInt256" {
+				columns[field.DBName] = true
+			}
+		}
+	}
+	return columns
+})
+
+// columnName is the model column a result column is named after. The right side of a join names its columns
+// "t.<column>", as clickhouse does.
+func columnName(column string) string {
+	column = strings.ToLower(column)
+	return column[strings.LastIndex(column, ".")+1:]
+}
+
+// OuterJoinColumns selects the columns of model from alias, each named prefix followed by the column name. Rows an
+// outer join has no match for read the clickhouse default values rather than NULL.
+func OuterJoinColumns(model interface{}, alias, prefix string) (string, error) {
+	parsed, err := schema.Parse(model, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		return "", fmt.Errorf("could not parse model: %w", err)
+	}
+	columns := make([]string, 0, len(parsed.DBNames))
+	for _, name := range parsed.DBNames {
+		value := fmt.Sprintf("%s.%s", alias, name)
+		if defaultValue, ok := columnDefaultValue(parsed.LookUpField(name)); ok {
+			value = fmt.Sprintf("COALESCE(%s, %s)", value, defaultValue)
+		}
+		columns = append(columns, fmt.Sprintf(`%s AS "%s%s"`, value, prefix, name))
+	}
+	return strings.Join(columns, ", "), nil
+}
+
+// migrateEmbedded creates the tables and unique keys of the sqlite and postgres backends.
+func migrateEmbedded(ctx context.Context, db *gorm.DB) error {
+	err := db.WithContext(ctx).AutoMigrate(&TokenIndex{}, &SwapFees{}, &LastBlock{})
+	if err != nil {
+		return fmt.Errorf("could not migrate: %w", err)
+	}
+	for _, key := range uniqueKeys {
+		err = db.WithContext(ctx).AutoMigrate(key.model)
+		if err != nil {
+			return fmt.Errorf("could not migrate %s: %w", key.table, err)
+		}
+		err = db.WithContext(ctx).Exec(fmt.Sprintf("CREATE UNIQUE INDEX IF NOT EXISTS idx_%s_key ON %s (%s)", key.table, key.table, key.columns)).Error
+		if err != nil {
+			return fmt.Errorf("could not index %s: %w", key.table, err)
+		}
+	}
+	return nil
+}
+
+// uniqueKeyColumns gets the unique key of a table.
+func uniqueKeyColumns(table string) string {
+	for _, key := range uniqueKeys {
+		if key.table == table {
+			return key.columns
+		}
+	}
+	return ""
+}
+
+// upsert is the conflict clause that replaces a row with the same unique key, unless the stored row is newer. This
+// mirrors ReplacingMergeTree(insert_time).
+func upsert(table string) clause.OnConflict {
+	var columns []clause.Column
+	for _, column := range strings.Split(uniqueKeyColumns(table), ",") {
+		columns = append(columns, clause.Column{Name: strings.TrimSpace(column)})
+	}
+	return clause.OnConflict{
+		Columns:   columns,
+		UpdateAll: true,
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: fmt.Sprintf("excluded.%s >= %s.%s", InsertTimeFieldName, table, InsertTimeFieldName)},
+		}},
+	}
+}
+
+// mvDialect holds the backend specific expressions of the mv_bridge_events refresh query.
+type mvDialect struct {
+	// mapBigInt reads a UInt256 from a Map(UInt8, String) column, NULL if the key is missing.
+	mapBigInt func(column, key string) string
+	// mapFloat reads a Map(UInt8, Float64) or Map(UInt8, UInt8) column, 0 if the key is missing.
+	mapFloat func(column, key string) string
+}
+
+// mvBridgeEventsRefreshQuery builds the query that (re)computes mv_bridge_events rows for a set of kappas. Each
+// origin event is paired with the destination event carrying its kappa, and a swap in the same transaction (e.g.
+// a swap and bridge) replaces the bridged token and amount with the swapped ones, as the clickhouse view does. When
+// an origin has several candidate rows the newest destination is kept, and the first swap of the transaction.
+func mvBridgeEventsRefreshQuery(db *gorm.DB, dialect mvDialect) (string, error) {
+	stmt := &gorm.Statement{DB: db}
+	err := stmt.Parse(&BridgeEvent{})
+	if err != nil {
+		return "", fmt.Errorf("could not parse bridge events: %w", err)
+	}
+
+	swapped := map[string]func(alias, swapIndex string) string{
+		TokenFieldName: func(alias, swapIndex string) string {
+			return fmt.Sprintf("COALESCE(NULLIF(%[1]sti.token_address, ''), %[1]s.token)", alias)
+		},
+		AmountFieldName: func(alias, swapIndex string) string {
+			return fmt.Sprintf("COALESCE(%s, %s.amount)", dialect.mapBigInt(alias+"se.amount", alias+"se."+swapIndex), alias)
+		},
+		AmountUSDFieldName: func(alias, swapIndex string) string {
+			value := dialect.mapFloat(alias+"se.amount_usd", alias+"se."+swapIndex)
+			return fmt.Sprintf("CASE WHEN %s > 0 THEN %s ELSE %s.amount_usd END", value, value, alias)
+		},
+		TokenDecimalFieldName: func(alias, swapIndex string) string {
+			value := dialect.mapFloat(alias+"se.token_decimal", alias+"se."+swapIndex)
+			return fmt.Sprintf("CASE WHEN %s > 0 THEN CAST(%s AS INTEGER) ELSE %s.token_decimal END", value, value, alias)
+		},
+	}
+	columns := []string{InsertTimeFieldName}
+	values := []string{fmt.Sprintf("CAST(? AS BIGINT) AS %s", InsertTimeFieldName)}
+	for _, side := range []struct {
+		alias, swapIndex string
+	}{{"f", "sold_id"}, {"t", "bought_id"}} {
+		for _, name := range stmt.Schema.DBNames {
+			column := side.alias + name
+			switch name {
+			case InsertTimeFieldName:
+				// both sides of HybridBridgeEvent read finsert_time.
+				if side.alias == "t" {
+					continue
+				}
+			case FeeUSDFieldName:
+				column = side.alias + "fee_amount_usd"
+			}
+			value := fmt.Sprintf("%s.%s", side.alias, name)
+			if expr, ok := swapped[name]; ok {
+				value = expr(side.alias, side.swapIndex)
+			}
+			// pending transfers have no destination, clickhouse fills its columns with default values.
+			if defaultValue, ok := columnDefaultValue(stmt.Schema.LookUpField(name)); ok && side.alias == "t" {
+				value = fmt.Sprintf("COALESCE(%s, %s)", value, defaultValue)
+			}
+			columns = append(columns, column)
+			values = append(values, fmt.Sprintf("%s AS %s", value, column))
+		}
+	}
+
+	var updates []string
+	for _, column := range columns {
+		updates = append(updates, fmt.Sprintf("%s = excluded.%s", column, column))
+	}
+
+	return fmt.Sprintf(`INSERT INTO mv_bridge_events (%[1]s)
+SELECT %[1]s FROM (
+SELECT %[2]s,
+       ROW_NUMBER() OVER (PARTITION BY f.chain_id, f.contract_address, f.event_type, f.block_number, f.event_index, f.tx_hash
+           ORDER BY t.insert_time DESC NULLS LAST, t.block_number DESC NULLS LAST, t.event_index DESC NULLS LAST, fse.event_index NULLS LAST, tse.event_index NULLS LAST) AS row_rank
+FROM bridge_events f
+         LEFT JOIN bridge_events t ON t.chain_id = CAST(f.destination_chain_id AS INTEGER) AND t.kappa = f.destination_kappa
+         LEFT JOIN swap_events fse ON fse.chain_id = f.chain_id AND fse.tx_hash = f.tx_hash
+         LEFT JOIN token_indices fti ON fti.chain_id = f.chain_id AND fti.contract_address = fse.contract_address AND fti.token_index = CAST(fse.sold_id AS INTEGER)
+         LEFT JOIN swap_events tse ON tse.chain_id = t.chain_id AND tse.tx_hash = t.tx_hash
+         LEFT JOIN token_indices tti ON tti.chain_id = t.chain_id AND tti.contract_address = tse.contract_address AND tti.token_index = CAST(tse.bought_id AS INTEGER)
+WHERE f.destination_chain_id != 0 AND f.destination_kappa IN ?
+) ranked WHERE row_rank = 1
+ON CONFLICT (%[3]s) DO UPDATE SET %[4]s`, strings.Join(columns, ", "), strings.Join(values, ",\n       "), uniqueKeyColumns(mvBridgeEvent{}.TableName()), strings.Join(updates, ", ")), nil
+}
+
+// columnDefaultValue is the clickhouse default value of a column, clickhouse columns are not nullable.
+func columnDefaultValue(field *schema.Field) (string, bool) {
+	if field == nil {
+		return "", false
+	}
+	if columnType, ok := sqliteColumnTypes[field.DataType]; ok {
+		if columnType.goType.Kind() == reflect.Map {
+			return "'{}'", true
+		}
+		return "0", true
+	}
+	switch field.DataType {
+	case schema.String:
+		return "''", true
+	case schema.Bool:
+		return "false", true
+	case schema.Int, schema.Uint, schema.Float, "Float64":
+		return "0", true
+	default:
+		return "", false
+	}
+}
+
+// refreshMvBridgeEvents recomputes the view rows touched by the given bridge events.
+func (s *Store) refreshMvBridgeEvents(ctx context.Context, events []*BridgeEvent) error {
+	if s.dbType == dbcommon.Clickhouse {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	var kappas []string
+	for _, event := range events {
+		kappa := event.DestinationKappa
+		if event.Kappa.Valid && event.Kappa.String != "" {
+			kappa = event.Kappa.String
+		}
+		if kappa == "" || seen[kappa] {
+			continue
+		}
+		seen[kappa] = true
+		kappas = append(kappas, kappa)
+	}
+
+	for start := 0; start < len(kappas); start += kappaBatchSize {
+		end := start + kappaBatchSize
+		if end > len(kappas) {
+			end = len(kappas)
+		}
+		dbTx := s.db.WithContext(ctx).Exec(s.mvRefreshQuery, uint64(time.Now().UnixNano()), kappas[start:end])
+		if dbTx.Error != nil {
+			return fmt.Errorf("could not refresh mv_bridge_events: %w", dbTx.Error)
+		}
+	}
+	return nil
+}
@@ -130,6 +130,59 @@ type CCTPEvent struct {
 	TimeStamp *uint64 `gorm:"column:timestamp"`
 }
 
+// FastBridgeEvent stores an rfq fastbridge event.
+type FastBridgeEvent struct {
+	// InsertTime is the time the event was inserted into the database.
+	InsertTime uint64 `gorm:"column:insert_time"`
+	// ChainID is the chain ID of the chain in which the indexed event occurred.
+	ChainID uint32 `gorm:"column:chain_id"`
+	// TxHash is the transaction hash of the event.
+	TxHash string `gorm:"column:tx_hash"`
+	// ContractAddress is the address of the contract that generated the event.
+	ContractAddress string `gorm:"column:contract_address"`
+	// BlockNumber is the block number of the event.
+	BlockNumber uint64 `gorm:"column:block_number"`
+	// EventType is the type of the event.
+	EventType uint8 `gorm:"column:event_type"`
+	// EventIndex is the index of the log.
+	EventIndex uint64 `gorm:"column:event_index"`
+	// TransactionID is the transaction ID of the rfq bridge, shared by its origin and destination events.
+	TransactionID string `gorm:"column:transaction_id"`
+
+	// OriginChainID is the chain ID of the rfq bridge's origin.
+	OriginChainID *big.Int `gorm:"column:origin_chain_id;type:UInt256"`
+	// DestinationChainID is the chain ID of the rfq bridge's destination.
+	DestinationChainID *big.Int `gorm:"column:destination_chain_id;type:UInt256"`
+	// Sender is the address of the sender on origin.
+	Sender sql.NullString `gorm:"column:sender"`
+	// Recipient is the address the tokens are sent to.
+	Recipient sql.NullString `gorm:"column:recipient"`
+	// Relayer is the address of the relayer.
+	Relayer sql.NullString `gorm:"column:relayer"`
+	// OriginToken is the address of the token on origin.
+	OriginToken sql.NullString `gorm:"column:origin_token"`
+	// DestinationToken is the address of the token on destination.
+	DestinationToken sql.NullString `gorm:"column:destination_token"`
+	// OriginAmount is the amount of the origin token.
+	OriginAmount *big.Int `gorm:"column:origin_amount;type:UInt256"`
+	// DestinationAmount is the amount of the destination token.
+	DestinationAmount *big.Int `gorm:"column:destination_amount;type:UInt256"`
+	// Fee is the protocol fee taken on origin.
+	Fee *big.Int `gorm:"column:fee;type:UInt256"`
+	// ChainGasAmount is the amount of gas sent to the recipient on destination.
+	ChainGasAmount *big.Int `gorm:"column:chain_gas_amount;type:UInt256"`
+	// AmountUSD is the amount of the event's token in USD.
+	AmountUSD *float64 `gorm:"column:amount_usd;type:Float64"`
+	// FeeUSD is the protocol fee in USD.
+	FeeUSD *float64 `gorm:"column:fee_usd;type:Float64"`
+	// TokenDecimal is the decimals of the event's token.
+	TokenDecimal *uint8 `gorm:"column:token_decimal"`
+	// TokenSymbol is the symbol of the event's token.
+	TokenSymbol sql.NullString `gorm:"column:token_symbol"`
+	// TimeStamp is the timestamp of the block in which the event occurred.
+	TimeStamp *uint64 `gorm:"column:timestamp"`
+}
+
 // BridgeEvent stores data for emitted events from the Bridge contract.
 type BridgeEvent struct {
 	// InsertTime is the time the event was inserted into the database.
@@ -310,6 +363,21 @@ type SwapFees struct {
 	Fee uint64 `gorm:"column:fee"`
 }
 
+// TokenPrice caches the historical usd price of a token for a day, so restarts and backfills do not re-request
+// prices from the price providers.
+type TokenPrice struct {
+	// InsertTime is the time the price was inserted into the database.
+	InsertTime uint64 `gorm:"column:insert_time"`
+	// CoinGeckoID is the coin gecko id of the token.
+	CoinGeckoID string `gorm:"column:coin_gecko_id"`
+	// Day is the unix timestamp of the start (00:00 UTC) of the day the price is for.
+	Day uint64 `gorm:"column:day"`
+	// Price is the usd price of the token.
+	Price float64 `gorm:"column:price;type:Float64"`
+	// Source is the name of the price provider the price came from.
+	Source string `gorm:"column:source"`
+}
+
 // MessageBusEvent stores data for emitted events from the message bus contract.
 type MessageBusEvent struct {
 	// InsertTime is the time the event was inserted into the database
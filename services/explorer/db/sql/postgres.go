@@ -0,0 +1,52 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+)
+
+// OpenGormPostgres opens the postgres store at the given dsn.
+func OpenGormPostgres(ctx context.Context, dsn string, readOnly bool, handler metrics.Handler) (*Store, error) {
+	postgresDB, err := gorm.Open(newPostgresDialector(dsn), &gorm.Config{
+		Logger:                 gormLogger.Default.LogMode(gormLogger.Silent),
+		FullSaveAssociations:   true,
+		SkipDefaultTransaction: true,
+		CreateBatchSize:        embeddedBatchSize,
+		NowFunc:                time.Now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open gorm postgres: %w", err)
+	}
+
+	if !readOnly {
+		err = migrateEmbedded(ctx, postgresDB)
+		if err != nil {
+			return nil, fmt.Errorf("could not migrate on postgres: %w", err)
+		}
+	}
+
+	refreshQuery, err := mvBridgeEventsRefreshQuery(postgresDB, postgresMvDialect)
+	if err != nil {
+		return nil, err
+	}
+
+	handler.AddGormCallbacks(postgresDB)
+
+	return &Store{db: postgresDB, dbType: dbcommon.Postgres, mvRefreshQuery: refreshQuery}, nil
+}
+
+// postgresMvDialect reads the jsonb maps with the json operators, json objects are keyed by the decimal key.
+var postgresMvDialect = mvDialect{
+	mapBigInt: func(column, key string) string {
+		return fmt.Sprintf("CAST(NULLIF(%s ->> CAST(%s AS TEXT), '') AS NUMERIC)", column, key)
+	},
+	mapFloat: func(column, key string) string {
+		return fmt.Sprintf("COALESCE(CAST(%s ->> CAST(%s AS TEXT) AS DOUBLE PRECISION), 0)", column, key)
+	},
+}
@@ -0,0 +1,212 @@
+package sql
+
+import (
+	"context"
+	gosql "database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/migrator"
+	"gorm.io/gorm/schema"
+)
+
+// postgresDriverName is the database/sql driver the postgres store connects through. It wraps the pgx driver to
+// store the clickhouse column types.
+const postgresDriverName = "explorer_postgres"
+
+var registerPostgresDriver sync.Once
+
+// postgresColumnTypes maps the clickhouse column types from the models onto postgres types.
+var postgresColumnTypes = map[schema.DataType]string{
+	"UInt256":             "NUMERIC(78, 0)",
+	"Float64":             "DOUBLE PRECISION",
+	"Map(UInt8, String)":  "JSONB",
+	"Map(UInt8, Float64)": "JSONB",
+	"Map(UInt8, UInt8)":   "JSONB",
+}
+
+// mapColumns are the go types of the Map columns of the stored models, which postgres holds as jsonb.
+var mapColumns = sync.OnceValue(func() map[string]reflect.Type {
+	columns := make(map[string]reflect.Type)
+	parsed, err := schema.Parse(&SwapEvent{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		return columns
+	}
+	for _, field := range parsed.Fields {
+		if columnType, ok := sqliteColumnTypes[field.DataType]; ok && columnType.goType.Kind() == reflect.Map {
+			columns[field.DBName] = columnType.goType
+		}
+	}
+	return columns
+})
+
+// postgresDialector is the gorm postgres dialector with the clickhouse column types mapped.
+type postgresDialector struct {
+	postgres.Dialector
+}
+
+// DataTypeOf gets the postgres type of a field.
+func (d postgresDialector) DataTypeOf(field *schema.Field) string {
+	if columnType, ok := postgresColumnTypes[field.DataType]; ok {
+		return columnType
+	}
+	// gorm maps floats to decimal, keep them floating point like clickhouse.
+	if field.DataType == schema.Float {
+		return "DOUBLE PRECISION"
+	}
+	return d.Dialector.DataTypeOf(field)
+}
+
+// Migrator returns the postgres migrator bound to this dialector so migrations use DataTypeOf above.
+func (d postgresDialector) Migrator(db *gorm.DB) gorm.Migrator {
+	return postgres.Migrator{Migrator: migrator.Migrator{Config: migrator.Config{
+		DB:                          db,
+		Dialector:                   d,
+		CreateIndexAfterCreateTable: true,
+	}}}
+}
+
+func newPostgresDialector(dsn string) gorm.Dialector {
+	registerPostgresDriver.Do(func() {
+		gosql.Register(postgresDriverName, &postgresDriver{driver: stdlib.GetDefaultDriver()})
+	})
+	return postgresDialector{postgres.Dialector{Config: &postgres.Config{DriverName: postgresDriverName, DSN: dsn}}}
+}
+
+type postgresDriver struct {
+	driver driver.Driver
+}
+
+// Open opens a new postgres connection.
+func (d *postgresDriver) Open(dsn string) (driver.Conn, error) {
+	conn, err := d.driver.Open(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("could not open postgres connection: %w", err)
+	}
+	//nolint:forcetypeassert
+	return &postgresConn{conn.(*stdlib.Conn)}, nil
+}
+
+type postgresConn struct {
+	*stdlib.Conn
+}
+
+// CheckNamedValue encodes big ints as decimal text and maps as json, everything else is passed to pgx as is.
+func (c *postgresConn) CheckNamedValue(value *driver.NamedValue) error {
+	switch conv := value.Value.(type) {
+	case *big.Int:
+		// clickhouse stores a nil UInt256 as 0.
+		if conv == nil {
+			value.Value = "0"
+			return nil
+		}
+		value.Value = conv.String()
+		return nil
+	case big.Int:
+		value.Value = conv.String()
+		return nil
+	}
+	if value.Value != nil && reflect.ValueOf(value.Value).Kind() == reflect.Map {
+		encoded, err := json.Marshal(value.Value)
+		if err != nil {
+			return fmt.Errorf("could not encode map: %w", err)
+		}
+		value.Value = string(encoded)
+	}
+	return nil
+}
+
+// QueryContext runs a query and decodes the clickhouse column types of its result.
+//
+//nolint:wrapcheck
+func (c *postgresConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := c.Conn.QueryContext(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+	return newPostgresRows(rows), nil
+}
+
+// PrepareContext prepares a statement whose results are decoded like QueryContext's.
+//
+//nolint:wrapcheck
+func (c *postgresConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	stmt, err := c.Conn.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:forcetypeassert
+	return &postgresStmt{stmt.(*stdlib.Stmt)}, nil
+}
+
+type postgresStmt struct {
+	*stdlib.Stmt
+}
+
+// QueryContext runs the prepared statement.
+//
+//nolint:wrapcheck
+func (s *postgresStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := s.Stmt.QueryContext(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return newPostgresRows(rows), nil
+}
+
+type postgresRows struct {
+	*stdlib.Rows
+	// decoders is the go type of each column that needs decoding, nil otherwise.
+	decoders []reflect.Type
+}
+
+func newPostgresRows(rows driver.Rows) driver.Rows {
+	conv, ok := rows.(*stdlib.Rows)
+	if !ok {
+		return rows
+	}
+	return &postgresRows{Rows: conv}
+}
+
+// Next reads the next row and decodes UInt256 and Map columns. Columns are matched by type and name, so computed
+// columns keep the type of the model column they are named after.
+//
+//nolint:wrapcheck
+func (r *postgresRows) Next(dest []driver.Value) error {
+	if err := r.Rows.Next(dest); err != nil {
+		return err
+	}
+	if r.decoders == nil {
+		columns := r.Columns()
+		r.decoders = make([]reflect.Type, len(columns))
+		for i, column := range columns {
+			name := columnName(column)
+			switch r.ColumnTypeDatabaseTypeName(i) {
+			case "NUMERIC":
+				if bigIntColumns()[name] {
+					r.decoders[i] = sqliteColumnTypes["UInt256"].goType
+				}
+			case "JSONB", "JSON":
+				r.decoders[i] = mapColumns()[name]
+			}
+		}
+	}
+	for i, goType := range r.decoders {
+		if goType == nil || i >= len(dest) || dest[i] == nil {
+			continue
+		}
+		decoded, err := decodeSqliteValue(dest[i], goType)
+		if err != nil {
+			return err
+		}
+		dest[i] = decoded
+	}
+	return nil
+}
@@ -16,7 +16,7 @@
 func (s *Store) GetUint64(ctx context.Context, query string) (uint64, error) {
 	var res int64
 
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return 0, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -27,7 +27,7 @@ func (s *Store) GetUint64(ctx context.Context, query string) (uint64, error) {
 // GetFloat64 gets a float64 from a given query.
 func (s *Store) GetFloat64(ctx context.Context, query string) (float64, error) {
 	var res float64
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return 0, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -38,7 +38,7 @@ func (s *Store) GetFloat64(ctx context.Context, query string) (float64, error) {
 // GetString gets a string from a given query.
 func (s *Store) GetString(ctx context.Context, query string) (string, error) {
 	var res string
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return "", fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -50,7 +50,7 @@ func (s *Store) GetString(ctx context.Context, query string) (string, error) {
 func (s *Store) GetStringArray(ctx context.Context, query string) ([]string, error) {
 	var res []string
 
-	dbTx := s.db.WithContext(ctx).Raw(query + " SETTINGS readonly=1").Find(&res)
+	dbTx := s.raw(ctx, s.readOnly(query)).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -62,7 +62,7 @@ func (s *Store) GetStringArray(ctx context.Context, query string) ([]string, err
 func (s *Store) GetBridgeEvent(ctx context.Context, query string) (*BridgeEvent, error) {
 	var res BridgeEvent
 
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -74,7 +74,7 @@ func (s *Store) GetBridgeEvent(ctx context.Context, query string) (*BridgeEvent,
 func (s *Store) GetMVBridgeEvent(ctx context.Context, query string) (*HybridBridgeEvent, error) {
 	var res HybridBridgeEvent
 
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -85,7 +85,7 @@ func (s *Store) GetMVBridgeEvent(ctx context.Context, query string) (*HybridBrid
 // GetBridgeEvents returns bridge events.
 func (s *Store) GetBridgeEvents(ctx context.Context, query string) ([]BridgeEvent, error) {
 	var res []BridgeEvent
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -93,10 +93,43 @@ func (s *Store) GetBridgeEvents(ctx context.Context, query string) ([]BridgeEven
 	return res, nil
 }
 
+// GetFastBridgeEvents returns fastbridge events.
+func (s *Store) GetFastBridgeEvents(ctx context.Context, query string) ([]FastBridgeEvent, error) {
+	var res []FastBridgeEvent
+	dbTx := s.raw(ctx, query).Find(&res)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to read fastbridge events: %w", dbTx.Error)
+	}
+
+	return res, nil
+}
+
+// GetSwapEvents returns swap events.
+func (s *Store) GetSwapEvents(ctx context.Context, query string) ([]SwapEvent, error) {
+	var res []SwapEvent
+	dbTx := s.raw(ctx, query).Find(&res)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to read swap events: %w", dbTx.Error)
+	}
+
+	return res, nil
+}
+
+// GetMessageBusEvents returns message bus events.
+func (s *Store) GetMessageBusEvents(ctx context.Context, query string) ([]MessageBusEvent, error) {
+	var res []MessageBusEvent
+	dbTx := s.raw(ctx, query).Find(&res)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to read message bus events: %w", dbTx.Error)
+	}
+
+	return res, nil
+}
+
 // GetAllBridgeEvents returns bridge events.
 func (s *Store) GetAllBridgeEvents(ctx context.Context, query string) ([]HybridBridgeEvent, error) {
 	var res []HybridBridgeEvent
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -107,7 +140,7 @@ func (s *Store) GetAllBridgeEvents(ctx context.Context, query string) ([]HybridB
 func (s *Store) GetDailyTotals(ctx context.Context, query string) ([]*model.DateResultByChain, error) {
 	var res []*model.DateResultByChain
 
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -117,7 +150,7 @@ func (s *Store) GetDailyTotals(ctx context.Context, query string) ([]*model.Date
 // GetAllMessageBusEvents returns message bus events.
 func (s *Store) GetAllMessageBusEvents(ctx context.Context, query string) ([]HybridMessageBusEvent, error) {
 	var res []HybridMessageBusEvent
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read message bus event: %w", dbTx.Error)
 	}
@@ -127,7 +160,7 @@ func (s *Store) GetAllMessageBusEvents(ctx context.Context, query string) ([]Hyb
 // GetTxCounts returns Tx counts.
 func (s *Store) GetTxCounts(ctx context.Context, query string) ([]*model.TransactionCountResult, error) {
 	var res []*model.TransactionCountResult
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -138,7 +171,7 @@ func (s *Store) GetTxCounts(ctx context.Context, query string) ([]*model.Transac
 // GetTokenCounts returns Tx counts.
 func (s *Store) GetTokenCounts(ctx context.Context, query string) ([]*model.TokenCountResult, error) {
 	var res []*model.TokenCountResult
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -149,7 +182,7 @@ func (s *Store) GetTokenCounts(ctx context.Context, query string) ([]*model.Toke
 // GetRankedChainsByVolume gets ranked chains by volume.
 func (s *Store) GetRankedChainsByVolume(ctx context.Context, query string) ([]*model.VolumeByChainID, error) {
 	var res []*model.VolumeByChainID
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -160,7 +193,7 @@ func (s *Store) GetRankedChainsByVolume(ctx context.Context, query string) ([]*m
 // GetDateResults returns the dya by day data.
 func (s *Store) GetDateResults(ctx context.Context, query string) ([]*model.DateResult, error) {
 	var res []*model.DateResult
-	dbTx := s.db.WithContext(ctx).Raw(query + " SETTINGS readonly=1").Scan(&res)
+	dbTx := s.raw(ctx, s.readOnly(query)).Scan(&res)
 
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to get date results: %w", dbTx.Error)
@@ -178,7 +211,7 @@ type addressData struct {
 	}
 	var res addressData
 	// var test map[string]interface{}
-	dbTx := s.db.WithContext(ctx).Raw(query).Scan(&res)
+	dbTx := s.raw(ctx, query).Scan(&res)
 	if dbTx.Error != nil {
 		return 0, 0, 0, fmt.Errorf("failed to get address data: %w", dbTx.Error)
 	}
@@ -189,7 +222,7 @@ type addressData struct {
 // GetAddressChainRanking ranks chains by volume for a given address.
 func (s *Store) GetAddressChainRanking(ctx context.Context, query string) ([]*model.AddressChainRanking, error) {
 	var res []*model.AddressChainRanking
-	dbTx := s.db.WithContext(ctx).Raw(query).Scan(&res)
+	dbTx := s.raw(ctx, query).Scan(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to get address chain ranking: %w", dbTx.Error)
 	}
@@ -200,7 +233,7 @@ func (s *Store) GetAddressChainRanking(ctx context.Context, query string) ([]*mo
 // GetAddressDailyData gets daily data (number of txs_ for a given address.
 func (s *Store) GetAddressDailyData(ctx context.Context, query string) ([]*model.AddressDailyCount, error) {
 	var res []*model.AddressDailyCount
-	dbTx := s.db.WithContext(ctx).Raw(query).Scan(&res)
+	dbTx := s.raw(ctx, query).Scan(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to get address daily data: %w", dbTx.Error)
 	}
@@ -212,7 +245,7 @@ func (s *Store) GetAddressDailyData(ctx context.Context, query string) ([]*model
 func (s *Store) GetAddressRanking(ctx context.Context, query string) ([]*model.AddressRanking, error) {
 	var res []*model.AddressRanking
 
-	dbTx := s.db.WithContext(ctx).Raw(query + " SETTINGS readonly=1").Scan(&res)
+	dbTx := s.raw(ctx, s.readOnly(query)).Scan(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -237,7 +270,7 @@ func (s *Store) GetLastStoredBlock(ctx context.Context, chainID uint32, contract
 // GetLeaderboard gets the bridge leaderboard.
 func (s *Store) GetLeaderboard(ctx context.Context, query string) ([]*model.Leaderboard, error) {
 	var res []*model.Leaderboard
-	dbTx := s.db.WithContext(ctx).Raw(query).Scan(&res)
+	dbTx := s.raw(ctx, query).Scan(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to get leaderboard: %w", dbTx.Error)
 	}
@@ -253,8 +286,8 @@ func (s *Store) GetLeaderboard(ctx context.Context, query string) ([]*model.Lead
 // TODO: test this.
 func (s *Store) GetPendingByChain(ctx context.Context) (res *immutable.Map[int, int], err error) {
 	const query = `SELECT
-		toInt64(destination_chain_id) as destination_chain_id,
-		toInt64(COUNTDistinct(destination_kappa)) AS distinct_count
+		CAST(destination_chain_id AS BIGINT) as destination_chain_id,
+		CAST(COUNT(DISTINCT destination_kappa) AS BIGINT) AS distinct_count
 	FROM bridge_events
 	WHERE destination_kappa NOT IN (
 		SELECT kappa
@@ -270,7 +303,7 @@ type PendingByChain struct {
 
 	var pending []*PendingByChain
 
-	dbTx := s.db.WithContext(ctx).Raw(query).Scan(&pending)
+	dbTx := s.raw(ctx, query).Scan(&pending)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to get pending by chain: %w", dbTx.Error)
 	}
@@ -286,7 +319,7 @@ type PendingByChain struct {
 
 func (s *Store) GetBlockHeights(ctx context.Context, query string, contractTypeMap map[string]model.ContractType) ([]*model.BlockHeight, error) {
 	var res []*LastBlock
-	dbTx := s.db.WithContext(ctx).Raw(query).Scan(&res)
+	dbTx := s.raw(ctx, query).Scan(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to get block heights: %w", dbTx.Error)
 	}
@@ -307,3 +340,25 @@ func (s *Store) GetBlockHeights(ctx context.Context, query string, contractTypeM
 
 	return formatted, nil
 }
+
+// GetTokenPrice gets the cached price of a token for a day. Nil is returned if the price has not been cached.
+func (s *Store) GetTokenPrice(ctx context.Context, coinGeckoID string, day uint64) (*TokenPrice, error) {
+	var res []TokenPrice
+	dbTx := s.db.WithContext(ctx).
+		Model(&TokenPrice{}).
+		Where(&TokenPrice{
+			CoinGeckoID: coinGeckoID,
+			Day:         day,
+		}).
+		Order("insert_time DESC").
+		Limit(1).
+		Find(&res)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get token price: %w", dbTx.Error)
+	}
+	if len(res) == 0 {
+		return nil, nil
+	}
+
+	return &res[0], nil
+}
@@ -0,0 +1,60 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+)
+
+// OpenGormSqlite opens the embedded sqlite store in the given directory.
+func OpenGormSqlite(ctx context.Context, dbPath string, readOnly bool, handler metrics.Handler) (*Store, error) {
+	err := os.MkdirAll(dbPath, os.ModePerm)
+	if err != nil {
+		return nil, fmt.Errorf("could not create sqlite store: %w", err)
+	}
+
+	dsn := fmt.Sprintf("%s?_busy_timeout=5000&_journal_mode=WAL", filepath.Join(dbPath, "explorer.db"))
+	sqliteDB, err := gorm.Open(newSqliteDialector(dsn), &gorm.Config{
+		Logger:                 gormLogger.Default.LogMode(gormLogger.Silent),
+		FullSaveAssociations:   true,
+		SkipDefaultTransaction: true,
+		CreateBatchSize:        embeddedBatchSize,
+		NowFunc:                time.Now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open gorm sqlite: %w", err)
+	}
+
+	if !readOnly {
+		err = migrateEmbedded(ctx, sqliteDB)
+		if err != nil {
+			return nil, fmt.Errorf("could not migrate on sqlite: %w", err)
+		}
+	}
+
+	refreshQuery, err := mvBridgeEventsRefreshQuery(sqliteDB, sqliteMvDialect)
+	if err != nil {
+		return nil, err
+	}
+
+	handler.AddGormCallbacks(sqliteDB)
+
+	return &Store{db: sqliteDB, dbType: dbcommon.Sqlite, mvRefreshQuery: refreshQuery}, nil
+}
+
+// sqliteMvDialect reads the json encoded maps through the functions registered by the sqlite driver.
+var sqliteMvDialect = mvDialect{
+	mapBigInt: func(column, key string) string {
+		return fmt.Sprintf("NULLIF(mapText(%s, %s), '')", column, key)
+	},
+	mapFloat: func(column, key string) string {
+		return fmt.Sprintf("mapFloat(%s, %s)", column, key)
+	},
+}
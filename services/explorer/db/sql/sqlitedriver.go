@@ -0,0 +1,394 @@
+package sql
+
+import (
+	"context"
+	gosql "database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mattn/go-sqlite3"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/migrator"
+	"gorm.io/gorm/schema"
+)
+
+// sqliteDriverName is the database/sql driver the embedded store connects through. It wraps go-sqlite3 to
+// register the clickhouse functions the read queries rely on and to store the clickhouse column types.
+const sqliteDriverName = "explorer_sqlite"
+
+var registerSqliteDriver sync.Once
+
+// sqliteColumnType maps a clickhouse column type from the models onto a sqlite declared type.
+type sqliteColumnType struct {
+	// declType is the declared type. It must contain TEXT (and not INT) so sqlite keeps the stored text as is,
+	// e.g. a UInt256 must not be coerced into a lossy REAL.
+	declType string
+	// goType is the type values are decoded into when read back.
+	goType reflect.Type
+}
+
+var sqliteColumnTypes = map[schema.DataType]sqliteColumnType{
+	"UInt256":             {declType: "TEXT_BIGNUM", goType: reflect.TypeOf(&big.Int{})},
+	"Map(UInt8, String)":  {declType: "TEXT_MAP_U8_STRING", goType: reflect.TypeOf(map[uint8]string{})},
+	"Map(UInt8, Float64)": {declType: "TEXT_MAP_U8_FLOAT64", goType: reflect.TypeOf(map[uint8]float64{})},
+	"Map(UInt8, UInt8)":   {declType: "TEXT_MAP_U8_U8", goType: reflect.TypeOf(map[uint8]uint8{})},
+}
+
+// sqliteDecoders holds the decoder for each declared type, go-sqlite3 reports declared types in lower case.
+var sqliteDecoders = func() map[string]reflect.Type {
+	decoders := make(map[string]reflect.Type)
+	for _, columnType := range sqliteColumnTypes {
+		decoders[strings.ToLower(columnType.declType)] = columnType.goType
+	}
+	return decoders
+}()
+
+// sqliteDialector is the gorm sqlite dialector with the clickhouse column types mapped.
+type sqliteDialector struct {
+	sqlite.Dialector
+}
+
+// DataTypeOf gets the sqlite declared type of a field.
+func (d sqliteDialector) DataTypeOf(field *schema.Field) string {
+	if columnType, ok := sqliteColumnTypes[field.DataType]; ok {
+		return columnType.declType
+	}
+	return d.Dialector.DataTypeOf(field)
+}
+
+// Migrator returns the sqlite migrator bound to this dialector so migrations use DataTypeOf above.
+func (d sqliteDialector) Migrator(db *gorm.DB) gorm.Migrator {
+	return sqlite.Migrator{Migrator: migrator.Migrator{Config: migrator.Config{
+		DB:                          db,
+		Dialector:                   d,
+		CreateIndexAfterCreateTable: true,
+	}}}
+}
+
+func newSqliteDialector(dsn string) gorm.Dialector {
+	registerSqliteDriver.Do(func() {
+		gosql.Register(sqliteDriverName, &sqliteDriver{driver: &sqlite3.SQLiteDriver{ConnectHook: registerSqliteFunctions}})
+	})
+	return sqliteDialector{sqlite.Dialector{DriverName: sqliteDriverName, DSN: dsn}}
+}
+
+type sqliteDriver struct {
+	driver *sqlite3.SQLiteDriver
+}
+
+// Open opens a new sqlite connection.
+func (d *sqliteDriver) Open(dsn string) (driver.Conn, error) {
+	conn, err := d.driver.Open(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("could not open sqlite connection: %w", err)
+	}
+	//nolint:forcetypeassert
+	return &sqliteConn{conn.(*sqlite3.SQLiteConn)}, nil
+}
+
+type sqliteConn struct {
+	*sqlite3.SQLiteConn
+}
+
+// CheckNamedValue encodes big ints as decimal text and maps as json, everything else goes through the default converter.
+func (c *sqliteConn) CheckNamedValue(value *driver.NamedValue) error {
+	switch conv := value.Value.(type) {
+	case *big.Int:
+		// clickhouse stores a nil UInt256 as 0.
+		if conv == nil {
+			value.Value = "0"
+			return nil
+		}
+		value.Value = conv.String()
+		return nil
+	case big.Int:
+		value.Value = conv.String()
+		return nil
+	}
+	if reflect.ValueOf(value.Value).Kind() == reflect.Map {
+		encoded, err := json.Marshal(value.Value)
+		if err != nil {
+			return fmt.Errorf("could not encode map: %w", err)
+		}
+		value.Value = string(encoded)
+		return nil
+	}
+	return driver.ErrSkip
+}
+
+// QueryContext runs a query and decodes the clickhouse column types of its result.
+//
+//nolint:wrapcheck
+func (c *sqliteConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := c.SQLiteConn.QueryContext(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+	return newSqliteRows(rows), nil
+}
+
+// PrepareContext prepares a statement whose results are decoded like QueryContext's.
+//
+//nolint:wrapcheck
+func (c *sqliteConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	stmt, err := c.SQLiteConn.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:forcetypeassert
+	return &sqliteStmt{stmt.(*sqlite3.SQLiteStmt)}, nil
+}
+
+type sqliteStmt struct {
+	*sqlite3.SQLiteStmt
+}
+
+// QueryContext runs the prepared statement.
+//
+//nolint:wrapcheck
+func (s *sqliteStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := s.SQLiteStmt.QueryContext(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return newSqliteRows(rows), nil
+}
+
+type sqliteRows struct {
+	*sqlite3.SQLiteRows
+	// decoders is the go type of each column that needs decoding, nil otherwise.
+	decoders []reflect.Type
+}
+
+func newSqliteRows(rows driver.Rows) driver.Rows {
+	conv, ok := rows.(*sqlite3.SQLiteRows)
+	if !ok {
+		return rows
+	}
+	return &sqliteRows{SQLiteRows: conv}
+}
+
+// Next reads the next row and decodes UInt256 and Map columns.
+//
+//nolint:wrapcheck
+func (r *sqliteRows) Next(dest []driver.Value) error {
+	if err := r.SQLiteRows.Next(dest); err != nil {
+		return err
+	}
+	if r.decoders == nil {
+		declTypes := r.DeclTypes()
+		columns := r.Columns()
+		r.decoders = make([]reflect.Type, len(declTypes))
+		for i, declType := range declTypes {
+			r.decoders[i] = sqliteDecoders[declType]
+			// computed columns have no declared type, e.g. a swapped amount picked from a map.
+			if declType == "" && i < len(columns) && bigIntColumns()[columnName(columns[i])] {
+				r.decoders[i] = sqliteColumnTypes["UInt256"].goType
+			}
+		}
+	}
+	for i, goType := range r.decoders {
+		if goType == nil || i >= len(dest) || dest[i] == nil {
+			continue
+		}
+		decoded, err := decodeSqliteValue(dest[i], goType)
+		if err != nil {
+			return err
+		}
+		dest[i] = decoded
+	}
+	return nil
+}
+
+func decodeSqliteValue(value driver.Value, goType reflect.Type) (driver.Value, error) {
+	var text string
+	switch conv := value.(type) {
+	case string:
+		text = conv
+	case []byte:
+		text = string(conv)
+	case int64:
+		text = strconv.FormatInt(conv, 10)
+	default:
+		return value, nil
+	}
+	if goType.Kind() != reflect.Map {
+		res, ok := new(big.Int).SetString(text, 10)
+		if !ok {
+			return nil, fmt.Errorf("could not decode %s as a big int", text)
+		}
+		return res, nil
+	}
+	res := reflect.New(goType)
+	if err := json.Unmarshal([]byte(text), res.Interface()); err != nil {
+		return nil, fmt.Errorf("could not decode map: %w", err)
+	}
+	return res.Elem().Interface(), nil
+}
+
+// registerSqliteFunctions registers the functions the sqlite queries use to read maps, which are json objects keyed
+// by the decimal key, and the median aggregate sqlite lacks.
+func registerSqliteFunctions(conn *sqlite3.SQLiteConn) error {
+	funcs := map[string]interface{}{
+		"mapFloat": sqliteMapFloat,
+		"mapText":  sqliteMapText,
+		"mapSum":   sqliteMapSum,
+		"mapMin":   sqliteMapMin,
+		"mapSize":  sqliteMapSize,
+		"mapKey":   sqliteMapNthKey,
+	}
+	for name, impl := range funcs {
+		if err := conn.RegisterFunc(name, impl, true); err != nil {
+			return fmt.Errorf("could not register %s: %w", name, err)
+		}
+	}
+	aggregators := map[string]interface{}{
+		"median": newSqliteMedian,
+	}
+	for name, impl := range aggregators {
+		if err := conn.RegisterAggregator(name, impl, true); err != nil {
+			return fmt.Errorf("could not register %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func sqliteMap(value interface{}) map[string]interface{} {
+	var text string
+	switch conv := value.(type) {
+	case string:
+		text = conv
+	case []byte:
+		text = string(conv)
+	default:
+		return nil
+	}
+	var res map[string]interface{}
+	if err := json.Unmarshal([]byte(text), &res); err != nil {
+		return nil
+	}
+	return res
+}
+
+func sqliteMapKey(key interface{}) string {
+	switch conv := key.(type) {
+	case float64:
+		return strconv.FormatFloat(conv, 'f', -1, 64)
+	case []byte:
+		return string(conv)
+	default:
+		return fmt.Sprintf("%v", conv)
+	}
+}
+
+func sqliteFloat(value interface{}) float64 {
+	switch conv := value.(type) {
+	case float64:
+		return conv
+	case int64:
+		return float64(conv)
+	case string:
+		res, _ := strconv.ParseFloat(conv, 64)
+		return res
+	case []byte:
+		res, _ := strconv.ParseFloat(string(conv), 64)
+		return res
+	}
+	return 0
+}
+
+// sqliteMapFloat is m[key] for float maps, 0 if the key is missing.
+func sqliteMapFloat(m interface{}, key interface{}) float64 {
+	return sqliteFloat(sqliteMap(m)[sqliteMapKey(key)])
+}
+
+// sqliteMapText is m[key] for string maps, an empty string if the key is missing.
+func sqliteMapText(m interface{}, key interface{}) string {
+	value, ok := sqliteMap(m)[sqliteMapKey(key)]
+	if !ok || value == nil {
+		return ""
+	}
+	if text, ok := value.(string); ok {
+		return text
+	}
+	return sqliteMapKey(value)
+}
+
+// sqliteMapSum is arraySum(mapValues(m)).
+func sqliteMapSum(m interface{}) float64 {
+	var res float64
+	for _, value := range sqliteMap(m) {
+		res += sqliteFloat(value)
+	}
+	return res
+}
+
+// sqliteMapMin is arrayMin(mapValues(m)), 0 if m is empty.
+func sqliteMapMin(m interface{}) float64 {
+	var res float64
+	first := true
+	for _, value := range sqliteMap(m) {
+		if conv := sqliteFloat(value); first || conv < res {
+			res = conv
+			first = false
+		}
+	}
+	return res
+}
+
+// sqliteMapSize is length(m).
+func sqliteMapSize(m interface{}) int64 {
+	return int64(len(sqliteMap(m)))
+}
+
+// sqliteMapNthKey is m.keys[n], keys are numeric and ordered ascending. It is 0 if m has fewer than n keys.
+func sqliteMapNthKey(m interface{}, n int64) int64 {
+	var keys []int64
+	for key := range sqliteMap(m) {
+		parsed, err := strconv.ParseInt(key, 10, 64)
+		if err == nil {
+			keys = append(keys, parsed)
+		}
+	}
+	if n < 1 || int(n) > len(keys) {
+		return 0
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys[n-1]
+}
+
+type sqliteMedian struct {
+	values []float64
+}
+
+func newSqliteMedian() *sqliteMedian {
+	return &sqliteMedian{}
+}
+
+// Step adds a value.
+func (m *sqliteMedian) Step(value interface{}) {
+	if value != nil {
+		m.values = append(m.values, sqliteFloat(value))
+	}
+}
+
+// Done interpolates between the middle values like clickhouse's median.
+func (m *sqliteMedian) Done() float64 {
+	if len(m.values) == 0 {
+		return 0
+	}
+	sort.Float64s(m.values)
+	middle := len(m.values) / 2
+	if len(m.values)%2 == 0 {
+		return (m.values[middle-1] + m.values[middle]) / 2
+	}
+	return m.values[middle]
+}
@@ -2,7 +2,9 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/synapsecns/sanguine/core/dbcommon"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"time"
 
@@ -11,9 +13,34 @@
 	gormLogger "gorm.io/gorm/logger"
 )
 
-// Store is the clickhouse store. It extends the base store for sqlite specific queries.
+// Store is the consumer store. It is backed by clickhouse, postgres or an embedded sqlite database. Callers building
+// queries pick the sql for the backend through DBType.
 type Store struct {
-	db *gorm.DB
+	db     *gorm.DB
+	dbType dbcommon.DBType
+	// mvRefreshQuery maintains mv_bridge_events on sqlite and postgres.
+	mvRefreshQuery string
+}
+
+// Connect opens the store for the given db type.
+func Connect(ctx context.Context, dbType dbcommon.DBType, address string, readOnly bool, handler metrics.Handler) (*Store, error) {
+	switch dbType {
+	case dbcommon.Clickhouse:
+		return OpenGormClickhouse(ctx, address, readOnly, handler)
+	case dbcommon.Sqlite:
+		return OpenGormSqlite(ctx, address, readOnly, handler)
+	case dbcommon.Postgres:
+		return OpenGormPostgres(ctx, address, readOnly, handler)
+	case dbcommon.Mysql:
+		return nil, errors.New("driver not supported")
+	default:
+		return nil, fmt.Errorf("invalid dbType: %s", dbType)
+	}
+}
+
+// DBType gets the backend of the store.
+func (s *Store) DBType() dbcommon.DBType {
+	return s.dbType
 }
 
 // UNSAFE_DB gets the underlying gorm db.
@@ -71,6 +98,18 @@ func OpenGormClickhouse(ctx context.Context, address string, readOnly bool, hand
 				return nil, fmt.Errorf("could not migrate last block number on clickhouse: %w", err)
 			}
 		}
+		if (!clickhouseDB.WithContext(ctx).Migrator().HasTable(&FastBridgeEvent{})) {
+			err = clickhouseDB.WithContext(ctx).Set("gorm:table_options", "ENGINE=ReplacingMergeTree(insert_time) ORDER BY (tx_hash, contract_address, block_number, event_type, event_index, transaction_id)").AutoMigrate(&FastBridgeEvent{})
+			if err != nil {
+				return nil, fmt.Errorf("could not migrate fastbridge events on clickhouse: %w", err)
+			}
+		}
+		if (!clickhouseDB.WithContext(ctx).Migrator().HasTable(&TokenPrice{})) {
+			err = clickhouseDB.WithContext(ctx).Set("gorm:table_options", "ENGINE=ReplacingMergeTree(insert_time) ORDER BY (coin_gecko_id, day)").AutoMigrate(&TokenPrice{})
+			if err != nil {
+				return nil, fmt.Errorf("could not migrate token prices on clickhouse: %w", err)
+			}
+		}
 	}
 	db, err := clickhouseDB.DB()
 
@@ -81,5 +120,27 @@ func OpenGormClickhouse(ctx context.Context, address string, readOnly bool, hand
 	db.SetConnMaxLifetime(30 * time.Second)
 	handler.AddGormCallbacks(clickhouseDB)
 
-	return &Store{clickhouseDB}, nil
+	return &Store{db: clickhouseDB, dbType: dbcommon.Clickhouse}, nil
+}
+
+// raw prepares a raw query.
+func (s *Store) raw(ctx context.Context, query string) *gorm.DB {
+	return s.db.WithContext(ctx).Raw(query)
+}
+
+// readOnly marks a query read only, which only clickhouse supports.
+func (s *Store) readOnly(query string) string {
+	if s.dbType == dbcommon.Clickhouse {
+		return query + " SETTINGS readonly=1"
+	}
+	return query
+}
+
+// insert prepares an insert into table. Sqlite and postgres replace rows with the same ordering key, as
+// clickhouse's ReplacingMergeTree does.
+func (s *Store) insert(ctx context.Context, table string) *gorm.DB {
+	if s.dbType != dbcommon.Clickhouse {
+		return s.db.WithContext(ctx).Clauses(upsert(table))
+	}
+	return s.db.WithContext(ctx)
 }
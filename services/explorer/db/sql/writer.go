@@ -9,25 +9,34 @@
 func (s *Store) StoreEvent(ctx context.Context, event interface{}) error {
 	switch conv := event.(type) {
 	case *BridgeEvent:
-		dbTx := s.db.WithContext(ctx).Create(conv)
+		dbTx := s.insert(ctx, "bridge_events").Create(conv)
 		if dbTx.Error != nil {
 			return fmt.Errorf("failed to store bridge event: %w", dbTx.Error)
 		}
+		err := s.refreshMvBridgeEvents(ctx, []*BridgeEvent{conv})
+		if err != nil {
+			return fmt.Errorf("failed to store bridge event: %w", err)
+		}
 	case *SwapEvent:
-		dbTx := s.db.WithContext(ctx).Create(conv)
+		dbTx := s.insert(ctx, "swap_events").Create(conv)
 		if dbTx.Error != nil {
 			return fmt.Errorf("failed to store swap event: %w", dbTx.Error)
 		}
 	case *MessageBusEvent:
-		dbTx := s.db.WithContext(ctx).Create(conv)
+		dbTx := s.insert(ctx, "message_bus_events").Create(conv)
 		if dbTx.Error != nil {
 			return fmt.Errorf("failed to store message event: %w", dbTx.Error)
 		}
 	case *CCTPEvent:
-		dbTx := s.db.WithContext(ctx).Create(conv)
+		dbTx := s.insert(ctx, "cctp_events").Create(conv)
 		if dbTx.Error != nil {
 			return fmt.Errorf("failed to store cctp event: %w", dbTx.Error)
 		}
+	case *FastBridgeEvent:
+		dbTx := s.insert(ctx, "fast_bridge_events").Create(conv)
+		if dbTx.Error != nil {
+			return fmt.Errorf("failed to store fastbridge event: %w", dbTx.Error)
+		}
 	}
 	return nil
 }
@@ -40,6 +49,7 @@ func (s *Store) StoreEvents(ctx context.Context, events []interface{}) error {
 	var swapEvents []SwapEvent
 	var messageBusEvents []MessageBusEvent
 	var cctpEvents []*CCTPEvent
+	var fastBridgeEvents []*FastBridgeEvent
 	for _, event := range events {
 		switch conv := event.(type) {
 		case *BridgeEvent:
@@ -50,38 +60,53 @@ func (s *Store) StoreEvents(ctx context.Context, events []interface{}) error {
 			messageBusEvents = append(messageBusEvents, conv)
 		case *CCTPEvent:
 			cctpEvents = append(cctpEvents, conv)
+		case *FastBridgeEvent:
+			fastBridgeEvents = append(fastBridgeEvents, conv)
 		}
 	}
 
 	// TODO: maybe switch this out with a generic
 	if len(bridgeEvents) > 0 {
-		dbTx := s.db.WithContext(ctx).Create(&bridgeEvents)
+		dbTx := s.insert(ctx, "bridge_events").Create(&bridgeEvents)
 		if dbTx.Error != nil {
 			return fmt.Errorf("failed to store bridge events: %w", dbTx.Error)
 		}
 	}
 
 	if len(swapEvents) > 0 {
-		dbTx := s.db.WithContext(ctx).Create(&swapEvents)
+		dbTx := s.insert(ctx, "swap_events").Create(&swapEvents)
 		if dbTx.Error != nil {
 			return fmt.Errorf("failed to store swap events: %w", dbTx.Error)
 		}
 	}
 
 	if len(messageBusEvents) > 0 {
-		dbTx := s.db.WithContext(ctx).Create(&messageBusEvents)
+		dbTx := s.insert(ctx, "message_bus_events").Create(&messageBusEvents)
 		if dbTx.Error != nil {
 			return fmt.Errorf("failed to store message event: %w", dbTx.Error)
 		}
 	}
 
 	if len(cctpEvents) > 0 {
-		dbTx := s.db.WithContext(ctx).Create(&cctpEvents)
+		dbTx := s.insert(ctx, "cctp_events").Create(&cctpEvents)
 		if dbTx.Error != nil {
 			return fmt.Errorf("failed to store cctp event: %w", dbTx.Error)
 		}
 	}
 
+	if len(fastBridgeEvents) > 0 {
+		dbTx := s.insert(ctx, "fast_bridge_events").Create(&fastBridgeEvents)
+		if dbTx.Error != nil {
+			return fmt.Errorf("failed to store fastbridge events: %w", dbTx.Error)
+		}
+	}
+
+	// refreshed last so swaps from the same batch are picked up.
+	err := s.refreshMvBridgeEvents(ctx, bridgeEvents)
+	if err != nil {
+		return fmt.Errorf("failed to store bridge events: %w", err)
+	}
+
 	return nil
 }
 
@@ -176,3 +201,16 @@ func (s *Store) StoreSwapFee(ctx context.Context, chainID uint32, blockNumber ui
 	}
 	return nil
 }
+
+// StoreTokenPrices stores daily token prices, replacing any price already stored for the same token and day.
+func (s *Store) StoreTokenPrices(ctx context.Context, prices []TokenPrice) error {
+	if len(prices) == 0 {
+		return nil
+	}
+	dbTx := s.insert(ctx, "token_prices").Create(&prices)
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not store token prices: %w", dbTx.Error)
+	}
+
+	return nil
+}
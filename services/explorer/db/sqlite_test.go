@@ -0,0 +1,118 @@
+package db_test
+
+import (
+	gosql "database/sql"
+	"math/big"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	bridgeTypes "github.com/synapsecns/sanguine/services/explorer/types/bridge"
+)
+
+func (t *DBSuite) TestSqliteMvBridgeEvents() {
+	defer t.cleanup()
+	store, err := sql.OpenGormSqlite(t.GetTestContext(), t.T().TempDir(), false, metrics.NewNullHandler())
+	Nil(t.T(), err)
+
+	amount, ok := new(big.Int).SetString("123456789012345678901234567890", 10)
+	True(t.T(), ok)
+	kappa := common.BigToHash(big.NewInt(gofakeit.Int64())).String()
+	originTx := common.BigToHash(big.NewInt(gofakeit.Int64())).String()
+	pool := common.BigToAddress(big.NewInt(gofakeit.Int64())).String()
+	swappedToken := common.BigToAddress(big.NewInt(gofakeit.Int64())).String()
+	amountUSD := 5.5
+	swapUSD := 9.5
+	timestamp := uint64(1700000000)
+
+	origin := &sql.BridgeEvent{
+		InsertTime:         1,
+		ChainID:            1,
+		ContractAddress:    common.BigToAddress(big.NewInt(gofakeit.Int64())).String(),
+		EventType:          bridgeTypes.DepositEvent.Int(),
+		BlockNumber:        10,
+		TxHash:             originTx,
+		Token:              common.BigToAddress(big.NewInt(gofakeit.Int64())).String(),
+		Amount:             amount,
+		AmountUSD:          &amountUSD,
+		DestinationChainID: big.NewInt(56),
+		DestinationKappa:   kappa,
+		Sender:             common.BigToAddress(big.NewInt(gofakeit.Int64())).String(),
+		TimeStamp:          &timestamp,
+	}
+	destination := &sql.BridgeEvent{
+		InsertTime:         1,
+		ChainID:            56,
+		ContractAddress:    common.BigToAddress(big.NewInt(gofakeit.Int64())).String(),
+		EventType:          bridgeTypes.MintEvent.Int(),
+		BlockNumber:        20,
+		TxHash:             common.BigToHash(big.NewInt(gofakeit.Int64())).String(),
+		Token:              common.BigToAddress(big.NewInt(gofakeit.Int64())).String(),
+		Amount:             big.NewInt(5),
+		AmountUSD:          &amountUSD,
+		DestinationChainID: big.NewInt(0),
+		Kappa:              gosql.NullString{String: kappa, Valid: true},
+		TimeStamp:          &timestamp,
+	}
+	swap := sql.SwapEvent{
+		InsertTime:      1,
+		ChainID:         1,
+		ContractAddress: pool,
+		BlockNumber:     10,
+		TxHash:          originTx,
+		Amount:          map[uint8]string{1: "777"},
+		AmountUSD:       map[uint8]float64{1: swapUSD},
+		TokenDecimal:    map[uint8]uint8{1: 6},
+		SoldID:          big.NewInt(1),
+		BoughtID:        big.NewInt(0),
+		TimeStamp:       &timestamp,
+	}
+	Nil(t.T(), store.StoreTokenIndex(t.GetTestContext(), 1, 1, swappedToken, pool))
+	Nil(t.T(), store.StoreEvents(t.GetTestContext(), []interface{}{origin, destination, swap}))
+
+	// storing an event again replaces it rather than duplicating it.
+	destination.InsertTime = 2
+	destination.Amount = big.NewInt(6)
+	Nil(t.T(), store.StoreEvent(t.GetTestContext(), destination))
+
+	events, err := store.GetBridgeEvents(t.GetTestContext(), "SELECT * FROM bridge_events ORDER BY chain_id")
+	Nil(t.T(), err)
+	Len(t.T(), events, 2)
+	Equal(t.T(), amount.String(), events[0].Amount.String())
+	Equal(t.T(), "6", events[1].Amount.String())
+
+	mv, err := store.GetMVBridgeEvent(t.GetTestContext(), "SELECT * FROM mv_bridge_events WHERE ftx_hash = '"+originTx+"'")
+	Nil(t.T(), err)
+	Equal(t.T(), swappedToken, mv.FToken)
+	Equal(t.T(), "777", mv.FAmount.String())
+	Equal(t.T(), swapUSD, *mv.FAmountUSD)
+	Equal(t.T(), destination.TxHash, mv.TTxHash)
+	Equal(t.T(), "6", mv.TAmount.String())
+
+	volume, err := store.GetFloat64(t.GetTestContext(), "SELECT SUM(famount_usd) FROM mv_bridge_events")
+	Nil(t.T(), err)
+	Equal(t.T(), swapUSD, volume)
+}
+
+func (t *DBSuite) TestSqliteTokenPrices() {
+	defer t.cleanup()
+	store, err := sql.OpenGormSqlite(t.GetTestContext(), t.T().TempDir(), false, metrics.NewNullHandler())
+	Nil(t.T(), err)
+
+	day := uint64(1699920000)
+	price, err := store.GetTokenPrice(t.GetTestContext(), "usd-coin", day)
+	Nil(t.T(), err)
+	Nil(t.T(), price)
+
+	Nil(t.T(), store.StoreTokenPrices(t.GetTestContext(), []sql.TokenPrice{{InsertTime: 1, CoinGeckoID: "usd-coin", Day: day, Price: 1.01, Source: "defillama"}}))
+	// storing a price for the same token and day again replaces it.
+	Nil(t.T(), store.StoreTokenPrices(t.GetTestContext(), []sql.TokenPrice{{InsertTime: 2, CoinGeckoID: "usd-coin", Day: day, Price: 0.99, Source: "coingecko"}}))
+
+	price, err = store.GetTokenPrice(t.GetTestContext(), "usd-coin", day)
+	Nil(t.T(), err)
+	NotNil(t.T(), price)
+	Equal(t.T(), 0.99, price.Price)
+	Equal(t.T(), "coingecko", price.Source)
+}
@@ -19,6 +19,7 @@
 	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph"
 	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/interceptor"
 	resolvers "github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/resolver"
+	"github.com/synapsecns/sanguine/services/explorer/pubsub"
 	"github.com/synapsecns/sanguine/services/explorer/types"
 	"time"
 )
@@ -28,10 +29,12 @@
 	GraphqlEndpoint string = "/graphql"
 	// GraphiqlEndpoint is the endpoint for the graphql user interface.
 	GraphiqlEndpoint string = "/graphiql"
+	// NotifyEndpoint is the endpoint indexers in other processes post stored bridge events to.
+	NotifyEndpoint string = "/notify"
 )
 
-// EnableGraphql enables the scribe graphql service.
-func EnableGraphql(engine *gin.Engine, consumerDB db.ConsumerDB, fetcher fetcher.ScribeFetcher, apiCache cache.Service, clients map[uint32]etherClient.EVM, parsers *types.ServerParsers, refs *types.ServerRefs, swapFilters map[string]*swap.SwapFlashLoanFilterer, config serverConfig.Config, handler metrics.Handler) {
+// EnableGraphql enables the scribe graphql service. Subscriptions are fed by the events broker.
+func EnableGraphql(engine *gin.Engine, consumerDB db.ConsumerDB, events *pubsub.Broker, fetcher fetcher.ScribeFetcher, apiCache cache.Service, clients map[uint32]etherClient.EVM, parsers *types.ServerParsers, refs *types.ServerRefs, swapFilters map[string]*swap.SwapFlashLoanFilterer, config serverConfig.Config, handler metrics.Handler) {
 	server := createServer(
 		resolvers.NewExecutableSchema(
 			resolvers.Config{Resolvers: &graph.Resolver{
@@ -44,6 +47,7 @@ func EnableGraphql(engine *gin.Engine, consumerDB db.ConsumerDB, fetcher fetcher
 				Refs:        refs,
 				SwapFilters: swapFilters,
 				Config:      config,
+				Events:      events,
 			}},
 		),
 	)
@@ -54,6 +58,9 @@ func EnableGraphql(engine *gin.Engine, consumerDB db.ConsumerDB, fetcher fetcher
 	engine.GET(GraphqlEndpoint, graphqlHandler(server))
 	engine.POST(GraphqlEndpoint, graphqlHandler(server))
 	engine.GET(GraphiqlEndpoint, graphiqlHandler())
+	if config.NotifyToken != "" {
+		engine.POST(NotifyEndpoint, gin.WrapH(events.Handler(config.NotifyToken)))
+	}
 }
 
 // Create a server without introspection.
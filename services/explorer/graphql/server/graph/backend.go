@@ -0,0 +1,185 @@
+package graph
+
+import (
+	"fmt"
+
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/model"
+)
+
+// backendQueries builds the queries whose sql differs between the db backends. Filters are built by the shared
+// generate*SpecifierSQL functions, which only emit sql every backend understands.
+//
+// nolint:interfacebloat
+type backendQueries interface {
+	// numeric reads a UInt256 column as a number, for comparing it with a number.
+	numeric(column string) string
+	// mvBridgeTxs pages through the mv_bridge_events rows matching filters and then postFilters, newest first.
+	mvBridgeTxs(filters, postFilters string, page int) string
+	// mvBridgeTxLegs pages through the mv_bridge_events rows matching filters, newest first.
+	mvBridgeTxLegs(filters string, page int) string
+	// messageBusTxs pages through message bus transactions, with the executions of the messages sent joined on.
+	messageBusTxs(filters string, pending bool, reverted bool, page int) (string, error)
+	// bridgeEventCount counts the bridge transactions by chain, or by chain and token.
+	bridgeEventCount(chainID *int, address *string, directionIn bool, timestamp *uint64, isTokenCount bool) string
+	// addressRanking ranks the senders of bridge transactions since targetTime by their number of transactions.
+	addressRanking(targetTime uint64) string
+	// amountStatisticBridge computes a statistic over the bridge platform.
+	amountStatisticBridge(typeArg model.StatisticType, address *string, chainID *int, tokenAddress *string) (*string, error)
+	// amountStatisticSwap computes a statistic over the swap platform.
+	amountStatisticSwap(typeArg model.StatisticType, compositeFilters string, tokenAddress *string) (*string, error)
+	// amountStatisticMessageBus computes a statistic over the message bus platform.
+	amountStatisticMessageBus(typeArg model.StatisticType, compositeFilters string) (*string, error)
+	// dailyStatisticBridge gets a daily statistic by chain over mv_bridge_events.
+	dailyStatisticBridge(typeArg *model.DailyStatisticType, compositeFiltersMv string) (*string, error)
+	// dailyStatisticSwap gets a daily statistic by chain over the swap platform.
+	dailyStatisticSwap(typeArg *model.DailyStatisticType, compositeFilters string) (*string, error)
+	// dailyStatisticMessageBus gets a daily statistic by chain over the message bus platform.
+	dailyStatisticMessageBus(typeArg *model.DailyStatisticType, compositeFilters string) (*string, error)
+	// dailyStatisticAll gets a daily statistic by chain across all platforms, the bridge read from mv_bridge_events.
+	dailyStatisticAll(typeArg *model.DailyStatisticType, compositeFilters string, compositeFiltersMv string) (*string, error)
+	// rankedChainsByVolume ranks the chains by their bridge and swap volume.
+	rankedChainsByVolume(duration *model.Duration) string
+	// addressData gets the queries of the addressData resolver.
+	addressData(address string) addressDataQueries
+	// leaderboard ranks the bridge senders by volume.
+	leaderboard(filters string, limit int, offset int) string
+	// originBridgeTx gets the mv_bridge_events row of an origin transaction.
+	originBridgeTx(chainID int, txHash string) string
+	// destinationBridgeTx gets the mv_bridge_events row of a destination kappa.
+	destinationBridgeTx(chainID int, kappa string) string
+}
+
+// addressDataQueries are the queries of the addressData resolver.
+type addressDataQueries struct {
+	bridge       string
+	swap         string
+	ranking      string
+	firstTx      string
+	dailyData    string
+	chainRanking string
+}
+
+// queries gets the queries of the backend the resolver reads from.
+func (r *Resolver) queries() backendQueries {
+	switch r.DB.DBType() {
+	case dbcommon.Sqlite:
+		return sqliteQueries
+	case dbcommon.Postgres:
+		return postgresQueries
+	default:
+		return clickhouseQueries{}
+	}
+}
+
+// onlyMv is true if the backend only answers from mv_bridge_events. Unlike clickhouse, the sqlite and postgres
+// backends have no non mv variant of the bridge queries, their view is maintained as events are stored.
+func (r *Resolver) onlyMv() bool {
+	return r.DB.DBType() != dbcommon.Clickhouse
+}
+
+// clickhouseQueries are the queries of the clickhouse backend.
+type clickhouseQueries struct{}
+
+func (clickhouseQueries) numeric(column string) string {
+	return column
+}
+
+func (clickhouseQueries) mvBridgeTxs(filters, postFilters string, page int) string {
+	pageValue := sql.PageSize
+	pageOffset := (page - 1) * sql.PageSize
+	return fmt.Sprintf("SELECT * FROM(SELECT * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) %s LIMIT %d OFFSET %d SETTINGS memory_overcommit_ratio_denominator=4000, memory_usage_overcommit_max_wait_microseconds=500 ", filters, postFilters, pageValue, pageOffset)
+}
+
+func (clickhouseQueries) mvBridgeTxLegs(filters string, page int) string {
+	pageValue := sql.PageSize
+	pageOffset := (page - 1) * sql.PageSize
+	return fmt.Sprintf("SELECT * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash LIMIT %d OFFSET %d ", filters, pageValue, pageOffset)
+}
+
+func (clickhouseQueries) messageBusTxs(filters string, pending bool, reverted bool, page int) (string, error) {
+	operation := " = ''"
+	if !pending {
+		operation = " != ''"
+	}
+	pendingSpecifier := fmt.Sprintf(" WHERE t.message_id %s", operation)
+	pageValue := sql.PageSize
+	pageOffset := (page - 1) * sql.PageSize
+
+	cte := fmt.Sprintf("WITH baseQuery AS (SELECT * FROM message_bus_events %s ORDER BY timestamp DESC, block_number DESC, event_index DESC, insert_time DESC LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash), (SELECT min(timestamp) FROM baseQuery) AS minTimestamp", filters)
+
+	if reverted {
+		return fmt.Sprintf("%s SELECT * FROM  (SELECT * FROM (select * from (%s) WHERE %s = 1) f RIGHT OUTER JOIN (Select r.reverted_reason AS reverted_reason, j.reverted_reason AS rrr, * FROM (select * from %s WHERE event_type = 0 and status = 'Fail') j LEFT JOIN (select reverted_reason, tx_hash from (%s) WHERE %s = 2) r on j.tx_hash = r.tx_hash) t ON f.%s = t.%s)  LIMIT %d OFFSET %d", cte, baseMessageBus, sql.EventTypeFieldName, "baseQuery", baseMessageBus, sql.EventTypeFieldName, "message_id", "message_id", pageValue, pageOffset), nil
+	}
+	return fmt.Sprintf("%s SELECT * FROM (SELECT * FROM (SELECT * FROM %s WHERE %s = 1 ) f LEFT JOIN (SELECT * FROM (%s) WHERE %s = 0) t ON f.%s = t.%s %s)  LIMIT %d OFFSET %d", cte, "baseQuery", sql.EventTypeFieldName, baseMessageBus, sql.EventTypeFieldName, "message_id", "message_id", pendingSpecifier, pageValue, pageOffset), nil
+}
+
+func (clickhouseQueries) bridgeEventCount(chainID *int, address *string, directionIn bool, timestamp *uint64, isTokenCount bool) string {
+	return generateBridgeEventCountQuery(chainID, address, nil, directionIn, timestamp, isTokenCount)
+}
+
+func (clickhouseQueries) addressRanking(targetTime uint64) string {
+	firstFilter := true
+	timeStampSpecifier := generateTimestampSpecifierSQL(&targetTime, sql.TimeStampFieldName, &firstFilter, "")
+	directionSpecifier := generateDirectionSpecifierSQL(true, &firstFilter, "")
+	compositeFilters := fmt.Sprintf("%s%s", timeStampSpecifier, directionSpecifier)
+	return fmt.Sprintf(`SELECT %s AS address, COUNT(DISTINCT %s) AS Count FROM (%s) GROUP BY %s ORDER BY Count Desc`, sql.SenderFieldName, sql.TxHashFieldName, generateDeDepQuery(compositeFilters, nil, nil), sql.SenderFieldName)
+}
+
+func (clickhouseQueries) amountStatisticBridge(typeArg model.StatisticType, address *string, chainID *int, tokenAddress *string) (*string, error) {
+	return GenerateAmountStatisticBridgeSQL(typeArg, address, chainID, tokenAddress)
+}
+
+func (clickhouseQueries) amountStatisticSwap(typeArg model.StatisticType, compositeFilters string, tokenAddress *string) (*string, error) {
+	return GenerateAmountStatisticSwapSQL(typeArg, compositeFilters, tokenAddress)
+}
+
+func (clickhouseQueries) amountStatisticMessageBus(typeArg model.StatisticType, compositeFilters string) (*string, error) {
+	return GenerateAmountStatisticMessageBusSQL(typeArg, compositeFilters)
+}
+
+func (clickhouseQueries) dailyStatisticBridge(typeArg *model.DailyStatisticType, compositeFiltersMv string) (*string, error) {
+	return GenerateDailyStatisticByChainBridgeSQLMv(typeArg, compositeFiltersMv)
+}
+
+func (clickhouseQueries) dailyStatisticSwap(typeArg *model.DailyStatisticType, compositeFilters string) (*string, error) {
+	return GenerateDailyStatisticByChainSwapSQL(typeArg, compositeFilters)
+}
+
+func (clickhouseQueries) dailyStatisticMessageBus(typeArg *model.DailyStatisticType, compositeFilters string) (*string, error) {
+	return GenerateDailyStatisticByChainMessageBusSQL(typeArg, compositeFilters)
+}
+
+func (clickhouseQueries) dailyStatisticAll(typeArg *model.DailyStatisticType, compositeFilters string, compositeFiltersMv string) (*string, error) {
+	return GenerateDailyStatisticByChainAllSQLMv(typeArg, compositeFilters, compositeFiltersMv)
+}
+
+func (clickhouseQueries) rankedChainsByVolume(duration *model.Duration) string {
+	firstFilter := true
+	timestampSpecifier := GetDurationFilter(duration, &firstFilter, "")
+	return GenerateRankedChainsByVolumeSQL(timestampSpecifier, &firstFilter)
+}
+
+func (clickhouseQueries) addressData(address string) addressDataQueries {
+	return addressDataQueries{
+		bridge:       fmt.Sprintf("SELECT toFloat64(sumKahan(famount_usd)) AS volumeTotal, toFloat64(sumKahan(tfee_amount_usd)) AS feeTotal, toInt64(uniq(fchain_id, ftx_hash)) AS txTotal FROM (SELECT * FROM mv_bridge_events where fsender = '%s' LIMIT 1 BY fchain_id,fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", address),
+		swap:         fmt.Sprintf("SELECT toFloat64(sumKahan(multiIf(event_type = 0, amount_usd[sold_id], event_type = 1, arraySum(mapValues(amount_usd)), event_type = 9, arraySum(mapValues(amount_usd)), event_type = 10, amount_usd[sold_id],0))) AS volumeTotal, toFloat64(sumKahan(arraySum(mapValues(fee_usd)))) AS feeTotal,  toInt64(uniq(chain_id, tx_hash)) AS txTotal FROM (SELECT * FROM swap_events where sender = '%s' LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash)", address),
+		ranking:      fmt.Sprintf("select rowNumber from (select sender, row_number() over (order by sumTotal desc ) as rowNumber from (select fsender as sender, sumKahan(famount_usd) as sumTotal from (SELECT * FROM mv_bridge_events where fsender != '' LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) where fsender != '' group by fsender)) where sender = '%s'", address),
+		firstTx:      fmt.Sprintf("SELECT min(ftimestamp) AS earliestTime FROM (SELECT * FROM mv_bridge_events where fsender = '%s' LIMIT 1 BY fchain_id,fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", address),
+		dailyData:    fmt.Sprintf("SELECT coalesce(toString(b.date), toString(s.date)) AS date, toFloat64(coalesce(b.sumTotal, 0)) + toFloat64(coalesce(s.sumTotal, 0)) as count FROM (SELECT %s, uniq(fchain_id, ftx_hash) AS sumTotal FROM (SELECT * FROM mv_bridge_events where fsender = '%s' LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) group by date order by date) b FULL OUTER JOIN (SELECT %s, uniq(chain_id, tx_hash) AS sumTotal FROM (SELECT * FROM swap_events WHERE sender = '%s' LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date) s ON b.date = s.date SETTINGS join_use_nulls=1", toDateSelectMv, address, toDateSelect, address),
+		chainRanking: fmt.Sprintf("SELECT row_number() over (order by sumKahan(tamount_usd) desc ) as Rank, tchain_id as ChainID, sumKahan(tamount_usd) AS VolumeUsd FROM (SELECT * FROM mv_bridge_events where fsender = '%s' LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) where ChainID > 0 group by ChainID", address),
+	}
+}
+
+func (clickhouseQueries) leaderboard(filters string, limit int, offset int) string {
+	return fmt.Sprintf("select row_number() over (order by VolumeUsd desc ) as Rank, * from (select fsender as Address, toFloat64(sumKahan(famount_usd)) as VolumeUsd,toFloat64(avg(famount_usd)) as AvgVolumeUsd, count(DISTINCT ftx_hash) as Txs,toFloat64(sumKahan(tfee_amount_usd)) as Fees from (SELECT * FROM mv_bridge_events where fsender != '' LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) where fsender != '' %s group by fsender) LIMIT %d OFFSET %d", filters, limit, offset)
+}
+
+func (clickhouseQueries) originBridgeTx(chainID int, txHash string) string {
+	return fmt.Sprintf("SELECT * FROM mv_bridge_events WHERE fchain_id = %d AND ftx_hash = '%s' ORDER BY insert_time desc LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash", chainID, txHash)
+}
+
+func (clickhouseQueries) destinationBridgeTx(chainID int, kappa string) string {
+	return fmt.Sprintf("SELECT * FROM mv_bridge_events WHERE tchain_id = %d AND tkappa = '%s' ORDER BY insert_time desc LIMIT 1 BY tchain_id, tcontract_address, tevent_type, tblock_number, tevent_index, ttx_hash", chainID, kappa)
+}
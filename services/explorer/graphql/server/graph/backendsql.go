@@ -0,0 +1,412 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/model"
+)
+
+// sqlQueries are the queries of the sqlite and postgres backends. Both store events under a unique key, so unlike
+// clickhouse there are no duplicate rows to skip. The functions below are the only sql the two backends disagree on.
+type sqlQueries struct {
+	// numericColumn reads a UInt256 column as a number.
+	numericColumn func(column string) string
+	// mapValue is the float value of a map column at key, 0 if missing.
+	mapValue func(column, key string) string
+	// mapSum is the sum of the float values of a map column.
+	mapSum func(column string) string
+	// firstKey is the smallest key of a map column, null if the map is empty.
+	firstKey func(column string) string
+	// median is the median aggregate.
+	median func(expr string) string
+	// date formats a unix timestamp column as YYYY-MM-DD.
+	date func(column string) string
+}
+
+// sqliteQueries read maps through the functions registered on the sqlite connection. UInt256 columns are text.
+var sqliteQueries = sqlQueries{
+	numericColumn: func(column string) string {
+		return fmt.Sprintf("CAST(%s AS REAL)", column)
+	},
+	mapValue: func(column, key string) string {
+		return fmt.Sprintf("mapFloat(%s, %s)", column, key)
+	},
+	mapSum: func(column string) string {
+		return fmt.Sprintf("mapSum(%s)", column)
+	},
+	firstKey: func(column string) string {
+		return fmt.Sprintf("CASE WHEN mapSize(%s) > 0 THEN mapKey(%s, 1) END", column, column)
+	},
+	median: func(expr string) string {
+		return fmt.Sprintf("median(%s)", expr)
+	},
+	date: func(column string) string {
+		return fmt.Sprintf("date(%s, 'unixepoch')", column)
+	},
+}
+
+// postgresQueries read maps with the jsonb operators. UInt256 columns are numeric.
+var postgresQueries = sqlQueries{
+	numericColumn: func(column string) string {
+		return column
+	},
+	mapValue: func(column, key string) string {
+		return fmt.Sprintf("COALESCE(CAST(%s ->> CAST(%s AS TEXT) AS DOUBLE PRECISION), 0)", column, key)
+	},
+	mapSum: func(column string) string {
+		return fmt.Sprintf("(SELECT COALESCE(SUM(CAST(value AS DOUBLE PRECISION)), 0) FROM jsonb_each_text(%s))", column)
+	},
+	firstKey: func(column string) string {
+		return fmt.Sprintf("(SELECT MIN(CAST(k AS INTEGER)) FROM jsonb_object_keys(%s) AS k)", column)
+	},
+	median: func(expr string) string {
+		return fmt.Sprintf("percentile_cont(0.5) WITHIN GROUP (ORDER BY %s)", expr)
+	},
+	date: func(column string) string {
+		return fmt.Sprintf("to_char(to_timestamp(%s) AT TIME ZONE 'UTC', 'YYYY-MM-DD')", column)
+	},
+}
+
+// dailyChains are the chains of the DateResultByChain columns.
+var dailyChains = []struct {
+	name    string
+	chainID int
+}{
+	{"ethereum", 1},
+	{"optimism", 10},
+	{"cronos", 25},
+	{"bsc", 56},
+	{"polygon", 137},
+	{"fantom", 250},
+	{"boba", 288},
+	{"metis", 1088},
+	{"moonbeam", 1284},
+	{"moonriver", 1285},
+	{"klaytn", 8217},
+	{"arbitrum", 42161},
+	{"avalanche", 43114},
+	{"dfk", 53935},
+	{"aurora", 1313161554},
+	{"harmony", 1666600000},
+	{"canto", 7700},
+	{"dogechain", 2000},
+	{"base", 8453},
+}
+
+func (q sqlQueries) numeric(column string) string {
+	return q.numericColumn(column)
+}
+
+// uniq counts the distinct pairs of a and b, like clickhouse's uniq(a, b).
+func (q sqlQueries) uniq(a, b string) string {
+	return fmt.Sprintf("COUNT(DISTINCT CAST(%s AS TEXT) || '-' || COALESCE(CAST(%s AS TEXT), ''))", a, b)
+}
+
+// swapVolume is the usd volume of a swap event.
+func (q sqlQueries) swapVolume() string {
+	return fmt.Sprintf("CASE WHEN event_type IN (0, 10) THEN %s WHEN event_type IN (1, 9) THEN %s ELSE 0 END", q.mapValue("amount_usd", "sold_id"), q.mapSum("amount_usd"))
+}
+
+// swapTokenIndex is the index of the sold or bought token of the swap at alias. Events that only carry the amount
+// map, e.g. liquidity events, use its first key.
+func (q sqlQueries) swapTokenIndex(alias string, bought bool) string {
+	side := "sold"
+	if bought {
+		side = "bought"
+	}
+	firstKey := q.firstKey(alias + ".amount")
+	return fmt.Sprintf("CASE WHEN %s = 0 AND %s IS NOT NULL THEN %s ELSE CAST(%s.%s_id AS INTEGER) END", q.numeric(fmt.Sprintf("%s.tokens_%s", alias, side)), firstKey, firstKey, alias, side)
+}
+
+func (q sqlQueries) mvBridgeTxs(filters, postFilters string, page int) string {
+	return fmt.Sprintf("SELECT * FROM (SELECT * FROM mv_bridge_events %s) mv %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, fchain_id, ftx_hash LIMIT %d OFFSET %d", filters, postFilters, sql.PageSize, (page-1)*sql.PageSize)
+}
+
+func (q sqlQueries) mvBridgeTxLegs(filters string, page int) string {
+	return fmt.Sprintf("SELECT * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, fchain_id, ftx_hash LIMIT %d OFFSET %d", filters, sql.PageSize, (page-1)*sql.PageSize)
+}
+
+func (q sqlQueries) messageBusTxs(filters string, pending bool, reverted bool, page int) (string, error) {
+	pageValue := sql.PageSize
+	pageOffset := (page - 1) * sql.PageSize
+
+	if reverted {
+		sentColumns, err := sql.OuterJoinColumns(&sql.MessageBusEvent{}, "f", "")
+		if err != nil {
+			return "", fmt.Errorf("could not select sent messages: %w", err)
+		}
+		executedColumns, err := sql.OuterJoinColumns(&sql.MessageBusEvent{}, "j", "t.")
+		if err != nil {
+			return "", fmt.Errorf("could not select executed messages: %w", err)
+		}
+		// the revert reason is emitted by a separate event of the execution transaction.
+		executedColumns = strings.Replace(executedColumns, "j.reverted_reason", "r.reverted_reason", 1)
+		return fmt.Sprintf("SELECT %s, %s FROM (SELECT * FROM message_bus_events %s) j LEFT JOIN (SELECT reverted_reason, tx_hash FROM message_bus_events WHERE event_type = 2) r ON j.tx_hash = r.tx_hash LEFT JOIN message_bus_events f ON f.event_type = 1 AND f.message_id = j.message_id WHERE j.event_type = 0 AND j.status = 'Fail' ORDER BY j.timestamp DESC, j.block_number DESC, j.event_index DESC LIMIT %d OFFSET %d", sentColumns, executedColumns, filters, pageValue, pageOffset), nil
+	}
+
+	executedColumns, err := sql.OuterJoinColumns(&sql.MessageBusEvent{}, "t", "t.")
+	if err != nil {
+		return "", fmt.Errorf("could not select executed messages: %w", err)
+	}
+	operation := "= ''"
+	if !pending {
+		operation = "!= ''"
+	}
+	return fmt.Sprintf("SELECT f.*, %s FROM (SELECT * FROM message_bus_events %s) f LEFT JOIN message_bus_events t ON t.event_type = 0 AND f.message_id = t.message_id WHERE f.event_type = 1 AND COALESCE(t.message_id, '') %s ORDER BY f.timestamp DESC, f.block_number DESC, f.event_index DESC LIMIT %d OFFSET %d", executedColumns, filters, operation, pageValue, pageOffset), nil
+}
+
+func (q sqlQueries) bridgeEventCount(chainID *int, address *string, directionIn bool, timestamp *uint64, isTokenCount bool) string {
+	firstFilter := true
+	directionSpecifier := generateDirectionSpecifierSQL(directionIn, &firstFilter, "")
+	chainIDSpecifier := generateSingleSpecifierI32SQL(chainID, sql.ChainIDFieldName, &firstFilter, "")
+	addressSpecifier := generateSingleSpecifierStringSQL(address, sql.RecipientFieldName, &firstFilter, "")
+	timestampSpecifier := generateTimestampSpecifierSQL(timestamp, sql.TimeStampFieldName, &firstFilter, "")
+	compositeFilters := directionSpecifier + chainIDSpecifier + addressSpecifier + timestampSpecifier
+
+	if !isTokenCount {
+		return fmt.Sprintf("SELECT chain_id, COUNT(DISTINCT tx_hash) AS count FROM bridge_events %s GROUP BY chain_id ORDER BY count DESC, chain_id", compositeFilters)
+	}
+	// bridges that swap count towards the token swapped from, as in the single side clickhouse query.
+	swappedToken := fmt.Sprintf("SELECT ti.token_address FROM swap_events se JOIN token_indices ti ON ti.chain_id = se.chain_id AND ti.contract_address = se.contract_address AND ti.token_index = %s WHERE se.chain_id = be.chain_id AND se.tx_hash = be.tx_hash LIMIT 1", q.swapTokenIndex("se", false))
+	return fmt.Sprintf("SELECT chain_id, token AS token_address, COUNT(DISTINCT tx_hash) AS count FROM (SELECT be.chain_id, be.tx_hash, COALESCE(NULLIF((%s), ''), be.token) AS token FROM bridge_events be %s) tokens GROUP BY chain_id, token ORDER BY count DESC, chain_id, token", swappedToken, compositeFilters)
+}
+
+func (q sqlQueries) addressRanking(targetTime uint64) string {
+	firstFilter := true
+	timeStampSpecifier := generateTimestampSpecifierSQL(&targetTime, sql.TimeStampFieldName, &firstFilter, "")
+	directionSpecifier := generateDirectionSpecifierSQL(true, &firstFilter, "")
+	return fmt.Sprintf("SELECT sender AS address, COUNT(DISTINCT tx_hash) AS count FROM bridge_events %s%s GROUP BY sender ORDER BY count DESC, sender", timeStampSpecifier, directionSpecifier)
+}
+
+func (q sqlQueries) amountStatisticBridge(typeArg model.StatisticType, address *string, chainID *int, tokenAddress *string) (*string, error) {
+	firstFilter := true
+	addressFilter := generateSingleSpecifierStringSQL(address, sql.SenderFieldName, &firstFilter, "f")
+	chainIDFilter := generateSingleSpecifierI32SQL(chainID, sql.ChainIDFieldName, &firstFilter, "f")
+	tokenAddressFilter := generateSingleSpecifierStringSQL(tokenAddress, sql.TokenFieldName, &firstFilter, "f")
+	compositeFilters := addressFilter + chainIDFilter + tokenAddressFilter
+
+	var operation string
+	switch typeArg {
+	case model.StatisticTypeMeanVolumeUsd:
+		operation = "AVG(famount_usd)"
+	case model.StatisticTypeMedianVolumeUsd:
+		operation = q.median("famount_usd")
+	case model.StatisticTypeTotalVolumeUsd:
+		operation = "SUM(famount_usd)"
+	case model.StatisticTypeCountTransactions:
+		operation = q.uniq("fchain_id", "ftx_hash")
+	case model.StatisticTypeCountAddresses:
+		operation = q.uniq("fchain_id", "fsender")
+	case model.StatisticTypeMeanFeeUsd:
+		operation = "AVG(tfee_amount_usd)"
+	case model.StatisticTypeMedianFeeUsd:
+		operation = q.median("tfee_amount_usd")
+	case model.StatisticTypeTotalFeeUsd:
+		operation = "SUM(tfee_amount_usd)"
+	default:
+		return nil, fmt.Errorf("invalid statistic type: %s", typeArg)
+	}
+	finalSQL := fmt.Sprintf("SELECT COALESCE(%s, 0) FROM mv_bridge_events %s", operation, compositeFilters)
+	return &finalSQL, nil
+}
+
+func (q sqlQueries) amountStatisticSwap(typeArg model.StatisticType, compositeFilters string, tokenAddress *string) (*string, error) {
+	var operation string
+	switch typeArg {
+	case model.StatisticTypeMeanVolumeUsd:
+		operation = fmt.Sprintf("AVG(%s)", q.swapVolume())
+	case model.StatisticTypeMedianVolumeUsd:
+		operation = q.median(q.swapVolume())
+	case model.StatisticTypeTotalVolumeUsd:
+		operation = fmt.Sprintf("SUM(%s)", q.swapVolume())
+	case model.StatisticTypeCountTransactions:
+		operation = q.uniq(sql.ChainIDFieldName, sql.TxHashFieldName)
+	case model.StatisticTypeCountAddresses:
+		operation = q.uniq(sql.ChainIDFieldName, sql.SenderFieldName)
+	case model.StatisticTypeMeanFeeUsd:
+		operation = fmt.Sprintf("AVG(%s)", q.mapSum(sql.FeeUSDFieldName))
+	case model.StatisticTypeMedianFeeUsd:
+		operation = q.median(q.mapSum(sql.FeeUSDFieldName))
+	case model.StatisticTypeTotalFeeUsd:
+		operation = fmt.Sprintf("SUM(%s)", q.mapSum(sql.FeeUSDFieldName))
+	default:
+		return nil, fmt.Errorf("invalid statistic type: %s", typeArg)
+	}
+	var finalSQL string
+	if tokenAddress == nil {
+		finalSQL = fmt.Sprintf("SELECT COALESCE(%s, 0) FROM swap_events %s", operation, compositeFilters)
+	} else {
+		finalSQL = fmt.Sprintf("SELECT COALESCE(%s, 0) FROM (SELECT * FROM swap_events %s) swaps WHERE EXISTS (SELECT 1 FROM token_indices ti WHERE ti.chain_id = swaps.chain_id AND ti.contract_address = swaps.contract_address AND ti.token_index = %s AND ti.token_address = '%s')", operation, compositeFilters, q.swapTokenIndex("swaps", true), *tokenAddress)
+	}
+	return &finalSQL, nil
+}
+
+func (q sqlQueries) amountStatisticMessageBus(typeArg model.StatisticType, compositeFilters string) (*string, error) {
+	var operation string
+	switch typeArg {
+	case model.StatisticTypeMeanVolumeUsd, model.StatisticTypeMedianVolumeUsd, model.StatisticTypeTotalVolumeUsd:
+		return nil, fmt.Errorf("cannot calculate volume data for messagebus events")
+	case model.StatisticTypeCountTransactions:
+		operation = q.uniq(sql.ChainIDFieldName, sql.TxHashFieldName)
+	case model.StatisticTypeCountAddresses:
+		operation = q.uniq(sql.ChainIDFieldName, "source_address")
+	case model.StatisticTypeMeanFeeUsd:
+		operation = fmt.Sprintf("AVG(%s)", sql.FeeUSDFieldName)
+	case model.StatisticTypeMedianFeeUsd:
+		operation = q.median(sql.FeeUSDFieldName)
+	case model.StatisticTypeTotalFeeUsd:
+		operation = fmt.Sprintf("SUM(%s)", sql.FeeUSDFieldName)
+	default:
+		return nil, fmt.Errorf("invalid statistic type: %s", typeArg)
+	}
+	finalSQL := fmt.Sprintf("SELECT COALESCE(%s, 0) FROM message_bus_events %s", operation, compositeFilters)
+	return &finalSQL, nil
+}
+
+// dailyByChain pivots the (date, chain_id, total) rows of each platform into a DateResultByChain per day.
+func (q sqlQueries) dailyByChain(platforms ...string) *string {
+	columns := make([]string, 0, len(dailyChains)+2)
+	columns = append(columns, "date")
+	for _, chain := range dailyChains {
+		columns = append(columns, fmt.Sprintf("COALESCE(MAX(CASE WHEN chain_id = %d THEN total END), 0) AS %s", chain.chainID, chain.name))
+	}
+	columns = append(columns, "COALESCE(SUM(total), 0) AS total")
+	query := fmt.Sprintf("SELECT %s FROM (SELECT date, chain_id, SUM(total) AS total FROM (%s) platforms GROUP BY date, chain_id) totals GROUP BY date ORDER BY date", strings.Join(columns, ", "), strings.Join(platforms, " UNION ALL "))
+	return &query
+}
+
+// daily is the (date, chain_id, total) rows of a platform.
+func (q sqlQueries) daily(table, timestamp, chainID, total, filters string) string {
+	return fmt.Sprintf("SELECT %s AS date, %s AS chain_id, %s AS total FROM %s %s GROUP BY 1, 2", q.date(timestamp), chainID, total, table, filters)
+}
+
+func (q sqlQueries) dailyBridge(typeArg *model.DailyStatisticType, compositeFiltersMv string, allPlatforms bool) (string, error) {
+	switch *typeArg {
+	case model.DailyStatisticTypeVolume:
+		return q.daily("mv_bridge_events", "ftimestamp", "fchain_id", "SUM(famount_usd)", compositeFiltersMv), nil
+	case model.DailyStatisticTypeFee:
+		// fees are collected on the destination chain, across all platforms they are dated by the origin.
+		timestamp := "ttimestamp"
+		if allPlatforms {
+			timestamp = "ftimestamp"
+		}
+		return q.daily("mv_bridge_events", timestamp, "tchain_id", "SUM(tfee_amount_usd)", compositeFiltersMv), nil
+	case model.DailyStatisticTypeAddresses:
+		return q.daily("mv_bridge_events", "ftimestamp", "fchain_id", q.uniq("fchain_id", "fsender"), compositeFiltersMv), nil
+	case model.DailyStatisticTypeTransactions:
+		return q.daily("mv_bridge_events", "ftimestamp", "fchain_id", q.uniq("fchain_id", "ftx_hash"), compositeFiltersMv), nil
+	default:
+		return "", fmt.Errorf("unsupported statistic type")
+	}
+}
+
+func (q sqlQueries) dailySwap(typeArg *model.DailyStatisticType, compositeFilters string) (string, error) {
+	switch *typeArg {
+	case model.DailyStatisticTypeVolume:
+		return q.daily("swap_events", "timestamp", "chain_id", fmt.Sprintf("SUM(%s)", q.swapVolume()), compositeFilters), nil
+	case model.DailyStatisticTypeFee:
+		return q.daily("swap_events", "timestamp", "chain_id", fmt.Sprintf("SUM(%s)", q.mapSum(sql.FeeUSDFieldName)), compositeFilters), nil
+	case model.DailyStatisticTypeAddresses:
+		return q.daily("swap_events", "timestamp", "chain_id", q.uniq(sql.ChainIDFieldName, sql.SenderFieldName), compositeFilters), nil
+	case model.DailyStatisticTypeTransactions:
+		return q.daily("swap_events", "timestamp", "chain_id", q.uniq(sql.ChainIDFieldName, sql.TxHashFieldName), compositeFilters), nil
+	default:
+		return "", fmt.Errorf("unsupported statistic type")
+	}
+}
+
+func (q sqlQueries) dailyMessageBus(typeArg *model.DailyStatisticType, compositeFilters string, allPlatforms bool) (string, error) {
+	switch *typeArg {
+	case model.DailyStatisticTypeVolume:
+		return "", fmt.Errorf("cannot calculate volume for messagebus")
+	case model.DailyStatisticTypeFee:
+		return q.daily("message_bus_events", "timestamp", "chain_id", fmt.Sprintf("SUM(%s)", sql.FeeUSDFieldName), compositeFilters), nil
+	case model.DailyStatisticTypeAddresses:
+		// across all platforms messages count towards the address they are sent from.
+		address := sql.SenderFieldName
+		if allPlatforms {
+			address = "source_address"
+		}
+		return q.daily("message_bus_events", "timestamp", "chain_id", q.uniq(sql.ChainIDFieldName, address), compositeFilters), nil
+	case model.DailyStatisticTypeTransactions:
+		return q.daily("message_bus_events", "timestamp", "chain_id", q.uniq(sql.ChainIDFieldName, sql.TxHashFieldName), compositeFilters), nil
+	default:
+		return "", fmt.Errorf("unsupported statistic type")
+	}
+}
+
+func (q sqlQueries) dailyStatisticBridge(typeArg *model.DailyStatisticType, compositeFiltersMv string) (*string, error) {
+	bridge, err := q.dailyBridge(typeArg, compositeFiltersMv, false)
+	if err != nil {
+		return nil, err
+	}
+	return q.dailyByChain(bridge), nil
+}
+
+func (q sqlQueries) dailyStatisticSwap(typeArg *model.DailyStatisticType, compositeFilters string) (*string, error) {
+	swap, err := q.dailySwap(typeArg, compositeFilters)
+	if err != nil {
+		return nil, err
+	}
+	return q.dailyByChain(swap), nil
+}
+
+func (q sqlQueries) dailyStatisticMessageBus(typeArg *model.DailyStatisticType, compositeFilters string) (*string, error) {
+	messageBus, err := q.dailyMessageBus(typeArg, compositeFilters, false)
+	if err != nil {
+		return nil, err
+	}
+	return q.dailyByChain(messageBus), nil
+}
+
+func (q sqlQueries) dailyStatisticAll(typeArg *model.DailyStatisticType, compositeFilters string, compositeFiltersMv string) (*string, error) {
+	bridge, err := q.dailyBridge(typeArg, compositeFiltersMv, true)
+	if err != nil {
+		return nil, err
+	}
+	swap, err := q.dailySwap(typeArg, compositeFilters)
+	if err != nil {
+		return nil, err
+	}
+	if *typeArg == model.DailyStatisticTypeVolume {
+		return q.dailyByChain(bridge, swap), nil
+	}
+	messageBus, err := q.dailyMessageBus(typeArg, compositeFilters, true)
+	if err != nil {
+		return nil, err
+	}
+	return q.dailyByChain(bridge, swap, messageBus), nil
+}
+
+func (q sqlQueries) rankedChainsByVolume(duration *model.Duration) string {
+	firstFilter := true
+	bridgeFilters := GetDurationFilter(duration, &firstFilter, "f")
+	firstFilter = true
+	swapFilters := GetDurationFilter(duration, &firstFilter, "")
+	return fmt.Sprintf("SELECT chain_id, COALESCE(SUM(total), 0) AS total FROM (SELECT fchain_id AS chain_id, SUM(famount_usd) AS total FROM mv_bridge_events %s GROUP BY fchain_id UNION ALL SELECT chain_id, SUM(%s) AS total FROM swap_events %s GROUP BY chain_id) volumes GROUP BY chain_id ORDER BY total DESC, chain_id", bridgeFilters, q.swapVolume(), swapFilters)
+}
+
+func (q sqlQueries) addressData(address string) addressDataQueries {
+	return addressDataQueries{
+		bridge:       fmt.Sprintf(`SELECT COALESCE(SUM(famount_usd), 0) AS "volumeTotal", COALESCE(SUM(tfee_amount_usd), 0) AS "feeTotal", %s AS "txTotal" FROM mv_bridge_events WHERE fsender = '%s'`, q.uniq("fchain_id", "ftx_hash"), address),
+		swap:         fmt.Sprintf(`SELECT COALESCE(SUM(%s), 0) AS "volumeTotal", COALESCE(SUM(%s), 0) AS "feeTotal", %s AS "txTotal" FROM swap_events WHERE sender = '%s'`, q.swapVolume(), q.mapSum(sql.FeeUSDFieldName), q.uniq("chain_id", "tx_hash"), address),
+		ranking:      fmt.Sprintf("SELECT row_num FROM (SELECT fsender AS sender, ROW_NUMBER() OVER (ORDER BY SUM(famount_usd) DESC, fsender) AS row_num FROM mv_bridge_events WHERE fsender != '' GROUP BY fsender) ranked WHERE sender = '%s'", address),
+		firstTx:      fmt.Sprintf("SELECT COALESCE(MIN(ftimestamp), 0) FROM mv_bridge_events WHERE fsender = '%s'", address),
+		dailyData:    fmt.Sprintf("SELECT date, SUM(total) AS count FROM (SELECT %s AS date, %s AS total FROM mv_bridge_events WHERE fsender = '%s' GROUP BY 1 UNION ALL SELECT %s AS date, %s AS total FROM swap_events WHERE sender = '%s' GROUP BY 1) daily GROUP BY date ORDER BY date", q.date("ftimestamp"), q.uniq("fchain_id", "ftx_hash"), address, q.date("timestamp"), q.uniq("chain_id", "tx_hash"), address),
+		chainRanking: fmt.Sprintf("SELECT ROW_NUMBER() OVER (ORDER BY SUM(tamount_usd) DESC, tchain_id) AS rank, tchain_id AS chain_id, COALESCE(SUM(tamount_usd), 0) AS volume_usd FROM mv_bridge_events WHERE fsender = '%s' AND tchain_id > 0 GROUP BY tchain_id ORDER BY rank", address),
+	}
+}
+
+func (q sqlQueries) leaderboard(filters string, limit int, offset int) string {
+	return fmt.Sprintf("SELECT ROW_NUMBER() OVER (ORDER BY volume_usd DESC, address) AS rank, ranked.* FROM (SELECT fsender AS address, COALESCE(SUM(famount_usd), 0) AS volume_usd, COALESCE(AVG(famount_usd), 0) AS avg_volume_usd, COUNT(DISTINCT ftx_hash) AS txs, COALESCE(SUM(tfee_amount_usd), 0) AS fees FROM mv_bridge_events WHERE fsender != '' %s GROUP BY fsender) ranked ORDER BY rank LIMIT %d OFFSET %d", filters, limit, offset)
+}
+
+func (q sqlQueries) originBridgeTx(chainID int, txHash string) string {
+	return fmt.Sprintf("SELECT * FROM mv_bridge_events WHERE fchain_id = %d AND ftx_hash = '%s' ORDER BY insert_time DESC LIMIT 1", chainID, txHash)
+}
+
+func (q sqlQueries) destinationBridgeTx(chainID int, kappa string) string {
+	return fmt.Sprintf("SELECT * FROM mv_bridge_events WHERE tchain_id = %d AND tkappa = '%s' ORDER BY insert_time DESC LIMIT 1", chainID, kappa)
+}
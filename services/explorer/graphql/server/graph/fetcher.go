@@ -10,10 +10,12 @@
 	"github.com/jpillora/backoff"
 	"github.com/synapsecns/sanguine/ethergo/client"
 	"github.com/synapsecns/sanguine/services/explorer/backfill"
+	"github.com/synapsecns/sanguine/services/explorer/contracts/fastbridge"
 	"github.com/synapsecns/sanguine/services/explorer/db/sql"
 	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/model"
 	"github.com/synapsecns/sanguine/services/explorer/types/bridge"
 	"github.com/synapsecns/sanguine/services/explorer/types/cctp"
+	fastBridgeTypes "github.com/synapsecns/sanguine/services/explorer/types/fastbridge"
 	"github.com/synapsecns/sanguine/services/scribe/service/indexer"
 	scribeTypes "github.com/synapsecns/sanguine/services/scribe/types"
 	"math/big"
@@ -32,6 +34,11 @@ type ifaceCCTPEvent struct {
 	CCTPEvent *sql.CCTPEvent
 }
 
+type ifaceFastBridgeEvent struct {
+	IFace           fastBridgeTypes.EventLog
+	FastBridgeEvent *sql.FastBridgeEvent
+}
+
 type swapReplacementData struct {
 	Address common.Address
 	Amount  *big.Int
@@ -129,6 +136,44 @@ func (r Resolver) bwOriginFallbackCCTP(ctx context.Context, chainID uint32, txHa
 	}
 }
 
+func (r Resolver) bwOriginFallbackRFQ(ctx context.Context, chainID uint32, txHash string) (*model.BridgeWatcherTx, error) {
+	txFetchContext, cancelTxFetch := context.WithTimeout(ctx, maxTimeToWaitForTx)
+	defer cancelTxFetch()
+	b := &backoff.Backoff{
+		Factor: 2,
+		Jitter: true,
+		Min:    30 * time.Millisecond,
+		Max:    5 * time.Second,
+	}
+	timeout := time.Duration(0)
+	backendClient := r.Clients[chainID]
+	if r.Refs.FastBridgeRefs[chainID] == nil {
+		return nil, fmt.Errorf("fastbridge contract not set for chain %d", chainID)
+	}
+	contractAddress := r.Refs.FastBridgeRefs[chainID].Address().String()
+
+	for {
+		select {
+		case <-txFetchContext.Done():
+			return nil, fmt.Errorf("context canceled: %w", ctx.Err())
+		case <-time.After(timeout):
+			receipt, err := backendClient.TransactionReceipt(txFetchContext, common.HexToHash(txHash))
+			if err != nil {
+				timeout = b.Duration()
+				logger.Errorf("Could not get receipt on chain %d Error: %v", chainID, err)
+				continue
+			}
+			var logs []ethTypes.Log
+			for _, log := range receipt.Logs {
+				if log.Address.String() == contractAddress {
+					logs = append(logs, *log)
+				}
+			}
+			return r.parseAndStoreLogRFQ(txFetchContext, chainID, logs)
+		}
+	}
+}
+
 // nolint:gocognit,cyclop
 func (r Resolver) bwDestinationFallback(ctx context.Context, chainID uint32, address string, identifier string, timestamp int, historical bool, bridgeType model.BridgeType) (*model.BridgeWatcherTx, error) {
 	txFetchContext, cancelTxFetch := context.WithTimeout(ctx, maxTimeToWaitForTx)
@@ -157,6 +202,11 @@ func (r Resolver) bwDestinationFallback(ctx context.Context, chainID uint32, add
 		if !r.checkRequestIDExists(txFetchContext, identifier, chainID) {
 			return nil, fmt.Errorf(kappaDoesNotExist)
 		}
+	case model.BridgeTypeRfq:
+		contractAddress = r.Refs.FastBridgeRefs[chainID].Address()
+		if !r.checkTransactionRelayed(txFetchContext, identifier, chainID) {
+			return nil, fmt.Errorf(kappaDoesNotExist)
+		}
 	}
 
 	// Start trying to fetch logs
@@ -187,6 +237,10 @@ func (r Resolver) bwDestinationFallback(ctx context.Context, chainID uint32, add
 				toKappaTopic := common.HexToHash(fmt.Sprintf("0x%s", identifier))
 				topics = append(topics, []common.Hash{toKappaTopic})
 			}
+			if bridgeType == model.BridgeTypeRfq { // relays are indexed by transaction id rather than recipient
+				transactionIDTopic := common.HexToHash(fmt.Sprintf("0x%s", identifier))
+				topics = [][]common.Hash{{fastbridge.BridgeRelayedTopic}, {transactionIDTopic}}
+			}
 
 			indexerConfig := &scribeTypes.IndexerConfig{
 				Addresses:            []common.Address{contractAddress},
@@ -216,7 +270,7 @@ func (r Resolver) bwDestinationFallback(ctx context.Context, chainID uint32, add
 				}
 				return bwBridgeToBWTx(bridgeEvent, model.BridgeTxTypeDestination)
 
-			case model.BridgeTypeCctp:
+			case model.BridgeTypeCctp, model.BridgeTypeRfq:
 				bridgeEvent, ok := maturedBridgeEvent.(sql.BridgeEvent)
 				if !ok {
 					logger.Errorf("type assertion failed when converting bridge event")
@@ -335,16 +389,39 @@ func (r Resolver) parseAndStoreLogCCTP(ctx context.Context, chainID uint32, logs
 	return bwBridgeToBWTx(&bridgeEvent, model.BridgeTxTypeOrigin)
 }
 
+func (r Resolver) parseAndStoreLogRFQ(ctx context.Context, chainID uint32, logs []ethTypes.Log) (*model.BridgeWatcherTx, error) {
+	parsedLogs, err := backfill.ProcessLogs(ctx, logs, chainID, r.Parsers.FastBridgeParsers[chainID])
+	if err != nil || len(parsedLogs) == 0 {
+		return nil, fmt.Errorf("could not parse logs: %w", err)
+	}
+
+	// a request is the only origin log converted to a bridge event
+	var bridgeEvent *sql.BridgeEvent
+	for _, log := range parsedLogs {
+		if converted, ok := log.(sql.BridgeEvent); ok {
+			bridgeEvent = &converted
+		}
+	}
+	if bridgeEvent == nil {
+		return nil, fmt.Errorf("no bridge request found in logs")
+	}
+	go r.storeBridgeEvent(bridgeEvent)
+
+	return bwBridgeToBWTx(bridgeEvent, model.BridgeTxTypeOrigin)
+}
+
 // nolint:cyclop,gocognit
 func (r Resolver) getAndParseLogs(ctx context.Context, logFetcher *indexer.LogFetcher, chainID uint32, kappa string, bridgeType model.BridgeType) (interface{}, error) {
 	streamLogsCtx, cancelStreamLogs := context.WithCancel(ctx)
 	logsChan := *logFetcher.GetFetchedLogsChan()
 	destinationData := make(chan *ifaceBridgeEvent, 1)
 	destinationDataCCTP := make(chan *ifaceCCTPEvent, 1)
+	destinationDataRFQ := make(chan *ifaceFastBridgeEvent, 1)
 
 	closeDestinationChannels := func() {
 		close(destinationData)
 		close(destinationDataCCTP)
+		close(destinationDataRFQ)
 	}
 	errorChan := make(chan error)
 
@@ -396,6 +473,20 @@ func (r Resolver) getAndParseLogs(ctx context.Context, logFetcher *indexer.LogFe
 						}
 						return
 					}
+
+				case model.BridgeTypeRfq:
+					fastBridgeEvent, iFace, err := r.Parsers.FastBridgeParsers[chainID].ParseLog(log, chainID)
+					if err != nil {
+						logger.Errorf("could not parse log: %v", err)
+						continue
+					}
+					if fastBridgeEvent.EventType == fastBridgeTypes.BridgeRelayedEvent.Int() && fastBridgeEvent.TransactionID == kappa {
+						destinationDataRFQ <- &ifaceFastBridgeEvent{
+							IFace:           iFace,
+							FastBridgeEvent: fastBridgeEvent,
+						}
+						return
+					}
 				}
 
 			case streamErr, ok := <-errorChan:
@@ -425,6 +516,13 @@ func (r Resolver) getAndParseLogs(ctx context.Context, logFetcher *indexer.LogFe
 			return nil, fmt.Errorf("no cctp log found with request id %s", kappa)
 		}
 		bridgeEvent, err = r.Parsers.CCTParsers[chainID].MatureLogs(ctx, ifaceCctpEvent.CCTPEvent, ifaceCctpEvent.IFace, chainID)
+
+	case model.BridgeTypeRfq:
+		ifaceRfqEvent, ok := <-destinationDataRFQ
+		if !ok {
+			return nil, fmt.Errorf("no fastbridge relay found with transaction id %s", kappa)
+		}
+		bridgeEvent, err = r.Parsers.FastBridgeParsers[chainID].MatureLogs(ctx, ifaceRfqEvent.FastBridgeEvent, ifaceRfqEvent.IFace, chainID)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("could not mature logs: %w", err)
@@ -488,6 +586,20 @@ func (r Resolver) checkRequestIDExists(ctx context.Context, requestID string, ch
 	return exists
 }
 
+func (r Resolver) checkTransactionRelayed(ctx context.Context, transactionID string, chainID uint32) bool {
+	var transactionIDBytes32 [32]byte
+	transactionIDBytes := common.Hex2Bytes(transactionID)
+	copy(transactionIDBytes32[:], transactionIDBytes)
+	relayed, err := r.Refs.FastBridgeRefs[chainID].BridgeRelays(&bind.CallOpts{
+		Context: ctx,
+	}, transactionIDBytes32)
+	if err != nil {
+		logger.Errorf("Could not check if transaction was relayed on chain %d. Error: %v", chainID, err)
+		return false
+	}
+	return relayed
+}
+
 // will ignore non-bridge events
 func (r Resolver) storeBridgeEvent(bridgeEvent interface{}) {
 	storeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
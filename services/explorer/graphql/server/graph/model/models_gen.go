@@ -167,6 +167,11 @@ type PartialMessageBusInfo struct {
 	RevertedReason       *string     `json:"revertedReason,omitempty"`
 }
 
+type PendingCountByChain struct {
+	ChainID *int `json:"chainID,omitempty"`
+	Count   *int `json:"count,omitempty"`
+}
+
 type PetType struct {
 	Recipient string `json:"recipient"`
 	PetID     string `json:"petID"`
@@ -257,16 +262,18 @@ func (e BridgeTxType) MarshalGQL(w io.Writer) {
 const (
 	BridgeTypeBridge BridgeType = "BRIDGE"
 	BridgeTypeCctp   BridgeType = "CCTP"
+	BridgeTypeRfq    BridgeType = "RFQ"
 )
 
 var AllBridgeType = []BridgeType{
 	BridgeTypeBridge,
 	BridgeTypeCctp,
+	BridgeTypeRfq,
 }
 
 func (e BridgeType) IsValid() bool {
 	switch e {
-	case BridgeTypeBridge, BridgeTypeCctp:
+	case BridgeTypeBridge, BridgeTypeCctp, BridgeTypeRfq:
 		return true
 	}
 	return false
@@ -298,16 +305,18 @@ func (e BridgeType) MarshalGQL(w io.Writer) {
 const (
 	ContractTypeBridge ContractType = "BRIDGE"
 	ContractTypeCctp   ContractType = "CCTP"
+	ContractTypeRfq    ContractType = "RFQ"
 )
 
 var AllContractType = []ContractType{
 	ContractTypeBridge,
 	ContractTypeCctp,
+	ContractTypeRfq,
 }
 
 func (e ContractType) IsValid() bool {
 	switch e {
-	case ContractTypeBridge, ContractTypeCctp:
+	case ContractTypeBridge, ContractTypeCctp, ContractTypeRfq:
 		return true
 	}
 	return false
@@ -19,7 +19,7 @@
 // BridgeTransactions is the resolver for the bridgeTransactions2 field.
 func (r *queryResolver) BridgeTransactions(ctx context.Context, chainIDFrom []*int, chainIDTo []*int, addressFrom *string, addressTo *string, maxAmount *int, minAmount *int, maxAmountUsd *int, minAmountUsd *int, startTime *int, endTime *int, txnHash *string, kappa *string, pending *bool, useMv *bool, page *int, tokenAddressFrom []*string, tokenAddressTo []*string, onlyCctp *bool) ([]*model.BridgeTransaction, error) {
 	var results []*model.BridgeTransaction
-	if useMv != nil && *useMv {
+	if (useMv != nil && *useMv) || r.onlyMv() {
 		var mvResults []*model.BridgeTransaction
 		var err error
 		mvResults, err = r.GetBridgeTxs(ctx, chainIDFrom, chainIDTo, addressFrom, addressTo, maxAmount, minAmount, maxAmountUsd, minAmountUsd, startTime, endTime, txnHash, tokenAddressTo, tokenAddressFrom, kappa, pending, onlyCctp, page)
@@ -72,7 +72,7 @@ func (r *queryResolver) MessageBusTransactions(ctx context.Context, chainID []*i
 func (r *queryResolver) CountByChainID(ctx context.Context, chainID *int, address *string, direction *model.Direction, hours *int) ([]*model.TransactionCountResult, error) {
 	directionIn := r.getDirectionIn(direction)
 	targetTime := GetTargetTime(hours)
-	results, err := r.DB.GetTxCounts(ctx, generateBridgeEventCountQuery(chainID, address, nil, directionIn, &targetTime, false))
+	results, err := r.DB.GetTxCounts(ctx, r.queries().bridgeEventCount(chainID, address, directionIn, &targetTime, false))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get count by chain ID: %w", err)
 	}
@@ -83,7 +83,7 @@ func (r *queryResolver) CountByChainID(ctx context.Context, chainID *int, addres
 func (r *queryResolver) CountByTokenAddress(ctx context.Context, chainID *int, address *string, direction *model.Direction, hours *int) ([]*model.TokenCountResult, error) {
 	directionIn := r.getDirectionIn(direction)
 	targetTime := GetTargetTime(hours)
-	results, err := r.DB.GetTokenCounts(ctx, generateBridgeEventCountQuery(chainID, address, nil, directionIn, &targetTime, true))
+	results, err := r.DB.GetTokenCounts(ctx, r.queries().bridgeEventCount(chainID, address, directionIn, &targetTime, true))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get count by chain ID: %w", err)
@@ -95,12 +95,7 @@ func (r *queryResolver) CountByTokenAddress(ctx context.Context, chainID *int, a
 // AddressRanking is the resolver for the addressRanking field.
 func (r *queryResolver) AddressRanking(ctx context.Context, hours *int) ([]*model.AddressRanking, error) {
 	targetTime := GetTargetTime(hours)
-	firstFilter := true
-	timeStampSpecifier := generateTimestampSpecifierSQL(&targetTime, sql.TimeStampFieldName, &firstFilter, "")
-	directionSpecifier := generateDirectionSpecifierSQL(true, &firstFilter, "")
-	compositeFilters := fmt.Sprintf("%s%s", timeStampSpecifier, directionSpecifier)
-	query := fmt.Sprintf(`SELECT %s AS address, COUNT(DISTINCT %s) AS Count FROM (%s) GROUP BY %s ORDER BY Count Desc`, sql.SenderFieldName, sql.TxHashFieldName, generateDeDepQuery(compositeFilters, nil, nil), sql.SenderFieldName)
-	res, err := r.DB.GetAddressRanking(ctx, query)
+	res, err := r.DB.GetAddressRanking(ctx, r.queries().addressRanking(targetTime))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get count by chain ID: %w", err)
 	}
@@ -130,12 +125,12 @@ func (r *queryResolver) AmountStatistic(ctx context.Context, typeArg model.Stati
 	var finalSQL *string
 	switch *platform {
 	case model.PlatformBridge:
-		finalSQL, err = GenerateAmountStatisticBridgeSQL(typeArg, address, chainID, tokenAddress)
+		finalSQL, err = r.queries().amountStatisticBridge(typeArg, address, chainID, tokenAddress)
 		if err != nil {
 			return nil, err
 		}
 	case model.PlatformSwap:
-		finalSQL, err = GenerateAmountStatisticSwapSQL(typeArg, compositeFilters, tokenAddress)
+		finalSQL, err = r.queries().amountStatisticSwap(typeArg, compositeFilters, tokenAddress)
 		if err != nil {
 			return nil, err
 		}
@@ -144,7 +139,7 @@ func (r *queryResolver) AmountStatistic(ctx context.Context, typeArg model.Stati
 			return nil, fmt.Errorf("cannot filter by token on message bus events")
 		}
 
-		finalSQL, err = GenerateAmountStatisticMessageBusSQL(typeArg, compositeFilters)
+		finalSQL, err = r.queries().amountStatisticMessageBus(typeArg, compositeFilters)
 
 		if err != nil {
 			return nil, err
@@ -200,7 +195,7 @@ func (r *queryResolver) DailyStatisticsByChain(ctx context.Context, chainID *int
 		}
 	}
 
-	if useMv != nil && *useMv {
+	if (useMv != nil && *useMv) || r.onlyMv() {
 		mvResult, err := r.getDateResultByChainMv(ctx, chainID, typeArg, platform, duration)
 		if err != nil {
 			return nil, fmt.Errorf("error getting mv data, %w", err)
@@ -263,10 +258,7 @@ func (r *queryResolver) DailyStatisticsByChain(ctx context.Context, chainID *int
 // RankedChainIDsByVolume is the resolver for the rankedChainIDsByVolume field.
 func (r *queryResolver) RankedChainIDsByVolume(ctx context.Context, duration *model.Duration, useCache *bool) ([]*model.VolumeByChainID, error) {
 	var err error
-	firstFilter := true
-	timestampSpecifier := GetDurationFilter(duration, &firstFilter, "")
-
-	query := GenerateRankedChainsByVolumeSQL(timestampSpecifier, &firstFilter)
+	query := r.queries().rankedChainsByVolume(duration)
 
 	var res []*model.VolumeByChainID
 	g, groupCtx := errgroup.WithContext(ctx)
@@ -290,12 +282,7 @@ func (r *queryResolver) RankedChainIDsByVolume(ctx context.Context, duration *mo
 
 // AddressData is the resolver for the addressData field.
 func (r *queryResolver) AddressData(ctx context.Context, address string) (*model.AddressData, error) {
-	bridgeQuery := fmt.Sprintf("SELECT toFloat64(sumKahan(famount_usd)) AS volumeTotal, toFloat64(sumKahan(tfee_amount_usd)) AS feeTotal, toInt64(uniq(fchain_id, ftx_hash)) AS txTotal FROM (SELECT * FROM mv_bridge_events where fsender = '%s' LIMIT 1 BY fchain_id,fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", address)
-	swapQuery := fmt.Sprintf("SELECT toFloat64(sumKahan(multiIf(event_type = 0, amount_usd[sold_id], event_type = 1, arraySum(mapValues(amount_usd)), event_type = 9, arraySum(mapValues(amount_usd)), event_type = 10, amount_usd[sold_id],0))) AS volumeTotal, toFloat64(sumKahan(arraySum(mapValues(fee_usd)))) AS feeTotal,  toInt64(uniq(chain_id, tx_hash)) AS txTotal FROM (SELECT * FROM swap_events where sender = '%s' LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash)", address)
-	rankingQuery := fmt.Sprintf("select rowNumber from (select sender, row_number() over (order by sumTotal desc ) as rowNumber from (select fsender as sender, sumKahan(famount_usd) as sumTotal from (SELECT * FROM mv_bridge_events where fsender != '' LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) where fsender != '' group by fsender)) where sender = '%s'", address)
-	firstTx := fmt.Sprintf("SELECT min(ftimestamp) AS earliestTime FROM (SELECT * FROM mv_bridge_events where fsender = '%s' LIMIT 1 BY fchain_id,fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", address)
-	dailyDataQuery := fmt.Sprintf("SELECT coalesce(toString(date), toString(s.date)) AS date, toFloat64(coalesce(sumTotal, 0)) + toFloat64(coalesce(s.sumTotal, 0)) as count FROM (SELECT * FROM (SELECT %s, uniq(fchain_id, ftx_hash) AS sumTotal FROM (SELECT * FROM mv_bridge_events where fsender = '%s' LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) group by date order by date) b FULL OUTER JOIN (SELECT %s, uniq(chain_id, tx_hash) AS sumTotal FROM (SELECT * FROM swap_events WHERE sender = '%s' LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date) s ON b.date = s.date) SETTINGS join_use_nulls=1", toDateSelectMv, address, toDateSelect, address)
-	chainRankingQuery := fmt.Sprintf("SELECT row_number() over (order by VolumeUsd desc ) as Rank, tchain_id as ChainID, sumKahan(tamount_usd) AS VolumeUsd FROM (SELECT * FROM mv_bridge_events where fsender = '%s' LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) where ChainID > 0 group by ChainID", address)
+	queries := r.queries().addressData(address)
 	var bridgeVolume float64
 	var bridgeFees float64
 	var bridgeTxs int
@@ -311,7 +298,7 @@ func (r *queryResolver) AddressData(ctx context.Context, address string) (*model
 	g, groupCtx := errgroup.WithContext(ctx)
 	g.Go(func() error {
 		var err error
-		bridgeVolume, bridgeFees, bridgeTxs, err = r.DB.GetAddressData(groupCtx, bridgeQuery)
+		bridgeVolume, bridgeFees, bridgeTxs, err = r.DB.GetAddressData(groupCtx, queries.bridge)
 		if err != nil {
 			return fmt.Errorf("failed to get bridge data for address %s: %w", address, err)
 		}
@@ -319,14 +306,14 @@ func (r *queryResolver) AddressData(ctx context.Context, address string) (*model
 	})
 	g.Go(func() error {
 		var err error
-		swapVolume, swapFees, swapTxs, err = r.DB.GetAddressData(groupCtx, swapQuery)
+		swapVolume, swapFees, swapTxs, err = r.DB.GetAddressData(groupCtx, queries.swap)
 		if err != nil {
 			return fmt.Errorf("failed to get swap data for address %s: %w", address, err)
 		}
 		return nil
 	})
 	g.Go(func() error {
-		res, err := r.DB.GetUint64(groupCtx, rankingQuery)
+		res, err := r.DB.GetUint64(groupCtx, queries.ranking)
 		if err != nil {
 			return fmt.Errorf("failed to get ranking for address %s: %w", address, err)
 		}
@@ -334,7 +321,7 @@ func (r *queryResolver) AddressData(ctx context.Context, address string) (*model
 		return nil
 	})
 	g.Go(func() error {
-		res, err := r.DB.GetUint64(groupCtx, firstTx)
+		res, err := r.DB.GetUint64(groupCtx, queries.firstTx)
 		if err != nil {
 			return fmt.Errorf("failed to get first timestamp for address %s: %w", address, err)
 		}
@@ -343,7 +330,7 @@ func (r *queryResolver) AddressData(ctx context.Context, address string) (*model
 	})
 	g.Go(func() error {
 		var err error
-		addressDailyData, err = r.DB.GetAddressDailyData(groupCtx, dailyDataQuery)
+		addressDailyData, err = r.DB.GetAddressDailyData(groupCtx, queries.dailyData)
 		if err != nil {
 			return fmt.Errorf("failed to get first daily data for address %s: %w", address, err)
 		}
@@ -352,7 +339,7 @@ func (r *queryResolver) AddressData(ctx context.Context, address string) (*model
 
 	g.Go(func() error {
 		var err error
-		addressChainRanking, err = r.DB.GetAddressChainRanking(groupCtx, chainRankingQuery)
+		addressChainRanking, err = r.DB.GetAddressChainRanking(groupCtx, queries.chainRanking)
 		if err != nil {
 			return fmt.Errorf("failed to get ranking for address %s: %w", address, err)
 		}
@@ -389,7 +376,7 @@ func (r *queryResolver) Leaderboard(ctx context.Context, duration *model.Duratio
 	pageValue := sql.PageSize
 	pageOffset := (*page - 1) * sql.PageSize
 	filters := timestampSpecifier + chainIDSpecifier
-	leaderboardQuery := fmt.Sprintf("select row_number() over (order by VolumeUsd desc ) as Rank, * from (select fsender as Address, toFloat64(sumKahan(famount_usd)) as VolumeUsd,toFloat64(avg(famount_usd)) as AvgVolumeUsd, count(DISTINCT ftx_hash) as Txs,toFloat64(sumKahan(tfee_amount_usd)) as Fees from (SELECT * FROM mv_bridge_events where fsender != '' LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) where fsender != '' %s group by fsender) LIMIT %d OFFSET %d", filters, pageValue, pageOffset)
+	leaderboardQuery := r.queries().leaderboard(filters, pageValue, pageOffset)
 	leaderboardRes, err := r.DB.GetLeaderboard(ctx, leaderboardQuery)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get leaderboard %w", err)
@@ -699,7 +699,7 @@ func GetPartialInfoFromBridgeEventHybrid(bridgeEvent sql.HybridBridgeEvent, incl
 	return &bridgeTx, nil
 }
 
-func generateMessageBusQuery(chainID []*int, address *string, startTime *int, endTime *int, messageID *string, pending bool, reverted bool, txHash *string, page int) string {
+func generateMessageBusQuery(q backendQueries, chainID []*int, address *string, startTime *int, endTime *int, messageID *string, pending bool, reverted bool, txHash *string, page int) (string, error) {
 	firstFilter := true
 
 	chainIDSpecifier := generateSingleSpecifierI32ArrSQL(chainID, sql.ChainIDFieldName, &firstFilter, "")
@@ -710,24 +710,11 @@ func generateMessageBusQuery(chainID []*int, address *string, startTime *int, en
 	addressSpecifier := generateAddressSpecifierSQL(address, &firstFilter, "")
 	messageIDSpecifier := generateSingleSpecifierStringSQL(messageID, "message_id", &firstFilter, "")
 	txHashSpecifier := generateSingleSpecifierStringSQL(txHash, sql.TxHashFieldName, &firstFilter, "")
-	operation := " = ''"
-	if !pending {
-		operation = " != ''"
-	}
-	pendingSpecifier := fmt.Sprintf(" WHERE t.message_id %s", operation)
 	compositeFilters := chainIDSpecifier + minTimeSpecfier + maxTimeSpecfier + addressSpecifier + messageIDSpecifier + txHashSpecifier
-	pageValue := sql.PageSize
-	pageOffset := (page - 1) * sql.PageSize
-
-	cte := fmt.Sprintf("WITH baseQuery AS (SELECT * FROM message_bus_events %s ORDER BY timestamp DESC, block_number DESC, event_index DESC, insert_time DESC LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash), (SELECT min(timestamp) FROM baseQuery) AS minTimestamp", compositeFilters)
 
-	finalQuery := fmt.Sprintf("%s SELECT * FROM (SELECT * FROM (SELECT * FROM %s WHERE %s = 1 ) f LEFT JOIN (SELECT * FROM (%s) WHERE %s = 0) t ON f.%s = t.%s %s)  LIMIT %d OFFSET %d", cte, "baseQuery", sql.EventTypeFieldName, baseMessageBus, sql.EventTypeFieldName, "message_id", "message_id", pendingSpecifier, pageValue, pageOffset)
-
-	if reverted {
-		finalQuery = fmt.Sprintf("%s SELECT * FROM  (SELECT * FROM (select * from (%s) WHERE %s = 1) f RIGHT OUTER JOIN (Select r.reverted_reason AS reverted_reason, j.reverted_reason AS rrr, * FROM (select * from %s WHERE event_type = 0 and status = 'Fail') j LEFT JOIN (select reverted_reason, tx_hash from (%s) WHERE %s = 2) r on j.tx_hash = r.tx_hash) t ON f.%s = t.%s)  LIMIT %d OFFSET %d", cte, baseMessageBus, sql.EventTypeFieldName, "baseQuery", baseMessageBus, sql.EventTypeFieldName, "message_id", "message_id", pageValue, pageOffset)
-	}
-	return finalQuery
+	return q.messageBusTxs(compositeFilters, pending, reverted, page)
 }
+
 func generateAllBridgeEventsQueryFromDestination(chainIDTo []*int, chainIDFrom []*int, addressFrom *string, addressTo *string, maxAmount *int, minAmount *int, maxAmountUsd *int, minAmountUsd *int, startTime *int, endTime *int, tokenAddressFrom []*string, tokenAddressTo []*string, kappa *string, txHash *string, onlyCctp *bool, page int, in bool) string {
 	firstFilter := true
 	chainIDToFilter := generateSingleSpecifierI32ArrSQL(chainIDTo, sql.ChainIDFieldName, &firstFilter, "")
@@ -764,7 +751,7 @@ func generateAllBridgeEventsQueryFromDestination(chainIDTo []*int, chainIDFrom [
 	return fmt.Sprintf("%s SELECT * FROM (SELECT %s FROM %s %s %s %s) %s LIMIT %d OFFSET %d", generateDeDepQueryCTE(toFilters, nil, nil, false), destToOriginCol, "baseQuery", destToOriginJoinsPt1, fromFilters, destToOriginJoinsPt2, postJoinFilters, pageValue, pageOffset)
 }
 
-func generateAllBridgeEventsQueryFromDestinationMv(chainIDTo []*int, addressTo *string, minAmount *int, minAmountUsd *int, startTime *int, endTime *int, tokenAddressTo []*string, kappa *string, txHash *string, pending *bool, page int) string {
+func generateAllBridgeEventsQueryFromDestinationMv(q backendQueries, chainIDTo []*int, addressTo *string, minAmount *int, minAmountUsd *int, startTime *int, endTime *int, tokenAddressTo []*string, kappa *string, txHash *string, pending *bool, page int) string {
 	firstFilter := true
 	chainIDToFilter := generateSingleSpecifierI32ArrSQL(chainIDTo, sql.ChainIDFieldName, &firstFilter, "t")
 	minTimeFilter := generateEqualitySpecifierSQL(startTime, sql.TimeStampFieldName, &firstFilter, "t", true)
@@ -772,7 +759,7 @@ func generateAllBridgeEventsQueryFromDestinationMv(chainIDTo []*int, addressTo *
 	addressToFilter := generateRecipientSpecifierSQL(addressTo, &firstFilter, "t")
 	kappaFilter := generateKappaSpecifierSQL(kappa, sql.KappaFieldName, &firstFilter, "t")
 	txHashFilter := generateSingleSpecifierStringSQL(txHash, sql.TxHashFieldName, &firstFilter, "t")
-	minAmountFilter := generateEqualitySpecifierSQL(minAmount, "tamount", &firstFilter, "", true)
+	minAmountFilter := generateEqualitySpecifierSQL(minAmount, q.numeric("tamount"), &firstFilter, "", true)
 	minAmountFilterUsd := generateEqualitySpecifierSQL(minAmountUsd, "tamount_usd", &firstFilter, "", true)
 	tokenAddressToFilter := generateSingleSpecifierStringArrSQL(tokenAddressTo, "ttoken", &firstFilter, "")
 
@@ -803,10 +790,7 @@ func generateAllBridgeEventsQueryFromDestinationMv(chainIDTo []*int, addressTo *
 
 	toFilters := chainIDToFilter + minTimeFilter + maxTimeFilter + addressToFilter + kappaFilter + txHashFilter + minAmountFilter + minAmountFilterUsd + tokenAddressToFilter + pendingFilter
 
-	pageValue := sql.PageSize
-	pageOffset := (page - 1) * sql.PageSize
-
-	return fmt.Sprintf("SELECT * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash LIMIT %d OFFSET %d ", toFilters, pageValue, pageOffset)
+	return q.mvBridgeTxLegs(toFilters, page)
 }
 
 // generateAllBridgeEventsQueryFromOrigin gets all the filters for query from origin.
@@ -855,7 +839,7 @@ func generateAllBridgeEventsQueryFromOrigin(chainIDFrom []*int, chainIDTo []*int
 // generateAllBridgeEventsQueryFromOriginMv gets all the filters for query from origin.
 //
 // nolint:dupl
-func generateAllBridgeEventsQueryFromOriginMv(chainIDFrom []*int, addressFrom *string, maxAmount *int, maxAmountUsd *int, startTime *int, endTime *int, tokenAddressFrom []*string, txHash *string, kappa *string, pending *bool, page int) string {
+func generateAllBridgeEventsQueryFromOriginMv(q backendQueries, chainIDFrom []*int, addressFrom *string, maxAmount *int, maxAmountUsd *int, startTime *int, endTime *int, tokenAddressFrom []*string, txHash *string, kappa *string, pending *bool, page int) string {
 	firstFilter := true
 	chainIDFromFilter := generateSingleSpecifierI32ArrSQL(chainIDFrom, sql.ChainIDFieldName, &firstFilter, "f")
 	minTimeFilter := generateEqualitySpecifierSQL(startTime, sql.TimeStampFieldName, &firstFilter, "f", true)
@@ -863,7 +847,7 @@ func generateAllBridgeEventsQueryFromOriginMv(chainIDFrom []*int, addressFrom *s
 	addressFromFilter := generateAddressSpecifierSQL(addressFrom, &firstFilter, "f")
 	txHashFilter := generateSingleSpecifierStringSQL(txHash, sql.TxHashFieldName, &firstFilter, "f")
 	tokenAddressFromFilter := generateSingleSpecifierStringArrSQL(tokenAddressFrom, "ftoken", &firstFilter, "")
-	maxAmountFilter := generateEqualitySpecifierSQL(maxAmount, "famount", &firstFilter, "", false)
+	maxAmountFilter := generateEqualitySpecifierSQL(maxAmount, q.numeric("famount"), &firstFilter, "", false)
 	maxAmountFilterUsd := generateEqualitySpecifierSQL(maxAmountUsd, "famount_usd", &firstFilter, "", false)
 	kappaFilter := generateKappaSpecifierSQL(kappa, sql.DestinationKappaFieldName, &firstFilter, "f")
 	// firstFilter = false
@@ -887,18 +871,16 @@ func generateAllBridgeEventsQueryFromOriginMv(chainIDFrom []*int, addressFrom *s
 	}
 
 	fromFilters := chainIDFromFilter + minTimeFilter + maxTimeFilter + addressFromFilter + txHashFilter + tokenAddressFromFilter + maxAmountFilter + maxAmountFilterUsd + pendingFilter + kappaFilter
-	pageValue := sql.PageSize
-	pageOffset := (page - 1) * sql.PageSize
-	return fmt.Sprintf("SELECT * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash LIMIT %d OFFSET %d ", fromFilters, pageValue, pageOffset)
+	return q.mvBridgeTxLegs(fromFilters, page)
 }
-func generateAllBridgeEventsQueryMv(chainIDFrom []*int, chainIDTo []*int, addressFrom *string, addressTo *string, maxAmount *int, minAmount *int, maxAmountUsd *int, minAmountUsd *int, startTime *int, endTime *int, tokenAddressFrom []*string, tokenAddressTo []*string, txHash *string, kappa *string, pending *bool, onlyCctp *bool, page int) string {
+func generateAllBridgeEventsQueryMv(q backendQueries, chainIDFrom []*int, chainIDTo []*int, addressFrom *string, addressTo *string, maxAmount *int, minAmount *int, maxAmountUsd *int, minAmountUsd *int, startTime *int, endTime *int, tokenAddressFrom []*string, tokenAddressTo []*string, txHash *string, kappa *string, pending *bool, onlyCctp *bool, page int) string {
 	firstFilter := true
 	firstInLocale := true
 	chainIDFromFilter := generateSingleSpecifierI32ArrSQLMv(chainIDFrom, sql.ChainIDFieldName, &firstFilter, &firstInLocale, "f")
 	addressFromFilter := generateAddressSpecifierSQLMv(addressFrom, &firstFilter, &firstInLocale, "f")
 	txHashFromFilter := generateSingleSpecifierStringSQLMv(txHash, sql.TxHashFieldName, &firstFilter, &firstInLocale, "f")
 	tokenAddressFromFilter := generateSingleSpecifierStringArrSQLMv(tokenAddressFrom, sql.TokenFieldName, &firstFilter, &firstInLocale, "f")
-	maxAmountFilter := generateEqualitySpecifierSQLMv(maxAmount, sql.AmountFieldName, &firstFilter, &firstInLocale, "f", false)
+	maxAmountFilter := generateEqualitySpecifierSQLMv(maxAmount, q.numeric("f"+sql.AmountFieldName), &firstFilter, &firstInLocale, "", false)
 	maxAmountFilterUsd := generateEqualitySpecifierSQLMv(maxAmountUsd, sql.AmountUSDFieldName, &firstFilter, &firstInLocale, "f", false)
 	kappaFromFilter := generateKappaSpecifierSQLMv(kappa, sql.DestinationKappaFieldName, &firstFilter, &firstInLocale, "f")
 	onlyCCTPFromFilter := generateCCTPSpecifierSQLMv(onlyCctp, false, sql.EventTypeFieldName, &firstFilter, &firstInLocale, "f")
@@ -909,7 +891,7 @@ func generateAllBridgeEventsQueryMv(chainIDFrom []*int, chainIDTo []*int, addres
 	addressToFilter := generateRecipientSpecifierSQLMv(addressTo, &firstFilter, &firstInLocale, "t")
 	txHashToFilter := generateSingleSpecifierStringSQLMv(txHash, sql.TxHashFieldName, &firstFilter, &firstInLocale, "t")
 	tokenAddressToFilter := generateSingleSpecifierStringArrSQLMv(tokenAddressTo, sql.TokenFieldName, &firstFilter, &firstInLocale, "t")
-	minAmountFilter := generateEqualitySpecifierSQLMv(minAmount, sql.AmountFieldName, &firstFilter, &firstInLocale, "t", true)
+	minAmountFilter := generateEqualitySpecifierSQLMv(minAmount, q.numeric("t"+sql.AmountFieldName), &firstFilter, &firstInLocale, "", true)
 	minAmountFilterUsd := generateEqualitySpecifierSQLMv(minAmountUsd, sql.AmountUSDFieldName, &firstFilter, &firstInLocale, "t", true)
 	kappaToFilter := generateKappaSpecifierSQLMv(kappa, sql.KappaFieldName, &firstFilter, &firstInLocale, "t")
 	onlyCCTPToFilter := generateCCTPSpecifierSQLMv(onlyCctp, true, sql.EventTypeFieldName, &firstFilter, &firstInLocale, "t")
@@ -940,9 +922,7 @@ func generateAllBridgeEventsQueryMv(chainIDFrom []*int, chainIDTo []*int, addres
 			pendingFilter = " WHERE tkappa != ''"
 		}
 	}
-	pageValue := sql.PageSize
-	pageOffset := (page - 1) * sql.PageSize
-	return fmt.Sprintf("SELECT * FROM(SELECT * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) %s LIMIT %d OFFSET %d SETTINGS memory_overcommit_ratio_denominator=4000, memory_usage_overcommit_max_wait_microseconds=500 ", allFilters, pendingFilter, pageValue, pageOffset)
+	return q.mvBridgeTxs(allFilters, pendingFilter, page)
 }
 
 // nolint:cyclop
@@ -954,7 +934,7 @@ func (r *queryResolver) GetBridgeTxsFromDestination(ctx context.Context, useMv *
 		if chainIDTo == nil && addressTo == nil && minAmount == nil && minAmountUsd == nil && startTime == nil && endTime == nil && tokenAddressTo == nil && kappa == nil && txHash == nil {
 			return nil, nil
 		}
-		query = generateAllBridgeEventsQueryFromDestinationMv(chainIDTo, addressTo, minAmount, minAmountUsd, startTime, endTime, tokenAddressTo, kappa, txHash, pending, *page)
+		query = generateAllBridgeEventsQueryFromDestinationMv(r.queries(), chainIDTo, addressTo, minAmount, minAmountUsd, startTime, endTime, tokenAddressTo, kappa, txHash, pending, *page)
 	} else {
 		query = generateAllBridgeEventsQueryFromDestination(chainIDFrom, chainIDTo, addressFrom, addressTo, maxAmount, minAmount, minAmountUsd, maxAmountUsd, startTime, endTime, tokenAddressFrom, tokenAddressTo, kappa, txHash, onlyCctp, *page, false)
 	}
@@ -986,7 +966,7 @@ func (r *queryResolver) GetBridgeTxsFromOrigin(ctx context.Context, useMv *bool,
 	var results []*model.BridgeTransaction
 	query := generateAllBridgeEventsQueryFromOrigin(chainIDFrom, chainIDTo, addressFrom, addressTo, maxAmount, minAmount, maxAmountUsd, minAmountUsd, startTime, endTime, tokenAddressFrom, tokenAddressTo, txHash, pending, onlyCctp, *page, true)
 	if useMv != nil && *useMv {
-		query = generateAllBridgeEventsQueryFromOriginMv(chainIDFrom, addressFrom, maxAmount, maxAmountUsd, startTime, endTime, tokenAddressFrom, txHash, kappa, pending, *page)
+		query = generateAllBridgeEventsQueryFromOriginMv(r.queries(), chainIDFrom, addressFrom, maxAmount, maxAmountUsd, startTime, endTime, tokenAddressFrom, txHash, kappa, pending, *page)
 	}
 	allBridgeEvents, err := r.DB.GetAllBridgeEvents(ctx, query)
 
@@ -1018,7 +998,7 @@ func (r *queryResolver) GetBridgeTxsFromOrigin(ctx context.Context, useMv *bool,
 func (r *queryResolver) GetBridgeTxs(ctx context.Context, chainIDFrom []*int, chainIDTo []*int, addressFrom *string, addressTo *string, maxAmount *int, minAmount *int, maxAmountUsd *int, minAmountUsd *int, startTime *int, endTime *int, txHash *string, tokenAddressTo []*string, tokenAddressFrom []*string, kappa *string, pending *bool, onlyCctp *bool, page *int) ([]*model.BridgeTransaction, error) {
 	var err error
 	var results []*model.BridgeTransaction
-	query := generateAllBridgeEventsQueryMv(chainIDFrom, chainIDTo, addressFrom, addressTo, maxAmount, minAmount, maxAmountUsd, minAmountUsd, startTime, endTime, tokenAddressFrom, tokenAddressTo, txHash, kappa, pending, onlyCctp, *page)
+	query := generateAllBridgeEventsQueryMv(r.queries(), chainIDFrom, chainIDTo, addressFrom, addressTo, maxAmount, minAmount, maxAmountUsd, minAmountUsd, startTime, endTime, tokenAddressFrom, tokenAddressTo, txHash, kappa, pending, onlyCctp, *page)
 	allBridgeEvents, err := r.DB.GetAllBridgeEvents(ctx, query)
 
 	if err != nil {
@@ -1107,8 +1087,11 @@ func GetPartialInfoFromMessageBusEventHybrid(ctx context.Context, messageBusEven
 
 // nolint:gocognit,cyclop
 func (r *queryResolver) GetMessageBusTxs(ctx context.Context, chainID []*int, address *string, startTime *int, endTime *int, txHash *string, messageID *string, pending bool, reverted bool, page *int) ([]*model.MessageBusTransaction, error) {
-	var err error
-	allMessageBusEvents, err := r.DB.GetAllMessageBusEvents(ctx, generateMessageBusQuery(chainID, address, startTime, endTime, messageID, pending, reverted, txHash, *page))
+	query, err := generateMessageBusQuery(r.queries(), chainID, address, startTime, endTime, messageID, pending, reverted, txHash, *page)
+	if err != nil {
+		return nil, err
+	}
+	allMessageBusEvents, err := r.DB.GetAllMessageBusEvents(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get destinationbridge events from identifiers: %w", err)
 	}
@@ -1459,12 +1442,12 @@ func (r *queryResolver) getAmountStatisticsAll(ctx context.Context, typeArg mode
 	var swapSum float64
 	var messageBusSum float64
 
-	bridgeFinalSQL, err = GenerateAmountStatisticBridgeSQL(typeArg, address, chainID, tokenAddress)
+	bridgeFinalSQL, err = r.queries().amountStatisticBridge(typeArg, address, chainID, tokenAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	swapFinalSQL, err = GenerateAmountStatisticSwapSQL(typeArg, compositeFilters, tokenAddress)
+	swapFinalSQL, err = r.queries().amountStatisticSwap(typeArg, compositeFilters, tokenAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -1472,7 +1455,7 @@ func (r *queryResolver) getAmountStatisticsAll(ctx context.Context, typeArg mode
 	g, groupCtx := errgroup.WithContext(ctx)
 
 	if tokenAddress == nil && typeArg != model.StatisticTypeTotalVolumeUsd && typeArg != model.StatisticTypeMedianVolumeUsd && typeArg != model.StatisticTypeMeanVolumeUsd {
-		messageBusFinalSQL, err = GenerateAmountStatisticMessageBusSQL(typeArg, compositeFilters)
+		messageBusFinalSQL, err = r.queries().amountStatisticMessageBus(typeArg, compositeFilters)
 		if err != nil {
 			return nil, err
 		}
@@ -1540,22 +1523,22 @@ func (r *queryResolver) getDateResultByChainMv(ctx context.Context, chainID *int
 				timestampSpecifierMv, chainIDSpecifierMv,
 			)
 		}
-		query, err = GenerateDailyStatisticByChainBridgeSQLMv(typeArg, compositeFiltersMv)
+		query, err = r.queries().dailyStatisticBridge(typeArg, compositeFiltersMv)
 		if err != nil {
 			return nil, err
 		}
 	case model.PlatformSwap:
-		query, err = GenerateDailyStatisticByChainSwapSQL(typeArg, compositeFilters)
+		query, err = r.queries().dailyStatisticSwap(typeArg, compositeFilters)
 		if err != nil {
 			return nil, err
 		}
 	case model.PlatformMessageBus:
-		query, err = GenerateDailyStatisticByChainMessageBusSQL(typeArg, compositeFilters)
+		query, err = r.queries().dailyStatisticMessageBus(typeArg, compositeFilters)
 		if err != nil {
 			return nil, err
 		}
 	case model.PlatformAll:
-		query, err = GenerateDailyStatisticByChainAllSQLMv(typeArg, compositeFilters, compositeFiltersMv)
+		query, err = r.queries().dailyStatisticAll(typeArg, compositeFilters, compositeFiltersMv)
 	default:
 		return nil, fmt.Errorf("unsupported platform")
 	}
@@ -1633,7 +1616,7 @@ func UnsafeSetFallbackTime(ttf time.Duration) {
 // GetOriginBridgeTxBW gets an origin bridge tx.
 func (r *queryResolver) GetOriginBridgeTxBW(ctx context.Context, chainID int, txnHash string, eventType model.BridgeType) (*model.BridgeWatcherTx, error) {
 	txType := model.BridgeTxTypeOrigin
-	query := fmt.Sprintf("SELECT * FROM mv_bridge_events WHERE fchain_id = %d AND ftx_hash = '%s' ORDER BY insert_time desc LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash", chainID, txnHash)
+	query := r.queries().originBridgeTx(chainID, txnHash)
 
 	bwQueryCtx, cancel := context.WithTimeout(ctx, timeToFallback)
 	defer cancel()
@@ -1646,6 +1629,8 @@ func (r *queryResolver) GetOriginBridgeTxBW(ctx context.Context, chainID int, tx
 			return r.bwOriginFallback(ctx, uint32(chainID), txnHash)
 		case model.BridgeTypeCctp:
 			return r.bwOriginFallbackCCTP(ctx, uint32(chainID), txnHash)
+		case model.BridgeTypeRfq:
+			return r.bwOriginFallbackRFQ(ctx, uint32(chainID), txnHash)
 		}
 	}
 	return bwBridgeMVToBWTxOrigin(bridgeEventMV, txType)
@@ -1658,7 +1643,7 @@ func (r *queryResolver) GetDestinationBridgeTxBW(ctx context.Context, chainID in
 	bwQueryCtx, cancel := context.WithTimeout(ctx, timeToFallback)
 	defer cancel()
 
-	query := fmt.Sprintf("SELECT * FROM mv_bridge_events WHERE tchain_id = %d AND tkappa = '%s' ORDER BY insert_time desc LIMIT 1 BY tchain_id, tcontract_address, tevent_type, tblock_number, tevent_index, ttx_hash", chainID, kappa)
+	query := r.queries().destinationBridgeTx(chainID, kappa)
 	bridgeEventMV, err := r.DB.GetMVBridgeEvent(bwQueryCtx, query)
 
 	var bridgeTx model.PartialInfo
@@ -1846,6 +1831,10 @@ func (r *queryResolver) checkIfChainIDExists(chainIDNeeded uint32, bridgeType mo
 				if chainConfig.Contracts.CCTP != "" {
 					exists = true
 				}
+			case model.BridgeTypeRfq:
+				if chainConfig.Contracts.FastBridge != "" {
+					exists = true
+				}
 			}
 		}
 	}
@@ -1861,6 +1850,8 @@ func (r *queryResolver) getContractAddressFromType(chainID uint32, contractType
 		return r.Config.Chains[chainID].Contracts.Bridge, nil
 	case model.ContractTypeCctp:
 		return r.Config.Chains[chainID].Contracts.CCTP, nil
+	case model.ContractTypeRfq:
+		return r.Config.Chains[chainID].Contracts.FastBridge, nil
 	default:
 		return "", fmt.Errorf("contract type not supported")
 	}
@@ -8,6 +8,7 @@
 	"github.com/synapsecns/sanguine/services/explorer/consumer/fetcher"
 	"github.com/synapsecns/sanguine/services/explorer/contracts/swap"
 	"github.com/synapsecns/sanguine/services/explorer/db"
+	"github.com/synapsecns/sanguine/services/explorer/pubsub"
 	"github.com/synapsecns/sanguine/services/explorer/types"
 )
 
@@ -30,4 +31,6 @@ type Resolver struct {
 	Refs        *types.ServerRefs
 	SwapFilters map[string]*swap.SwapFlashLoanFilterer
 	Config      serverConfig.Config
+	// Events is the broker of stored bridge events that feeds the subscriptions.
+	Events *pubsub.Broker
 }
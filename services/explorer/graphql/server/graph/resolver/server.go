@@ -7,6 +7,7 @@
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -37,6 +38,7 @@ type Config struct {
 
 type ResolverRoot interface {
 	Query() QueryResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
@@ -182,6 +184,11 @@ type ComplexityRoot struct {
 		TxnHash              func(childComplexity int) int
 	}
 
+	PendingCountByChain struct {
+		ChainID func(childComplexity int) int
+		Count   func(childComplexity int) int
+	}
+
 	PetType struct {
 		Name      func(childComplexity int) int
 		PetID     func(childComplexity int) int
@@ -204,6 +211,12 @@ type ComplexityRoot struct {
 		RankedChainIDsByVolume func(childComplexity int, duration *model.Duration, useCache *bool) int
 	}
 
+	Subscription struct {
+		BridgeTransactionAdded  func(childComplexity int, chainIDFrom []*int, address *string, tokenAddress *string) int
+		BridgeTransactionStatus func(childComplexity int, chainIDTo []*int, address *string, kappa *string, txnHash *string) int
+		PendingByChain          func(childComplexity int) int
+	}
+
 	TearType struct {
 		Amount    func(childComplexity int) int
 		Recipient func(childComplexity int) int
@@ -249,6 +262,11 @@ type QueryResolver interface {
 	GetDestinationBridgeTx(ctx context.Context, chainID int, address string, kappa string, timestamp int, bridgeType model.BridgeType, historical *bool) (*model.BridgeWatcherTx, error)
 	GetBlockHeight(ctx context.Context, contracts []*model.ContractQuery) ([]*model.BlockHeight, error)
 }
+type SubscriptionResolver interface {
+	BridgeTransactionAdded(ctx context.Context, chainIDFrom []*int, address *string, tokenAddress *string) (<-chan *model.BridgeTransaction, error)
+	BridgeTransactionStatus(ctx context.Context, chainIDTo []*int, address *string, kappa *string, txnHash *string) (<-chan *model.BridgeTransaction, error)
+	PendingByChain(ctx context.Context) (<-chan []*model.PendingCountByChain, error)
+}
 
 type executableSchema struct {
 	resolvers  ResolverRoot
@@ -923,6 +941,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PartialMessageBusInfo.TxnHash(childComplexity), true
 
+	case "PendingCountByChain.chainID":
+		if e.complexity.PendingCountByChain.ChainID == nil {
+			break
+		}
+
+		return e.complexity.PendingCountByChain.ChainID(childComplexity), true
+
+	case "PendingCountByChain.count":
+		if e.complexity.PendingCountByChain.Count == nil {
+			break
+		}
+
+		return e.complexity.PendingCountByChain.Count(childComplexity), true
+
 	case "PetType.name":
 		if e.complexity.PetType.Name == nil {
 			break
@@ -1100,6 +1132,37 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.RankedChainIDsByVolume(childComplexity, args["duration"].(*model.Duration), args["useCache"].(*bool)), true
 
+	case "Subscription.bridgeTransactionAdded":
+		if e.complexity.Subscription.BridgeTransactionAdded == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_bridgeTransactionAdded_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.BridgeTransactionAdded(childComplexity, args["chainIDFrom"].([]*int), args["address"].(*string), args["tokenAddress"].(*string)), true
+
+	case "Subscription.bridgeTransactionStatus":
+		if e.complexity.Subscription.BridgeTransactionStatus == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_bridgeTransactionStatus_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.BridgeTransactionStatus(childComplexity, args["chainIDTo"].([]*int), args["address"].(*string), args["kappa"].(*string), args["txnHash"].(*string)), true
+
+	case "Subscription.pendingByChain":
+		if e.complexity.Subscription.PendingByChain == nil {
+			break
+		}
+
+		return e.complexity.Subscription.PendingByChain(childComplexity), true
+
 	case "TearType.amount":
 		if e.complexity.TearType.Amount == nil {
 			break
@@ -1220,6 +1283,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 
 			return &response
 		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
 
 	default:
 		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
@@ -1457,6 +1537,34 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 }
 
 
+`, BuiltIn: false},
+	{Name: "../schema/subscriptions.graphql", Input: `type Subscription {
+  """
+  Streams bridge transactions as their origin leg is indexed, filterable by origin chain, sender or recipient address
+  and token address.
+  """
+  bridgeTransactionAdded(
+    chainIDFrom:    [Int]
+    address:        String
+    tokenAddress:   String
+  ): BridgeTransaction
+
+  """
+  Streams bridge transactions when their destination leg is indexed and they are no longer pending, filterable by
+  destination chain, sender or recipient address, kappa and origin txn hash.
+  """
+  bridgeTransactionStatus(
+    chainIDTo:      [Int]
+    address:        String
+    kappa:          String
+    txnHash:        String
+  ): BridgeTransaction
+
+  """
+  Streams the number of pending bridge transactions per destination chain, updated as bridge events are indexed.
+  """
+  pendingByChain: [PendingCountByChain]
+}
 `, BuiltIn: false},
 	{Name: "../schema/types.graphql", Input: `"""
 BridgeTransaction represents an entire bridge transaction, including both
@@ -1644,6 +1752,10 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
   chainID:  Int
   total:    Float
 }
+type PendingCountByChain {
+  chainID:  Int
+  count:    Int
+}
 type AddressDailyCount{
   date: String
   count: Int
@@ -1677,6 +1789,7 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 enum BridgeType{
   BRIDGE
   CCTP
+  RFQ
 }
 
 enum KappaStatus{
@@ -1693,6 +1806,7 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 enum ContractType{
   BRIDGE
   CCTP
+  RFQ
 }
 
 type BlockHeight {
@@ -2406,6 +2520,81 @@ func (ec *executionContext) field_Query_rankedChainIDsByVolume_args(ctx context.
 	return args, nil
 }
 
+func (ec *executionContext) field_Subscription_bridgeTransactionAdded_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []*int
+	if tmp, ok := rawArgs["chainIDFrom"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("chainIDFrom"))
+		arg0, err = ec.unmarshalOInt2ᚕᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["chainIDFrom"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["address"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["address"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["tokenAddress"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tokenAddress"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tokenAddress"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_bridgeTransactionStatus_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []*int
+	if tmp, ok := rawArgs["chainIDTo"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("chainIDTo"))
+		arg0, err = ec.unmarshalOInt2ᚕᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["chainIDTo"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["address"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["address"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["kappa"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kappa"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["kappa"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["txnHash"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("txnHash"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["txnHash"] = arg3
+	return args, nil
+}
+
 func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -6466,6 +6655,88 @@ func (ec *executionContext) fieldContext_PartialMessageBusInfo_revertedReason(ct
 	return fc, nil
 }
 
+func (ec *executionContext) _PendingCountByChain_chainID(ctx context.Context, field graphql.CollectedField, obj *model.PendingCountByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PendingCountByChain_chainID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ChainID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PendingCountByChain_chainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PendingCountByChain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PendingCountByChain_count(ctx context.Context, field graphql.CollectedField, obj *model.PendingCountByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PendingCountByChain_count(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Count, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PendingCountByChain_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PendingCountByChain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _PetType_recipient(ctx context.Context, field graphql.CollectedField, obj *model.PetType) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_PetType_recipient(ctx, field)
 	if err != nil {
@@ -7567,6 +7838,223 @@ func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, fie
 	return fc, nil
 }
 
+func (ec *executionContext) _Subscription_bridgeTransactionAdded(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_bridgeTransactionAdded(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().BridgeTransactionAdded(rctx, fc.Args["chainIDFrom"].([]*int), fc.Args["address"].(*string), fc.Args["tokenAddress"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.BridgeTransaction):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalOBridgeTransaction2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐBridgeTransaction(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_bridgeTransactionAdded(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "fromInfo":
+				return ec.fieldContext_BridgeTransaction_fromInfo(ctx, field)
+			case "toInfo":
+				return ec.fieldContext_BridgeTransaction_toInfo(ctx, field)
+			case "kappa":
+				return ec.fieldContext_BridgeTransaction_kappa(ctx, field)
+			case "pending":
+				return ec.fieldContext_BridgeTransaction_pending(ctx, field)
+			case "swapSuccess":
+				return ec.fieldContext_BridgeTransaction_swapSuccess(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BridgeTransaction", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_bridgeTransactionAdded_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_bridgeTransactionStatus(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_bridgeTransactionStatus(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().BridgeTransactionStatus(rctx, fc.Args["chainIDTo"].([]*int), fc.Args["address"].(*string), fc.Args["kappa"].(*string), fc.Args["txnHash"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.BridgeTransaction):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalOBridgeTransaction2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐBridgeTransaction(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_bridgeTransactionStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "fromInfo":
+				return ec.fieldContext_BridgeTransaction_fromInfo(ctx, field)
+			case "toInfo":
+				return ec.fieldContext_BridgeTransaction_toInfo(ctx, field)
+			case "kappa":
+				return ec.fieldContext_BridgeTransaction_kappa(ctx, field)
+			case "pending":
+				return ec.fieldContext_BridgeTransaction_pending(ctx, field)
+			case "swapSuccess":
+				return ec.fieldContext_BridgeTransaction_swapSuccess(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BridgeTransaction", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_bridgeTransactionStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_pendingByChain(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_pendingByChain(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().PendingByChain(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan []*model.PendingCountByChain):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalOPendingCountByChain2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐPendingCountByChain(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_pendingByChain(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "chainID":
+				return ec.fieldContext_PendingCountByChain_chainID(ctx, field)
+			case "count":
+				return ec.fieldContext_PendingCountByChain_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PendingCountByChain", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _TearType_recipient(ctx context.Context, field graphql.CollectedField, obj *model.TearType) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TearType_recipient(ctx, field)
 	if err != nil {
@@ -10587,6 +11075,44 @@ func (ec *executionContext) _PartialMessageBusInfo(ctx context.Context, sel ast.
 	return out
 }
 
+var pendingCountByChainImplementors = []string{"PendingCountByChain"}
+
+func (ec *executionContext) _PendingCountByChain(ctx context.Context, sel ast.SelectionSet, obj *model.PendingCountByChain) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pendingCountByChainImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PendingCountByChain")
+		case "chainID":
+			out.Values[i] = ec._PendingCountByChain_chainID(ctx, field, obj)
+		case "count":
+			out.Values[i] = ec._PendingCountByChain_count(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var petTypeImplementors = []string{"PetType", "MessageType"}
 
 func (ec *executionContext) _PetType(ctx context.Context, sel ast.SelectionSet, obj *model.PetType) graphql.Marshaler {
@@ -10933,6 +11459,30 @@ func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 	return out
 }
 
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "bridgeTransactionAdded":
+		return ec._Subscription_bridgeTransactionAdded(ctx, fields[0])
+	case "bridgeTransactionStatus":
+		return ec._Subscription_bridgeTransactionStatus(ctx, fields[0])
+	case "pendingByChain":
+		return ec._Subscription_pendingByChain(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
 var tearTypeImplementors = []string{"TearType", "MessageType"}
 
 func (ec *executionContext) _TearType(ctx context.Context, sel ast.SelectionSet, obj *model.TearType) graphql.Marshaler {
@@ -12519,6 +13069,54 @@ func (ec *executionContext) marshalOPartialMessageBusInfo2ᚖgithubᚗcomᚋsyna
 	return ec._PartialMessageBusInfo(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOPendingCountByChain2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐPendingCountByChain(ctx context.Context, sel ast.SelectionSet, v []*model.PendingCountByChain) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOPendingCountByChain2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐPendingCountByChain(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
+func (ec *executionContext) marshalOPendingCountByChain2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐPendingCountByChain(ctx context.Context, sel ast.SelectionSet, v *model.PendingCountByChain) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._PendingCountByChain(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOPlatform2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐPlatform(ctx context.Context, v interface{}) (*model.Platform, error) {
 	if v == nil {
 		return nil, nil
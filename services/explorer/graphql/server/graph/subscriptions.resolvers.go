@@ -0,0 +1,129 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.36
+
+import (
+	"context"
+	"time"
+
+	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/model"
+	resolvers "github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/resolver"
+)
+
+// BridgeTransactionAdded is the resolver for the bridgeTransactionAdded field.
+func (r *subscriptionResolver) BridgeTransactionAdded(ctx context.Context, chainIDFrom []*int, address *string, tokenAddress *string) (<-chan *model.BridgeTransaction, error) {
+	if r.Events == nil {
+		return nil, ErrSubscriptionsDisabled
+	}
+	events := r.Events.Subscribe(ctx)
+	results := make(chan *model.BridgeTransaction, 1)
+	go func() {
+		defer close(results)
+		for batch := range events {
+			for i := range batch {
+				bridgeEvent := &batch[i].BridgeEvent
+				if !isOriginEvent(bridgeEvent) || !matchesChainID(chainIDFrom, bridgeEvent.ChainID) || !matchesAddress(address, bridgeEvent) || !matchesString(tokenAddress, bridgeEvent.Token) {
+					continue
+				}
+				bridgeTx, err := originBridgeTransaction(bridgeEvent)
+				if err != nil {
+					logger.Warnf("could not convert origin bridge event %s: %v", bridgeEvent.TxHash, err)
+					continue
+				}
+				select {
+				case results <- bridgeTx:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return results, nil
+}
+
+// BridgeTransactionStatus is the resolver for the bridgeTransactionStatus field.
+func (r *subscriptionResolver) BridgeTransactionStatus(ctx context.Context, chainIDTo []*int, address *string, kappa *string, txnHash *string) (<-chan *model.BridgeTransaction, error) {
+	if r.Events == nil {
+		return nil, ErrSubscriptionsDisabled
+	}
+	events := r.Events.Subscribe(ctx)
+	results := make(chan *model.BridgeTransaction, 1)
+	go func() {
+		defer close(results)
+		for batch := range events {
+			for i := range batch {
+				bridgeEvent, originEvent := &batch[i].BridgeEvent, batch[i].Origin
+				if !isDestinationEvent(bridgeEvent) || !matchesChainID(chainIDTo, bridgeEvent.ChainID) || !matchesString(kappa, bridgeEvent.Kappa.String) {
+					continue
+				}
+				if txnHash != nil && (originEvent == nil || !matchesString(txnHash, originEvent.TxHash)) {
+					continue
+				}
+				if address != nil && !matchesAddress(address, bridgeEvent) && (originEvent == nil || !matchesAddress(address, originEvent)) {
+					continue
+				}
+				bridgeTx, err := completedBridgeTransaction(originEvent, bridgeEvent)
+				if err != nil {
+					logger.Warnf("could not convert destination bridge event %s: %v", bridgeEvent.TxHash, err)
+					continue
+				}
+				select {
+				case results <- bridgeTx:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return results, nil
+}
+
+// PendingByChain is the resolver for the pendingByChain field.
+func (r *subscriptionResolver) PendingByChain(ctx context.Context) (<-chan []*model.PendingCountByChain, error) {
+	if r.Events == nil {
+		return nil, ErrSubscriptionsDisabled
+	}
+	events := r.Events.Subscribe(ctx)
+	results := make(chan []*model.PendingCountByChain, 1)
+	go func() {
+		defer close(results)
+		ticker := time.NewTicker(pendingRefreshInterval)
+		defer ticker.Stop()
+		// the initial counts are always sent, afterwards they are only recomputed when bridge events were stored.
+		refresh, stale := true, false
+		for {
+			if refresh {
+				counts, err := r.getPendingCounts(ctx)
+				if err != nil {
+					logger.Warnf("could not get pending counts: %v", err)
+				} else {
+					select {
+					case results <- counts:
+					case <-ctx.Done():
+						return
+					}
+				}
+				refresh = false
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-events:
+				if !ok {
+					return
+				}
+				stale = true
+			case <-ticker.C:
+				refresh, stale = stale, false
+			}
+		}
+	}()
+	return results, nil
+}
+
+// Subscription returns resolvers.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() resolvers.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type subscriptionResolver struct{ *Resolver }
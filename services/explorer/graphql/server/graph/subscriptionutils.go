@@ -0,0 +1,155 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/model"
+	"github.com/synapsecns/sanguine/services/explorer/types/bridge"
+)
+
+// ErrSubscriptionsDisabled is returned when subscribing to a server that was started without an event broker.
+var ErrSubscriptionsDisabled = errors.New("subscriptions are not enabled on this server")
+
+// pendingRefreshInterval is the minimum time between two recomputations of the pending counts of a subscription.
+const pendingRefreshInterval = 5 * time.Second
+
+// isOriginEvent checks if a bridge event is the origin leg of a bridge transaction.
+func isOriginEvent(bridgeEvent *sql.BridgeEvent) bool {
+	return bridgeEvent.DestinationKappa != ""
+}
+
+// isDestinationEvent checks if a bridge event is the destination leg of a bridge transaction.
+func isDestinationEvent(bridgeEvent *sql.BridgeEvent) bool {
+	return bridgeEvent.Kappa.Valid && bridgeEvent.Kappa.String != ""
+}
+
+func matchesChainID(chainIDs []*int, chainID uint32) bool {
+	if len(chainIDs) == 0 {
+		return true
+	}
+	for _, id := range chainIDs {
+		if id != nil && *id == int(chainID) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesString(filter *string, value string) bool {
+	return filter == nil || strings.EqualFold(*filter, value)
+}
+
+// matchesAddress checks if the address filter is the sender or the recipient of a bridge event.
+func matchesAddress(address *string, bridgeEvent *sql.BridgeEvent) bool {
+	return address == nil || strings.EqualFold(*address, bridgeEvent.Sender) || strings.EqualFold(*address, bridgeEvent.Recipient.String)
+}
+
+// getPendingCounts gets the pending bridge transactions per destination chain, ordered by chain id.
+func (r *Resolver) getPendingCounts(ctx context.Context) ([]*model.PendingCountByChain, error) {
+	pending, err := r.DB.GetPendingByChain(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get pending by chain: %w", err)
+	}
+	counts := []*model.PendingCountByChain{}
+	itr := pending.Iterator()
+	for !itr.Done() {
+		chainID, count, _ := itr.Next()
+		counts = append(counts, &model.PendingCountByChain{ChainID: &chainID, Count: &count})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		return *counts[i].ChainID < *counts[j].ChainID
+	})
+	return counts, nil
+}
+
+// originBridgeTransaction creates a pending bridge transaction from its origin leg.
+func originBridgeTransaction(originEvent *sql.BridgeEvent) (*model.BridgeTransaction, error) {
+	fromInfo, err := bridgeEventToPartialInfo(originEvent, true)
+	if err != nil {
+		return nil, err
+	}
+	pending := true
+	swapSuccess := false
+	return &model.BridgeTransaction{
+		FromInfo:    fromInfo,
+		Kappa:       &originEvent.DestinationKappa,
+		Pending:     &pending,
+		SwapSuccess: &swapSuccess,
+	}, nil
+}
+
+// completedBridgeTransaction creates a completed bridge transaction from its destination leg and, if it has been
+// indexed, its origin leg.
+func completedBridgeTransaction(originEvent *sql.BridgeEvent, destinationEvent *sql.BridgeEvent) (*model.BridgeTransaction, error) {
+	toInfo, err := bridgeEventToPartialInfo(destinationEvent, false)
+	if err != nil {
+		return nil, err
+	}
+	var fromInfo *model.PartialInfo
+	if originEvent != nil {
+		fromInfo, err = bridgeEventToPartialInfo(originEvent, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pending := false
+	swapSuccess := destinationEvent.SwapSuccess != nil && destinationEvent.SwapSuccess.Uint64() == 1
+	return &model.BridgeTransaction{
+		FromInfo:    fromInfo,
+		ToInfo:      toInfo,
+		Kappa:       &destinationEvent.Kappa.String,
+		Pending:     &pending,
+		SwapSuccess: &swapSuccess,
+	}, nil
+}
+
+// bridgeEventToPartialInfo converts one leg of a bridge transaction to a partial info, mirroring
+// GetPartialInfoFromBridgeEventHybrid.
+func bridgeEventToPartialInfo(bridgeEvent *sql.BridgeEvent, origin bool) (*model.PartialInfo, error) {
+	if bridgeEvent.TokenDecimal == nil {
+		return nil, fmt.Errorf("token decimal is not valid")
+	}
+	if bridgeEvent.TimeStamp == nil {
+		return nil, fmt.Errorf("timestamp is not valid")
+	}
+	chainID := int(bridgeEvent.ChainID)
+	blockNumber := int(bridgeEvent.BlockNumber)
+	value := bridgeEvent.Amount.String()
+	formattedValue := getAdjustedValue(bridgeEvent.Amount, *bridgeEvent.TokenDecimal)
+	timestamp := int(*bridgeEvent.TimeStamp)
+	timeStampFormatted := time.Unix(int64(*bridgeEvent.TimeStamp), 0).String()
+	eventTypeFormatted := bridge.GetEventType(bridgeEvent.EventType)
+	eventType := int(bridgeEvent.EventType)
+
+	address := bridgeEvent.Recipient.String
+	if bridgeEvent.EventType == bridge.CircleRequestSentEvent.Int() || bridgeEvent.EventType == bridge.CircleRequestFulfilledEvent.Int() {
+		address = bridgeEvent.Sender
+	}
+
+	partialInfo := &model.PartialInfo{
+		ChainID:            &chainID,
+		Address:            &address,
+		TxnHash:            &bridgeEvent.TxHash,
+		Value:              &value,
+		FormattedValue:     formattedValue,
+		USDValue:           bridgeEvent.AmountUSD,
+		TokenAddress:       &bridgeEvent.Token,
+		TokenSymbol:        &bridgeEvent.TokenSymbol.String,
+		BlockNumber:        &blockNumber,
+		Time:               &timestamp,
+		FormattedTime:      &timeStampFormatted,
+		FormattedEventType: &eventTypeFormatted,
+		EventType:          &eventType,
+	}
+	if origin && bridgeEvent.DestinationChainID != nil {
+		destinationChainID := int(bridgeEvent.DestinationChainID.Uint64())
+		partialInfo.DestinationChainID = &destinationChainID
+	}
+	return partialInfo, nil
+}
@@ -18,6 +18,7 @@
 	"github.com/synapsecns/sanguine/services/explorer/consumer/parser/tokendata"
 	"github.com/synapsecns/sanguine/services/explorer/contracts/bridgeconfig"
 	"github.com/synapsecns/sanguine/services/explorer/db"
+	"github.com/synapsecns/sanguine/services/explorer/pubsub"
 	"github.com/synapsecns/sanguine/services/explorer/static"
 	"golang.org/x/sync/errgroup"
 )
@@ -32,12 +33,17 @@ type ExplorerBackfiller struct {
 	ChainBackfillers map[uint32]*backfill.ChainBackfiller
 	// config is the config for the backfiller.
 	config indexerConfig.Config
+	// priceDataService is the price data service usd values are recomputed with.
+	priceDataService tokenprice.Service
 }
 
 // NewExplorerBackfiller creates a new backfiller for the explorer.
 //
 // nolint:gocognit
 func NewExplorerBackfiller(consumerDB db.ConsumerDB, config indexerConfig.Config, clients map[uint32]bind.ContractBackend, handler metrics.Handler) (*ExplorerBackfiller, error) {
+	if config.NotifyURL != "" {
+		consumerDB = pubsub.NewPublishingDB(consumerDB, pubsub.NewHTTPPublisher(config.NotifyURL, config.NotifyToken))
+	}
 	chainBackfillers := make(map[uint32]*backfill.ChainBackfiller)
 	httpClient := http.Client{
 		Timeout: 10 * time.Second,
@@ -50,7 +56,11 @@ func NewExplorerBackfiller(consumerDB db.ConsumerDB, config indexerConfig.Config
 	if err != nil || bridgeConfigRef == nil {
 		return nil, fmt.Errorf("could not create bridge config ScribeFetcher: %w", err)
 	}
-	priceDataService, err := tokenprice.NewPriceDataService()
+	priceProviders, err := tokenprice.NewProviders(config.PriceProviders)
+	if err != nil {
+		return nil, fmt.Errorf("could not create price providers: %w", err)
+	}
+	priceDataService, err := tokenprice.NewPriceDataService(consumerDB, priceProviders...)
 	if err != nil {
 		return nil, fmt.Errorf("could not create price data service: %w", err)
 	}
@@ -82,6 +92,7 @@ func NewExplorerBackfiller(consumerDB db.ConsumerDB, config indexerConfig.Config
 		clients:          clients,
 		ChainBackfillers: chainBackfillers,
 		config:           config,
+		priceDataService: priceDataService,
 	}, nil
 }
 
@@ -108,6 +119,12 @@ func (e ExplorerBackfiller) Backfill(ctx context.Context, livefill bool) error {
 			return nil
 		})
 	}
+	if livefill && e.config.RecomputeInterval > 0 {
+		g.Go(func() error {
+			recomputeUSDLoop(groupCtx, e.consumerDB, e.priceDataService, time.Duration(e.config.RecomputeInterval)*time.Second)
+			return nil
+		})
+	}
 	if err := g.Wait(); err != nil {
 		logger.Errorf("backfill completed: %v", err)
 
@@ -124,6 +141,7 @@ func getChainBackfiller(consumerDB db.ConsumerDB, chainConfig indexerConfig.Chai
 	var bridgeParser *parser.BridgeParser
 	var messageBusParser *parser.MessageBusParser
 	var cctpParser *parser.CCTPParser
+	var fastBridgeParser *parser.FastBridgeParser
 	var swapService fetcherpkg.SwapService
 	var cctpService fetcherpkg.CCTPService
 
@@ -174,11 +192,16 @@ func getChainBackfiller(consumerDB db.ConsumerDB, chainConfig indexerConfig.Chai
 			if err != nil || cctpParser == nil {
 				return nil, fmt.Errorf("could not create message bus parser: %w", err)
 			}
+		case "fastbridge":
+			fastBridgeParser, err = parser.NewFastBridgeParser(consumerDB, common.HexToAddress(chainConfig.Contracts[i].Address), fetcher, tokenDataService, priceDataService, false)
+			if err != nil || fastBridgeParser == nil {
+				return nil, fmt.Errorf("could not create fastbridge parser: %w", err)
+			}
 		}
 	}
 
 	// TODO Add the cctp parser
-	chainBackfiller := backfill.NewChainBackfiller(consumerDB, bridgeParser, swapParsers, messageBusParser, cctpParser, fetcher, chainConfig)
+	chainBackfiller := backfill.NewChainBackfiller(consumerDB, bridgeParser, swapParsers, messageBusParser, cctpParser, fastBridgeParser, fetcher, chainConfig)
 
 	return chainBackfiller, nil
 }
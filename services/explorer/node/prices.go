@@ -0,0 +1,96 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/synapsecns/sanguine/services/explorer/config"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/fetcher/tokenprice"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/parser"
+	"github.com/synapsecns/sanguine/services/explorer/db"
+	"github.com/synapsecns/sanguine/services/explorer/static"
+)
+
+// PrefillPrices fills the persistent price cache with the daily prices of the given coin gecko ids, or of every
+// known token if none are given, from the start of from up to and including to. Afterwards the usd values of events
+// in the range that were stored without a price are recomputed.
+func PrefillPrices(ctx context.Context, consumerDB db.ConsumerDB, priceProviders []config.PriceProviderConfig, coinGeckoIDs []string, from, to time.Time) error {
+	providers, err := tokenprice.NewProviders(priceProviders)
+	if err != nil {
+		return fmt.Errorf("could not create price providers: %w", err)
+	}
+	priceDataService, err := tokenprice.NewPriceDataService(consumerDB, providers...)
+	if err != nil {
+		return fmt.Errorf("could not create price data service: %w", err)
+	}
+
+	if len(coinGeckoIDs) == 0 {
+		coinGeckoIDs, err = knownCoinGeckoIDs()
+		if err != nil {
+			return err
+		}
+	}
+
+	missing, err := tokenprice.Prefill(ctx, priceDataService, coinGeckoIDs, from, to)
+	if err != nil {
+		return fmt.Errorf("could not prefill prices: %w", err)
+	}
+	if missing > 0 {
+		logger.Warnf("%d prices could not be found between %s and %s", missing, from.Format(time.DateOnly), to.Format(time.DateOnly))
+	}
+
+	// the range is inclusive of the last day.
+	updated, err := parser.RecomputeUSD(ctx, consumerDB, priceDataService, uint64(from.Unix()), uint64(to.Add(24*time.Hour).Unix()))
+	if err != nil {
+		return fmt.Errorf("could not recompute usd values: %w", err)
+	}
+	logger.Infof("recomputed the usd values of %d events", updated)
+	return nil
+}
+
+// recomputeLookback is how far back the periodic recompute looks for events stored without a price.
+const recomputeLookback = 7 * 24 * time.Hour
+
+// recomputeUSDLoop recomputes the usd values of the recent events that were stored without a price every interval,
+// until the context is canceled. Failures are logged and retried on the next interval.
+func recomputeUSDLoop(ctx context.Context, consumerDB db.ConsumerDB, priceDataService tokenprice.Service, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			now := time.Now()
+			updated, err := parser.RecomputeUSD(ctx, consumerDB, priceDataService, uint64(now.Add(-recomputeLookback).Unix()), uint64(now.Unix()))
+			if err != nil {
+				logger.Errorf("could not recompute usd values: %v", err)
+				continue
+			}
+			if updated > 0 {
+				logger.Infof("recomputed the usd values of %d events", updated)
+			}
+		}
+	}
+}
+
+// knownCoinGeckoIDs gets the coin gecko ids of every token the explorer knows about.
+func knownCoinGeckoIDs() ([]string, error) {
+	tokenIDs, err := parser.ParseYaml(static.GetTokenIDToCoingekoConfig())
+	if err != nil {
+		return nil, fmt.Errorf("could not open yaml file: %w", err)
+	}
+	seen := make(map[string]bool)
+	var coinGeckoIDs []string
+	for _, coinGeckoID := range tokenIDs {
+		if seen[coinGeckoID] || coinGeckoID == "NO_TOKEN" || coinGeckoID == "NO_PRICE" {
+			continue
+		}
+		seen[coinGeckoID] = true
+		coinGeckoIDs = append(coinGeckoIDs, coinGeckoID)
+	}
+	sort.Strings(coinGeckoIDs)
+	return coinGeckoIDs, nil
+}
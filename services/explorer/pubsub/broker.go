@@ -0,0 +1,165 @@
+package pubsub
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/synapsecns/sanguine/services/explorer/db"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+)
+
+// Publisher publishes bridge events after they have been stored.
+type Publisher interface {
+	// Publish publishes stored bridge events. It must not block on slow consumers.
+	Publish(ctx context.Context, events []sql.BridgeEvent)
+}
+
+// subscriberBuffer is the number of batches buffered per subscriber before batches are dropped.
+const subscriberBuffer = 64
+
+// Event is a stored bridge event as sent to subscribers.
+type Event struct {
+	sql.BridgeEvent
+	// Origin is the origin leg of a destination event's bridge transaction, or nil if the event is an origin leg or
+	// its origin has not been indexed. It is looked up once when the event is published rather than by every
+	// subscriber.
+	Origin *sql.BridgeEvent
+}
+
+// Broker fans bridge events out to every subscriber in the process.
+type Broker struct {
+	// db is used to look up the origins of destination events, it may be nil.
+	db          db.ConsumerDB
+	mux         sync.RWMutex
+	subscribers map[chan []Event]struct{}
+}
+
+// NewBroker creates a new in process broker. The origins of destination events are looked up in consumerDB, unless
+// it is nil.
+func NewBroker(consumerDB db.ConsumerDB) *Broker {
+	return &Broker{
+		db:          consumerDB,
+		subscribers: make(map[chan []Event]struct{}),
+	}
+}
+
+// Subscribe subscribes to batches of stored bridge events until the context is canceled, at which point the channel
+// is closed. Batches are dropped for subscribers that do not keep up.
+func (b *Broker) Subscribe(ctx context.Context) <-chan []Event {
+	sub := make(chan []Event, subscriberBuffer)
+	b.mux.Lock()
+	b.subscribers[sub] = struct{}{}
+	b.mux.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mux.Lock()
+		delete(b.subscribers, sub)
+		b.mux.Unlock()
+		close(sub)
+	}()
+	return sub
+}
+
+// Publish sends bridge events to every subscriber, along with the origins of destination events. Origins are only
+// looked up if there are subscribers.
+func (b *Broker) Publish(ctx context.Context, events []sql.BridgeEvent) {
+	if len(events) == 0 {
+		return
+	}
+	b.mux.RLock()
+	subscribed := len(b.subscribers) > 0
+	b.mux.RUnlock()
+	if !subscribed {
+		return
+	}
+
+	batch := b.withOrigins(ctx, events)
+
+	b.mux.RLock()
+	defer b.mux.RUnlock()
+	for sub := range b.subscribers {
+		select {
+		case sub <- batch:
+		default:
+			logger.Warnf("dropping %d bridge events for a slow subscriber", len(batch))
+		}
+	}
+}
+
+// withOrigins attaches the origin of each destination event, taken from the same batch if it is in it.
+func (b *Broker) withOrigins(ctx context.Context, events []sql.BridgeEvent) []Event {
+	batchOrigins := make(map[string]*sql.BridgeEvent)
+	for i := range events {
+		if events[i].DestinationKappa != "" {
+			batchOrigins[events[i].DestinationKappa] = &events[i]
+		}
+	}
+
+	batch := make([]Event, len(events))
+	for i, event := range events {
+		batch[i].BridgeEvent = event
+		if !event.Kappa.Valid || event.Kappa.String == "" {
+			continue
+		}
+
+		if origin, ok := batchOrigins[event.Kappa.String]; ok {
+			originEvent := *origin
+			batch[i].Origin = &originEvent
+			continue
+		}
+		if b.db == nil {
+			continue
+		}
+		origin, err := getOriginByKappa(ctx, b.db, event.Kappa.String)
+		if err != nil {
+			logger.Warnf("could not get origin of kappa %s: %v", event.Kappa.String, err)
+			continue
+		}
+		batch[i].Origin = origin
+	}
+	return batch
+}
+
+// getOriginByKappa gets the origin leg of a bridge transaction from the kappa of its destination leg, or nil if the
+// origin has not been indexed.
+func getOriginByKappa(ctx context.Context, consumerDB db.ConsumerDB, kappa string) (*sql.BridgeEvent, error) {
+	query := fmt.Sprintf("SELECT * FROM bridge_events WHERE destination_kappa = '%s' ORDER BY insert_time DESC LIMIT 1", kappa)
+	originEvent, err := consumerDB.GetBridgeEvent(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("could not get origin bridge event: %w", err)
+	}
+	if originEvent == nil || originEvent.TxHash == "" {
+		return nil, nil
+	}
+	return originEvent, nil
+}
+
+// Handler is the http handler that publishes the bridge events posted by an indexer running in another process. If
+// token is set, requests must carry it as a bearer token.
+func (b *Broker) Handler(token string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if token != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+token)) != 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var events []sql.BridgeEvent
+		err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxNotifyBytes)).Decode(&events)
+		if err != nil {
+			http.Error(w, "could not decode bridge events", http.StatusBadRequest)
+			return
+		}
+		b.Publish(r.Context(), events)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+var _ Publisher = &Broker{}
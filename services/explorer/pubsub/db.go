@@ -0,0 +1,69 @@
+package pubsub
+
+import (
+	"context"
+
+	"github.com/synapsecns/sanguine/services/explorer/db"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+)
+
+// publishingDB is a consumer db that publishes bridge events once they are stored.
+type publishingDB struct {
+	db.ConsumerDB
+	publisher Publisher
+}
+
+// NewPublishingDB wraps a consumer db so that every bridge event stored through it, including cctp and rfq events
+// converted to bridge events, is published after the write succeeds.
+func NewPublishingDB(consumerDB db.ConsumerDB, publisher Publisher) db.ConsumerDB {
+	return &publishingDB{
+		ConsumerDB: consumerDB,
+		publisher:  publisher,
+	}
+}
+
+// Unwrap returns the db a publishing db writes to, so events that were already published when they were first stored,
+// e.g. events whose usd values are recomputed, can be rewritten without being published again. Any other db is
+// returned as is.
+func Unwrap(consumerDB db.ConsumerDB) db.ConsumerDB {
+	if p, ok := consumerDB.(*publishingDB); ok {
+		return p.ConsumerDB
+	}
+	return consumerDB
+}
+
+func (p *publishingDB) StoreEvent(ctx context.Context, event interface{}) error {
+	//nolint:wrapcheck
+	err := p.ConsumerDB.StoreEvent(ctx, event)
+	if err != nil {
+		return err
+	}
+	p.publisher.Publish(ctx, bridgeEvents([]interface{}{event}))
+	return nil
+}
+
+func (p *publishingDB) StoreEvents(ctx context.Context, events []interface{}) error {
+	//nolint:wrapcheck
+	err := p.ConsumerDB.StoreEvents(ctx, events)
+	if err != nil {
+		return err
+	}
+	p.publisher.Publish(ctx, bridgeEvents(events))
+	return nil
+}
+
+// bridgeEvents picks the bridge events out of a list of stored events.
+func bridgeEvents(events []interface{}) []sql.BridgeEvent {
+	var res []sql.BridgeEvent
+	for _, event := range events {
+		switch e := event.(type) {
+		case *sql.BridgeEvent:
+			if e != nil {
+				res = append(res, *e)
+			}
+		case sql.BridgeEvent:
+			res = append(res, e)
+		}
+	}
+	return res
+}
@@ -0,0 +1,2 @@
+// Package pubsub streams bridge events from the consumer's write path to the graphql subscriptions.
+package pubsub
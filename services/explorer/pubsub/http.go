@@ -0,0 +1,92 @@
+package pubsub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+)
+
+// maxNotifyBytes is the largest batch of bridge events accepted by the notify endpoint.
+const maxNotifyBytes = 16 << 20
+
+// publishQueueSize is the number of batches queued for the notify endpoint before batches are dropped.
+const publishQueueSize = 256
+
+type httpPublisher struct {
+	url    string
+	token  string
+	client *http.Client
+	queue  chan []sql.BridgeEvent
+}
+
+// NewHTTPPublisher creates a publisher that posts stored bridge events to the notify endpoint of an explorer server,
+// so an indexer in another process can feed the server's subscriptions. Batches are posted in order by a background
+// goroutine that lives as long as the process.
+func NewHTTPPublisher(url, token string) Publisher {
+	h := &httpPublisher{
+		url:   url,
+		token: token,
+		client: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		queue: make(chan []sql.BridgeEvent, publishQueueSize),
+	}
+	go h.run()
+	return h
+}
+
+// Publish queues the events to be posted without waiting on the server, so the write path is never slowed down.
+// If the queue is full the events are dropped, since they have already been stored.
+func (h *httpPublisher) Publish(_ context.Context, events []sql.BridgeEvent) {
+	if len(events) == 0 {
+		return
+	}
+	select {
+	case h.queue <- events:
+	default:
+		logger.Warnf("dropping %d bridge events, the notify queue is full", len(events))
+	}
+}
+
+// run posts queued events. Failures are logged rather than returned since the events have already been stored.
+func (h *httpPublisher) run() {
+	for events := range h.queue {
+		// the write that queued the events may be done by now, so they are posted with the client timeout only.
+		err := h.post(context.Background(), events)
+		if err != nil {
+			logger.Warnf("could not publish %d bridge events: %v", len(events), err)
+		}
+	}
+}
+
+func (h *httpPublisher) post(ctx context.Context, events []sql.BridgeEvent) error {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return fmt.Errorf("could not marshall bridge events: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("could not create notify request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if h.token != "" {
+		req.Header.Set("Authorization", "Bearer "+h.token)
+	}
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not notify %s: %w", h.url, err)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode/100 != 2 {
+		return fmt.Errorf("could not notify %s: status %d", h.url, res.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,5 @@
+package pubsub
+
+import "github.com/ipfs/go-log"
+
+var logger = log.Logger("explorer-pubsub")
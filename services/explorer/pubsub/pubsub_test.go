@@ -0,0 +1,152 @@
+package pubsub_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/synapsecns/sanguine/services/explorer/db/mocks"
+	dbsql "github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"github.com/synapsecns/sanguine/services/explorer/pubsub"
+)
+
+func (t *PubSubSuite) TestBrokerOverHTTP() {
+	broker := pubsub.NewBroker(nil)
+	ctx, cancel := context.WithCancel(t.GetTestContext())
+	events := broker.Subscribe(ctx)
+
+	server := httptest.NewServer(broker.Handler("token"))
+	defer server.Close()
+
+	// events posted with the wrong token are not published.
+	pubsub.NewHTTPPublisher(server.URL, "wrong").Publish(ctx, []dbsql.BridgeEvent{{TxHash: "0x1"}})
+	select {
+	case <-events:
+		t.T().Fatal("unauthorized events were published")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	pubsub.NewHTTPPublisher(server.URL, "token").Publish(ctx, []dbsql.BridgeEvent{{
+		TxHash: "0x2",
+		Kappa:  sql.NullString{String: "kappa", Valid: true},
+		Amount: big.NewInt(5),
+	}})
+	batch := <-events
+	Len(t.T(), batch, 1)
+	Equal(t.T(), "0x2", batch[0].TxHash)
+	Equal(t.T(), "kappa", batch[0].Kappa.String)
+	Equal(t.T(), int64(5), batch[0].Amount.Int64())
+
+	// the channel is closed once the subscriber is gone.
+	cancel()
+	_, ok := <-events
+	False(t.T(), ok)
+}
+
+func (t *PubSubSuite) TestHTTPPublisherDoesNotBlock() {
+	// the notify endpoint never responds.
+	blocked := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-blocked
+	}))
+	defer server.Close()
+	defer close(blocked)
+
+	publisher := pubsub.NewHTTPPublisher(server.URL, "")
+	done := make(chan struct{})
+	go func() {
+		// more batches than can be queued are dropped rather than waited on.
+		for i := 0; i < 1000; i++ {
+			publisher.Publish(t.GetTestContext(), []dbsql.BridgeEvent{{TxHash: "0x1"}})
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.T().Fatal("publishing blocked on the notify endpoint")
+	}
+}
+
+func (t *PubSubSuite) TestPublishingDB() {
+	broker := pubsub.NewBroker(nil)
+	events := broker.Subscribe(t.GetTestContext())
+
+	consumerDB := new(mocks.ConsumerDB)
+	consumerDB.On("StoreEvents", mock.Anything, mock.Anything).Return(nil).Once()
+	consumerDB.On("StoreEvent", mock.Anything, mock.Anything).Return(errors.New("could not store")).Once()
+	publishingDB := pubsub.NewPublishingDB(consumerDB, broker)
+
+	// only bridge events are published.
+	err := publishingDB.StoreEvents(t.GetTestContext(), []interface{}{&dbsql.SwapEvent{TxHash: "0x1"}, &dbsql.BridgeEvent{TxHash: "0x2"}})
+	Nil(t.T(), err)
+	batch := <-events
+	Len(t.T(), batch, 1)
+	Equal(t.T(), "0x2", batch[0].TxHash)
+
+	// events that could not be stored are not published.
+	err = publishingDB.StoreEvent(t.GetTestContext(), &dbsql.BridgeEvent{TxHash: "0x3"})
+	NotNil(t.T(), err)
+	select {
+	case batch = <-events:
+		t.T().Fatalf("unexpected events: %v", batch)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// writes through the unwrapped db are not published.
+	consumerDB.On("StoreEvents", mock.Anything, mock.Anything).Return(nil).Once()
+	err = pubsub.Unwrap(publishingDB).StoreEvents(t.GetTestContext(), []interface{}{&dbsql.BridgeEvent{TxHash: "0x4"}})
+	Nil(t.T(), err)
+	select {
+	case batch = <-events:
+		t.T().Fatalf("unexpected events: %v", batch)
+	case <-time.After(100 * time.Millisecond):
+	}
+	Equal(t.T(), consumerDB, pubsub.Unwrap(consumerDB))
+	consumerDB.AssertExpectations(t.T())
+}
+
+func (t *PubSubSuite) TestBrokerOrigins() {
+	consumerDB := new(mocks.ConsumerDB)
+	broker := pubsub.NewBroker(consumerDB)
+
+	// origins are not looked up without subscribers.
+	broker.Publish(t.GetTestContext(), []dbsql.BridgeEvent{{TxHash: "0x1", Kappa: sql.NullString{String: "a", Valid: true}}})
+	consumerDB.AssertNotCalled(t.T(), "GetBridgeEvent", mock.Anything, mock.Anything)
+
+	events := broker.Subscribe(t.GetTestContext())
+	queriesKappa := func(kappa string) interface{} {
+		return mock.MatchedBy(func(query string) bool {
+			return strings.Contains(query, fmt.Sprintf("destination_kappa = '%s'", kappa))
+		})
+	}
+	consumerDB.On("GetBridgeEvent", mock.Anything, queriesKappa("a")).Return(&dbsql.BridgeEvent{TxHash: "0xa"}, nil).Once()
+	consumerDB.On("GetBridgeEvent", mock.Anything, queriesKappa("c")).Return(&dbsql.BridgeEvent{}, nil).Once()
+
+	broker.Publish(t.GetTestContext(), []dbsql.BridgeEvent{
+		{TxHash: "0x2", Kappa: sql.NullString{String: "a", Valid: true}},
+		{TxHash: "0xb", DestinationKappa: "b"},
+		{TxHash: "0x3", Kappa: sql.NullString{String: "b", Valid: true}},
+		{TxHash: "0x4", Kappa: sql.NullString{String: "c", Valid: true}},
+	})
+	batch := <-events
+	Len(t.T(), batch, 4)
+	// the origin is looked up once for every subscriber.
+	Equal(t.T(), "0xa", batch[0].Origin.TxHash)
+	// origin events have no origin.
+	Nil(t.T(), batch[1].Origin)
+	// origins in the same batch are not looked up.
+	Equal(t.T(), "0xb", batch[2].Origin.TxHash)
+	// origins that have not been indexed are nil.
+	Nil(t.T(), batch[3].Origin)
+	consumerDB.AssertExpectations(t.T())
+}
@@ -0,0 +1,22 @@
+package pubsub_test
+
+import (
+	"github.com/stretchr/testify/suite"
+	"github.com/synapsecns/sanguine/core/testsuite"
+	"testing"
+)
+
+type PubSubSuite struct {
+	*testsuite.TestSuite
+}
+
+func NewPubSubSuite(tb testing.TB) *PubSubSuite {
+	tb.Helper()
+	return &PubSubSuite{
+		TestSuite: testsuite.NewTestSuite(tb),
+	}
+}
+
+func TestPubSubSuite(t *testing.T) {
+	suite.Run(t, NewPubSubSuite(t))
+}
@@ -0,0 +1,2 @@
+// Package postgres spins up a temp postgres db with docker.
+package postgres
@@ -36,13 +36,18 @@
 	CircleRequestSentEvent // Origin
 	// CircleRequestFulfilledEvent is emitted when the destination bridge event is executed using the cctp contract.
 	CircleRequestFulfilledEvent // Destination
+	// RFQBridgeRequestedEvent is emitted when the origin bridge event is executed using the rfq fastbridge contract.
+	RFQBridgeRequestedEvent // Origin
+	// RFQBridgeRelayedEvent is emitted when the destination bridge event is executed using the rfq fastbridge contract.
+	RFQBridgeRelayedEvent // Destination
 )
 
 // AllEventTypes is a list of the event types.
 func AllEventTypes() []EventType {
 	return []EventType{DepositEvent, RedeemEvent, WithdrawEvent, MintEvent,
 		DepositAndSwapEvent, MintAndSwapEvent, RedeemAndSwapEvent, RedeemAndRemoveEvent,
-		WithdrawAndRemoveEvent, RedeemV2Event, CircleRequestSentEvent, CircleRequestFulfilledEvent}
+		WithdrawAndRemoveEvent, RedeemV2Event, CircleRequestSentEvent, CircleRequestFulfilledEvent,
+		RFQBridgeRequestedEvent, RFQBridgeRelayedEvent}
 }
 
 // GetEventType gets the str/clear text event type from EventType.
@@ -74,6 +79,10 @@ func GetEventType(eventType uint8) string {
 		return "CircleRequestSentEvent"
 	case 11:
 		return "CircleRequestFulfilledEvent"
+	case 12:
+		return "RFQBridgeRequestedEvent"
+	case 13:
+		return "RFQBridgeRelayedEvent"
 	default:
 		return "Unknown"
 	}
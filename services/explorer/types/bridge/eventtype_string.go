@@ -20,11 +20,13 @@ func _() {
 	_ = x[RedeemV2Event-9]
 	_ = x[CircleRequestSentEvent-10]
 	_ = x[CircleRequestFulfilledEvent-11]
+	_ = x[RFQBridgeRequestedEvent-12]
+	_ = x[RFQBridgeRelayedEvent-13]
 }
 
-const _EventType_name = "DepositEventRedeemEventWithdrawEventMintEventDepositAndSwapEventMintAndSwapEventRedeemAndSwapEventRedeemAndRemoveEventWithdrawAndRemoveEventRedeemV2EventCircleRequestSentEventCircleRequestFulfilledEvent"
+const _EventType_name = "DepositEventRedeemEventWithdrawEventMintEventDepositAndSwapEventMintAndSwapEventRedeemAndSwapEventRedeemAndRemoveEventWithdrawAndRemoveEventRedeemV2EventCircleRequestSentEventCircleRequestFulfilledEventRFQBridgeRequestedEventRFQBridgeRelayedEvent"
 
-var _EventType_index = [...]uint8{0, 12, 23, 36, 45, 64, 80, 98, 118, 140, 153, 175, 202}
+var _EventType_index = [...]uint8{0, 12, 23, 36, 45, 64, 80, 98, 118, 140, 153, 175, 202, 225, 246}
 
 func (i EventType) String() string {
 	if i >= EventType(len(_EventType_index)-1) {
@@ -0,0 +1,15 @@
+package bridge_test
+
+import (
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/explorer/types/bridge"
+)
+
+// TestEventTypeValues pins the values of the event types that are served as eventType by the graphql api,
+// which consumers such as the stip relayer match on.
+func TestEventTypeValues(t *testing.T) {
+	Equal(t, 10, int(bridge.CircleRequestSentEvent))
+	Equal(t, 12, int(bridge.RFQBridgeRequestedEvent))
+}
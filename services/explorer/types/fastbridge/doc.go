@@ -0,0 +1,2 @@
+// Package fastbridge contains the event types of the rfq FastBridge contract.
+package fastbridge
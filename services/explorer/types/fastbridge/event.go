@@ -0,0 +1,47 @@
+package fastbridge
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EventLog is the interface for all fastbridge events.
+//
+//nolint:interfacebloat
+type EventLog interface {
+	// GetTxHash returns the transaction hash of the log.
+	GetTxHash() common.Hash
+	// GetContractAddress returns the contract address of the log.
+	GetContractAddress() common.Address
+	// GetBlockNumber returns the block number of the log.
+	GetBlockNumber() uint64
+	// GetEventType returns the event type of the log.
+	GetEventType() EventType
+	// GetEventIndex returns the index of the log.
+	GetEventIndex() uint64
+	// GetTransactionID returns the transaction id of the rfq bridge, shared by its origin and destination events.
+	GetTransactionID() [32]byte
+	// GetOriginChainID returns the origin chain id of the rfq bridge.
+	GetOriginChainID() *big.Int
+	// GetDestinationChainID returns the destination chain id of the rfq bridge.
+	GetDestinationChainID() *big.Int
+	// GetSender returns the sender of the rfq bridge on origin.
+	GetSender() *string
+	// GetRecipient returns the recipient of the tokens.
+	GetRecipient() *string
+	// GetRelayer returns the relayer of the rfq bridge.
+	GetRelayer() *string
+	// GetOriginToken returns the address of the token on origin.
+	GetOriginToken() *string
+	// GetDestinationToken returns the address of the token on destination.
+	GetDestinationToken() *string
+	// GetOriginAmount returns the amount of the origin token.
+	GetOriginAmount() *big.Int
+	// GetDestinationAmount returns the amount of the destination token.
+	GetDestinationAmount() *big.Int
+	// GetChainGasAmount returns the amount of gas sent to the recipient on destination.
+	GetChainGasAmount() *big.Int
+	// GetRequest returns the encoded bridge transaction of the request.
+	GetRequest() *[]byte
+}
@@ -0,0 +1,32 @@
+package fastbridge
+
+// EventType is the type of the fastbridge event.
+//
+//go:generate go run golang.org/x/tools/cmd/stringer -type=EventType
+type EventType uint8
+
+const (
+	// BridgeRequestedEvent is emitted on origin when a user requests an rfq bridge.
+	BridgeRequestedEvent EventType = iota // Origin
+	// BridgeRelayedEvent is emitted on destination when a relayer completes an rfq bridge.
+	BridgeRelayedEvent // Destination
+	// BridgeProofProvidedEvent is emitted on origin when a relayer proves it relayed a bridge.
+	BridgeProofProvidedEvent // Origin
+	// BridgeDepositClaimedEvent is emitted on origin when a relayer claims the deposit of a proven bridge.
+	BridgeDepositClaimedEvent // Origin
+	// BridgeDepositRefundedEvent is emitted on origin when the deposit of an unrelayed bridge is refunded.
+	BridgeDepositRefundedEvent // Origin
+	// BridgeProofDisputedEvent is emitted on origin when a guard disputes a relayer's proof.
+	BridgeProofDisputedEvent // Origin
+)
+
+// AllEventTypes is a list of the event types.
+func AllEventTypes() []EventType {
+	return []EventType{BridgeRequestedEvent, BridgeRelayedEvent, BridgeProofProvidedEvent,
+		BridgeDepositClaimedEvent, BridgeDepositRefundedEvent, BridgeProofDisputedEvent}
+}
+
+// Int gets the int value of the event type.
+func (i EventType) Int() uint8 {
+	return uint8(i)
+}
@@ -5,16 +5,19 @@
 	"github.com/synapsecns/sanguine/services/explorer/consumer/parser"
 	bridgeContract "github.com/synapsecns/sanguine/services/explorer/contracts/bridge"
 	cctpContract "github.com/synapsecns/sanguine/services/explorer/contracts/cctp"
+	fastBridgeContract "github.com/synapsecns/sanguine/services/explorer/contracts/fastbridge"
 )
 
 // ServerParsers is a custom type for holding parsers for the server.
 type ServerParsers struct {
-	BridgeParsers map[uint32]*parser.BridgeParser
-	CCTParsers    map[uint32]*parser.CCTPParser
+	BridgeParsers     map[uint32]*parser.BridgeParser
+	CCTParsers        map[uint32]*parser.CCTPParser
+	FastBridgeParsers map[uint32]*parser.FastBridgeParser
 }
 
 // ServerRefs is a custom type for holding refs for the server.
 type ServerRefs struct {
-	BridgeRefs map[uint32]*bridgeContract.BridgeRef
-	CCTPRefs   map[uint32]*cctpContract.CCTPRef
+	BridgeRefs     map[uint32]*bridgeContract.BridgeRef
+	CCTPRefs       map[uint32]*cctpContract.CCTPRef
+	FastBridgeRefs map[uint32]*fastBridgeContract.FastBridgeRef
 }
@@ -0,0 +1,59 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+)
+
+// Cache stores rpc responses by key.
+type Cache interface {
+	// Get gets a value from the cache. ok is false if the key is not present.
+	Get(ctx context.Context, key string) (value []byte, ok bool)
+	// Put adds a value to the cache, overwriting any previous value.
+	Put(ctx context.Context, key string, value []byte) error
+}
+
+// Type is the type of cache backend.
+type Type string
+
+const (
+	// MemoryType is an in memory lru cache.
+	MemoryType Type = "memory"
+	// DiskType is an on disk cache.
+	DiskType Type = "disk"
+)
+
+// defaultSize is the default number of responses held by the memory cache.
+const defaultSize = 10000
+
+// NewCacheFromConfig creates a cache from the config. A nil cache is returned if caching is disabled.
+func NewCacheFromConfig(cfg config.CacheConfig, handler metrics.Handler) (Cache, error) {
+	var backend Cache
+	var err error
+
+	switch Type(cfg.Type) {
+	case "":
+		//nolint: nilnil
+		return nil, nil
+	case MemoryType:
+		size := cfg.Size
+		if size == 0 {
+			size = defaultSize
+		}
+
+		backend, err = NewMemoryCache(size)
+	case DiskType:
+		backend, err = NewDiskCache(cfg.Path)
+	default:
+		return nil, fmt.Errorf("unknown cache type %s, must be one of %s or %s", cfg.Type, MemoryType, DiskType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewInstrumentedCache(backend, handler, cfg.Type)
+}
@@ -0,0 +1,80 @@
+package cache_test
+
+import (
+	"fmt"
+
+	"github.com/Flaque/filet"
+	"github.com/brianvoe/gofakeit/v6"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/omnirpc/cache"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+)
+
+func (c *CacheSuite) testCache(responseCache cache.Cache) {
+	key := gofakeit.UUID()
+	value := []byte(gofakeit.Sentence(10))
+
+	_, ok := responseCache.Get(c.GetTestContext(), key)
+	False(c.T(), ok)
+
+	err := responseCache.Put(c.GetTestContext(), key, value)
+	Nil(c.T(), err)
+
+	res, ok := responseCache.Get(c.GetTestContext(), key)
+	True(c.T(), ok)
+	Equal(c.T(), value, res)
+}
+
+func (c *CacheSuite) TestMemoryCache() {
+	responseCache, err := cache.NewMemoryCache(10)
+	c.Require().NoError(err)
+
+	c.testCache(responseCache)
+}
+
+func (c *CacheSuite) TestMemoryCacheEviction() {
+	const size = 2
+	responseCache, err := cache.NewMemoryCache(size)
+	c.Require().NoError(err)
+
+	for i := 0; i <= size; i++ {
+		c.Require().NoError(responseCache.Put(c.GetTestContext(), fmt.Sprintf("key-%d", i), []byte{byte(i)}))
+	}
+
+	// the least recently used key should be evicted
+	_, ok := responseCache.Get(c.GetTestContext(), "key-0")
+	False(c.T(), ok)
+}
+
+func (c *CacheSuite) TestDiskCache() {
+	dir := filet.TmpDir(c.T(), "")
+
+	responseCache, err := cache.NewDiskCache(dir)
+	c.Require().NoError(err)
+	c.testCache(responseCache)
+
+	// a new cache in the same dir should see the previous responses
+	key := gofakeit.UUID()
+	c.Require().NoError(responseCache.Put(c.GetTestContext(), key, []byte(key)))
+
+	reopened, err := cache.NewDiskCache(dir)
+	c.Require().NoError(err)
+
+	res, ok := reopened.Get(c.GetTestContext(), key)
+	True(c.T(), ok)
+	Equal(c.T(), []byte(key), res)
+}
+
+func (c *CacheSuite) TestCacheFromConfig() {
+	responseCache, err := cache.NewCacheFromConfig(config.CacheConfig{}, metrics.NewNullHandler())
+	Nil(c.T(), err)
+	Nil(c.T(), responseCache)
+
+	responseCache, err = cache.NewCacheFromConfig(config.CacheConfig{Type: string(cache.MemoryType)}, metrics.NewNullHandler())
+	Nil(c.T(), err)
+	c.testCache(responseCache)
+
+	_, err = cache.NewCacheFromConfig(config.CacheConfig{Type: gofakeit.Word()}, metrics.NewNullHandler())
+	NotNil(c.T(), err)
+}
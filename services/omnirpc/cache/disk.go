@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/synapsecns/sanguine/core"
+)
+
+// diskCache stores each response as a file. Since cached responses never change, entries are never evicted.
+type diskCache struct {
+	// dir is the directory responses are stored in
+	dir string
+}
+
+// NewDiskCache creates a new cache in the directory at path. The directory is created if it doesn't exist.
+func NewDiskCache(path string) (Cache, error) {
+	if path == "" {
+		return nil, fmt.Errorf("disk cache requires a path")
+	}
+
+	dir := core.ExpandOrReturnPath(path)
+	//nolint: gosec
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, fmt.Errorf("could not create cache dir %s: %w", dir, err)
+	}
+
+	return &diskCache{
+		dir: dir,
+	}, nil
+}
+
+// path gets the file path for a key. Keys are hashed so they're always valid file names and
+// files are sharded by the first byte of the hash to keep directories small.
+func (d *diskCache) path(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	encoded := hex.EncodeToString(hash[:])
+	return filepath.Join(d.dir, encoded[:2], encoded)
+}
+
+func (d *diskCache) Get(_ context.Context, key string) ([]byte, bool) {
+	value, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+func (d *diskCache) Put(_ context.Context, key string, value []byte) error {
+	path := d.path(key)
+
+	//nolint: gosec
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return fmt.Errorf("could not create cache dir: %w", err)
+	}
+
+	// write to a temp file first so concurrent readers never see a partial response
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), "tmp-")
+	if err != nil {
+		return fmt.Errorf("could not create temp file: %w", err)
+	}
+
+	_, err = tmpFile.Write(value)
+	closeErr := tmpFile.Close()
+	if err != nil || closeErr != nil {
+		_ = os.Remove(tmpFile.Name())
+		return fmt.Errorf("could not write cache file: %v %v", err, closeErr)
+	}
+
+	err = os.Rename(tmpFile.Name(), path)
+	if err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return fmt.Errorf("could not move cache file: %w", err)
+	}
+
+	return nil
+}
+
+var _ Cache = &diskCache{}
@@ -0,0 +1,2 @@
+// Package cache provides response caches for rpc requests that are pinned to a finalized block.
+package cache
@@ -0,0 +1,36 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// memoryCache is an in memory lru cache.
+type memoryCache struct {
+	responses *lru.Cache[string, []byte]
+}
+
+// NewMemoryCache creates a new in memory cache holding up to size responses.
+func NewMemoryCache(size int) (Cache, error) {
+	responses, err := lru.New[string, []byte](size)
+	if err != nil {
+		return nil, fmt.Errorf("could not create lru cache: %w", err)
+	}
+
+	return &memoryCache{
+		responses: responses,
+	}, nil
+}
+
+func (m *memoryCache) Get(_ context.Context, key string) ([]byte, bool) {
+	return m.responses.Get(key)
+}
+
+func (m *memoryCache) Put(_ context.Context, key string, value []byte) error {
+	m.responses.Add(key, value)
+	return nil
+}
+
+var _ Cache = &memoryCache{}
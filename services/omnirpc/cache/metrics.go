@@ -0,0 +1,63 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/synapsecns/sanguine/core/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	meter        = "github.com/synapsecns/sanguine/services/omnirpc/cache"
+	hitsMetric   = "cache_hits"
+	missesMetric = "cache_misses"
+)
+
+// instrumentedCache records hits and misses of a backend.
+type instrumentedCache struct {
+	backend Cache
+	hits    metric.Int64Counter
+	misses  metric.Int64Counter
+	attrs   metric.MeasurementOption
+}
+
+// NewInstrumentedCache wraps a cache and records hit/miss counts to the metrics handler.
+func NewInstrumentedCache(backend Cache, handler metrics.Handler, backendName string) (Cache, error) {
+	meterMaid := handler.Meter(meter)
+
+	hits, err := meterMaid.Int64Counter(hitsMetric, metric.WithDescription("number of responses served from the cache"))
+	if err != nil {
+		return nil, fmt.Errorf("could not create counter: %w", err)
+	}
+
+	misses, err := meterMaid.Int64Counter(missesMetric, metric.WithDescription("number of cacheable responses not found in the cache"))
+	if err != nil {
+		return nil, fmt.Errorf("could not create counter: %w", err)
+	}
+
+	return &instrumentedCache{
+		backend: backend,
+		hits:    hits,
+		misses:  misses,
+		attrs:   metric.WithAttributeSet(attribute.NewSet(attribute.String("backend", backendName))),
+	}, nil
+}
+
+func (i *instrumentedCache) Get(ctx context.Context, key string) ([]byte, bool) {
+	value, ok := i.backend.Get(ctx, key)
+	if ok {
+		i.hits.Add(ctx, 1, i.attrs)
+	} else {
+		i.misses.Add(ctx, 1, i.attrs)
+	}
+	return value, ok
+}
+
+func (i *instrumentedCache) Put(ctx context.Context, key string, value []byte) error {
+	//nolint: wrapcheck
+	return i.backend.Put(ctx, key, value)
+}
+
+var _ Cache = &instrumentedCache{}
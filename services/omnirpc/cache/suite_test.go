@@ -0,0 +1,25 @@
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/synapsecns/sanguine/core/testsuite"
+)
+
+// CacheSuite defines the basic test suite.
+type CacheSuite struct {
+	*testsuite.TestSuite
+}
+
+// NewCacheSuite creates a new test suite.
+func NewCacheSuite(tb testing.TB) *CacheSuite {
+	tb.Helper()
+	return &CacheSuite{
+		testsuite.NewTestSuite(tb),
+	}
+}
+
+func TestCacheSuite(t *testing.T) {
+	suite.Run(t, NewCacheSuite(t))
+}
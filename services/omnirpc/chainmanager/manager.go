@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/ipfs/go-log"
 	"github.com/synapsecns/sanguine/core/metrics"
@@ -9,6 +10,7 @@
 	"github.com/synapsecns/sanguine/services/omnirpc/rpcinfo"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sort"
 	"sync"
 	"time"
@@ -19,6 +21,9 @@
 // rpcTimeout is how long to wait for a response.
 const rpcTimeout = time.Second * 5
 
+// DefaultFinalityBlocks is the default number of blocks behind the head a block must be to be considered final.
+const DefaultFinalityBlocks uint64 = 64
+
 // ChainManager manages chain context.
 type ChainManager interface {
 	// GetChainIDs gets all chainids
@@ -29,8 +34,29 @@ type ChainManager interface {
 	GetChain(chainID uint32) Chain
 	// PutChain adds chain urls. Any previous chain data is overwritten
 	PutChain(chainID uint32, urls []string, confirmations uint16)
+	// ApplyConfig updates chains to match the config. Chains not in the config are removed and
+	// latency info is kept for rpcs that are still configured.
+	ApplyConfig(configuration config.Config)
+	// AddRPC adds an rpc to a chain
+	AddRPC(chainID uint32, url string) error
+	// RemoveRPC removes an rpc from a chain
+	RemoveRPC(chainID uint32, url string) error
+	// SetRPCDisabled disables or re-enables an rpc. Disabled rpcs are still benchmarked but not returned by Chain.URLs()
+	SetRPCDisabled(chainID uint32, url string, disabled bool) error
+	// GetRPCInfo gets the latest latency info for every rpc on a chain, including disabled ones
+	GetRPCInfo(chainID uint32) ([]RPCInfo, error)
+}
+
+// RPCInfo is the latency info for an rpc along with its admin status.
+type RPCInfo struct {
+	rpcinfo.Result
+	// Disabled is whether or not the rpc has been disabled
+	Disabled bool
 }
 
+// ErrChainNotFound is returned when a chain is not configured.
+var ErrChainNotFound = errors.New("chain not found")
+
 // NewChainManager creates a new chain manager.
 func NewChainManager(handler metrics.Handler) ChainManager {
 	return &chainManager{
@@ -51,35 +77,59 @@ func NewChainManagerFromConfig(configuration config.Config, handler metrics.Hand
 	}
 
 	for chainID, chn := range configuration.Chains {
-		// default the confirmation threshold to 1
-		confThreshold := uint16(1)
+		cm.chainList[chainID] = newChainFromConfig(chainID, chn, nil)
+	}
+
+	err := cm.setupMetrics()
+	if err != nil {
+		logger.Errorf("could not setup metrics: %v", err)
+	}
+
+	return cm
+}
+
+// newChainFromConfig creates a chain from the config. Latency results from previous are kept
+// for rpcs that are still configured.
+func newChainFromConfig(chainID uint32, chn config.ChainConfig, previous *chain) *chain {
+	// default the confirmation threshold to 1
+	confThreshold := uint16(1)
 
-		// if confirmation threshold is 1, set the checks to 1
-		if chn.Checks > 0 {
-			confThreshold = chn.Checks
+	// if confirmation threshold is 1, set the checks to 1
+	if chn.Checks > 0 {
+		confThreshold = chn.Checks
+	}
+
+	previousResults := make(map[string]rpcinfo.Result)
+	if previous != nil {
+		for _, result := range previous.rpcs {
+			previousResults[result.URL] = result
 		}
+	}
 
-		// store all the chains w/ empty latency results
-		chains := make([]rpcinfo.Result, len(chn.RPCs))
-		for i := range chn.RPCs {
-			chains[i] = rpcinfo.Result{
+	// store all the chains w/ empty latency results unless we have previous results
+	rpcs := make([]rpcinfo.Result, len(chn.RPCs))
+	for i := range chn.RPCs {
+		result, ok := previousResults[chn.RPCs[i]]
+		if !ok {
+			result = rpcinfo.Result{
 				URL: chn.RPCs[i],
 			}
 		}
-
-		cm.chainList[chainID] = &chain{
-			chainID:               chainID,
-			confirmationThreshold: confThreshold,
-			rpcs:                  chains,
-		}
+		rpcs[i] = result
 	}
 
-	err := cm.setupMetrics()
-	if err != nil {
-		logger.Errorf("could not setup metrics: %v", err)
+	finalityBlocks := DefaultFinalityBlocks
+	if chn.FinalityBlocks > 0 {
+		finalityBlocks = chn.FinalityBlocks
 	}
 
-	return cm
+	return &chain{
+		chainID:               chainID,
+		confirmationThreshold: confThreshold,
+		finalityBlocks:        finalityBlocks,
+		rpcs:                  rpcs,
+		disabled:              sets.NewString(),
+	}
 }
 
 // chainManager contains a chain manager.
@@ -130,7 +180,109 @@ func (c *chainManager) PutChain(chainID uint32, urls []string, confirmations uin
 		chainID:               chainID,
 		rpcs:                  rpcs,
 		confirmationThreshold: confirmations,
+		finalityBlocks:        DefaultFinalityBlocks,
+		disabled:              sets.NewString(),
+	}
+}
+
+func (c *chainManager) ApplyConfig(configuration config.Config) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	chainList := make(map[uint32]*chain, len(configuration.Chains))
+	for chainID, chn := range configuration.Chains {
+		chainList[chainID] = newChainFromConfig(chainID, chn, c.chainList[chainID])
+	}
+
+	// in flight requests keep a reference to the old chains, so they can finish
+	c.chainList = chainList
+}
+
+func (c *chainManager) AddRPC(chainID uint32, url string) error {
+	return c.updateChain(chainID, func(updated *chain) error {
+		for _, rpc := range updated.rpcs {
+			if rpc.URL == url {
+				return fmt.Errorf("rpc %s already exists on chain %d", url, chainID)
+			}
+		}
+
+		updated.rpcs = append(updated.rpcs, rpcinfo.Result{URL: url})
+		return nil
+	})
+}
+
+func (c *chainManager) RemoveRPC(chainID uint32, url string) error {
+	return c.updateChain(chainID, func(updated *chain) error {
+		rpcs := make([]rpcinfo.Result, 0, len(updated.rpcs))
+		for _, rpc := range updated.rpcs {
+			if rpc.URL != url {
+				rpcs = append(rpcs, rpc)
+			}
+		}
+
+		if len(rpcs) == len(updated.rpcs) {
+			return fmt.Errorf("rpc %s not found on chain %d", url, chainID)
+		}
+
+		updated.rpcs = rpcs
+		updated.disabled.Delete(url)
+		return nil
+	})
+}
+
+func (c *chainManager) SetRPCDisabled(chainID uint32, url string, disabled bool) error {
+	return c.updateChain(chainID, func(updated *chain) error {
+		if !sets.NewString(updated.allURLs()...).Has(url) {
+			return fmt.Errorf("rpc %s not found on chain %d", url, chainID)
+		}
+
+		if disabled {
+			updated.disabled.Insert(url)
+		} else {
+			updated.disabled.Delete(url)
+		}
+		return nil
+	})
+}
+
+func (c *chainManager) GetRPCInfo(chainID uint32) ([]RPCInfo, error) {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	chn, ok := c.chainList[chainID]
+	if !ok {
+		return nil, fmt.Errorf("could not get chain %d: %w", chainID, ErrChainNotFound)
+	}
+
+	res := make([]RPCInfo, len(chn.rpcs))
+	for i, rpc := range chn.rpcs {
+		res[i] = RPCInfo{
+			Result:   rpc,
+			Disabled: chn.disabled.Has(rpc.URL),
+		}
+	}
+	return res, nil
+}
+
+// updateChain applies an update to a copy of a chain and swaps it in if the update succeeds.
+// chains are never modified in place so in flight requests see a consistent view.
+func (c *chainManager) updateChain(chainID uint32, update func(updated *chain) error) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	existing, ok := c.chainList[chainID]
+	if !ok {
+		return fmt.Errorf("could not get chain %d: %w", chainID, ErrChainNotFound)
+	}
+
+	updated := existing.copy()
+	err := update(updated)
+	if err != nil {
+		return err
 	}
+
+	c.chainList[chainID] = updated
+	return nil
 }
 
 // RefreshRPCInfo refreshes rpc info for a given chain id.
@@ -143,13 +295,24 @@ func (c *chainManager) RefreshRPCInfo(ctx context.Context, chainID uint32) {
 	if !ok {
 		return
 	}
-	rpcURLS := chainList.URLs()
+	// disabled rpcs are still benchmarked so the latency table is complete
+	rpcURLS := chainList.allURLs()
 
-	rpcInfoList := sortInfoList(rpcinfo.GetRPCLatency(ctx, rpcTimeout, rpcURLS, c.handler))
+	results := make(map[string]rpcinfo.Result)
+	for _, result := range rpcinfo.GetRPCLatency(ctx, rpcTimeout, rpcURLS, c.handler) {
+		results[result.URL] = result
+	}
 
-	c.mux.Lock()
-	c.chainList[chainID].rpcs = rpcInfoList
-	c.mux.Unlock()
+	// rpcs may have been added or removed while benchmarking, so merge into the current chain
+	_ = c.updateChain(chainID, func(updated *chain) error {
+		for i, rpc := range updated.rpcs {
+			if result, ok := results[rpc.URL]; ok {
+				updated.rpcs[i] = result
+			}
+		}
+		updated.rpcs = sortInfoList(updated.rpcs)
+		return nil
+	})
 }
 
 const (
@@ -239,6 +402,10 @@ type Chain interface {
 	URLs() []string
 	// ID returns the id of the chain
 	ID() uint32
+	// LatestBlock gets the highest block reported by any rpc. ok is false if no rpc has reported a block yet.
+	LatestBlock() (blockNumber uint64, ok bool)
+	// FinalizedBlock gets the highest block considered final. ok is false if no rpc has reported a block yet.
+	FinalizedBlock() (blockNumber uint64, ok bool)
 }
 
 // chain contains the settings for a single chain.
@@ -247,8 +414,35 @@ type chain struct {
 	chainID uint32
 	// confirmationThreshold is the confirmation threshold of the chain
 	confirmationThreshold uint16
+	// finalityBlocks is how many blocks behind the head a block must be to be considered final
+	finalityBlocks uint64
 	// rpcs contains a list of rpcs sorted by speed
 	rpcs []rpcinfo.Result
+	// disabled contains urls of rpcs that have been disabled
+	disabled sets.String
+}
+
+// copy creates a copy of the chain that can be modified without affecting the original.
+func (c *chain) copy() *chain {
+	rpcs := make([]rpcinfo.Result, len(c.rpcs))
+	copy(rpcs, c.rpcs)
+
+	return &chain{
+		chainID:               c.chainID,
+		confirmationThreshold: c.confirmationThreshold,
+		finalityBlocks:        c.finalityBlocks,
+		rpcs:                  rpcs,
+		disabled:              sets.NewString(c.disabled.List()...),
+	}
+}
+
+// allURLs gets all urls for a chain, including disabled ones.
+func (c *chain) allURLs() (res []string) {
+	res = make([]string, len(c.rpcs))
+	for i, chainInfo := range c.rpcs {
+		res[i] = chainInfo.URL
+	}
+	return res
 }
 
 func (c *chain) ID() uint32 {
@@ -259,13 +453,37 @@ func (c *chain) ConfirmationsThreshold() uint16 {
 	return c.confirmationThreshold
 }
 
-// URLs gets all urls for a chain.
+// URLs gets all enabled urls for a chain.
 func (c *chain) URLs() (res []string) {
-	res = make([]string, len(c.rpcs))
-	for i, chainInfo := range c.rpcs {
-		res[i] = chainInfo.URL
+	res = make([]string, 0, len(c.rpcs))
+	for _, chainInfo := range c.rpcs {
+		if c.disabled.Has(chainInfo.URL) {
+			continue
+		}
+		res = append(res, chainInfo.URL)
 	}
 	return res
 }
 
+// LatestBlock gets the highest block reported by any rpc at the last benchmark.
+func (c *chain) LatestBlock() (blockNumber uint64, ok bool) {
+	for _, chainInfo := range c.rpcs {
+		if !chainInfo.HasError && chainInfo.BlockNumber > blockNumber {
+			blockNumber = chainInfo.BlockNumber
+		}
+	}
+
+	return blockNumber, blockNumber > 0
+}
+
+// FinalizedBlock gets the highest block considered final based on the latest block reported by any rpc.
+func (c *chain) FinalizedBlock() (blockNumber uint64, ok bool) {
+	head, ok := c.LatestBlock()
+	if !ok || head <= c.finalityBlocks {
+		return 0, false
+	}
+
+	return head - c.finalityBlocks, true
+}
+
 var _ Chain = &chain{}
@@ -8,6 +8,7 @@
 	. "github.com/stretchr/testify/assert"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/services/omnirpc/chainmanager"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
 	"github.com/synapsecns/sanguine/services/omnirpc/metadata"
 	"github.com/synapsecns/sanguine/services/omnirpc/rpcinfo"
 	"sort"
@@ -90,3 +91,70 @@ func sortSlice(res []uint32) []uint32 {
 	})
 	return res
 }
+
+func TestApplyConfig(t *testing.T) {
+	nullHandler, err := metrics.NewByType(context.Background(), metadata.BuildInfo(), metrics.Null)
+	NoError(t, err)
+
+	keptURL, removedURL, addedURL := gofakeit.URL(), gofakeit.URL(), gofakeit.URL()
+
+	cm := chainmanager.NewChainManagerFromConfig(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			1: {RPCs: []string{keptURL, removedURL}},
+			2: {RPCs: []string{gofakeit.URL()}},
+		},
+	}, nullHandler)
+
+	oldChain := cm.GetChain(1)
+
+	cm.ApplyConfig(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			1: {RPCs: []string{keptURL, addedURL}, Checks: 2},
+		},
+	})
+
+	// chains not in the config are removed
+	Nil(t, cm.GetChain(2))
+
+	chain := cm.GetChain(1)
+	Equal(t, []string{keptURL, addedURL}, chain.URLs())
+	Equal(t, uint16(2), chain.ConfirmationsThreshold())
+
+	// in flight requests keep the old chain
+	Equal(t, []string{keptURL, removedURL}, oldChain.URLs())
+}
+
+func TestAdminRPCs(t *testing.T) {
+	nullHandler, err := metrics.NewByType(context.Background(), metadata.BuildInfo(), metrics.Null)
+	NoError(t, err)
+
+	const chainID = 1
+	firstURL, secondURL := gofakeit.URL(), gofakeit.URL()
+
+	cm := chainmanager.NewChainManager(nullHandler)
+	cm.PutChain(chainID, []string{firstURL}, 1)
+
+	NoError(t, cm.AddRPC(chainID, secondURL))
+	Error(t, cm.AddRPC(chainID, secondURL))
+	Equal(t, []string{firstURL, secondURL}, cm.GetChain(chainID).URLs())
+
+	// disabled rpcs aren't used but are still listed
+	NoError(t, cm.SetRPCDisabled(chainID, firstURL, true))
+	Equal(t, []string{secondURL}, cm.GetChain(chainID).URLs())
+
+	infos, err := cm.GetRPCInfo(chainID)
+	NoError(t, err)
+	Len(t, infos, 2)
+	True(t, infos[0].Disabled)
+	False(t, infos[1].Disabled)
+
+	NoError(t, cm.SetRPCDisabled(chainID, firstURL, false))
+	Equal(t, []string{firstURL, secondURL}, cm.GetChain(chainID).URLs())
+
+	NoError(t, cm.RemoveRPC(chainID, firstURL))
+	Error(t, cm.RemoveRPC(chainID, firstURL))
+	Equal(t, []string{secondURL}, cm.GetChain(chainID).URLs())
+
+	_, err = cm.GetRPCInfo(gofakeit.Uint32())
+	ErrorIs(t, err, chainmanager.ErrChainNotFound)
+}
@@ -23,6 +23,27 @@ func (_m *Chain) ConfirmationsThreshold() uint16 {
 	return r0
 }
 
+// FinalizedBlock provides a mock function with given fields:
+func (_m *Chain) FinalizedBlock() (uint64, bool) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // ID provides a mock function with given fields:
 func (_m *Chain) ID() uint32 {
 	ret := _m.Called()
@@ -37,6 +58,27 @@ func (_m *Chain) ID() uint32 {
 	return r0
 }
 
+// LatestBlock provides a mock function with given fields:
+func (_m *Chain) LatestBlock() (uint64, bool) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // URLs provides a mock function with given fields:
 func (_m *Chain) URLs() []string {
 	ret := _m.Called()
@@ -59,7 +59,10 @@
 			rConfig.Port = uint16(freeport.GetPort())
 		}
 
-		server := proxy.NewProxy(rConfig, metrics.Get())
+		server, err := proxy.NewProxy(rConfig, metrics.Get())
+		if err != nil {
+			return fmt.Errorf("could not create proxy: %w", err)
+		}
 
 		server.Run(c.Context)
 
@@ -113,7 +116,8 @@
 		// See: https://blog.twitch.tv/en/2019/04/10/go-memory-ballast-how-i-learnt-to-stop-worrying-and-love-the-heap/
 		_ = make([]byte, 10<<30)
 
-		fileContents, err := os.ReadFile(core.ExpandOrReturnPath(c.String(configFlag.Name)))
+		configPath := core.ExpandOrReturnPath(c.String(configFlag.Name))
+		fileContents, err := os.ReadFile(configPath)
 		if err != nil {
 			return fmt.Errorf("could not read file %s: %w", c.String(configFlag.Name), err)
 		}
@@ -130,7 +134,18 @@
 			rConfig.Port = uint16(freeport.GetPort())
 		}
 
-		server := proxy.NewProxy(rConfig, metrics.Get())
+		server, err := proxy.NewProxy(rConfig, metrics.Get())
+		if err != nil {
+			return fmt.Errorf("could not create proxy: %w", err)
+		}
+
+		// reload on file change or SIGHUP
+		go func() {
+			err := rpcConfig.Watch(c.Context, configPath, server.ApplyConfig)
+			if err != nil {
+				logger.Errorf("could not watch config, hot reloading is disabled: %v", err)
+			}
+		}()
 
 		server.Run(c.Context)
 
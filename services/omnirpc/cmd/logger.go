@@ -0,0 +1,5 @@
+package cmd
+
+import "github.com/ipfs/go-log"
+
+var logger = log.Logger("omnirpc-cmd")
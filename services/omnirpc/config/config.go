@@ -18,6 +18,31 @@ type Config struct {
 	RefreshInterval int `yaml:"refresh_interval,omitempty"`
 	// ClientType is the client type to use
 	ClientType string `yaml:"client_type,omitempty"`
+	// Cache is the config for the response cache. Caching is disabled if no type is set
+	Cache CacheConfig `yaml:"cache,omitempty"`
+	// CircuitBreaker is the config for ejecting failing rpcs
+	CircuitBreaker CircuitBreakerConfig `yaml:"circuit_breaker,omitempty"`
+	// AdminToken is the bearer token required by the admin api. The admin api is disabled if this is empty
+	AdminToken string `yaml:"admin_token,omitempty"`
+}
+
+// CircuitBreakerConfig is the config for the per rpc circuit breaker.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures before an rpc is ejected
+	FailureThreshold int `yaml:"failure_threshold,omitempty"`
+	// Cooldown is how long an ejected rpc is skipped before it's probed again
+	// expressed in seconds
+	Cooldown int `yaml:"cooldown,omitempty"`
+}
+
+// CacheConfig is the config for the finalized response cache.
+type CacheConfig struct {
+	// Type is the cache backend, either memory or disk
+	Type string `yaml:"type,omitempty"`
+	// Size is the max number of responses held by the memory cache
+	Size int `yaml:"size,omitempty"`
+	// Path is the directory used by the disk cache
+	Path string `yaml:"path,omitempty"`
 }
 
 // ChainConfig is the config for a single chain.
@@ -26,6 +51,42 @@ type ChainConfig struct {
 	RPCs []string `yaml:"rpcs"`
 	// Checks is how many rpcs must return the same result for it to be used. This does not apply to height/status based methods
 	Checks uint16 `yaml:"confirmations,omitempty"`
+	// FinalityBlocks is how many blocks behind the head a block must be before responses pinned to it are cached
+	FinalityBlocks uint64 `yaml:"finality_blocks,omitempty"`
+	// RPCOptions contains optional limits for individual rpcs keyed by rpc url
+	RPCOptions map[string]RPCOptions `yaml:"rpc_options,omitempty"`
+	// Routes are method based routing rules. The first matching route is used, requests that
+	// don't match any route can use any rpc
+	Routes []RouteConfig `yaml:"routes,omitempty"`
+	// MaxLogRange is the max number of blocks in an eth_getLogs request sent to an rpc. Wider ranges are split
+	// across rpcs. 0 only splits ranges rpcs reject as too large
+	MaxLogRange uint64 `yaml:"max_log_range,omitempty"`
+}
+
+// RouteConfig is a method based routing rule.
+type RouteConfig struct {
+	// Methods are the methods the route applies to. A trailing * matches any method with the prefix, e.g. debug_*
+	Methods []string `yaml:"methods"`
+	// Tags restricts the route to rpcs with at least one of the tags. Any rpc can be used if empty
+	Tags []string `yaml:"tags,omitempty"`
+	// Broadcast sends the request to every healthy rpc and returns the first successful response
+	Broadcast bool `yaml:"broadcast,omitempty"`
+	// HistoricalBlocks restricts the route to requests pinned to a block at least this many blocks behind the head
+	HistoricalBlocks uint64 `yaml:"historical_blocks,omitempty"`
+}
+
+// RPCOptions contains optional limits for a single rpc.
+type RPCOptions struct {
+	// RequestsPerSecond is the max number of requests per second sent to the rpc. 0 is unlimited
+	RequestsPerSecond float64 `yaml:"requests_per_second,omitempty"`
+	// MaxConcurrency is the max number of in flight requests to the rpc. 0 is unlimited
+	MaxConcurrency int `yaml:"max_concurrency,omitempty"`
+	// Weight is the relative share of requests routed to the rpc. Defaults to 1
+	Weight uint `yaml:"weight,omitempty"`
+	// Tags are used by routes to select rpcs, e.g. archive or trace
+	Tags []string `yaml:"tags,omitempty"`
+	// MaxLogRange overrides the chains max_log_range for the rpc
+	MaxLogRange uint64 `yaml:"max_log_range,omitempty"`
 }
 
 // UnmarshallConfig unmarshalls a config.
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// reloadDebounce is how long to wait for writes to settle before reloading.
+// editors often write a file in several steps.
+const reloadDebounce = time.Millisecond * 250
+
+// Watch calls onChange with the new config whenever the file at path changes or the process receives a SIGHUP.
+// Configs that can't be read or parsed are logged and skipped. Watch blocks until the context is canceled.
+func Watch(ctx context.Context, path string, onChange func(Config)) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("could not create watcher: %w", err)
+	}
+	defer func() {
+		_ = watcher.Close()
+	}()
+
+	// watch the directory rather than the file so renames (used by most editors and k8s config maps) are picked up
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("could not get absolute path of %s: %w", path, err)
+	}
+
+	err = watcher.Add(filepath.Dir(absPath))
+	if err != nil {
+		return fmt.Errorf("could not watch %s: %w", path, err)
+	}
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	// debounce is nil until a change is seen
+	var debounce <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-hup:
+			logger.Infof("received SIGHUP, reloading %s", absPath)
+			reload(absPath, onChange)
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			if filepath.Clean(event.Name) != absPath || event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+				continue
+			}
+			debounce = time.After(reloadDebounce)
+		case <-debounce:
+			debounce = nil
+			logger.Infof("%s changed, reloading", absPath)
+			reload(absPath, onChange)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			logger.Warnf("error watching config: %v", err)
+		}
+	}
+}
+
+func reload(path string, onChange func(Config)) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		logger.Warnf("could not read config %s, keeping current config: %v", path, err)
+		return
+	}
+
+	cfg, err := UnmarshallConfig(contents)
+	if err != nil {
+		logger.Warnf("could not parse config %s, keeping current config: %v", path, err)
+		return
+	}
+
+	onChange(cfg)
+}
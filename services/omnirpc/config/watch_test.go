@@ -0,0 +1,52 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+)
+
+func TestWatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	configPath := filepath.Join(t.TempDir(), "omnirpc.yaml")
+	writeConfig := func(cfg config.Config) {
+		out, err := cfg.Marshall()
+		NoError(t, err)
+		NoError(t, os.WriteFile(configPath, out, 0600))
+	}
+
+	writeConfig(config.Config{Port: 1})
+
+	changes := make(chan config.Config, 10)
+	go func() {
+		NoError(t, config.Watch(ctx, configPath, func(cfg config.Config) {
+			changes <- cfg
+		}))
+	}()
+
+	// give the watcher time to start
+	time.Sleep(time.Millisecond * 100)
+
+	newConfig := config.Config{
+		Port: 2,
+		Chains: map[uint32]config.ChainConfig{
+			1: {RPCs: []string{gofakeit.URL()}},
+		},
+	}
+	writeConfig(newConfig)
+
+	select {
+	case cfg := <-changes:
+		Equal(t, newConfig, cfg)
+	case <-time.After(time.Second * 10):
+		t.Fatal("config was not reloaded")
+	}
+}
@@ -0,0 +1,195 @@
+package proxy
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/synapsecns/sanguine/services/omnirpc/chainmanager"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+)
+
+// AdminRPC is an rpc as returned by the admin api.
+type AdminRPC struct {
+	// URL is the url of the rpc
+	URL string `json:"url"`
+	// Disabled is whether or not the rpc was disabled through the admin api
+	Disabled bool `json:"disabled"`
+	// Available is false if the rpc has been ejected by the circuit breaker
+	Available bool `json:"available"`
+	// LatencySeconds is the latency of the last benchmark
+	LatencySeconds float64 `json:"latency_seconds"`
+	// BlockAgeSeconds is the age of the latest block at the last benchmark
+	BlockAgeSeconds float64 `json:"block_age_seconds"`
+	// BlockNumber is the latest block at the last benchmark
+	BlockNumber uint64 `json:"block_number"`
+	// Error is the error from the last benchmark, if any
+	Error string `json:"error,omitempty"`
+}
+
+// AdminRPCRequest is the body used to add, remove, enable or disable an rpc.
+type AdminRPCRequest struct {
+	// URL is the url of the rpc
+	URL string `json:"url" binding:"required"`
+}
+
+// ApplyConfig applies a new config without restarting the server. In flight requests finish against the old config.
+// Port, client type and cache settings require a restart. Changes made through the admin api are overwritten.
+func (r *RPCProxy) ApplyConfig(cfg config.Config) {
+	r.chainManager.ApplyConfig(cfg)
+	r.upstreams.ApplyConfig(cfg)
+
+	r.adminMux.Lock()
+	r.adminToken = cfg.AdminToken
+	r.adminMux.Unlock()
+
+	logger.Infof("applied new config with %d chains", len(cfg.Chains))
+}
+
+// setupAdminRoutes adds the authenticated admin api to the router.
+func (r *RPCProxy) setupAdminRoutes(router gin.IRouter) {
+	admin := router.Group("/admin", r.authenticateAdmin)
+
+	admin.GET("/latency", func(c *gin.Context) {
+		res := make(map[uint32][]AdminRPC)
+		for _, chainID := range r.chainManager.GetChainIDs() {
+			rpcs, err := r.adminRPCs(chainID)
+			if err != nil {
+				continue
+			}
+			res[chainID] = rpcs
+		}
+		c.JSON(http.StatusOK, res)
+	})
+
+	admin.GET("/chains/:id/rpcs", func(c *gin.Context) {
+		chainID, ok := adminChainID(c)
+		if !ok {
+			return
+		}
+
+		rpcs, err := r.adminRPCs(chainID)
+		if err != nil {
+			adminError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, rpcs)
+	})
+
+	admin.POST("/chains/:id/rpcs", r.adminRPCAction(r.chainManager.AddRPC))
+	admin.DELETE("/chains/:id/rpcs", r.adminRPCAction(r.chainManager.RemoveRPC))
+	admin.POST("/chains/:id/rpcs/disable", r.adminRPCAction(func(chainID uint32, url string) error {
+		return r.chainManager.SetRPCDisabled(chainID, url, true)
+	}))
+	admin.POST("/chains/:id/rpcs/enable", r.adminRPCAction(func(chainID uint32, url string) error {
+		return r.chainManager.SetRPCDisabled(chainID, url, false)
+	}))
+}
+
+// authenticateAdmin requires the configured admin token as a bearer token.
+func (r *RPCProxy) authenticateAdmin(c *gin.Context) {
+	r.adminMux.RLock()
+	token := r.adminToken
+	r.adminMux.RUnlock()
+
+	if token == "" {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+			"error": "admin api is disabled, set admin_token to enable it",
+		})
+		return
+	}
+
+	provided := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"error": "invalid admin token",
+		})
+		return
+	}
+
+	c.Next()
+}
+
+// adminRPCAction creates a handler that applies an action to the rpc in the request body and returns the updated rpcs.
+func (r *RPCProxy) adminRPCAction(action func(chainID uint32, url string) error) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		chainID, ok := adminChainID(c)
+		if !ok {
+			return
+		}
+
+		var req AdminRPCRequest
+		err := c.ShouldBindJSON(&req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": fmt.Sprintf("could not parse request: %v", err),
+			})
+			return
+		}
+
+		err = action(chainID, req.URL)
+		if err != nil {
+			adminError(c, err)
+			return
+		}
+
+		rpcs, err := r.adminRPCs(chainID)
+		if err != nil {
+			adminError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, rpcs)
+	}
+}
+
+// adminRPCs gets the rpcs of a chain in latency order.
+func (r *RPCProxy) adminRPCs(chainID uint32) ([]AdminRPC, error) {
+	infos, err := r.chainManager.GetRPCInfo(chainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get rpcs: %w", err)
+	}
+
+	rpcs := make([]AdminRPC, len(infos))
+	for i, info := range infos {
+		rpcs[i] = AdminRPC{
+			URL:             info.URL,
+			Disabled:        info.Disabled,
+			Available:       r.upstreams.Get(chainID, info.URL).Available(),
+			LatencySeconds:  info.Latency.Seconds(),
+			BlockAgeSeconds: info.BlockAge.Seconds(),
+			BlockNumber:     info.BlockNumber,
+		}
+
+		if info.Error != nil {
+			rpcs[i].Error = info.Error.Error()
+		}
+	}
+
+	return rpcs, nil
+}
+
+func adminChainID(c *gin.Context) (uint32, bool) {
+	chainID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": fmt.Sprintf("chainid must be a number: %s", c.Param("id")),
+		})
+		return 0, false
+	}
+	return uint32(chainID), true
+}
+
+func adminError(c *gin.Context, err error) {
+	status := http.StatusBadRequest
+	if errors.Is(err, chainmanager.ErrChainNotFound) {
+		status = http.StatusNotFound
+	}
+
+	c.JSON(status, gin.H{
+		"error": err.Error(),
+	})
+}
@@ -0,0 +1,97 @@
+package proxy_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gin-gonic/gin"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	"github.com/synapsecns/sanguine/services/omnirpc/proxy"
+)
+
+func (p *ProxySuite) adminRequest(router http.Handler, method, path, token string, body interface{}) *httptest.ResponseRecorder {
+	var reqBody []byte
+	if body != nil {
+		reqBody = p.MustMarshall(body)
+	}
+
+	req := httptest.NewRequest(method, path, bytes.NewReader(reqBody))
+	if token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func (p *ProxySuite) TestAdminDisabled() {
+	prxy, err := proxy.NewProxy(config.Config{}, p.metrics)
+	p.Require().NoError(err)
+	router := gin.New()
+	prxy.SetupAdminRoutes(router)
+
+	res := p.adminRequest(router, http.MethodGet, "/admin/latency", gofakeit.UUID(), nil)
+	Equal(p.T(), http.StatusNotFound, res.Code)
+}
+
+func (p *ProxySuite) TestAdminRPCs() {
+	const chainID = 1
+	token := gofakeit.UUID()
+	existingURL, newURL := gofakeit.URL(), gofakeit.URL()
+
+	prxy, err := proxy.NewProxy(config.Config{
+		AdminToken: token,
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {RPCs: []string{existingURL}},
+		},
+	}, p.metrics)
+	p.Require().NoError(err)
+	router := gin.New()
+	prxy.SetupAdminRoutes(router)
+
+	rpcsPath := fmt.Sprintf("/admin/chains/%d/rpcs", chainID)
+
+	res := p.adminRequest(router, http.MethodGet, rpcsPath, gofakeit.UUID(), nil)
+	Equal(p.T(), http.StatusUnauthorized, res.Code)
+
+	res = p.adminRequest(router, http.MethodPost, rpcsPath, token, proxy.AdminRPCRequest{URL: newURL})
+	Equal(p.T(), http.StatusOK, res.Code)
+
+	res = p.adminRequest(router, http.MethodPost, rpcsPath+"/disable", token, proxy.AdminRPCRequest{URL: existingURL})
+	Equal(p.T(), http.StatusOK, res.Code)
+
+	var rpcs []proxy.AdminRPC
+	p.Require().NoError(json.Unmarshal(res.Body.Bytes(), &rpcs))
+	p.Require().Len(rpcs, 2)
+	Equal(p.T(), existingURL, rpcs[0].URL)
+	True(p.T(), rpcs[0].Disabled)
+	Equal(p.T(), newURL, rpcs[1].URL)
+	False(p.T(), rpcs[1].Disabled)
+	True(p.T(), rpcs[1].Available)
+
+	res = p.adminRequest(router, http.MethodDelete, rpcsPath, token, proxy.AdminRPCRequest{URL: newURL})
+	Equal(p.T(), http.StatusOK, res.Code)
+
+	res = p.adminRequest(router, http.MethodGet, "/admin/chains/2/rpcs", token, nil)
+	Equal(p.T(), http.StatusNotFound, res.Code)
+
+	// reloading the config replaces admin changes
+	prxy.ApplyConfig(config.Config{
+		AdminToken: token,
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {RPCs: []string{existingURL}},
+		},
+	})
+
+	res = p.adminRequest(router, http.MethodGet, rpcsPath, token, nil)
+	Equal(p.T(), http.StatusOK, res.Code)
+	p.Require().NoError(json.Unmarshal(res.Body.Bytes(), &rpcs))
+	p.Require().Len(rpcs, 1)
+	False(p.T(), rpcs[0].Disabled)
+}
@@ -0,0 +1,245 @@
+package proxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// cacheHeader is a header specifying whether the response was served from the cache.
+const cacheHeader = "x-cache"
+
+const (
+	cacheHit  = "hit"
+	cacheMiss = "miss"
+)
+
+// blockPin describes the block a request is pinned to.
+type blockPin struct {
+	// blockNumber is the block number the request is pinned to
+	blockNumber uint64
+	// byHash is true if the request is pinned to a block hash. These responses never change.
+	byHash bool
+}
+
+// pinnedBlock gets the block a request is pinned to. ok is false if the response could change
+// regardless of finality (e.g. it uses latest or isn't tied to a block at all).
+//
+//nolint:cyclop
+func pinnedBlock(r rpc.Request) (pin blockPin, ok bool) {
+	//nolint: exhaustive
+	switch client.RPCMethod(r.Method) {
+	case client.BlockByNumberMethod, client.PendingTransactionCountMethod:
+		return blockArgPin(r.Params, 0)
+	case client.GetBalanceMethod, client.GetCodeMethod, client.TransactionCountMethod, client.CallMethod:
+		return blockArgPin(r.Params, 1)
+	case client.StorageAtMethod:
+		return blockArgPin(r.Params, 2)
+	case client.BlockByHashMethod, client.TransactionCountByHashMethod, client.TransactionByBlockHashAndIndexMethod:
+		return blockPin{byHash: true}, true
+	case client.GetLogsMethod:
+		if len(r.Params) == 0 {
+			return blockPin{}, false
+		}
+		return filterPin(r.Params[0])
+	}
+	return blockPin{}, false
+}
+
+// blockArgPin gets the pin of a block number or EIP-1898 block hash argument.
+func blockArgPin(params []json.RawMessage, index int) (blockPin, bool) {
+	if len(params) <= index {
+		return blockPin{}, false
+	}
+
+	var blockNumber hexutil.Uint64
+	if err := json.Unmarshal(params[index], &blockNumber); err == nil {
+		return blockPin{blockNumber: uint64(blockNumber)}, true
+	}
+
+	var blockHash struct {
+		BlockHash *string `json:"blockHash"`
+	}
+	if err := json.Unmarshal(params[index], &blockHash); err == nil && blockHash.BlockHash != nil {
+		return blockPin{byHash: true}, true
+	}
+
+	return blockPin{}, false
+}
+
+// filterPin gets the pin of a log filter. Filters are pinned to their to block.
+func filterPin(arg json.RawMessage) (blockPin, bool) {
+	var filter struct {
+		BlockHash *string         `json:"blockHash"`
+		FromBlock *hexutil.Uint64 `json:"fromBlock"`
+		ToBlock   *hexutil.Uint64 `json:"toBlock"`
+	}
+
+	// tags like latest won't unmarshall into a number and can't be cached
+	if err := json.Unmarshal(arg, &filter); err != nil {
+		return blockPin{}, false
+	}
+
+	if filter.BlockHash != nil {
+		return blockPin{byHash: true}, true
+	}
+
+	if filter.FromBlock == nil || filter.ToBlock == nil {
+		return blockPin{}, false
+	}
+
+	return blockPin{blockNumber: uint64(*filter.ToBlock)}, true
+}
+
+// cacheKey gets the cache key for a request. ok is false if the request isn't cacheable on the chain yet.
+func (f *Forwarder) cacheKey() (key string, ok bool) {
+	// batches are not cached
+	if len(f.rpcRequest) != 1 {
+		return "", false
+	}
+
+	request := f.rpcRequest[0]
+	pin, ok := pinnedBlock(request)
+	if !ok {
+		return "", false
+	}
+
+	if !pin.byHash {
+		finalizedBlock, known := f.chain.FinalizedBlock()
+		if !known || pin.blockNumber > finalizedBlock {
+			return "", false
+		}
+	}
+
+	params, err := standardizeParams(request.Params)
+	if err != nil {
+		return "", false
+	}
+
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d:%s:%s", f.chain.ID(), request.Method, params)))
+	return fmt.Sprintf("%x", hash), true
+}
+
+// standardizeParams produces params that are equal regardless of formatting, key order or hex casing.
+func standardizeParams(params []json.RawMessage) ([]byte, error) {
+	standardized := make([]interface{}, len(params))
+	for i, param := range params {
+		var value interface{}
+		err := json.Unmarshal(param, &value)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse param %d: %w", i, err)
+		}
+		standardized[i] = lowerHex(value)
+	}
+
+	//nolint: wrapcheck
+	return json.Marshal(standardized)
+}
+
+// lowerHex lowercases any hex strings so checksummed and non-checksummed addresses match.
+func lowerHex(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case string:
+		if strings.HasPrefix(typed, "0x") || strings.HasPrefix(typed, "0X") {
+			return strings.ToLower(typed)
+		}
+		return typed
+	case []interface{}:
+		for i := range typed {
+			typed[i] = lowerHex(typed[i])
+		}
+		return typed
+	case map[string]interface{}:
+		for key := range typed {
+			typed[key] = lowerHex(typed[key])
+		}
+		return typed
+	default:
+		return typed
+	}
+}
+
+// cachedResponse is a response stored in the cache.
+type cachedResponse struct {
+	// Confirmations is the number of rpcs the response was confirmed against
+	Confirmations uint16 `json:"confirmations"`
+	// Result is the result of the rpc call
+	Result json.RawMessage `json:"result"`
+}
+
+// serveFromCache serves the request from the cache if possible.
+func (f *Forwarder) serveFromCache(ctx context.Context) (served bool) {
+	if f.r.cache == nil {
+		return false
+	}
+
+	key, ok := f.cacheKey()
+	if !ok {
+		return false
+	}
+	f.cacheKeyValue = key
+
+	raw, ok := f.r.cache.Get(ctx, key)
+	if !ok {
+		f.c.Header(cacheHeader, cacheMiss)
+		return false
+	}
+
+	var cached cachedResponse
+	err := json.Unmarshal(raw, &cached)
+	// responses confirmed against fewer rpcs than required are refetched
+	if err != nil || cached.Confirmations < f.requiredConfirmations {
+		f.c.Header(cacheHeader, cacheMiss)
+		return false
+	}
+
+	res, err := json.Marshal(JSONRPCMessage{
+		Version: jsonRPCVersion,
+		ID:      f.rpcRequest[0].ID,
+		Result:  cached.Result,
+	})
+	if err != nil {
+		return false
+	}
+
+	f.span.SetAttributes(attribute.Bool("cache_hit", true))
+	f.c.Header(cacheHeader, cacheHit)
+	f.c.Data(http.StatusOK, gin.MIMEJSON, res)
+	return true
+}
+
+// storeInCache stores a confirmed response in the cache if the request is cacheable.
+func (f *Forwarder) storeInCache(ctx context.Context, response rawResponse) {
+	if f.r.cache == nil || f.cacheKeyValue == "" || response.hasError {
+		return
+	}
+
+	var rpcMessage JSONRPCMessage
+	err := json.Unmarshal(response.body, &rpcMessage)
+	// null results (e.g. a block hash that isn't known yet) could change
+	if err != nil || rpcMessage.Error != nil || len(rpcMessage.Result) == 0 || string(rpcMessage.Result) == "null" {
+		return
+	}
+
+	cached, err := json.Marshal(cachedResponse{
+		Confirmations: f.requiredConfirmations,
+		Result:        rpcMessage.Result,
+	})
+	if err != nil {
+		return
+	}
+
+	err = f.r.cache.Put(ctx, f.cacheKeyValue, cached)
+	if err != nil {
+		logger.Warnf("could not cache response: %v", err)
+	}
+}
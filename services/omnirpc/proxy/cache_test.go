@@ -0,0 +1,95 @@
+package proxy_test
+
+import (
+	"encoding/json"
+
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	chainManagerMocks "github.com/synapsecns/sanguine/services/omnirpc/chainmanager/mocks"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	"github.com/synapsecns/sanguine/services/omnirpc/proxy"
+)
+
+func (p *ProxySuite) TestCacheKey() {
+	prxy, err := proxy.NewProxy(config.Config{}, p.metrics)
+	p.Require().NoError(err)
+
+	const finalizedBlock = uint64(100)
+
+	chain := new(chainManagerMocks.Chain)
+	chain.On("ID").Return(uint32(1))
+	chain.On("FinalizedBlock").Return(finalizedBlock, true)
+
+	tests := []struct {
+		name      string
+		method    client.RPCMethod
+		params    []string
+		cacheable bool
+	}{
+		{name: "finalized block", method: client.BlockByNumberMethod, params: []string{`"0x10"`, `false`}, cacheable: true},
+		{name: "unfinalized block", method: client.BlockByNumberMethod, params: []string{`"0x100"`, `false`}, cacheable: false},
+		{name: "latest block", method: client.BlockByNumberMethod, params: []string{`"latest"`, `false`}, cacheable: false},
+		{name: "call at height", method: client.CallMethod, params: []string{`{"to":"0x0000000000000000000000000000000000000001"}`, `"0x5"`}, cacheable: true},
+		{name: "call by hash", method: client.CallMethod, params: []string{`{}`, `{"blockHash":"0x01"}`}, cacheable: true},
+		{name: "bounded logs", method: client.GetLogsMethod, params: []string{`{"fromBlock":"0x1","toBlock":"0x5"}`}, cacheable: true},
+		{name: "logs to latest", method: client.GetLogsMethod, params: []string{`{"fromBlock":"0x1","toBlock":"latest"}`}, cacheable: false},
+		{name: "block number", method: client.BlockNumberMethod, cacheable: false},
+	}
+
+	for _, test := range tests {
+		forwarder := prxy.AcquireForwarder()
+		forwarder.SetChain(chain)
+
+		var params []json.RawMessage
+		for _, param := range test.params {
+			params = append(params, json.RawMessage(param))
+		}
+		forwarder.SetRPCRequest([]rpc.Request{{ID: 1, Method: string(test.method), Params: params}})
+
+		_, ok := forwarder.CacheKey()
+		Equal(p.T(), test.cacheable, ok, test.name)
+
+		prxy.ReleaseForwarder(forwarder)
+	}
+}
+
+// TestCacheKeyStandardized makes sure equivalent params produce the same key.
+func (p *ProxySuite) TestCacheKeyStandardized() {
+	prxy, err := proxy.NewProxy(config.Config{}, p.metrics)
+	p.Require().NoError(err)
+
+	chain := new(chainManagerMocks.Chain)
+	chain.On("ID").Return(uint32(1))
+	chain.On("FinalizedBlock").Return(uint64(100), true)
+
+	var keys []string
+	for _, params := range [][]string{
+		{`{"to":"0xABCDEF0000000000000000000000000000000001","data":"0x01"}`, `"0x5"`},
+		{`{"data": "0x01", "to": "0xabcdef0000000000000000000000000000000001"}`, `"0x5"`},
+	} {
+		forwarder := prxy.AcquireForwarder()
+		forwarder.SetChain(chain)
+
+		var rawParams []json.RawMessage
+		for _, param := range params {
+			rawParams = append(rawParams, json.RawMessage(param))
+		}
+		forwarder.SetRPCRequest([]rpc.Request{{ID: len(keys), Method: string(client.CallMethod), Params: rawParams}})
+
+		key, ok := forwarder.CacheKey()
+		True(p.T(), ok)
+		keys = append(keys, key)
+
+		prxy.ReleaseForwarder(forwarder)
+	}
+
+	Equal(p.T(), keys[0], keys[1])
+}
+
+func (p *ProxySuite) TestInvalidCacheConfig() {
+	_, err := proxy.NewProxy(config.Config{
+		Cache: config.CacheConfig{Type: "redis"},
+	}, p.metrics)
+	NotNil(p.T(), err)
+}
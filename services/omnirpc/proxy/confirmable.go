@@ -29,7 +29,7 @@ func isConfirmable(r rpc2.Request) (bool, error) {
 		return isBlockNumConfirmable(r.Params[2]), nil
 	case client.GetLogsMethod:
 		return isFilterArgConfirmable(r.Params[0])
-	// not confirmable because tx could be pending. We might want to handle w/ omnicast though
+	// not confirmable because tx could be pending. Routes can broadcast these instead
 	// left separate for comment
 	case client.SendRawTransactionMethod:
 		return false, nil
@@ -204,3 +204,26 @@ func StandardizeResponseFalseParams(ctx context.Context, req []rpc.Request, body
 func (f *Forwarder) CheckAndSetConfirmability() (ok bool) {
 	return f.checkAndSetConfirmability()
 }
+
+// CacheKey exports cacheKey for testing.
+func (f *Forwarder) CacheKey() (key string, ok bool) {
+	return f.cacheKey()
+}
+
+// SetupAdminRoutes exports setupAdminRoutes for testing.
+func (r *RPCProxy) SetupAdminRoutes(router gin.IRouter) {
+	r.setupAdminRoutes(router)
+}
+
+// SplitLogRange exports splitLogRange for testing.
+func SplitLogRange(fromBlock, toBlock, maxRange uint64) (ranges [][2]uint64) {
+	for _, r := range splitLogRange(fromBlock, toBlock, maxRange) {
+		ranges = append(ranges, [2]uint64{r.fromBlock, r.toBlock})
+	}
+	return ranges
+}
+
+// BlockHeader exports blockHeader for testing.
+func BlockHeader(block json.RawMessage) (json.RawMessage, error) {
+	return blockHeader(block)
+}
@@ -26,7 +26,7 @@ type rawResponse struct {
 	// hash is a unique hash of the raw response.
 	// we use this to check for equality
 	hash string
-	// hasError is wether or not the response could be deserialized
+	// hasError is whether or not the response could be deserialized
 	hasError bool
 }
 
@@ -126,6 +126,8 @@ func (f *Forwarder) standardizeBatch(ctx context.Context, body []byte) (res []by
 const (
 	httpSchema  = "http"
 	httpsSchema = "https"
+	wsSchema    = "ws"
+	wssSchema   = "wss"
 )
 
 func (f *Forwarder) forwardRequest(parentCtx context.Context, endpoint string) (_ *rawResponse, err error) {
@@ -137,6 +139,21 @@ func (f *Forwarder) forwardRequest(parentCtx context.Context, endpoint string) (
 		metrics.EndSpanWithErr(span, err)
 	}()
 
+	body, err := f.doRequest(ctx, endpoint, f.body)
+	if err != nil {
+		return nil, err
+	}
+
+	rawResp, err := f.newRawResponse(ctx, body, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return rawResp, nil
+}
+
+// doRequest sends a request body to an endpoint and returns the response body.
+func (f *Forwarder) doRequest(ctx context.Context, endpoint string, body []byte) ([]byte, error) {
 	endpointURL, err := fasturl.ParseURL(endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("could not parse endpoint (%s): %w", endpointURL, err)
@@ -153,7 +170,7 @@ func (f *Forwarder) forwardRequest(parentCtx context.Context, endpoint string) (
 	resp, err := req.
 		SetContext(ctx).
 		SetRequestURI(endpoint).
-		SetBody(f.body).
+		SetBody(body).
 		SetHeaderBytes(http.XRequestID, f.requestID).
 		SetHeaderBytes(http.XForwardedFor, http.OmniRPCValue).
 		SetHeaderBytes(http.ContentType, http.JSONType).
@@ -167,10 +184,5 @@ func (f *Forwarder) forwardRequest(parentCtx context.Context, endpoint string) (
 		return nil, fmt.Errorf("invalid response code: %d (%s)", resp.StatusCode(), goHTTP.StatusText(resp.StatusCode()))
 	}
 
-	rawResp, err := f.newRawResponse(ctx, resp.Body(), endpoint)
-	if err != nil {
-		return nil, fmt.Errorf("invalid response: %w", err)
-	}
-
-	return rawResp, nil
+	return resp.Body(), nil
 }
@@ -25,7 +25,8 @@
 )
 
 func (p *ProxySuite) TestServeRequestNoChain() {
-	prxy := proxy.NewProxy(config.Config{}, p.metrics)
+	prxy, err := proxy.NewProxy(config.Config{}, p.metrics)
+	p.Require().NoError(err)
 
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
@@ -35,7 +36,8 @@ func (p *ProxySuite) TestServeRequestNoChain() {
 }
 
 func (p *ProxySuite) TestCannotReadBody() {
-	prxy := proxy.NewProxy(config.Config{}, p.metrics)
+	prxy, err := proxy.NewProxy(config.Config{}, p.metrics)
+	p.Require().NoError(err)
 
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
@@ -66,7 +68,8 @@ func (p *ProxySuite) generateFakeJSON() []byte {
 }
 
 func (p *ProxySuite) TestMalformedRequestBody() {
-	prxy := proxy.NewProxy(config.Config{}, p.metrics)
+	prxy, err := proxy.NewProxy(config.Config{}, p.metrics)
+	p.Require().NoError(err)
 
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
@@ -79,7 +82,8 @@ func (p *ProxySuite) TestMalformedRequestBody() {
 
 // TestAcquireReleaseForwarder makes sure the forwarder is cleared afte r being released.
 func (p *ProxySuite) TestAcquireReleaseForwarder() {
-	prxy := proxy.NewProxy(config.Config{}, p.metrics)
+	prxy, err := proxy.NewProxy(config.Config{}, p.metrics)
+	p.Require().NoError(err)
 
 	forwarder := prxy.AcquireForwarder()
 	forwarder.SetChain(new(chainManagerMocks.Chain))
@@ -110,7 +114,8 @@ func (p *ProxySuite) TestAcquireReleaseForwarder() {
 }
 
 func (p *ProxySuite) TestForwardRequestDisallowWS() {
-	prxy := proxy.NewProxy(config.Config{}, p.metrics)
+	prxy, err := proxy.NewProxy(config.Config{}, p.metrics)
+	p.Require().NoError(err)
 
 	invalidSchemes := []string{"wss", "ws"}
 	for _, scheme := range invalidSchemes {
@@ -133,7 +138,8 @@ func (p *ProxySuite) TestForwardRequestDisallowWS() {
 }
 
 func (p *ProxySuite) TestForwardRequest() {
-	prxy := proxy.NewProxy(config.Config{}, p.metrics)
+	prxy, err := proxy.NewProxy(config.Config{}, p.metrics)
+	p.Require().NoError(err)
 
 	methodName := "test"
 	testRes := p.MustMarshall(proxy.JSONRPCMessage{
@@ -160,7 +166,7 @@ func (p *ProxySuite) TestForwardRequest() {
 	forwarder.SetRequestID([]byte(testRequestID))
 	forwarder.SetRPCRequest([]rpc.Request{{Method: methodName}})
 
-	_, err := forwarder.ForwardRequest(p.GetTestContext(), testURL)
+	_, err = forwarder.ForwardRequest(p.GetTestContext(), testURL)
 	Nil(p.T(), err)
 
 	requests := captureClient.Requests()
@@ -177,7 +183,8 @@ func (p *ProxySuite) TestForwardRequest() {
 }
 
 func (p *ProxySuite) TestOverrideConfirmability() {
-	prxy := proxy.NewProxy(config.Config{}, p.metrics)
+	prxy, err := proxy.NewProxy(config.Config{}, p.metrics)
+	p.Require().NoError(err)
 	forwarder := prxy.AcquireForwarder()
 	_, span := p.metrics.Tracer().Start(p.GetTestContext(), fmt.Sprintf("test-%d", p.GetTestID()))
 	forwarder.SetSpan(span)
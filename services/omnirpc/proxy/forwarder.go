@@ -18,7 +18,9 @@
 	"github.com/puzpuzpuz/xsync"
 	"github.com/synapsecns/sanguine/core/threaditer"
 	"github.com/synapsecns/sanguine/services/omnirpc/chainmanager"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
 	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
+	"github.com/synapsecns/sanguine/services/omnirpc/upstream"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -52,6 +54,18 @@ type Forwarder struct {
 	span trace.Span
 	// tracer is the tracer for the request
 	tracer trace.Tracer
+	// cacheKeyValue is the cache key of the request, empty if the request is not cacheable
+	cacheKeyValue string
+	// routes are the upstreams the request is routed to
+	routes upstream.Routes
+	// urls are the urls the request is routed to, in the order they're tried
+	urls []string
+	// chainID is the chain id of the request
+	chainID uint32
+	// route is the routing policy matched by the request, nil if none matched
+	route *config.RouteConfig
+	// candidates are the urls allowed by the route in latency order
+	candidates []string
 }
 
 // Reset resets the forwarder so it can be reused.
@@ -69,6 +83,12 @@ func (f *Forwarder) Reset() {
 	f.failedForwards = nil
 	f.rpcRequest = nil
 	f.span = nil
+	f.cacheKeyValue = ""
+	f.routes = upstream.Routes{}
+	f.urls = nil
+	f.chainID = 0
+	f.route = nil
+	f.candidates = nil
 }
 
 // AcquireForwarder allocates a forwarder and allows it to be released when not in use
@@ -117,6 +137,20 @@ func (r *RPCProxy) Forward(c *gin.Context, chainID uint32, requiredConfirmations
 		return
 	}
 
+	if served := forwarder.serveFromCache(ctx); served {
+		return
+	}
+
+	if forwarder.route != nil && forwarder.route.Broadcast {
+		forwarder.broadcast(ctx)
+		return
+	}
+
+	if filter, ok := forwarder.boundedLogFilter(); ok {
+		forwarder.forwardLogs(ctx, filter)
+		return
+	}
+
 	forwarder.attemptForwardAndValidate(ctx)
 }
 
@@ -126,7 +160,10 @@ func (r *RPCProxy) Forward(c *gin.Context, chainID uint32, requiredConfirmations
 //
 //nolint:gocognit,cyclop
 func (f *Forwarder) attemptForwardAndValidate(ctx context.Context) {
-	urlIter := threaditer.ThreadSafe(iter.Slice(f.chain.URLs()))
+	// drop ejected upstreams and apply weights
+	f.routes = f.r.upstreams.Route(f.chainID, f.candidates, int(f.requiredConfirmations))
+	f.urls = f.routes.URLs()
+	urlIter := threaditer.ThreadSafe(iter.Slice(f.urls))
 
 	// setup the channels we use for confirmation
 	errChan := make(chan FailedForward)
@@ -169,7 +206,7 @@ func (f *Forwarder) attemptForwardAndValidate(ctx context.Context) {
 			f.failedForwards.Store(failedForward.URL, failedForward.Err)
 
 			// if we've checked every url
-			if totalResponses == len(f.chain.URLs()) {
+			if totalResponses == len(f.urls) {
 				if done := f.checkResponses(totalResponses); done {
 					return
 				}
@@ -184,7 +221,7 @@ func (f *Forwarder) attemptForwardAndValidate(ctx context.Context) {
 
 			// if we've checked every url or the number of non-error responses is greater than or equal to the
 			// number of confirmations
-			if totalResponses == len(f.chain.URLs()) || uint16(f.resMap.Size()) >= f.requiredConfirmations {
+			if totalResponses == len(f.urls) || uint16(f.resMap.Size()) >= f.requiredConfirmations {
 				if done := f.checkResponses(totalResponses); done {
 					return
 				}
@@ -243,6 +280,7 @@ func (f *Forwarder) checkResponses(responseCount int) (done bool) {
 			f.c.Header(forwardedFrom, responses[0].url)
 
 			f.c.Data(http.StatusOK, gin.MIMEJSON, responses[0].body)
+			f.storeInCache(f.c, responses[0])
 			valid = true
 
 			return false
@@ -256,8 +294,8 @@ func (f *Forwarder) checkResponses(responseCount int) (done bool) {
 	}
 
 	// every urls been checked, we need to error
-	if responseCount == len(f.chain.URLs()) {
-		erroredUrls := sets.NewString(f.chain.URLs()...)
+	if responseCount == len(f.urls) {
+		erroredUrls := sets.NewString(f.urls...)
 
 		errResponse := ErrorResponse{
 			Error:  "could not get consistent response",
@@ -304,7 +342,7 @@ func (f *Forwarder) attemptForward(ctx context.Context, errChan chan FailedForwa
 
 	url := nextURL.Unwrap()
 
-	res, err := f.forwardRequest(ctx, url)
+	res, err := f.forwardToUpstream(ctx, url)
 	if err != nil {
 		// check if we're done, otherwise add to errchan
 		select {
@@ -324,10 +362,24 @@ func (f *Forwarder) attemptForward(ctx context.Context, errChan chan FailedForwa
 	}
 }
 
+// forwardToUpstream forwards the request if the upstream isn't over its limits and records the outcome.
+func (f *Forwarder) forwardToUpstream(ctx context.Context, url string) (*rawResponse, error) {
+	release, err := f.routes.Acquire(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("could not use %s: %w", url, err)
+	}
+
+	res, err := f.forwardRequest(ctx, url)
+	release(err == nil)
+
+	return res, err
+}
+
 // fillAndValidate fills request fields and validates fields.
 func (f *Forwarder) fillAndValidate(chainID uint32) (ok bool) {
 	var err error
 
+	f.chainID = chainID
 	f.chain = f.r.chainManager.GetChain(chainID)
 	if f.chain == nil {
 		f.c.JSON(http.StatusBadRequest, gin.H{
@@ -379,8 +431,11 @@ func (f *Forwarder) checkAndSetConfirmability() (ok bool) {
 		return false
 	}
 
-	// non-confirmable requests must use 1
-	if !confirmable {
+	f.route = f.matchRoute()
+	f.candidates = f.routeCandidates()
+
+	// non-confirmable and broadcast requests must use 1
+	if !confirmable || (f.route != nil && f.route.Broadcast) {
 		f.requiredConfirmations = 1
 	}
 
@@ -393,10 +448,14 @@ func (f *Forwarder) checkAndSetConfirmability() (ok bool) {
 	f.span.SetAttributes(attribute.Bool("confirmable", confirmable))
 	f.span.SetAttributes(attribute.String("method", f.rpcRequest.Method()))
 
+	if f.route != nil {
+		f.span.SetAttributes(attribute.StringSlice("route_tags", f.route.Tags))
+	}
+
 	// make sure we have enough urls to hit the required confirmation threshold
-	if len(f.chain.URLs()) < int(f.requiredConfirmations) {
+	if len(f.candidates) < int(f.requiredConfirmations) {
 		f.c.JSON(http.StatusBadRequest, gin.H{
-			"error": fmt.Sprintf("not enough endpoints for chain %d: found %d needed %d", f.chain.ID(), len(f.chain.URLs()), f.requiredConfirmations),
+			"error": fmt.Sprintf("not enough endpoints for chain %d: found %d needed %d", f.chain.ID(), len(f.candidates), f.requiredConfirmations),
 		})
 		return false
 	}
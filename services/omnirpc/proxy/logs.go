@@ -0,0 +1,475 @@
+package proxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/services/omnirpc/upstream"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// maxLogChunks is the max number of sub-ranges an eth_getLogs request is split into up front.
+const maxLogChunks = 1000
+
+// logChunksHeader is a header specifying how many sub-ranges an eth_getLogs request was split into.
+const logChunksHeader = "x-log-chunks"
+
+// errLogRangeTooLarge is returned when every rpc rejected an eth_getLogs range as too large.
+var errLogRangeTooLarge = errors.New("log range too large")
+
+// logRPCError is a json-rpc error returned by an rpc for an eth_getLogs range.
+type logRPCError struct {
+	rpcError *JSONError
+}
+
+func (l *logRPCError) Error() string {
+	return fmt.Sprintf("rpc error: %s", l.rpcError.Message)
+}
+
+// Is makes errors rejecting the range match errLogRangeTooLarge.
+func (l *logRPCError) Is(target error) bool {
+	return target == errLogRangeTooLarge && isRangeTooLarge(l.rpcError.Message)
+}
+
+// maxLogSplitDepth is the max number of times a rejected range is halved.
+const maxLogSplitDepth = 8
+
+// maxLogRequests is the max number of sub-ranges a single eth_getLogs request is fetched as, including resplits.
+const maxLogRequests = 2 * maxLogChunks
+
+// rangeTooLargeMessages are the errors rpc providers return when an eth_getLogs range is too wide
+// or returns too many logs. They're matched in lower case.
+var rangeTooLargeMessages = []string{
+	// geth, infura
+	"query returned more than",
+	// erigon
+	"query exceeds max results",
+	// ankr
+	"block range is too wide",
+	// alchemy
+	"log response size exceeded",
+	"eth_getlogs is limited to",
+	// quicknode, chainstack
+	"block range limit exceeded",
+	// publicnode, llamarpc
+	"exceed maximum block range",
+	"exceeds max block range",
+	// nodereal, blast
+	"block range too large",
+	"block range is too large",
+}
+
+// logFilter is an eth_getLogs filter with a numeric block range.
+type logFilter struct {
+	// fields are the raw filter fields so addresses and topics are passed through as is
+	fields map[string]json.RawMessage
+	// fromBlock is the first block in the range
+	fromBlock uint64
+	// toBlock is the last block in the range
+	toBlock uint64
+}
+
+// withRange gets the filter fields for a sub-range.
+func (l logFilter) withRange(fromBlock, toBlock uint64) map[string]json.RawMessage {
+	fields := make(map[string]json.RawMessage, len(l.fields))
+	for key, value := range l.fields {
+		fields[key] = value
+	}
+	fields["fromBlock"] = json.RawMessage(strconv.Quote(hexutil.EncodeUint64(fromBlock)))
+	fields["toBlock"] = json.RawMessage(strconv.Quote(hexutil.EncodeUint64(toBlock)))
+	return fields
+}
+
+// boundedLogFilter gets the filter of an eth_getLogs request if it has a numeric block range.
+func (f *Forwarder) boundedLogFilter() (*logFilter, bool) {
+	if len(f.rpcRequest) != 1 || f.rpcRequest[0].Method != string(client.GetLogsMethod) || len(f.rpcRequest[0].Params) != 1 {
+		return nil, false
+	}
+
+	filter := logFilter{}
+	if err := json.Unmarshal(f.rpcRequest[0].Params[0], &filter.fields); err != nil {
+		return nil, false
+	}
+
+	if _, ok := filter.fields["blockHash"]; ok {
+		return nil, false
+	}
+
+	var fromBlock, toBlock hexutil.Uint64
+	// tags like latest won't unmarshall into a number and can't be split
+	if json.Unmarshal(filter.fields["fromBlock"], &fromBlock) != nil || json.Unmarshal(filter.fields["toBlock"], &toBlock) != nil {
+		return nil, false
+	}
+
+	if fromBlock > toBlock {
+		return nil, false
+	}
+
+	filter.fromBlock = uint64(fromBlock)
+	filter.toBlock = uint64(toBlock)
+	return &filter, true
+}
+
+// logRange is an inclusive block range.
+type logRange struct {
+	fromBlock uint64
+	toBlock   uint64
+}
+
+// splitLogRange splits a range into sub-ranges of at most maxRange blocks. 0 doesn't split the range.
+func splitLogRange(fromBlock, toBlock, maxRange uint64) []logRange {
+	if maxRange == 0 {
+		return []logRange{{fromBlock: fromBlock, toBlock: toBlock}}
+	}
+
+	var ranges []logRange
+	for start := fromBlock; start <= toBlock; start += maxRange {
+		end := start + maxRange - 1
+		if end > toBlock || end < start {
+			end = toBlock
+		}
+		ranges = append(ranges, logRange{fromBlock: start, toBlock: end})
+
+		// avoid overflowing on the last block
+		if end == toBlock {
+			break
+		}
+	}
+	return ranges
+}
+
+// logResult is the result of fetching logs for a range.
+type logResult struct {
+	// logs are the logs in the range in block order
+	logs []json.RawMessage
+	// urls are the urls that confirmed the logs
+	urls []string
+}
+
+// forwardLogs splits an eth_getLogs request into sub-ranges the rpcs accept and fetches them in parallel
+// across rpcs. Ranges rpcs reject as too large are split in half until they're accepted.
+func (f *Forwarder) forwardLogs(ctx context.Context, filter *logFilter) {
+	ranges := splitLogRange(filter.fromBlock, filter.toBlock, f.r.upstreams.MaxLogRange(f.chainID, f.candidates))
+	if len(ranges) > maxLogChunks {
+		f.c.JSON(http.StatusBadRequest, gin.H{
+			"error": fmt.Sprintf("log range %d-%d too large: would be split into %d requests, max is %d", filter.fromBlock, filter.toBlock, len(ranges), maxLogChunks),
+		})
+		return
+	}
+
+	f.span.SetAttributes(attribute.Int("log_chunks", len(ranges)))
+
+	requests := new(atomic.Int64)
+	requests.Store(int64(len(ranges)))
+
+	results := make([]logResult, len(ranges))
+	g, groupCtx := errgroup.WithContext(ctx)
+	// roughly one range in flight per rpc
+	if len(f.candidates) > 0 {
+		g.SetLimit(len(f.candidates))
+	}
+
+	for i := range ranges {
+		i := i
+		g.Go(func() error {
+			var err error
+			results[i], err = f.fetchLogs(groupCtx, filter, ranges[i], i, 0, requests)
+			return err
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		// json-rpc errors are passed through to the client as the rpc returned them
+		var rpcErr *logRPCError
+		if errors.As(err, &rpcErr) {
+			f.writeLogRPCError(rpcErr)
+			return
+		}
+
+		f.c.JSON(http.StatusBadGateway, ErrorResponse{
+			Error: fmt.Sprintf("could not get logs: %v", err),
+		})
+		return
+	}
+
+	var logs []json.RawMessage
+	checkedURLs := sets.NewString()
+	for _, result := range results {
+		logs = append(logs, result.logs...)
+		checkedURLs.Insert(result.urls...)
+	}
+
+	mergedLogs, err := json.Marshal(logs)
+	if err != nil {
+		f.c.JSON(http.StatusBadGateway, ErrorResponse{
+			Error: fmt.Sprintf("could not merge logs: %v", err),
+		})
+		return
+	}
+
+	// an empty result should still be a list
+	if logs == nil {
+		mergedLogs = []byte("[]")
+	}
+
+	body, err := json.Marshal(JSONRPCMessage{
+		Version: jsonRPCVersion,
+		ID:      f.rpcRequest[0].ID,
+		Result:  mergedLogs,
+	})
+	if err != nil {
+		f.c.JSON(http.StatusBadGateway, ErrorResponse{
+			Error: fmt.Sprintf("could not create response: %v", err),
+		})
+		return
+	}
+
+	f.c.Header(urlConfirmationsHeader, strings.Join(checkedURLs.List(), ","))
+	f.c.Header(logChunksHeader, strconv.Itoa(len(ranges)))
+	f.c.Data(http.StatusOK, gin.MIMEJSON, body)
+	f.storeInCache(ctx, rawResponse{body: body})
+}
+
+// fetchLogs fetches the logs for a range, splitting it in half if every rpc rejects it as too large.
+// offset is used to spread ranges across rpcs. Ranges are split at most maxLogSplitDepth times and requests
+// counts the sub-ranges of the whole request so it can't exceed maxLogRequests.
+func (f *Forwarder) fetchLogs(ctx context.Context, filter *logFilter, r logRange, offset, depth int, requests *atomic.Int64) (logResult, error) {
+	result, err := f.fetchLogRange(ctx, filter, r, offset)
+	if !errors.Is(err, errLogRangeTooLarge) || r.fromBlock == r.toBlock {
+		return result, err
+	}
+
+	if depth >= maxLogSplitDepth {
+		return logResult{}, fmt.Errorf("blocks %d-%d still rejected after %d splits: %w", r.fromBlock, r.toBlock, depth, err)
+	}
+
+	if requests.Add(2) > maxLogRequests {
+		return logResult{}, fmt.Errorf("blocks %d-%d would need more than %d requests: %w", r.fromBlock, r.toBlock, maxLogRequests, err)
+	}
+
+	middle := r.fromBlock + (r.toBlock-r.fromBlock)/2
+	halves := []logRange{{fromBlock: r.fromBlock, toBlock: middle}, {fromBlock: middle + 1, toBlock: r.toBlock}}
+	results := make([]logResult, len(halves))
+
+	g, groupCtx := errgroup.WithContext(ctx)
+	for i := range halves {
+		i := i
+		g.Go(func() error {
+			var err error
+			results[i], err = f.fetchLogs(groupCtx, filter, halves[i], offset+i, depth+1, requests)
+			return err
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return logResult{}, err
+	}
+
+	return logResult{
+		logs: append(results[0].logs, results[1].logs...),
+		urls: append(results[0].urls, results[1].urls...),
+	}, nil
+}
+
+// writeLogRPCError writes an rpc's json-rpc error as the response to the client's request.
+func (f *Forwarder) writeLogRPCError(rpcErr *logRPCError) {
+	body, err := json.Marshal(JSONRPCMessage{
+		Version: jsonRPCVersion,
+		ID:      f.rpcRequest[0].ID,
+		Error:   rpcErr.rpcError,
+	})
+	if err != nil {
+		f.c.JSON(http.StatusBadGateway, ErrorResponse{
+			Error: fmt.Sprintf("could not create response: %v", err),
+		})
+		return
+	}
+
+	f.c.Data(http.StatusOK, gin.MIMEJSON, body)
+}
+
+// logResponse is the response of a single rpc to an eth_getLogs request.
+type logResponse struct {
+	url  string
+	logs []json.RawMessage
+	hash string
+	err  error
+}
+
+// fetchLogRange fetches the logs for a range from as many rpcs as needed to hit the required confirmations.
+func (f *Forwarder) fetchLogRange(parentCtx context.Context, filter *logFilter, r logRange, offset int) (logResult, error) {
+	ctx, cancel := context.WithCancel(parentCtx)
+	defer cancel()
+
+	params, err := json.Marshal([]map[string]json.RawMessage{filter.withRange(r.fromBlock, r.toBlock)})
+	if err != nil {
+		return logResult{}, fmt.Errorf("could not create params: %w", err)
+	}
+
+	body, err := json.Marshal(JSONRPCMessage{
+		Version: jsonRPCVersion,
+		ID:      f.rpcRequest[0].ID,
+		Method:  f.rpcRequest[0].Method,
+		Params:  params,
+	})
+	if err != nil {
+		return logResult{}, fmt.Errorf("could not create request: %w", err)
+	}
+
+	routes := f.r.upstreams.Route(f.chainID, f.candidates, int(f.requiredConfirmations))
+	urls := routes.URLs()
+	if len(urls) == 0 {
+		return logResult{}, fmt.Errorf("no rpcs available for blocks %d-%d", r.fromBlock, r.toBlock)
+	}
+	// start each range on a different rpc so ranges are spread across rpcs
+	start := offset % len(urls)
+	urls = append(append([]string{}, urls[start:]...), urls[:start]...)
+
+	// buffered so requests never block once we stop reading
+	resChan := make(chan logResponse, len(urls))
+
+	required := int(f.requiredConfirmations)
+	if required < 1 {
+		required = 1
+	}
+
+	// only request from another rpc when a response fails or doesn't confirm
+	nextURL, inFlight := 0, 0
+	fetchNext := func() {
+		url := urls[nextURL]
+		nextURL++
+		inFlight++
+
+		go func() {
+			f.mux.RLock()
+			defer f.mux.RUnlock()
+
+			resChan <- f.fetchLogsFrom(ctx, routes, url, body)
+		}()
+	}
+
+	for inFlight < required && nextURL < len(urls) {
+		fetchNext()
+	}
+
+	confirmations := make(map[string][]logResponse)
+	// tooLarge is the first rejection of the range, rpcErr the first other json-rpc error
+	var tooLarge, rpcErr error
+	var errs []string
+
+	for inFlight > 0 {
+		var res logResponse
+		select {
+		case <-ctx.Done():
+			return logResult{}, fmt.Errorf("could not get logs for blocks %d-%d: %w", r.fromBlock, r.toBlock, ctx.Err())
+		case res = <-resChan:
+		}
+		inFlight--
+
+		if res.err != nil {
+			var resRPCErr *logRPCError
+			switch {
+			case errors.Is(res.err, errLogRangeTooLarge):
+				if tooLarge == nil {
+					tooLarge = res.err
+				}
+			case errors.As(res.err, &resRPCErr):
+				if rpcErr == nil {
+					rpcErr = res.err
+				}
+			}
+			errs = append(errs, fmt.Sprintf("%s: %v", res.url, res.err))
+		} else {
+			confirmations[res.hash] = append(confirmations[res.hash], res)
+			if responses := confirmations[res.hash]; len(responses) >= required {
+				confirmedURLs := make([]string, len(responses))
+				for i, response := range responses {
+					confirmedURLs[i] = response.url
+				}
+				return logResult{logs: res.logs, urls: confirmedURLs}, nil
+			}
+		}
+
+		if nextURL < len(urls) {
+			fetchNext()
+		}
+	}
+
+	if tooLarge != nil {
+		return logResult{}, fmt.Errorf("blocks %d-%d rejected: %w", r.fromBlock, r.toBlock, tooLarge)
+	}
+
+	if rpcErr != nil {
+		return logResult{}, fmt.Errorf("could not get logs for blocks %d-%d: %w", r.fromBlock, r.toBlock, rpcErr)
+	}
+
+	return logResult{}, fmt.Errorf("could not get consistent logs for blocks %d-%d: %s", r.fromBlock, r.toBlock, strings.Join(errs, ", "))
+}
+
+// fetchLogsFrom fetches logs from a single routed rpc.
+func (f *Forwarder) fetchLogsFrom(ctx context.Context, routes upstream.Routes, url string, body []byte) (res logResponse) {
+	res.url = url
+
+	release, err := routes.Acquire(ctx, url)
+	if err != nil {
+		res.err = fmt.Errorf("could not use %s: %w", url, err)
+		return res
+	}
+
+	respBody, err := f.doRequest(ctx, url, body)
+	if err != nil {
+		release(false)
+		res.err = err
+		return res
+	}
+	// the rpc is healthy even if it rejects the range
+	release(true)
+
+	var rpcMessage JSONRPCMessage
+	if err := json.Unmarshal(respBody, &rpcMessage); err != nil {
+		res.err = fmt.Errorf("could not parse response: %w", err)
+		return res
+	}
+
+	if rpcMessage.Error != nil {
+		res.err = &logRPCError{rpcError: rpcMessage.Error}
+		return res
+	}
+
+	if err := json.Unmarshal(rpcMessage.Result, &res.logs); err != nil {
+		res.err = fmt.Errorf("could not parse logs: %w", err)
+		return res
+	}
+
+	standardized, err := standardizeResponse(ctx, &f.rpcRequest[0], rpcMessage)
+	if err != nil {
+		res.err = fmt.Errorf("could not standardize response: %w", err)
+		return res
+	}
+	res.hash = fmt.Sprintf("%x", sha256.Sum256(standardized))
+
+	return res
+}
+
+// isRangeTooLarge checks if an rpc error means the range was too wide or returned too many logs.
+func isRangeTooLarge(message string) bool {
+	message = strings.ToLower(message)
+	for _, tooLarge := range rangeTooLargeMessages {
+		if strings.Contains(message, tooLarge) {
+			return true
+		}
+	}
+	return false
+}
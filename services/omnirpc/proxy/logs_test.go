@@ -0,0 +1,216 @@
+package proxy_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	"github.com/synapsecns/sanguine/services/omnirpc/proxy"
+)
+
+// newLogUpstream creates an upstream that returns one log per block for eth_getLogs and rejects ranges
+// wider than maxRange.
+func (p *ProxySuite) newLogUpstream(maxRange uint64) (url string, requests *atomic.Int64) {
+	requests = new(atomic.Int64)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		body, err := io.ReadAll(r.Body)
+		p.Require().NoError(err)
+
+		var req struct {
+			ID     int `json:"id"`
+			Params []struct {
+				FromBlock hexutil.Uint64 `json:"fromBlock"`
+				ToBlock   hexutil.Uint64 `json:"toBlock"`
+			} `json:"params"`
+		}
+		p.Require().NoError(json.Unmarshal(body, &req))
+
+		w.Header().Set("Content-Type", "application/json")
+		filter := req.Params[0]
+		if uint64(filter.ToBlock-filter.FromBlock)+1 > maxRange {
+			_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"error":{"code":-32005,"message":"block range too large"}}`, req.ID)
+			return
+		}
+
+		logs := []types.Log{}
+		for block := uint64(filter.FromBlock); block <= uint64(filter.ToBlock); block++ {
+			logs = append(logs, types.Log{
+				Address:     common.BigToAddress(hexutil.MustDecodeBig("0x1")),
+				Topics:      []common.Hash{},
+				Data:        []byte{},
+				BlockNumber: block,
+				TxHash:      common.BigToHash(hexutil.MustDecodeBig(hexutil.EncodeUint64(block + 1))),
+			})
+		}
+
+		result, err := json.Marshal(logs)
+		p.Require().NoError(err)
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"result":%s}`, req.ID, result)
+	}))
+	p.T().Cleanup(server.Close)
+
+	return server.URL, requests
+}
+
+// newErrorUpstream creates an upstream that answers every request with a json-rpc error.
+func (p *ProxySuite) newErrorUpstream(code int, message string) (url string, requests *atomic.Int64) {
+	requests = new(atomic.Int64)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"error":{"code":%d,"message":"%s"}}`, code, message)
+	}))
+	p.T().Cleanup(server.Close)
+
+	return server.URL, requests
+}
+
+func (p *ProxySuite) getLogs(prxy *proxy.RPCProxy, chainID uint32, fromBlock, toBlock uint64) ([]types.Log, *httptest.ResponseRecorder) {
+	res := p.routedRequest(prxy, chainID, fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"fromBlock":"%s","toBlock":"%s"}]}`,
+		hexutil.EncodeUint64(fromBlock), hexutil.EncodeUint64(toBlock)))
+
+	var rpcMessage proxy.JSONRPCMessage
+	p.Require().NoError(json.Unmarshal(res.Body.Bytes(), &rpcMessage), res.Body.String())
+
+	var logs []types.Log
+	p.Require().NoError(json.Unmarshal(rpcMessage.Result, &logs), res.Body.String())
+	return logs, res
+}
+
+func (p *ProxySuite) checkLogOrder(logs []types.Log, fromBlock, toBlock uint64) {
+	p.Require().Len(logs, int(toBlock-fromBlock+1))
+	for i, log := range logs {
+		Equal(p.T(), fromBlock+uint64(i), log.BlockNumber)
+	}
+}
+
+func (p *ProxySuite) TestGetLogsSplitsConfiguredRange() {
+	const chainID = 1
+	firstURL, firstRequests := p.newLogUpstream(10)
+	secondURL, secondRequests := p.newLogUpstream(10)
+
+	prxy, err := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {
+				RPCs:        []string{firstURL, secondURL},
+				Checks:      1,
+				MaxLogRange: 10,
+			},
+		},
+	}, p.metrics)
+	p.Require().NoError(err)
+
+	logs, res := p.getLogs(prxy, chainID, 5, 104)
+	Equal(p.T(), http.StatusOK, res.Code)
+	Equal(p.T(), strconv.Itoa(10), res.Header().Get("x-log-chunks"))
+	p.checkLogOrder(logs, 5, 104)
+
+	// ranges should be spread across both rpcs without any rejections
+	Equal(p.T(), int64(10), firstRequests.Load()+secondRequests.Load())
+	Positive(p.T(), firstRequests.Load())
+	Positive(p.T(), secondRequests.Load())
+}
+
+func (p *ProxySuite) TestGetLogsResplitsRejectedRange() {
+	const chainID = 1
+	url, _ := p.newLogUpstream(16)
+
+	prxy, err := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {
+				RPCs:   []string{url},
+				Checks: 1,
+			},
+		},
+	}, p.metrics)
+	p.Require().NoError(err)
+
+	logs, res := p.getLogs(prxy, chainID, 0, 99)
+	Equal(p.T(), http.StatusOK, res.Code)
+	p.checkLogOrder(logs, 0, 99)
+}
+
+func (p *ProxySuite) TestGetLogsRPCOptionRange() {
+	const chainID = 1
+	url, requests := p.newLogUpstream(5)
+
+	prxy, err := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {
+				RPCs:        []string{url},
+				Checks:      1,
+				MaxLogRange: 100,
+				RPCOptions: map[string]config.RPCOptions{
+					url: {MaxLogRange: 5},
+				},
+			},
+		},
+	}, p.metrics)
+	p.Require().NoError(err)
+
+	logs, res := p.getLogs(prxy, chainID, 1, 20)
+	Equal(p.T(), http.StatusOK, res.Code)
+	p.checkLogOrder(logs, 1, 20)
+	Equal(p.T(), int64(4), requests.Load())
+}
+
+func (p *ProxySuite) TestGetLogsPassesThroughRPCError() {
+	const chainID = 1
+	url, requests := p.newErrorUpstream(-32602, "invalid argument 0: hex string without 0x prefix")
+
+	prxy, err := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {
+				RPCs:   []string{url},
+				Checks: 1,
+			},
+		},
+	}, p.metrics)
+	p.Require().NoError(err)
+
+	res := p.routedRequest(prxy, chainID, `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"fromBlock":"0x0","toBlock":"0x63"}]}`)
+	Equal(p.T(), http.StatusOK, res.Code)
+	JSONEq(p.T(), `{"jsonrpc":"2.0","id":1,"error":{"code":-32602,"message":"invalid argument 0: hex string without 0x prefix"}}`, res.Body.String())
+	// the error isn't a range error, so the range should never be split
+	Equal(p.T(), int64(1), requests.Load())
+}
+
+func (p *ProxySuite) TestGetLogsCapsResplits() {
+	const chainID = 1
+	url, requests := p.newErrorUpstream(-32005, "block range too large")
+
+	prxy, err := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {
+				RPCs:   []string{url},
+				Checks: 1,
+			},
+		},
+	}, p.metrics)
+	p.Require().NoError(err)
+
+	res := p.routedRequest(prxy, chainID, `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"fromBlock":"0x0","toBlock":"0x3e7"}]}`)
+	Equal(p.T(), http.StatusOK, res.Code)
+	JSONEq(p.T(), `{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"block range too large"}}`, res.Body.String())
+	// a full binary split of 1000 blocks would take ~2000 requests, depth is capped at 8 splits.
+	LessOrEqual(p.T(), requests.Load(), int64(1<<9))
+}
+
+func (p *ProxySuite) TestSplitLogRange() {
+	Equal(p.T(), [][2]uint64{{0, 99}}, proxy.SplitLogRange(0, 99, 0))
+	Equal(p.T(), [][2]uint64{{0, 49}, {50, 99}}, proxy.SplitLogRange(0, 99, 50))
+	Equal(p.T(), [][2]uint64{{0, 49}, {50, 99}, {100, 100}}, proxy.SplitLogRange(0, 100, 50))
+	Equal(p.T(), [][2]uint64{{7, 7}}, proxy.SplitLogRange(7, 7, 50))
+}
@@ -0,0 +1,216 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// broadcastTimeout is how long broadcasts keep propagating to slower rpcs after the first success is returned.
+const broadcastTimeout = time.Second * 30
+
+// matchRoute gets the first route matching the request. nil is returned if no route matches.
+// batches never match a route.
+func (f *Forwarder) matchRoute() *config.RouteConfig {
+	if len(f.rpcRequest) != 1 {
+		return nil
+	}
+
+	routes := f.r.upstreams.Routes(f.chainID)
+	for i := range routes {
+		if !methodMatches(routes[i].Methods, f.rpcRequest[0].Method) {
+			continue
+		}
+
+		if routes[i].HistoricalBlocks > 0 && !f.isHistorical(routes[i].HistoricalBlocks) {
+			continue
+		}
+
+		return &routes[i]
+	}
+	return nil
+}
+
+// methodMatches checks if a method matches any of the patterns. A trailing * matches any suffix.
+func methodMatches(patterns []string, method string) bool {
+	for _, pattern := range patterns {
+		if prefix, isPrefix := strings.CutSuffix(pattern, "*"); isPrefix {
+			if strings.HasPrefix(method, prefix) {
+				return true
+			}
+		} else if pattern == method {
+			return true
+		}
+	}
+	return false
+}
+
+// isHistorical checks if the request is pinned to a block at least minAge blocks behind the head.
+// requests pinned by hash are not considered historical since their age is unknown.
+func (f *Forwarder) isHistorical(minAge uint64) bool {
+	pin, ok := pinnedBlock(f.rpcRequest[0])
+	if !ok || pin.byHash {
+		return false
+	}
+
+	head, ok := f.chain.LatestBlock()
+	if !ok {
+		return false
+	}
+
+	return pin.blockNumber+minAge <= head
+}
+
+// routeCandidates gets the urls allowed by the route in latency order.
+func (f *Forwarder) routeCandidates() []string {
+	urls := f.chain.URLs()
+	if f.route == nil || len(f.route.Tags) == 0 {
+		return urls
+	}
+
+	candidates := make([]string, 0, len(urls))
+	for _, url := range urls {
+		if f.r.upstreams.Get(f.chainID, url).HasAnyTag(f.route.Tags) {
+			candidates = append(candidates, url)
+		}
+	}
+	return candidates
+}
+
+// broadcastResult is the result of broadcasting to a single rpc.
+type broadcastResult struct {
+	url  string
+	body []byte
+	err  error
+}
+
+// broadcast sends the request to every healthy candidate and returns the first response without an rpc error.
+// If every rpc returns an rpc error, the first one is returned so the client sees why (e.g. nonce too low).
+func (f *Forwarder) broadcast(parentCtx context.Context) {
+	routes := f.r.upstreams.Route(f.chainID, f.candidates, 1)
+	urls := routes.URLs()
+	f.span.SetAttributes(attribute.StringSlice("broadcast_urls", urls))
+
+	// the request keeps propagating after the first success, so copy everything the goroutines need since
+	// the forwarder is released once we return.
+	body := append([]byte{}, f.body...)
+	requestID := append([]byte{}, f.requestID...)
+	client := f.client
+	tracer := f.tracer
+
+	//nolint: containedctx
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(parentCtx), broadcastTimeout)
+	results := make(chan broadcastResult, len(urls))
+
+	var wg sync.WaitGroup
+	for _, url := range urls {
+		wg.Add(1)
+		go func(url string) {
+			defer wg.Done()
+
+			var res broadcastResult
+			release, err := routes.Acquire(ctx, url)
+			if err != nil {
+				res = broadcastResult{url: url, err: err}
+			} else {
+				res = broadcastRequest(ctx, tracer, client, url, body, requestID)
+				release(res.err == nil)
+			}
+			results <- res
+		}(url)
+	}
+
+	// cancel once every rpc has responded
+	go func() {
+		wg.Wait()
+		cancel()
+	}()
+
+	var firstRPCError *broadcastResult
+	failedForwards := make(map[string]string)
+
+	for i := 0; i < len(urls); i++ {
+		var res broadcastResult
+		select {
+		case <-f.c.Done():
+			return
+		case res = <-results:
+		}
+
+		if res.err != nil {
+			failedForwards[res.url] = res.err.Error()
+			continue
+		}
+
+		var rpcMessage JSONRPCMessage
+		if err := json.Unmarshal(res.body, &rpcMessage); err == nil && rpcMessage.Error != nil {
+			if firstRPCError == nil {
+				firstRPCError = &res
+			}
+			continue
+		}
+
+		f.c.Header(forwardedFrom, res.url)
+		f.c.Data(http.StatusOK, gin.MIMEJSON, res.body)
+		return
+	}
+
+	if firstRPCError != nil {
+		f.c.Header(forwardedFrom, firstRPCError.url)
+		f.c.Data(http.StatusOK, gin.MIMEJSON, firstRPCError.body)
+		return
+	}
+
+	f.c.JSON(http.StatusBadGateway, ErrorResponse{
+		Error:          "could not broadcast to any rpc",
+		ErroredURLS:    urls,
+		FailedForwards: failedForwards,
+	})
+}
+
+// broadcastRequest sends a request to a single rpc. Unlike forwardRequest this doesn't depend on the forwarder
+// so it can outlive the client request.
+func broadcastRequest(parentCtx context.Context, tracer trace.Tracer, client omniHTTP.Client, endpoint string, body, requestID []byte) (res broadcastResult) {
+	ctx, span := tracer.Start(parentCtx, "broadcastRequest",
+		trace.WithAttributes(attribute.String("endpoint", endpoint)),
+	)
+
+	defer func() {
+		metrics.EndSpanWithErr(span, res.err)
+	}()
+
+	res.url = endpoint
+
+	resp, err := client.NewRequest().
+		SetContext(ctx).
+		SetRequestURI(endpoint).
+		SetBody(body).
+		SetHeaderBytes(omniHTTP.XRequestID, requestID).
+		SetHeaderBytes(omniHTTP.XForwardedFor, omniHTTP.OmniRPCValue).
+		SetHeaderBytes(omniHTTP.ContentType, omniHTTP.JSONType).
+		SetHeaderBytes(omniHTTP.Accept, omniHTTP.JSONType).
+		Do()
+	if err != nil {
+		res.err = fmt.Errorf("could not get response from %s: %w", endpoint, err)
+		return res
+	}
+
+	if resp.StatusCode() < 200 || resp.StatusCode() > 400 {
+		res.err = fmt.Errorf("invalid response code: %d (%s)", resp.StatusCode(), http.StatusText(resp.StatusCode()))
+		return res
+	}
+
+	res.body = resp.Body()
+	return res
+}
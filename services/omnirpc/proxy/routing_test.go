@@ -0,0 +1,143 @@
+package proxy_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	"github.com/synapsecns/sanguine/services/omnirpc/proxy"
+)
+
+// newFixedUpstream creates an upstream that returns the same response to every request and counts requests.
+func (p *ProxySuite) newFixedUpstream(response string) (url string, hits *atomic.Int64) {
+	hits = new(atomic.Int64)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	p.T().Cleanup(server.Close)
+
+	return server.URL, hits
+}
+
+func (p *ProxySuite) routedRequest(prxy *proxy.RPCProxy, chainID uint32, body string) *httptest.ResponseRecorder {
+	router := gin.New()
+	router.POST("/rpc/:id", func(c *gin.Context) {
+		prxy.Forward(c, chainID, nil)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/rpc/%d", chainID), bytes.NewReader([]byte(body)))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func (p *ProxySuite) TestRouteByTag() {
+	const chainID = 1
+	archiveURL, archiveHits := p.newFixedUpstream(`{"jsonrpc":"2.0","id":1,"result":"archive"}`)
+	fullURL, fullHits := p.newFixedUpstream(`{"jsonrpc":"2.0","id":1,"result":"full"}`)
+
+	prxy, err := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {
+				RPCs:   []string{fullURL, archiveURL},
+				Checks: 1,
+				RPCOptions: map[string]config.RPCOptions{
+					archiveURL: {Tags: []string{"archive", "trace"}},
+				},
+				Routes: []config.RouteConfig{
+					{Methods: []string{"debug_*", "trace_*"}, Tags: []string{"trace"}},
+				},
+			},
+		},
+	}, p.metrics)
+	p.Require().NoError(err)
+
+	res := p.routedRequest(prxy, chainID, `{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction","params":["0x01"]}`)
+	Equal(p.T(), http.StatusOK, res.Code)
+	Contains(p.T(), res.Body.String(), "archive")
+	Equal(p.T(), int64(1), archiveHits.Load())
+	Equal(p.T(), int64(0), fullHits.Load())
+
+	// unrouted methods can go anywhere
+	res = p.routedRequest(prxy, chainID, `{"jsonrpc":"2.0","id":1,"method":"web3_clientVersion","params":[]}`)
+	Equal(p.T(), http.StatusOK, res.Code)
+}
+
+func (p *ProxySuite) TestRouteNoTaggedRPCs() {
+	const chainID = 1
+	fullURL, _ := p.newFixedUpstream(`{"jsonrpc":"2.0","id":1,"result":"full"}`)
+
+	prxy, err := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {
+				RPCs:   []string{fullURL},
+				Checks: 1,
+				Routes: []config.RouteConfig{
+					{Methods: []string{"trace_block"}, Tags: []string{"trace"}},
+				},
+			},
+		},
+	}, p.metrics)
+	p.Require().NoError(err)
+
+	res := p.routedRequest(prxy, chainID, `{"jsonrpc":"2.0","id":1,"method":"trace_block","params":["0x1"]}`)
+	Equal(p.T(), http.StatusBadRequest, res.Code)
+}
+
+func (p *ProxySuite) TestRouteBroadcast() {
+	const chainID = 1
+	knownURL, knownHits := p.newFixedUpstream(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"already known"}}`)
+	okURL, okHits := p.newFixedUpstream(`{"jsonrpc":"2.0","id":1,"result":"0xabc"}`)
+	otherURL, otherHits := p.newFixedUpstream(`{"jsonrpc":"2.0","id":1,"result":"0xabc"}`)
+
+	prxy, err := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {
+				RPCs:   []string{knownURL, okURL, otherURL},
+				Checks: 2,
+				Routes: []config.RouteConfig{
+					{Methods: []string{"eth_sendRawTransaction"}, Broadcast: true},
+				},
+			},
+		},
+	}, p.metrics)
+	p.Require().NoError(err)
+
+	res := p.routedRequest(prxy, chainID, `{"jsonrpc":"2.0","id":1,"method":"eth_sendRawTransaction","params":["0x01"]}`)
+	Equal(p.T(), http.StatusOK, res.Code)
+	Contains(p.T(), res.Body.String(), "0xabc")
+
+	// every rpc should receive the transaction, even after a success was returned
+	Eventually(p.T(), func() bool {
+		return knownHits.Load() == 1 && okHits.Load() == 1 && otherHits.Load() == 1
+	}, time.Second*10, time.Millisecond*50)
+}
+
+func (p *ProxySuite) TestRouteBroadcastAllErrored() {
+	const chainID = 1
+	knownURL, _ := p.newFixedUpstream(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"nonce too low"}}`)
+
+	prxy, err := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {
+				RPCs: []string{knownURL},
+				Routes: []config.RouteConfig{
+					{Methods: []string{"eth_sendRawTransaction"}, Broadcast: true},
+				},
+			},
+		},
+	}, p.metrics)
+	p.Require().NoError(err)
+
+	res := p.routedRequest(prxy, chainID, `{"jsonrpc":"2.0","id":1,"method":"eth_sendRawTransaction","params":["0x01"]}`)
+	Equal(p.T(), http.StatusOK, res.Code)
+	Contains(p.T(), res.Body.String(), "nonce too low")
+}
@@ -6,10 +6,12 @@
 	"github.com/gin-gonic/gin"
 	"github.com/synapsecns/sanguine/core/ginhelper"
 	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/omnirpc/cache"
 	"github.com/synapsecns/sanguine/services/omnirpc/chainmanager"
 	"github.com/synapsecns/sanguine/services/omnirpc/collection"
 	"github.com/synapsecns/sanguine/services/omnirpc/config"
 	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
+	"github.com/synapsecns/sanguine/services/omnirpc/upstream"
 	"go.opentelemetry.io/otel/trace"
 	"net/http"
 	"strconv"
@@ -33,25 +35,41 @@ type RPCProxy struct {
 	client omniHTTP.Client
 	// handler is the metrics handler
 	handler metrics.Handler
+	// cache is the response cache for finalized requests, nil if caching is disabled
+	cache cache.Cache
+	// upstreams tracks limits and health of each rpc
+	upstreams *upstream.Registry
+	// adminToken is the bearer token for the admin api
+	adminToken string
+	// adminMux protects adminToken, which can change on reload
+	adminMux sync.RWMutex
 }
 
 // defaultInterval is the default refresh interval.
 const defaultInterval = 30
 
 // NewProxy creates a new rpc proxy.
-func NewProxy(config config.Config, handler metrics.Handler) *RPCProxy {
+func NewProxy(config config.Config, handler metrics.Handler) (*RPCProxy, error) {
 	if config.RefreshInterval == 0 {
 		logger.Warn("no refresh interval set (or interval is 0), using default of %d seconds", defaultInterval)
 	}
 
+	responseCache, err := cache.NewCacheFromConfig(config.Cache, handler)
+	if err != nil {
+		return nil, fmt.Errorf("could not create cache: %w", err)
+	}
+
 	return &RPCProxy{
+		cache:           responseCache,
+		upstreams:       upstream.NewRegistry(config, handler),
+		adminToken:      config.AdminToken,
 		chainManager:    chainmanager.NewChainManagerFromConfig(config, handler),
 		refreshInterval: time.Second * time.Duration(config.RefreshInterval),
 		port:            config.Port,
 		client:          omniHTTP.NewClient(omniHTTP.ClientTypeFromString(config.ClientType)),
 		handler:         handler,
 		tracer:          handler.Tracer(),
-	}
+	}, nil
 }
 
 // Run runs the rpc server until context cancellation.
@@ -90,6 +108,40 @@ func (r *RPCProxy) Run(ctx context.Context) {
 		r.Forward(c, uint32(chainID), &confirmations)
 	})
 
+	router.GET("/ws/:id", func(c *gin.Context) {
+		chainID, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": fmt.Sprintf("chainid must be a number: %s", c.Param("id")),
+			})
+			return
+		}
+		r.ServeWebsocket(c, uint32(chainID), nil)
+	})
+
+	router.GET("/confirmations/:confirmations/ws/:id", func(c *gin.Context) {
+		chainID, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": fmt.Sprintf("chainid must be a number: %s", c.Param("id")),
+			})
+			return
+		}
+		realConfs, err := strconv.Atoi(c.Param("confirmations"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": fmt.Sprintf("confirmations must be a number: %s", c.Param("confirmations")),
+			})
+			return
+		}
+
+		confirmations := uint16(realConfs)
+
+		r.ServeWebsocket(c, uint32(chainID), &confirmations)
+	})
+
+	r.setupAdminRoutes(router)
+
 	// gets a list of chain-ids
 	// TODO: this needs to be added to the collection.json
 	router.GET("/chain-ids", func(c *gin.Context) {
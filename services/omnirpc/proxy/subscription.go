@@ -0,0 +1,436 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/goccy/go-json"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// subscriptionKind is the type of eth_subscribe subscription.
+type subscriptionKind string
+
+const (
+	// newHeadsKind subscribes to new block headers.
+	newHeadsKind subscriptionKind = "newHeads"
+	// logsKind subscribes to logs matching a filter.
+	logsKind subscriptionKind = "logs"
+)
+
+const (
+	// reconnectInterval is how often a subscription checks whether it's missing upstreams.
+	reconnectInterval = time.Second * 5
+	// dropCooldown is how long a dropped upstream is skipped before it's eligible again.
+	dropCooldown = time.Second * 30
+	// seenRetention is how many blocks behind the last delivered block events are remembered for de-duplication.
+	seenRetention = 128
+	// maxBackfill is the maximum number of blocks replayed when an upstream reconnects.
+	maxBackfill = 128
+)
+
+// seenEvent tracks which upstreams have reported an event.
+type seenEvent struct {
+	// blockNumber is the block the event belongs to
+	blockNumber uint64
+	// reporters is the set of upstream urls that reported the event
+	reporters sets.String
+	// delivered is whether or not the event has been sent to the client
+	delivered bool
+}
+
+// subscription fans a single client subscription in from several upstream websockets.
+// events are de-duplicated and only delivered after requiredConfirmations upstreams have reported them.
+// An extra upstream is kept connected so a dropped socket doesn't leave the client with a gap.
+type subscription struct {
+	// id is the client facing subscription id
+	id string
+	// kind is the subscription kind
+	kind subscriptionKind
+	// filter is the log filter, nil for newHeads
+	filter map[string]interface{}
+	// session is the client session notifications are written to
+	session *wsSession
+	// cancel stops the subscription
+	cancel context.CancelFunc
+	// dropped receives urls of upstreams that disconnected
+	dropped chan string
+	// mux protects all the fields below
+	mux sync.Mutex
+	// upstreams are the urls of the connected (or connecting) upstreams
+	upstreams sets.String
+	// droppedAt is when each url was last dropped
+	droppedAt map[string]time.Time
+	// seen contains events by key
+	seen map[string]*seenEvent
+	// lastBlock is the highest block number delivered to the client
+	lastBlock uint64
+	// outbox holds confirmed events in the order they were confirmed until they're written to the client
+	outbox []json.RawMessage
+	// outboxReady is signaled when events are added to the outbox
+	outboxReady chan struct{}
+}
+
+func newSubscription(session *wsSession, kind subscriptionKind, filter map[string]interface{}) *subscription {
+	return &subscription{
+		id:          string(gethRPC.NewID()),
+		kind:        kind,
+		filter:      filter,
+		session:     session,
+		dropped:     make(chan string, 1),
+		upstreams:   sets.NewString(),
+		droppedAt:   make(map[string]time.Time),
+		seen:        make(map[string]*seenEvent),
+		outboxReady: make(chan struct{}, 1),
+	}
+}
+
+// start starts connecting to upstreams until the context is canceled or stop is called.
+func (s *subscription) start(parentCtx context.Context) {
+	ctx, cancel := context.WithCancel(parentCtx)
+	s.cancel = cancel
+
+	go s.deliver(ctx)
+	s.fill(ctx)
+
+	go func() {
+		ticker := time.NewTicker(reconnectInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case url := <-s.dropped:
+				s.mux.Lock()
+				s.upstreams.Delete(url)
+				s.droppedAt[url] = time.Now()
+				s.mux.Unlock()
+
+				s.fill(ctx)
+			case <-ticker.C:
+				s.fill(ctx)
+			}
+		}
+	}()
+}
+
+// stop stops the subscription and disconnects all upstreams.
+func (s *subscription) stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+// desiredUpstreams is the number of upstreams to keep connected.
+func (s *subscription) desiredUpstreams() int {
+	desired := int(s.session.requiredConfirmations) + 1
+	if urlCount := len(s.session.chain.URLs()); urlCount < desired {
+		return urlCount
+	}
+	return desired
+}
+
+// fill connects new upstreams in latency order until the desired count is reached.
+// recently dropped upstreams are only used if nothing else is available.
+func (s *subscription) fill(ctx context.Context) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	desired := s.desiredUpstreams()
+	urls := s.session.chain.URLs()
+
+	for _, skipCoolingDown := range []bool{true, false} {
+		for _, url := range urls {
+			if s.upstreams.Len() >= desired {
+				return
+			}
+
+			if s.upstreams.Has(url) {
+				continue
+			}
+
+			if skipCoolingDown && time.Since(s.droppedAt[url]) < dropCooldown {
+				continue
+			}
+
+			s.upstreams.Insert(url)
+			go s.runUpstream(ctx, url)
+		}
+	}
+}
+
+// runUpstream subscribes to a single upstream and ingests its events until it drops or the context is canceled.
+func (s *subscription) runUpstream(ctx context.Context, url string) {
+	err := s.consumeUpstream(ctx, url)
+	if ctx.Err() != nil {
+		return
+	}
+
+	logger.Warnf("subscription %s lost upstream %s: %v", s.id, url, err)
+
+	select {
+	case <-ctx.Done():
+	case s.dropped <- url:
+	}
+}
+
+func (s *subscription) consumeUpstream(ctx context.Context, url string) error {
+	wsURL, err := toWebsocketURL(url)
+	if err != nil {
+		return err
+	}
+
+	client, err := gethRPC.DialWebsocket(ctx, wsURL, "")
+	if err != nil {
+		return fmt.Errorf("could not dial %s: %w", wsURL, err)
+	}
+	defer client.Close()
+
+	args := []interface{}{string(s.kind)}
+	if s.filter != nil {
+		args = append(args, s.filter)
+	}
+
+	events := make(chan json.RawMessage)
+	upstreamSub, err := client.EthSubscribe(ctx, events, args...)
+	if err != nil {
+		return fmt.Errorf("could not subscribe: %w", err)
+	}
+	defer upstreamSub.Unsubscribe()
+
+	// replay anything the client might have missed while this upstream was disconnected
+	err = s.backfill(ctx, client, url)
+	if err != nil {
+		logger.Warnf("could not backfill subscription %s from %s: %v", s.id, url, err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-upstreamSub.Err():
+			if err == nil {
+				return fmt.Errorf("subscription closed")
+			}
+			return fmt.Errorf("subscription dropped: %w", err)
+		case event := <-events:
+			s.ingest(url, event)
+		}
+	}
+}
+
+// backfill replays blocks after the last delivered block from an upstream.
+func (s *subscription) backfill(ctx context.Context, client *gethRPC.Client, url string) error {
+	s.mux.Lock()
+	lastBlock := s.lastBlock
+	s.mux.Unlock()
+
+	// nothing has been delivered yet, so there's nothing to fill
+	if lastBlock == 0 {
+		return nil
+	}
+
+	var head hexutil.Uint64
+	err := client.CallContext(ctx, &head, "eth_blockNumber")
+	if err != nil {
+		return fmt.Errorf("could not get block number: %w", err)
+	}
+
+	if uint64(head) <= lastBlock {
+		return nil
+	}
+
+	start := lastBlock + 1
+	if uint64(head)-start > maxBackfill {
+		start = uint64(head) - maxBackfill
+	}
+
+	switch s.kind {
+	case newHeadsKind:
+		for blockNumber := start; blockNumber <= uint64(head); blockNumber++ {
+			var block json.RawMessage
+			err = client.CallContext(ctx, &block, "eth_getBlockByNumber", hexutil.EncodeUint64(blockNumber), false)
+			if err != nil {
+				return fmt.Errorf("could not get block %d: %w", blockNumber, err)
+			}
+			header, err := blockHeader(block)
+			if err != nil {
+				return fmt.Errorf("could not get header of block %d: %w", blockNumber, err)
+			}
+			s.ingest(url, header)
+		}
+	case logsKind:
+		filter := make(map[string]interface{}, len(s.filter)+2)
+		for key, value := range s.filter {
+			filter[key] = value
+		}
+		filter["fromBlock"] = hexutil.EncodeUint64(start)
+		filter["toBlock"] = hexutil.EncodeUint64(uint64(head))
+
+		var logs []json.RawMessage
+		err = client.CallContext(ctx, &logs, "eth_getLogs", filter)
+		if err != nil {
+			return fmt.Errorf("could not get logs: %w", err)
+		}
+		for _, log := range logs {
+			s.ingest(url, log)
+		}
+	}
+
+	return nil
+}
+
+// blockOnlyFields are the fields of an eth_getBlockByNumber result that aren't part of a newHeads header.
+var blockOnlyFields = []string{"transactions", "uncles", "size", "totalDifficulty", "withdrawals"}
+
+// blockHeader projects an eth_getBlockByNumber result to the header a newHeads subscription sends, so backfilled
+// heads look the same as live ones. Chain specific header fields are kept.
+func blockHeader(block json.RawMessage) (json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(block, &fields)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse block: %w", err)
+	}
+	if fields == nil {
+		return nil, errors.New("block not found")
+	}
+
+	for _, field := range blockOnlyFields {
+		delete(fields, field)
+	}
+
+	header, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode header: %w", err)
+	}
+	return header, nil
+}
+
+// ingest records an event from an upstream and delivers it once enough upstreams have reported it.
+func (s *subscription) ingest(url string, event json.RawMessage) {
+	key, blockNumber, err := eventKey(s.kind, event)
+	if err != nil {
+		logger.Warnf("could not parse %s event from %s: %v", s.kind, url, err)
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// too old to de-duplicate, assume it's already been delivered
+	if blockNumber+seenRetention < s.lastBlock {
+		return
+	}
+
+	seen, ok := s.seen[key]
+	if !ok {
+		seen = &seenEvent{
+			blockNumber: blockNumber,
+			reporters:   sets.NewString(),
+		}
+		s.seen[key] = seen
+	}
+
+	seen.reporters.Insert(url)
+	if seen.delivered || seen.reporters.Len() < s.threshold() {
+		return
+	}
+
+	seen.delivered = true
+	// events are queued in the order they're confirmed and written by deliver, so a slow client doesn't hold
+	// the lock every upstream ingests under.
+	s.outbox = append(s.outbox, event)
+	select {
+	case s.outboxReady <- struct{}{}:
+	default:
+	}
+
+	if blockNumber > s.lastBlock {
+		s.lastBlock = blockNumber
+		s.prune()
+	}
+}
+
+// deliver writes confirmed events to the client until the context is canceled.
+func (s *subscription) deliver(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.outboxReady:
+		}
+
+		s.mux.Lock()
+		events := s.outbox
+		s.outbox = nil
+		s.mux.Unlock()
+
+		for _, event := range events {
+			s.session.notify(s.id, event)
+		}
+	}
+}
+
+// threshold is the number of upstreams that must report an event. Events are held until the required number of
+// upstreams have reported them, even if fewer upstreams are currently connected.
+func (s *subscription) threshold() int {
+	threshold := int(s.session.requiredConfirmations)
+	if threshold < 1 {
+		threshold = 1
+	}
+	return threshold
+}
+
+// prune removes events that are too old to be reported again. Must be called with the lock held.
+func (s *subscription) prune() {
+	for key, seen := range s.seen {
+		if seen.blockNumber+seenRetention < s.lastBlock {
+			delete(s.seen, key)
+		}
+	}
+}
+
+// headerKey contains the fields of a header used for de-duplication.
+type headerKey struct {
+	Hash   common.Hash    `json:"hash"`
+	Number hexutil.Uint64 `json:"number"`
+}
+
+// logKey contains the fields of a log used for de-duplication.
+type logKey struct {
+	BlockHash   common.Hash    `json:"blockHash"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	TxHash      common.Hash    `json:"transactionHash"`
+	Index       hexutil.Uint   `json:"logIndex"`
+	Removed     bool           `json:"removed"`
+}
+
+// eventKey gets a unique key and block number for a subscription event.
+func eventKey(kind subscriptionKind, event json.RawMessage) (key string, blockNumber uint64, err error) {
+	switch kind {
+	case newHeadsKind:
+		var header headerKey
+		err = json.Unmarshal(event, &header)
+		if err != nil {
+			return "", 0, fmt.Errorf("could not parse header: %w", err)
+		}
+		return header.Hash.String(), uint64(header.Number), nil
+	case logsKind:
+		var log logKey
+		err = json.Unmarshal(event, &log)
+		if err != nil {
+			return "", 0, fmt.Errorf("could not parse log: %w", err)
+		}
+		// removed logs are delivered separately so clients can handle reorgs
+		return fmt.Sprintf("%s-%s-%d-%t", log.BlockHash, log.TxHash, log.Index, log.Removed), uint64(log.BlockNumber), nil
+	default:
+		return "", 0, fmt.Errorf("unsupported subscription kind %s", kind)
+	}
+}
@@ -0,0 +1,339 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	"github.com/gorilla/websocket"
+	"github.com/puzpuzpuz/xsync"
+	"github.com/synapsecns/sanguine/ethergo/parser/rpc"
+	"github.com/synapsecns/sanguine/services/omnirpc/chainmanager"
+	omniHTTP "github.com/synapsecns/sanguine/services/omnirpc/http"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	subscribeMethod    = "eth_subscribe"
+	unsubscribeMethod  = "eth_unsubscribe"
+	subscriptionMethod = "eth_subscription"
+	jsonRPCVersion     = "2.0"
+)
+
+// JSON-RPC error codes used by the websocket endpoint.
+const (
+	invalidRequestCode = -32600
+	invalidParamsCode  = -32602
+	internalErrorCode  = -32603
+)
+
+// wsUpgrader upgrades incoming http requests to websockets.
+var wsUpgrader = websocket.Upgrader{
+	// omnirpc is an rpc endpoint, clients are expected to connect from any origin
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// wsRequest is a json-rpc request received over a websocket.
+// the id is kept raw since websocket clients are free to use string ids.
+type wsRequest struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// wsResponse is a json-rpc response sent over a websocket.
+type wsResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *JSONError      `json:"error,omitempty"`
+}
+
+// wsNotification is an eth_subscription notification sent to the client.
+type wsNotification struct {
+	Version string               `json:"jsonrpc"`
+	Method  string               `json:"method"`
+	Params  wsNotificationParams `json:"params"`
+}
+
+// wsNotificationParams contains the subscription id and the result of a notification.
+type wsNotificationParams struct {
+	Subscription string          `json:"subscription"`
+	Result       json.RawMessage `json:"result"`
+}
+
+// ServeWebsocket upgrades the request to a websocket and multiplexes eth_subscribe/eth_unsubscribe
+// across the rpcs of the chain. Notifications are only delivered once they've been seen on the required number
+// of upstreams. Any other method is forwarded to the fastest http rpc.
+func (r *RPCProxy) ServeWebsocket(c *gin.Context, chainID uint32, requiredConfirmationsOverride *uint16) {
+	chain := r.chainManager.GetChain(chainID)
+	if chain == nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": fmt.Sprintf("chain %d not found", chainID),
+		})
+		return
+	}
+
+	requiredConfirmations := chain.ConfirmationsThreshold()
+	if requiredConfirmationsOverride != nil {
+		requiredConfirmations = *requiredConfirmationsOverride
+	}
+
+	if len(chain.URLs()) < int(requiredConfirmations) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": fmt.Sprintf("not enough endpoints for chain %d: found %d needed %d", chainID, len(chain.URLs()), requiredConfirmations),
+		})
+		return
+	}
+
+	// the upgrader writes an error response on failure
+	conn, err := wsUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		logger.Warnf("could not upgrade websocket: %v", err)
+		return
+	}
+
+	ctx, span := r.tracer.Start(c.Request.Context(), "wsSession",
+		trace.WithAttributes(attribute.Int("chainID", int(chainID)), attribute.Int("required_confirmations", int(requiredConfirmations))),
+	)
+	defer span.End()
+
+	session := &wsSession{
+		r:                     r,
+		chain:                 chain,
+		conn:                  conn,
+		requiredConfirmations: requiredConfirmations,
+		subscriptions:         xsync.NewMapOf[*subscription](),
+	}
+
+	session.run(ctx)
+}
+
+// wsSession is a single client websocket connection.
+type wsSession struct {
+	// r is the parent rpc proxy object
+	r *RPCProxy
+	// chain is the chain from the chain manager
+	chain chainmanager.Chain
+	// conn is the client connection
+	conn *websocket.Conn
+	// requiredConfirmations is the number of upstreams that must report an event before it's delivered
+	requiredConfirmations uint16
+	// subscriptions maps subscription ids to active subscriptions
+	subscriptions *xsync.MapOf[*subscription]
+	// writeMux serializes writes, websocket connections only support one concurrent writer
+	writeMux sync.Mutex
+}
+
+// run reads messages from the client until the connection is closed.
+func (s *wsSession) run(parentCtx context.Context) {
+	ctx, cancel := context.WithCancel(parentCtx)
+	defer func() {
+		cancel()
+		s.subscriptions.Range(func(key string, sub *subscription) bool {
+			sub.stop()
+			return true
+		})
+		_ = s.conn.Close()
+	}()
+
+	for {
+		_, msg, err := s.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		// batches can't contain subscriptions, so they go straight to the upstream
+		if rpc.IsBatch(msg) {
+			go s.forwardRaw(ctx, msg)
+			continue
+		}
+
+		var req wsRequest
+		err = json.Unmarshal(msg, &req)
+		if err != nil {
+			s.writeError(nil, invalidRequestCode, fmt.Sprintf("could not parse request: %v", err))
+			continue
+		}
+
+		switch req.Method {
+		case subscribeMethod:
+			s.subscribe(ctx, req)
+		case unsubscribeMethod:
+			s.unsubscribe(req)
+		default:
+			go s.forwardRaw(ctx, msg)
+		}
+	}
+}
+
+// subscribe handles an eth_subscribe request.
+func (s *wsSession) subscribe(ctx context.Context, req wsRequest) {
+	var params []json.RawMessage
+	err := json.Unmarshal(req.Params, &params)
+	if err != nil || len(params) == 0 {
+		s.writeError(req.ID, invalidParamsCode, "eth_subscribe expects a subscription type")
+		return
+	}
+
+	var kind string
+	err = json.Unmarshal(params[0], &kind)
+	if err != nil {
+		s.writeError(req.ID, invalidParamsCode, fmt.Sprintf("could not parse subscription type: %v", err))
+		return
+	}
+
+	var filter map[string]interface{}
+	switch subscriptionKind(kind) {
+	case newHeadsKind:
+	case logsKind:
+		if len(params) > 1 {
+			err = json.Unmarshal(params[1], &filter)
+			if err != nil {
+				s.writeError(req.ID, invalidParamsCode, fmt.Sprintf("could not parse log filter: %v", err))
+				return
+			}
+		}
+	default:
+		s.writeError(req.ID, invalidParamsCode, fmt.Sprintf("unsupported subscription type %s, must be one of %s or %s", kind, newHeadsKind, logsKind))
+		return
+	}
+
+	sub := newSubscription(s, subscriptionKind(kind), filter)
+
+	result, err := json.Marshal(sub.id)
+	if err != nil {
+		s.writeError(req.ID, internalErrorCode, err.Error())
+		return
+	}
+
+	// the id has to reach the client before the first notification, so streaming starts after it's written.
+	s.subscriptions.Store(sub.id, sub)
+	s.write(wsResponse{Version: jsonRPCVersion, ID: req.ID, Result: result})
+	sub.start(ctx)
+}
+
+// unsubscribe handles an eth_unsubscribe request.
+func (s *wsSession) unsubscribe(req wsRequest) {
+	var params []string
+	err := json.Unmarshal(req.Params, &params)
+	if err != nil || len(params) != 1 {
+		s.writeError(req.ID, invalidParamsCode, "eth_unsubscribe expects a subscription id")
+		return
+	}
+
+	sub, ok := s.subscriptions.LoadAndDelete(params[0])
+	if ok {
+		sub.stop()
+	}
+
+	result, _ := json.Marshal(ok)
+	s.write(wsResponse{Version: jsonRPCVersion, ID: req.ID, Result: result})
+}
+
+// forwardRaw forwards a non-subscription request to the first http rpc that responds and writes the body back.
+// responses are not checked against multiple rpcs, clients that need confirmations should use the http endpoint.
+func (s *wsSession) forwardRaw(ctx context.Context, body []byte) {
+	var lastErr error
+	for _, endpoint := range s.chain.URLs() {
+		endpointURL, err := url.Parse(endpoint)
+		if err != nil || (endpointURL.Scheme != httpSchema && endpointURL.Scheme != httpsSchema) {
+			continue
+		}
+
+		resp, err := s.r.client.NewRequest().
+			SetContext(ctx).
+			SetRequestURI(endpoint).
+			SetBody(body).
+			SetHeaderBytes(omniHTTP.XForwardedFor, omniHTTP.OmniRPCValue).
+			SetHeaderBytes(omniHTTP.ContentType, omniHTTP.JSONType).
+			SetHeaderBytes(omniHTTP.Accept, omniHTTP.JSONType).
+			Do()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if resp.StatusCode() < 200 || resp.StatusCode() > 400 {
+			lastErr = fmt.Errorf("invalid response code: %d (%s)", resp.StatusCode(), http.StatusText(resp.StatusCode()))
+			continue
+		}
+
+		s.writeRaw(resp.Body())
+		return
+	}
+
+	var req wsRequest
+	_ = json.Unmarshal(body, &req)
+	s.writeError(req.ID, internalErrorCode, fmt.Sprintf("could not forward request: %v", lastErr))
+}
+
+// notify sends a subscription notification to the client.
+func (s *wsSession) notify(subscriptionID string, result json.RawMessage) {
+	s.write(wsNotification{
+		Version: jsonRPCVersion,
+		Method:  subscriptionMethod,
+		Params: wsNotificationParams{
+			Subscription: subscriptionID,
+			Result:       result,
+		},
+	})
+}
+
+func (s *wsSession) writeError(id json.RawMessage, code int, message string) {
+	s.write(wsResponse{
+		Version: jsonRPCVersion,
+		ID:      id,
+		Error: &JSONError{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
+
+func (s *wsSession) write(msg interface{}) {
+	res, err := json.Marshal(msg)
+	if err != nil {
+		logger.Warnf("could not marshall websocket message: %v", err)
+		return
+	}
+	s.writeRaw(res)
+}
+
+func (s *wsSession) writeRaw(msg []byte) {
+	s.writeMux.Lock()
+	defer s.writeMux.Unlock()
+
+	err := s.conn.WriteMessage(websocket.TextMessage, msg)
+	if err != nil {
+		logger.Debugf("could not write to websocket: %v", err)
+	}
+}
+
+// toWebsocketURL converts an rpc url to its websocket equivalent.
+func toWebsocketURL(rawURL string) (string, error) {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("could not parse url %s: %w", rawURL, err)
+	}
+
+	switch parsedURL.Scheme {
+	case wsSchema, wssSchema:
+	case httpSchema:
+		parsedURL.Scheme = wsSchema
+	case httpsSchema:
+		parsedURL.Scheme = wssSchema
+	default:
+		return "", fmt.Errorf("unsupported scheme %s for websocket", parsedURL.Scheme)
+	}
+
+	return parsedURL.String(), nil
+}
@@ -0,0 +1,190 @@
+package proxy_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/gin-gonic/gin"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	"github.com/synapsecns/sanguine/services/omnirpc/proxy"
+)
+
+// headService is a fake upstream that emits a fixed list of headers to newHeads subscribers.
+type headService struct {
+	headers []*types.Header
+}
+
+// NewHeads implements eth_subscribe for newHeads.
+func (h *headService) NewHeads(ctx context.Context) (*gethRPC.Subscription, error) {
+	notifier, ok := gethRPC.NotifierFromContext(ctx)
+	if !ok {
+		return nil, gethRPC.ErrNotificationsUnsupported
+	}
+
+	sub := notifier.CreateSubscription()
+	go func() {
+		for _, header := range h.headers {
+			_ = notifier.Notify(sub.ID, header)
+		}
+	}()
+
+	return sub, nil
+}
+
+func (p *ProxySuite) newHeadUpstream(headers []*types.Header) string {
+	server := gethRPC.NewServer()
+	p.Require().NoError(server.RegisterName("eth", &headService{headers: headers}))
+
+	httpServer := httptest.NewServer(server.WebsocketHandler([]string{"*"}))
+	p.T().Cleanup(func() {
+		httpServer.Close()
+		server.Stop()
+	})
+
+	return httpServer.URL
+}
+
+func (p *ProxySuite) TestWebsocketDeduplicatesHeads() {
+	const chainID = 1
+	const headCount = 5
+
+	headers := make([]*types.Header, headCount)
+	for i := range headers {
+		headers[i] = &types.Header{
+			Number:     big.NewInt(int64(i + 1)),
+			Difficulty: big.NewInt(0),
+		}
+	}
+
+	prxy, err := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {
+				RPCs:   []string{p.newHeadUpstream(headers), p.newHeadUpstream(headers)},
+				Checks: 2,
+			},
+		},
+	}, p.metrics)
+	p.Require().NoError(err)
+
+	router := gin.New()
+	router.GET("/ws/:id", func(c *gin.Context) {
+		prxy.ServeWebsocket(c, chainID, nil)
+	})
+	proxyServer := httptest.NewServer(router)
+	defer proxyServer.Close()
+
+	client, err := gethRPC.DialWebsocket(p.GetTestContext(), fmt.Sprintf("%s/ws/%d", strings.Replace(proxyServer.URL, "http", "ws", 1), chainID), "")
+	p.Require().NoError(err)
+	defer client.Close()
+
+	heads := make(chan *types.Header, headCount*2)
+	sub, err := client.EthSubscribe(p.GetTestContext(), heads, "newHeads")
+	p.Require().NoError(err)
+	defer sub.Unsubscribe()
+
+	for i := 0; i < headCount; i++ {
+		select {
+		case head := <-heads:
+			Equal(p.T(), headers[i].Hash(), head.Hash())
+		case <-time.After(time.Second * 10):
+			p.T().Fatalf("timed out waiting for head %d", i)
+		}
+	}
+
+	// both upstreams reported every head, but each should only be delivered once
+	select {
+	case head := <-heads:
+		p.T().Fatalf("received duplicate head %d", head.Number)
+	case <-time.After(time.Second):
+	}
+}
+
+func (p *ProxySuite) TestWebsocketHoldsUnconfirmedHeads() {
+	const chainID = 1
+
+	headers := []*types.Header{{
+		Number:     big.NewInt(1),
+		Difficulty: big.NewInt(0),
+	}}
+
+	// the second upstream is down, so two confirmations are never reached.
+	deadUpstream := httptest.NewServer(nil)
+	deadUpstream.Close()
+
+	prxy, err := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {
+				RPCs:   []string{p.newHeadUpstream(headers), deadUpstream.URL},
+				Checks: 2,
+			},
+		},
+	}, p.metrics)
+	p.Require().NoError(err)
+
+	router := gin.New()
+	router.GET("/ws/:id", func(c *gin.Context) {
+		prxy.ServeWebsocket(c, chainID, nil)
+	})
+	proxyServer := httptest.NewServer(router)
+	defer proxyServer.Close()
+
+	client, err := gethRPC.DialWebsocket(p.GetTestContext(), fmt.Sprintf("%s/ws/%d", strings.Replace(proxyServer.URL, "http", "ws", 1), chainID), "")
+	p.Require().NoError(err)
+	defer client.Close()
+
+	heads := make(chan *types.Header, 1)
+	sub, err := client.EthSubscribe(p.GetTestContext(), heads, "newHeads")
+	p.Require().NoError(err)
+	defer sub.Unsubscribe()
+
+	select {
+	case head := <-heads:
+		p.T().Fatalf("received head %d with a single confirmation", head.Number)
+	case <-time.After(time.Second * 2):
+	}
+}
+
+func (p *ProxySuite) TestWebsocketUnsupportedSubscription() {
+	const chainID = 1
+
+	prxy, err := proxy.NewProxy(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {
+				RPCs: []string{p.newHeadUpstream(nil)},
+			},
+		},
+	}, p.metrics)
+	p.Require().NoError(err)
+
+	router := gin.New()
+	router.GET("/ws/:id", func(c *gin.Context) {
+		prxy.ServeWebsocket(c, chainID, nil)
+	})
+	proxyServer := httptest.NewServer(router)
+	defer proxyServer.Close()
+
+	client, err := gethRPC.DialWebsocket(p.GetTestContext(), fmt.Sprintf("%s/ws/%d", strings.Replace(proxyServer.URL, "http", "ws", 1), chainID), "")
+	p.Require().NoError(err)
+	defer client.Close()
+
+	_, err = client.EthSubscribe(p.GetTestContext(), make(chan interface{}), "newPendingTransactions")
+	NotNil(p.T(), err)
+}
+
+func (p *ProxySuite) TestBlockHeader() {
+	block := `{"number":"0x5","hash":"0x01","parentHash":"0x02","l1BlockNumber":"0x10","size":"0x100","totalDifficulty":"0x1","transactions":["0x03"],"uncles":[],"withdrawals":[]}`
+
+	header, err := proxy.BlockHeader([]byte(block))
+	p.Require().NoError(err)
+	JSONEq(p.T(), `{"number":"0x5","hash":"0x01","parentHash":"0x02","l1BlockNumber":"0x10"}`, string(header))
+
+	_, err = proxy.BlockHeader([]byte("null"))
+	p.Require().Error(err)
+}
@@ -27,7 +27,7 @@ type Result struct {
 	BlockAge time.Duration
 	// BlockNumber is the block number
 	BlockNumber uint64
-	// HasError is wether or not the result has an error
+	// HasError is whether or not the result has an error
 	HasError bool
 	// Error is the error recevied when trying to establish latency
 	Error error
@@ -60,7 +60,8 @@ func NewOmnirpcServer(ctx context.Context, tb testing.TB, backends ...backends.S
 	handler, err := metrics.NewByType(ctx, metadata.BuildInfo(), metricsHandler)
 	assert.Nil(tb, err)
 
-	server := proxy.NewProxy(makeConfig(backends, omniHTTP.FastHTTP), handler)
+	server, err := proxy.NewProxy(makeConfig(backends, omniHTTP.FastHTTP), handler)
+	assert.Nil(tb, err)
 
 	go func() {
 		server.Run(ctx)
@@ -0,0 +1,117 @@
+package upstream
+
+import (
+	"sync"
+	"time"
+)
+
+// breakerState is the state of a circuit breaker.
+type breakerState int
+
+const (
+	// closed means requests are let through.
+	closed breakerState = iota
+	// open means the upstream has been ejected and requests are rejected until the cooldown passes.
+	open
+	// halfOpen means the cooldown has passed and a single probe request is let through.
+	halfOpen
+)
+
+// breaker ejects an upstream after consecutive failures and lets a single probe through once the cooldown passes.
+type breaker struct {
+	mux sync.Mutex
+	// threshold is the number of consecutive failures that opens the breaker
+	threshold int
+	// cooldown is how long the breaker stays open
+	cooldown time.Duration
+	// failures is the number of consecutive failures
+	failures int
+	// state is the current state
+	state breakerState
+	// openedAt is when the breaker was last opened
+	openedAt time.Time
+	// probing is true while a probe request is in flight
+	probing bool
+	// now is used to get the current time, overridable for testing
+	now func() time.Time
+}
+
+func newBreaker(threshold int, cooldown time.Duration) *breaker {
+	return &breaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// allow returns true if a request can be sent. Once the cooldown has passed, a single probe request is allowed.
+func (b *breaker) allow() bool {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	switch b.state {
+	case closed:
+		return true
+	case open:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return false
+		}
+		b.state = halfOpen
+		b.probing = true
+		return true
+	case halfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	}
+	return false
+}
+
+// available returns true if the breaker would let a request through without consuming a probe.
+func (b *breaker) available() bool {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	switch b.state {
+	case closed:
+		return true
+	case open:
+		return b.now().Sub(b.openedAt) >= b.cooldown
+	case halfOpen:
+		return !b.probing
+	}
+	return false
+}
+
+// record records the outcome of a request. opened is true if this request ejected the upstream.
+func (b *breaker) record(success bool) (opened bool) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	b.probing = false
+
+	if success {
+		b.failures = 0
+		b.state = closed
+		return false
+	}
+
+	b.failures++
+	if b.state == halfOpen || (b.state == closed && b.failures >= b.threshold) {
+		b.state = open
+		b.openedAt = b.now()
+		return true
+	}
+
+	return false
+}
+
+// abandon ends a request without recording an outcome, so a probe that was cut short lets the next one through.
+func (b *breaker) abandon() {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	b.probing = false
+}
@@ -0,0 +1,2 @@
+// Package upstream tracks rate limits, concurrency and health of individual rpcs and decides the order they're tried in.
+package upstream
@@ -0,0 +1,10 @@
+package upstream
+
+import "time"
+
+// SetNow overrides the clock used by the circuit breaker for testing.
+func (u *Upstream) SetNow(now func() time.Time) {
+	u.breaker.mux.Lock()
+	defer u.breaker.mux.Unlock()
+	u.breaker.now = now
+}
@@ -0,0 +1,266 @@
+package upstream
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-log"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var logger = log.Logger("upstream")
+
+const (
+	// defaultFailureThreshold is the default number of consecutive failures before an upstream is ejected.
+	defaultFailureThreshold = 5
+	// defaultCooldown is the default time an ejected upstream is skipped.
+	defaultCooldown = time.Second * 30
+)
+
+const (
+	meter         = "github.com/synapsecns/sanguine/services/omnirpc/upstream"
+	ejectedMetric = "upstream_ejected"
+)
+
+// Registry holds the upstream state for every rpc across chains.
+type Registry struct {
+	// mux protects upstreams and chains
+	mux sync.RWMutex
+	// upstreams contains upstreams keyed by chain id and url
+	upstreams map[uint32]map[string]*Upstream
+	// chains contains the rpc options for each chain
+	chains map[uint32]config.ChainConfig
+	// failureThreshold is the number of consecutive failures before an upstream is ejected
+	failureThreshold int
+	// cooldown is how long an ejected upstream is skipped
+	cooldown time.Duration
+	// ejections counts breaker openings
+	ejections metric.Int64Counter
+}
+
+// NewRegistry creates a registry from the config.
+func NewRegistry(cfg config.Config, handler metrics.Handler) *Registry {
+	registry := &Registry{
+		upstreams:        make(map[uint32]map[string]*Upstream),
+		chains:           cfg.Chains,
+		failureThreshold: cfg.CircuitBreaker.FailureThreshold,
+		cooldown:         time.Duration(cfg.CircuitBreaker.Cooldown) * time.Second,
+	}
+
+	if registry.chains == nil {
+		registry.chains = make(map[uint32]config.ChainConfig)
+	}
+
+	if registry.failureThreshold == 0 {
+		registry.failureThreshold = defaultFailureThreshold
+	}
+
+	if registry.cooldown == 0 {
+		registry.cooldown = defaultCooldown
+	}
+
+	var err error
+	registry.ejections, err = handler.Meter(meter).Int64Counter(ejectedMetric, metric.WithDescription("number of times an rpc was ejected by the circuit breaker"))
+	if err != nil {
+		logger.Errorf("could not setup metrics: %v", err)
+	}
+
+	return registry
+}
+
+// ApplyConfig updates the registry to a new config. Upstreams keep their state unless their options changed.
+func (r *Registry) ApplyConfig(cfg config.Config) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	failureThreshold := cfg.CircuitBreaker.FailureThreshold
+	if failureThreshold == 0 {
+		failureThreshold = defaultFailureThreshold
+	}
+
+	cooldown := time.Duration(cfg.CircuitBreaker.Cooldown) * time.Second
+	if cooldown == 0 {
+		cooldown = defaultCooldown
+	}
+
+	// breaker settings apply to every upstream
+	if failureThreshold != r.failureThreshold || cooldown != r.cooldown {
+		r.upstreams = make(map[uint32]map[string]*Upstream)
+	}
+
+	for chainID, upstreams := range r.upstreams {
+		for url := range upstreams {
+			if !reflect.DeepEqual(r.chains[chainID].RPCOptions[url], cfg.Chains[chainID].RPCOptions[url]) {
+				delete(upstreams, url)
+			}
+		}
+	}
+
+	r.chains = cfg.Chains
+	if r.chains == nil {
+		r.chains = make(map[uint32]config.ChainConfig)
+	}
+	r.failureThreshold = failureThreshold
+	r.cooldown = cooldown
+}
+
+// Get gets the upstream for a url on a chain, creating it if it doesn't exist.
+func (r *Registry) Get(chainID uint32, url string) *Upstream {
+	r.mux.RLock()
+	upstream, ok := r.upstreams[chainID][url]
+	r.mux.RUnlock()
+	if ok {
+		return upstream
+	}
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	// check again in case it was created while we were waiting for the lock
+	if upstream, ok = r.upstreams[chainID][url]; ok {
+		return upstream
+	}
+
+	if _, ok = r.upstreams[chainID]; !ok {
+		r.upstreams[chainID] = make(map[string]*Upstream)
+	}
+
+	upstream = newUpstream(url, r.chains[chainID].RPCOptions[url], r.failureThreshold, r.cooldown, func(url string) {
+		logger.Warnf("ejecting %s on chain %d after %d consecutive failures", url, chainID, r.failureThreshold)
+		if r.ejections == nil {
+			return
+		}
+		r.ejections.Add(context.Background(), 1, metric.WithAttributeSet(
+			attribute.NewSet(attribute.Int64(metrics.ChainID, int64(chainID)), attribute.String("rpc_url", url))),
+		)
+	})
+	r.upstreams[chainID][url] = upstream
+
+	return upstream
+}
+
+// Routes gets the method routing rules for a chain.
+func (r *Registry) Routes(chainID uint32) []config.RouteConfig {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+
+	return r.chains[chainID].Routes
+}
+
+// MaxLogRange gets the max eth_getLogs block range that every url accepts. 0 means no limit is configured.
+func (r *Registry) MaxLogRange(chainID uint32, urls []string) uint64 {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+
+	chain := r.chains[chainID]
+
+	var maxRange uint64
+	for _, url := range urls {
+		urlRange := chain.MaxLogRange
+		if options, ok := chain.RPCOptions[url]; ok && options.MaxLogRange != 0 {
+			urlRange = options.MaxLogRange
+		}
+
+		if urlRange != 0 && (maxRange == 0 || urlRange < maxRange) {
+			maxRange = urlRange
+		}
+	}
+	return maxRange
+}
+
+// Routes are the urls a request is routed to.
+type Routes struct {
+	// registry is the registry the routes were created from
+	registry *Registry
+	// chainID is the chain the routes are for
+	chainID uint32
+	// urls are the routed urls in the order they should be tried
+	urls []string
+	// fallback contains ejected urls that were routed to because too few upstreams were available
+	fallback map[string]bool
+}
+
+// URLs gets the routed urls in the order they should be tried.
+func (r Routes) URLs() []string {
+	return r.urls
+}
+
+// Acquire reserves capacity on a routed url for a request made with ctx. Ejected upstreams used as a fallback are acquired past their
+// circuit breaker, otherwise the request would be rejected anyway.
+func (r Routes) Acquire(ctx context.Context, url string) (release func(success bool), err error) {
+	upstream := r.registry.Get(r.chainID, url)
+	if r.fallback[url] {
+		return upstream.AcquireForced(ctx)
+	}
+	return upstream.Acquire(ctx)
+}
+
+// Route orders latency sorted urls for a request. Ejected upstreams are dropped unless fewer than minimum
+// upstreams are available. If any upstream has a non-default weight, available upstreams are shuffled by weight,
+// otherwise the latency order is kept.
+func (r *Registry) Route(chainID uint32, urls []string, minimum int) Routes {
+	var available, ejected []*Upstream
+	weighted := false
+
+	for _, url := range urls {
+		upstream := r.Get(chainID, url)
+		if upstream.Available() {
+			available = append(available, upstream)
+		} else {
+			ejected = append(ejected, upstream)
+		}
+
+		if upstream.Weight() != 1 {
+			weighted = true
+		}
+	}
+
+	if weighted {
+		available = weightedShuffle(available)
+	}
+
+	routes := Routes{
+		registry: r,
+		chainID:  chainID,
+		fallback: make(map[string]bool),
+	}
+
+	// fall back to ejected upstreams rather than failing outright
+	for len(available) < minimum && len(ejected) > 0 {
+		available = append(available, ejected[0])
+		routes.fallback[ejected[0].URL()] = true
+		ejected = ejected[1:]
+	}
+
+	routes.urls = make([]string, len(available))
+	for i, upstream := range available {
+		routes.urls[i] = upstream.URL()
+	}
+	return routes
+}
+
+// weightedShuffle orders upstreams randomly, with higher weighted upstreams more likely to come first.
+// see: https://en.wikipedia.org/wiki/Reservoir_sampling#Algorithm_A-Res
+func weightedShuffle(upstreams []*Upstream) []*Upstream {
+	keys := make(map[*Upstream]float64, len(upstreams))
+	for _, upstream := range upstreams {
+		//nolint: gosec
+		keys[upstream] = math.Pow(rand.Float64(), 1/float64(upstream.Weight()))
+	}
+
+	shuffled := make([]*Upstream, len(upstreams))
+	copy(shuffled, upstreams)
+	sort.SliceStable(shuffled, func(i, j int) bool {
+		return keys[shuffled[i]] > keys[shuffled[j]]
+	})
+
+	return shuffled
+}
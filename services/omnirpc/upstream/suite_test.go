@@ -0,0 +1,25 @@
+package upstream_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/synapsecns/sanguine/core/testsuite"
+)
+
+// UpstreamSuite defines the basic test suite.
+type UpstreamSuite struct {
+	*testsuite.TestSuite
+}
+
+// NewUpstreamSuite creates a new test suite.
+func NewUpstreamSuite(tb testing.TB) *UpstreamSuite {
+	tb.Helper()
+	return &UpstreamSuite{
+		testsuite.NewTestSuite(tb),
+	}
+}
+
+func TestUpstreamSuite(t *testing.T) {
+	suite.Run(t, NewUpstreamSuite(t))
+}
@@ -0,0 +1,135 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var (
+	// ErrCircuitOpen is returned when an upstream has been ejected by the circuit breaker.
+	ErrCircuitOpen = errors.New("circuit breaker is open")
+	// ErrRateLimited is returned when an upstream is over its requests per second limit.
+	ErrRateLimited = errors.New("rate limit exceeded")
+	// ErrConcurrencyLimited is returned when an upstream has too many in flight requests.
+	ErrConcurrencyLimited = errors.New("concurrency limit exceeded")
+)
+
+// Upstream contains the limits and health of a single rpc.
+type Upstream struct {
+	// url is the url of the rpc
+	url string
+	// weight is the relative share of requests routed to the rpc
+	weight uint
+	// limiter limits requests per second, nil if unlimited
+	limiter *rate.Limiter
+	// slots limits concurrent requests, nil if unlimited
+	slots chan struct{}
+	// breaker ejects the upstream on consecutive failures
+	breaker *breaker
+	// onOpen is called when the breaker opens
+	onOpen func(url string)
+	// tags are used by routes to select the upstream
+	tags sets.String
+}
+
+func newUpstream(url string, options config.RPCOptions, failureThreshold int, cooldown time.Duration, onOpen func(url string)) *Upstream {
+	upstream := &Upstream{
+		url:     url,
+		weight:  options.Weight,
+		breaker: newBreaker(failureThreshold, cooldown),
+		onOpen:  onOpen,
+		tags:    sets.NewString(options.Tags...),
+	}
+
+	if upstream.weight == 0 {
+		upstream.weight = 1
+	}
+
+	if options.RequestsPerSecond > 0 {
+		burst := int(options.RequestsPerSecond)
+		if burst < 1 {
+			burst = 1
+		}
+		upstream.limiter = rate.NewLimiter(rate.Limit(options.RequestsPerSecond), burst)
+	}
+
+	if options.MaxConcurrency > 0 {
+		upstream.slots = make(chan struct{}, options.MaxConcurrency)
+	}
+
+	return upstream
+}
+
+// URL gets the url of the upstream.
+func (u *Upstream) URL() string {
+	return u.url
+}
+
+// Weight gets the routing weight of the upstream.
+func (u *Upstream) Weight() uint {
+	return u.weight
+}
+
+// HasAnyTag returns true if the upstream has at least one of the tags.
+func (u *Upstream) HasAnyTag(tags []string) bool {
+	return u.tags.HasAny(tags...)
+}
+
+// Available returns true if the upstream hasn't been ejected by the circuit breaker.
+func (u *Upstream) Available() bool {
+	return u.breaker.available()
+}
+
+// Acquire reserves capacity for a single request made with ctx. If no error is returned, release must be called
+// once the request finishes with whether or not it succeeded. If ctx is done by then, the request was cut short
+// by the caller rather than the upstream, so no outcome is recorded.
+func (u *Upstream) Acquire(ctx context.Context) (release func(success bool), err error) {
+	return u.acquire(ctx, false)
+}
+
+// AcquireForced is Acquire without the circuit breaker check, for ejected upstreams that are used as a fallback.
+// Rate and concurrency limits still apply, and the outcome is recorded so a success closes the breaker.
+func (u *Upstream) AcquireForced(ctx context.Context) (release func(success bool), err error) {
+	return u.acquire(ctx, true)
+}
+
+func (u *Upstream) acquire(ctx context.Context, forced bool) (release func(success bool), err error) {
+	if u.limiter != nil && !u.limiter.Allow() {
+		return nil, ErrRateLimited
+	}
+
+	if u.slots != nil {
+		select {
+		case u.slots <- struct{}{}:
+		default:
+			return nil, ErrConcurrencyLimited
+		}
+	}
+
+	if !forced && !u.breaker.allow() {
+		if u.slots != nil {
+			<-u.slots
+		}
+		return nil, ErrCircuitOpen
+	}
+
+	return func(success bool) {
+		if u.slots != nil {
+			<-u.slots
+		}
+
+		if ctx.Err() != nil {
+			u.breaker.abandon()
+			return
+		}
+
+		if opened := u.breaker.record(success); opened && u.onOpen != nil {
+			u.onOpen(u.url)
+		}
+	}, nil
+}
@@ -0,0 +1,190 @@
+package upstream_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/omnirpc/config"
+	"github.com/synapsecns/sanguine/services/omnirpc/upstream"
+)
+
+const chainID = 1
+
+func (u *UpstreamSuite) newRegistry(options map[string]config.RPCOptions, urls ...string) *upstream.Registry {
+	return upstream.NewRegistry(config.Config{
+		Chains: map[uint32]config.ChainConfig{
+			chainID: {
+				RPCs:       urls,
+				RPCOptions: options,
+			},
+		},
+		CircuitBreaker: config.CircuitBreakerConfig{
+			FailureThreshold: 2,
+			Cooldown:         10,
+		},
+	}, metrics.NewNullHandler())
+}
+
+func (u *UpstreamSuite) TestCircuitBreaker() {
+	url := gofakeit.URL()
+	registry := u.newRegistry(nil, url)
+
+	now := time.Now()
+	rpc := registry.Get(chainID, url)
+	rpc.SetNow(func() time.Time {
+		return now
+	})
+
+	// two consecutive failures should eject the upstream
+	for i := 0; i < 2; i++ {
+		release, err := rpc.Acquire(u.GetTestContext())
+		u.Require().NoError(err)
+		release(false)
+	}
+
+	False(u.T(), rpc.Available())
+	_, err := rpc.Acquire(u.GetTestContext())
+	ErrorIs(u.T(), err, upstream.ErrCircuitOpen)
+
+	// after the cooldown a single probe is let through
+	now = now.Add(time.Second * 11)
+	True(u.T(), rpc.Available())
+
+	release, err := rpc.Acquire(u.GetTestContext())
+	u.Require().NoError(err)
+
+	_, err = rpc.Acquire(u.GetTestContext())
+	ErrorIs(u.T(), err, upstream.ErrCircuitOpen)
+
+	// a successful probe closes the breaker
+	release(true)
+	True(u.T(), rpc.Available())
+
+	release, err = rpc.Acquire(u.GetTestContext())
+	u.Require().NoError(err)
+	release(true)
+}
+
+func (u *UpstreamSuite) TestCanceledRequestNotRecorded() {
+	url := gofakeit.URL()
+	registry := u.newRegistry(nil, url)
+	rpc := registry.Get(chainID, url)
+
+	// failures of requests the caller canceled aren't the upstream's fault
+	ctx, cancel := context.WithCancel(u.GetTestContext())
+	cancel()
+	for i := 0; i < 2; i++ {
+		release, err := rpc.Acquire(ctx)
+		u.Require().NoError(err)
+		release(false)
+	}
+
+	True(u.T(), rpc.Available())
+}
+
+func (u *UpstreamSuite) TestRateLimit() {
+	url := gofakeit.URL()
+	registry := u.newRegistry(map[string]config.RPCOptions{
+		url: {RequestsPerSecond: 1},
+	}, url)
+
+	rpc := registry.Get(chainID, url)
+
+	release, err := rpc.Acquire(u.GetTestContext())
+	u.Require().NoError(err)
+	release(true)
+
+	_, err = rpc.Acquire(u.GetTestContext())
+	ErrorIs(u.T(), err, upstream.ErrRateLimited)
+}
+
+func (u *UpstreamSuite) TestMaxConcurrency() {
+	url := gofakeit.URL()
+	registry := u.newRegistry(map[string]config.RPCOptions{
+		url: {MaxConcurrency: 1},
+	}, url)
+
+	rpc := registry.Get(chainID, url)
+
+	release, err := rpc.Acquire(u.GetTestContext())
+	u.Require().NoError(err)
+
+	_, err = rpc.Acquire(u.GetTestContext())
+	ErrorIs(u.T(), err, upstream.ErrConcurrencyLimited)
+
+	release(true)
+
+	release, err = rpc.Acquire(u.GetTestContext())
+	u.Require().NoError(err)
+	release(true)
+}
+
+func (u *UpstreamSuite) TestRouteSkipsEjected() {
+	urls := []string{gofakeit.URL(), gofakeit.URL(), gofakeit.URL()}
+	registry := u.newRegistry(nil, urls...)
+
+	ejected := registry.Get(chainID, urls[0])
+	for i := 0; i < 2; i++ {
+		release, err := ejected.Acquire(u.GetTestContext())
+		u.Require().NoError(err)
+		release(false)
+	}
+
+	// latency order is kept for the remaining upstreams
+	Equal(u.T(), urls[1:], registry.Route(chainID, urls, 1).URLs())
+
+	// ejected upstreams are used when there aren't enough available ones
+	Equal(u.T(), []string{urls[1], urls[2], urls[0]}, registry.Route(chainID, urls, 3).URLs())
+}
+
+func (u *UpstreamSuite) TestRouteFallbackToEjected() {
+	urls := []string{gofakeit.URL(), gofakeit.URL()}
+	registry := u.newRegistry(nil, urls...)
+
+	for _, url := range urls {
+		for i := 0; i < 2; i++ {
+			release, err := registry.Get(chainID, url).Acquire(u.GetTestContext())
+			u.Require().NoError(err)
+			release(false)
+		}
+	}
+
+	// every upstream is ejected and still cooling down, so the fallback has to get past the breaker
+	routes := registry.Route(chainID, urls, 1)
+	Equal(u.T(), urls[:1], routes.URLs())
+
+	release, err := routes.Acquire(u.GetTestContext(), urls[0])
+	u.Require().NoError(err)
+
+	// a successful fallback request closes the breaker
+	release(true)
+	True(u.T(), registry.Get(chainID, urls[0]).Available())
+	False(u.T(), registry.Get(chainID, urls[1]).Available())
+
+	// upstreams that weren't routed to as a fallback keep their breaker
+	_, err = registry.Route(chainID, urls[1:], 0).Acquire(u.GetTestContext(), urls[1])
+	ErrorIs(u.T(), err, upstream.ErrCircuitOpen)
+}
+
+func (u *UpstreamSuite) TestRouteWeighted() {
+	heavy, light := gofakeit.URL(), gofakeit.URL()
+	registry := u.newRegistry(map[string]config.RPCOptions{
+		heavy: {Weight: 100},
+	}, heavy, light)
+
+	heavyFirst := 0
+	const rounds = 1000
+	for i := 0; i < rounds; i++ {
+		routed := registry.Route(chainID, []string{light, heavy}, 1).URLs()
+		Len(u.T(), routed, 2)
+		if routed[0] == heavy {
+			heavyFirst++
+		}
+	}
+
+	// heavy should come first ~99% of the time
+	Greater(u.T(), heavyFirst, rounds*9/10)
+}
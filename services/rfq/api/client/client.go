@@ -3,9 +3,15 @@
 package client
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/google/uuid"
+	"math/big"
+	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/synapsecns/sanguine/core/ginhelper"
@@ -14,6 +20,7 @@
 	"github.com/dubonzi/otelresty"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/go-resty/resty/v2"
+	"github.com/gorilla/websocket"
 	"github.com/synapsecns/sanguine/ethergo/signer/signer"
 	"github.com/synapsecns/sanguine/services/rfq/api/model"
 	"github.com/synapsecns/sanguine/services/rfq/api/rest"
@@ -23,14 +30,30 @@
 // It provides methods for creating, retrieving and updating quotes.
 type AuthenticatedClient interface {
 	PutQuote(q *model.PutQuoteRequest) error
+	// DeleteQuotes withdraws the relayer's quotes matching the request.
+	DeleteQuotes(q *model.DeleteQuotesRequest) (*model.DeleteQuotesResponse, error)
+	// SubscribeActiveRFQ connects to the rfq stream and responds to active quote requests until the context is canceled.
+	SubscribeActiveRFQ(ctx context.Context, respond ActiveRFQHandler) error
 	UnauthenticatedClient
 }
 
+// ActiveRFQHandler quotes an active rfq request, returning the dest amount to respond with.
+// Requests are handled one at a time, so handlers should return well before the request deadline.
+// ok should be false (or destAmount nil) to skip the request.
+type ActiveRFQHandler func(ctx context.Context, req *model.ActiveRFQRequest) (destAmount *big.Int, ok bool)
+
 // UnauthenticatedClient is an interface for the RFQ API.
 type UnauthenticatedClient interface {
 	GetAllQuotes() ([]*model.GetQuoteResponse, error)
 	GetSpecificQuote(q *model.GetQuoteSpecificRequest) ([]*model.GetQuoteResponse, error)
 	GetQuoteByRelayerAddress(relayerAddr string) ([]*model.GetQuoteResponse, error)
+	// GetQuoteHistory gets a page of quote history, newest first.
+	GetQuoteHistory(q *model.GetQuoteHistoryRequest) ([]*model.GetQuoteHistoryResponse, error)
+	// PutRFQRequest requests an active quote from connected relayers and returns the best response.
+	PutRFQRequest(q *model.PutRFQRequest) (*model.PutRFQResponse, error)
+	// SubscribeQuotes streams quote upserts on a route until the context is canceled. Empty fields in q match any value.
+	// Withdrawn and expired quotes are sent with Deleted set.
+	SubscribeQuotes(ctx context.Context, q *model.GetQuoteSpecificRequest) (<-chan *model.GetQuoteResponse, error)
 	resty() *resty.Client
 }
 
@@ -44,7 +67,9 @@ func (c unauthenticatedClient) resty() *resty.Client {
 
 type clientImpl struct {
 	UnauthenticatedClient
-	rClient *resty.Client
+	rClient   *resty.Client
+	rfqURL    string
+	reqSigner signer.Signer
 }
 
 // NewAuthenticatedClient creates a new client for the RFQ quoting API.
@@ -60,21 +85,10 @@ func NewAuthenticatedClient(metrics metrics.Handler, rfqURL string, reqSigner si
 	authedClient := unauthedClient.resty().
 		OnBeforeRequest(func(client *resty.Client, request *resty.Request) error {
 			// if request.Method == "PUT" && request.URL == rfqURL+rest.QUOTE_ROUTE {
-			// i.e. signature (hex encoded) = keccak(bytes.concat("\x19Ethereum Signed Message:\n", len(strconv.Itoa(time.Now().Unix()), strconv.Itoa(time.Now().Unix())))
-			// so that full auth header string: auth = strconv.Itoa(time.Now().Unix()) + ":" + signature
-			// Get the current Unix timestamp as a string.
-			now := strconv.Itoa(int(time.Now().Unix()))
-
-			// Prepare the data to be signed.
-			data := "\x19Ethereum Signed Message:\n" + strconv.Itoa(len(now)) + now
-
-			sig, err := reqSigner.SignMessage(request.Context(), []byte(data), true)
-
+			res, err := authHeader(request.Context(), reqSigner)
 			if err != nil {
-				return fmt.Errorf("failed to sign request: %w", err)
+				return err
 			}
-
-			res := fmt.Sprintf("%s:%s", now, hexutil.Encode(signer.Encode(sig)))
 			request.SetHeader("Authorization", res)
 
 			return nil
@@ -83,9 +97,39 @@ func NewAuthenticatedClient(metrics metrics.Handler, rfqURL string, reqSigner si
 	return &clientImpl{
 		UnauthenticatedClient: unauthedClient,
 		rClient:               authedClient,
+		rfqURL:                rfqURL,
+		reqSigner:             reqSigner,
 	}, nil
 }
 
+// authHeader creates an eip-191 authorization header.
+// i.e. signature (hex encoded) = keccak(bytes.concat("\x19Ethereum Signed Message:\n", len(strconv.Itoa(time.Now().Unix()), strconv.Itoa(time.Now().Unix())))
+// so that full auth header string: auth = strconv.Itoa(time.Now().Unix()) + ":" + signature
+func authHeader(ctx context.Context, reqSigner signer.Signer) (string, error) {
+	// Get the current Unix timestamp as a string.
+	now := strconv.Itoa(int(time.Now().Unix()))
+
+	sig, err := signEIP191(ctx, reqSigner, now)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	return fmt.Sprintf("%s:%s", now, sig), nil
+}
+
+// signEIP191 signs the message as an eth signed message and returns the hex encoded signature.
+func signEIP191(ctx context.Context, reqSigner signer.Signer, message string) (string, error) {
+	// Prepare the data to be signed.
+	data := "\x19Ethereum Signed Message:\n" + strconv.Itoa(len(message)) + message
+
+	sig, err := reqSigner.SignMessage(ctx, []byte(data), true)
+	if err != nil {
+		return "", fmt.Errorf("could not sign message: %w", err)
+	}
+
+	return hexutil.Encode(signer.Encode(sig)), nil
+}
+
 // NewUnauthenticaedClient creates a new client for the RFQ quoting API.
 func NewUnauthenticaedClient(metricHandler metrics.Handler, rfqURL string) (UnauthenticatedClient, error) {
 	client := resty.New().
@@ -172,3 +216,205 @@ func (c *unauthenticatedClient) GetQuoteByRelayerAddress(relayerAddr string) ([]
 
 	return quotes, nil
 }
+
+// DeleteQuotes withdraws the relayer's quotes matching the request.
+func (c *clientImpl) DeleteQuotes(q *model.DeleteQuotesRequest) (*model.DeleteQuotesResponse, error) {
+	var response model.DeleteQuotesResponse
+	resp, err := c.rClient.R().
+		SetQueryParams(quoteRouteParams(q.OriginChainID, q.OriginTokenAddr, q.DestChainID, q.DestTokenAddr)).
+		SetResult(&response).
+		Delete(rest.QuoteRoute)
+
+	if err != nil {
+		return nil, fmt.Errorf("error from server: %s: %w", resp.Status(), err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("error from server: %s", resp.Status())
+	}
+
+	return &response, nil
+}
+
+// GetQuoteHistory gets a page of quote history, newest first.
+func (c *unauthenticatedClient) GetQuoteHistory(q *model.GetQuoteHistoryRequest) ([]*model.GetQuoteHistoryResponse, error) {
+	params := quoteRouteParams(q.OriginChainID, q.OriginTokenAddr, q.DestChainID, q.DestTokenAddr)
+	if q.RelayerAddr != "" {
+		params["relayerAddr"] = q.RelayerAddr
+	}
+	if q.From != 0 {
+		params["from"] = strconv.FormatInt(q.From, 10)
+	}
+	if q.To != 0 {
+		params["to"] = strconv.FormatInt(q.To, 10)
+	}
+	if q.Page != 0 {
+		params["page"] = strconv.Itoa(q.Page)
+	}
+	if q.PageSize != 0 {
+		params["pageSize"] = strconv.Itoa(q.PageSize)
+	}
+
+	var history []*model.GetQuoteHistoryResponse
+	resp, err := c.rClient.R().
+		SetQueryParams(params).
+		SetResult(&history).
+		Get(rest.QuoteHistoryRoute)
+
+	if err != nil {
+		return nil, fmt.Errorf("error from server: %s: %w", resp.Status(), err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("error from server: %s", resp.Status())
+	}
+
+	return history, nil
+}
+
+// quoteRouteParams creates query params for the set fields of a route.
+func quoteRouteParams(originChainID int, originTokenAddr string, destChainID int, destTokenAddr string) map[string]string {
+	params := map[string]string{}
+	if originChainID != 0 {
+		params["originChainId"] = strconv.Itoa(originChainID)
+	}
+	if originTokenAddr != "" {
+		params["originTokenAddr"] = originTokenAddr
+	}
+	if destChainID != 0 {
+		params["destChainId"] = strconv.Itoa(destChainID)
+	}
+	if destTokenAddr != "" {
+		params["destTokenAddr"] = destTokenAddr
+	}
+	return params
+}
+
+// PutRFQRequest requests an active quote from connected relayers and returns the best response.
+func (c *unauthenticatedClient) PutRFQRequest(q *model.PutRFQRequest) (*model.PutRFQResponse, error) {
+	var response model.PutRFQResponse
+	resp, err := c.rClient.R().
+		SetBody(q).
+		SetResult(&response).
+		Post(rest.RFQRoute)
+
+	if err != nil {
+		return nil, fmt.Errorf("error from server: %s: %w", resp.Status(), err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("error from server: %s", resp.Status())
+	}
+
+	return &response, nil
+}
+
+// SubscribeQuotes streams quote upserts and deletions from the server sent event stream.
+// The returned channel is closed when the context is canceled or the stream ends.
+func (c *unauthenticatedClient) SubscribeQuotes(ctx context.Context, q *model.GetQuoteSpecificRequest) (<-chan *model.GetQuoteResponse, error) {
+	resp, err := c.rClient.R().
+		SetContext(ctx).
+		SetQueryParams(quoteRouteParams(q.OriginChainID, q.OriginTokenAddr, q.DestChainID, q.DestTokenAddr)).
+		SetHeader("Accept", "text/event-stream").
+		SetDoNotParseResponse(true).
+		Get(rest.QuoteStreamRoute)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to quote stream: %w", err)
+	}
+
+	if resp.IsError() {
+		_ = resp.RawBody().Close()
+		return nil, fmt.Errorf("error from server: %s", resp.Status())
+	}
+
+	quotes := make(chan *model.GetQuoteResponse)
+	go func() {
+		defer close(quotes)
+		defer func() {
+			_ = resp.RawBody().Close()
+		}()
+
+		var event string
+		scanner := bufio.NewScanner(resp.RawBody())
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case strings.HasPrefix(line, "event:"):
+				event = strings.TrimPrefix(line, "event:")
+			case strings.HasPrefix(line, "data:") && (event == "quote" || event == "delete"):
+				var quote model.GetQuoteResponse
+				unmarshalErr := json.Unmarshal([]byte(strings.TrimPrefix(line, "data:")), &quote)
+				if unmarshalErr != nil {
+					continue
+				}
+				quote.Deleted = event == "delete"
+				select {
+				case quotes <- &quote:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return quotes, nil
+}
+
+// SubscribeActiveRFQ connects to the rfq stream and signs a response for every request respond quotes.
+func (c *clientImpl) SubscribeActiveRFQ(ctx context.Context, respond ActiveRFQHandler) error {
+	header, err := authHeader(ctx, c.reqSigner)
+	if err != nil {
+		return err
+	}
+
+	// http -> ws, https -> wss
+	wsURL := "ws" + strings.TrimPrefix(strings.TrimSuffix(c.rfqURL, "/"), "http") + rest.RFQStreamRoute
+	conn, dialResp, err := websocket.DefaultDialer.DialContext(ctx, wsURL, http.Header{"Authorization": []string{header}})
+	if dialResp != nil && dialResp.Body != nil {
+		_ = dialResp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("could not connect to rfq stream: %w", err)
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// close the connection once we're done so the blocking read below returns
+	go func() {
+		<-streamCtx.Done()
+		_ = conn.Close()
+	}()
+
+	for {
+		var req model.ActiveRFQRequest
+		err = conn.ReadJSON(&req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("could not read rfq request: %w", err)
+		}
+
+		destAmount, ok := respond(streamCtx, &req)
+		// a missing amount is treated as declining the request
+		if !ok || destAmount == nil {
+			continue
+		}
+
+		var signature string
+		signature, err = signEIP191(streamCtx, c.reqSigner, model.ActiveRFQResponseMessage(req.RequestID, destAmount.String()))
+		if err != nil {
+			return err
+		}
+
+		err = conn.WriteJSON(model.ActiveRFQResponse{
+			RequestID:  req.RequestID,
+			DestAmount: destAmount.String(),
+			Signature:  signature,
+		})
+		if err != nil {
+			return fmt.Errorf("could not send rfq response: %w", err)
+		}
+	}
+}
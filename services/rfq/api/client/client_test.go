@@ -1,6 +1,10 @@
 package client_test
 
 import (
+	"context"
+	"math/big"
+	"time"
+
 	"github.com/synapsecns/sanguine/services/rfq/api/model"
 )
 
@@ -107,3 +111,106 @@ func (c *ClientSuite) TestGetQuoteByRelayerAddress() {
 	}
 	c.Equal(expectedResp, *quotes[0])
 }
+
+func (c *ClientSuite) TestActiveRFQ() {
+	ctx, cancel := context.WithCancel(c.GetTestContext())
+	defer cancel()
+
+	// Quote every request 1:1
+	go func() {
+		_ = c.client.SubscribeActiveRFQ(ctx, func(ctx context.Context, req *model.ActiveRFQRequest) (*big.Int, bool) {
+			destAmount, ok := new(big.Int).SetString(req.OriginAmount, 10)
+			return destAmount, ok
+		})
+	}()
+
+	// The relayer registers asynchronously, so retry until it responds.
+	var resp *model.PutRFQResponse
+	c.Eventually(func() bool {
+		var err error
+		resp, err = c.client.PutRFQRequest(&model.PutRFQRequest{
+			OriginChainID:    1,
+			OriginTokenAddr:  "0xOriginTokenAddr",
+			DestChainID:      42161,
+			DestTokenAddr:    "0xDestTokenAddr",
+			OriginAmount:     "100",
+			ExpirationWindow: 500,
+		})
+		c.Require().NoError(err)
+		return resp.Success
+	})
+
+	c.Equal("100", resp.DestAmount)
+	c.Equal(c.testWallet.Address().Hex(), resp.RelayerAddr)
+}
+
+func (c *ClientSuite) TestSubscribeQuotes() {
+	ctx, cancel := context.WithCancel(c.GetTestContext())
+	defer cancel()
+
+	quotes, err := c.client.SubscribeQuotes(ctx, &model.GetQuoteSpecificRequest{DestChainID: 42161})
+	c.Require().NoError(err)
+
+	err = c.client.PutQuote(&model.PutQuoteRequest{
+		OriginChainID:   1,
+		OriginTokenAddr: "0xOriginTokenAddr",
+		DestChainID:     42161,
+		DestTokenAddr:   "0xDestTokenAddr",
+		DestAmount:      "100",
+		MaxOriginAmount: "200",
+		FixedFee:        "10",
+	})
+	c.Require().NoError(err)
+
+	select {
+	case quote := <-quotes:
+		c.Equal(42161, quote.DestChainID)
+		c.Equal("100", quote.DestAmount)
+	case <-time.After(10 * time.Second):
+		c.Fail("timed out waiting for quote")
+	}
+}
+
+func (c *ClientSuite) TestDeleteQuotesAndHistory() {
+	req := model.PutQuoteRequest{
+		OriginChainID:   1,
+		OriginTokenAddr: "0xOriginTokenAddr",
+		DestChainID:     42161,
+		DestTokenAddr:   "0xDestTokenAddr",
+		DestAmount:      "100",
+		MaxOriginAmount: "200",
+		FixedFee:        "10",
+	}
+	err := c.client.PutQuote(&req)
+	c.Require().NoError(err)
+
+	req.FixedFee = "20"
+	err = c.client.PutQuote(&req)
+	c.Require().NoError(err)
+
+	resp, err := c.client.DeleteQuotes(&model.DeleteQuotesRequest{DestChainID: 42161})
+	c.Require().NoError(err)
+	c.Equal(int64(1), resp.Deleted)
+
+	quotes, err := c.client.GetQuoteByRelayerAddress(c.testWallet.Address().String())
+	c.Require().NoError(err)
+	c.Empty(quotes)
+
+	// Both puts are kept in the history, newest first
+	history, err := c.client.GetQuoteHistory(&model.GetQuoteHistoryRequest{
+		RelayerAddr: c.testWallet.Address().String(),
+		PageSize:    1,
+	})
+	c.Require().NoError(err)
+	c.Require().Len(history, 1)
+	c.Equal("20", history[0].FixedFee)
+
+	history, err = c.client.GetQuoteHistory(&model.GetQuoteHistoryRequest{
+		RelayerAddr: c.testWallet.Address().String(),
+		Page:        2,
+		PageSize:    1,
+	})
+	c.Require().NoError(err)
+	c.Require().Len(history, 1)
+	c.Equal("10", history[0].FixedFee)
+}
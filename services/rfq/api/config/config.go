@@ -5,6 +5,7 @@
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/jftuga/ellipsis"
 	"gopkg.in/yaml.v2"
@@ -23,6 +24,27 @@ type Config struct {
 	// bridges is a map of chainid->address
 	Bridges map[uint32]string `yaml:"bridges"`
 	Port    string            `yaml:"port"`
+	// QuoteTTLSeconds is how long a quote is served after it was last put, quotes never expire if unset
+	QuoteTTLSeconds int `yaml:"quote_ttl_seconds"`
+	// AuthExpirySeconds is how long a relayer's eip-191 authorization is accepted after it was signed.
+	AuthExpirySeconds int `yaml:"auth_expiry_seconds"`
+}
+
+// GetQuoteTTL returns how long a quote is served after it was last put.
+// Zero means quotes never expire.
+func (c Config) GetQuoteTTL() time.Duration {
+	return time.Duration(c.QuoteTTLSeconds) * time.Second
+}
+
+// DefaultAuthExpiry is how long an authorization is accepted if no expiry is configured.
+const DefaultAuthExpiry = 1000 * time.Second
+
+// GetAuthExpiry returns how long a relayer's eip-191 authorization is accepted after it was signed.
+func (c Config) GetAuthExpiry() time.Duration {
+	if c.AuthExpirySeconds <= 0 {
+		return DefaultAuthExpiry
+	}
+	return time.Duration(c.AuthExpirySeconds) * time.Second
 }
 
 // LoadConfig loads the config from the given path.
@@ -34,6 +34,74 @@ type Quote struct {
 	UpdatedAt time.Time
 }
 
+// QuoteHistory is the database model for a quote as it was put by a relayer.
+// A row is recorded for every accepted quote so that what was quoted at a given time can be looked up later.
+type QuoteHistory struct {
+	// ID is the auto incrementing id of the history entry
+	ID uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+	// OriginChainID is the chain which the relayer is willing to relay from
+	OriginChainID uint64 `gorm:"column:origin_chain_id;index"`
+	// OriginTokenAddr is the token address for which the relayer willing to relay from
+	OriginTokenAddr string `gorm:"column:origin_token;index"`
+	// DestChainID is the chain which the relayer is willing to relay to
+	DestChainID uint64 `gorm:"column:dest_chain_id;index"`
+	// DestToken is the token address for which the relayer willing to relay to
+	DestTokenAddr string `gorm:"column:dest_token;index"`
+	// DestAmount is the max amount of liquidity which exists for a given destination token, provided in the destination token decimals
+	DestAmount decimal.Decimal `gorm:"column:dest_amount"`
+	// MaxOriginAmount is the maximum amount of origin tokens bridgeable
+	MaxOriginAmount decimal.Decimal `gorm:"column:max_origin_amount"`
+	// FixedFee is the fixed fee for the quote, provided in the destination token terms
+	FixedFee decimal.Decimal `gorm:"column:fixed_fee"`
+	// Address of the relayer providing the quote
+	RelayerAddr string `gorm:"column:relayer_address;index"`
+	// OriginFastBridgeAddress is the address of the fast bridge contract on the origin chain
+	OriginFastBridgeAddress string `gorm:"column:origin_fast_bridge_address"`
+	// DestFastBridgeAddress is the address of the fast bridge contract on the destination chain
+	DestFastBridgeAddress string `gorm:"column:dest_fast_bridge_address"`
+	// CreatedAt is the time that the quote was put
+	CreatedAt time.Time `gorm:"index"`
+}
+
+// TableName sets the quote history table name.
+func (QuoteHistory) TableName() string {
+	return "quote_history"
+}
+
+// QuoteHistoryFromQuote creates a history entry for a quote.
+func QuoteHistoryFromQuote(quote *Quote) *QuoteHistory {
+	return &QuoteHistory{
+		OriginChainID:           quote.OriginChainID,
+		OriginTokenAddr:         quote.OriginTokenAddr,
+		DestChainID:             quote.DestChainID,
+		DestTokenAddr:           quote.DestTokenAddr,
+		DestAmount:              quote.DestAmount,
+		MaxOriginAmount:         quote.MaxOriginAmount,
+		FixedFee:                quote.FixedFee,
+		RelayerAddr:             quote.RelayerAddr,
+		OriginFastBridgeAddress: quote.OriginFastBridgeAddress,
+		DestFastBridgeAddress:   quote.DestFastBridgeAddress,
+	}
+}
+
+// QuoteFilter filters quotes by route and relayer. Zero values match anything.
+type QuoteFilter struct {
+	OriginChainID   uint64
+	OriginTokenAddr string
+	DestChainID     uint64
+	DestTokenAddr   string
+	RelayerAddr     string
+}
+
+// QuoteHistoryFilter filters quote history.
+type QuoteHistoryFilter struct {
+	QuoteFilter
+	// From is the earliest time to include, ignored if zero
+	From time.Time
+	// To is the latest time to include, ignored if zero
+	To time.Time
+}
+
 // APIDBReader is the interface for reading from the database.
 type APIDBReader interface {
 	// GetQuotesByDestChainAndToken gets quotes from the database by destination chain and token.
@@ -44,12 +112,21 @@ type APIDBReader interface {
 	GetQuotesByRelayerAddress(ctx context.Context, relayerAddress string) ([]*Quote, error)
 	// GetAllQuotes retrieves all quotes from the database.
 	GetAllQuotes(ctx context.Context) ([]*Quote, error)
+	// GetQuotes gets the quotes matching the filter that were updated after updatedAfter, ignored if zero.
+	GetQuotes(ctx context.Context, filter QuoteFilter, updatedAfter time.Time) ([]*Quote, error)
+	// GetQuoteHistory gets a page of quote history, newest first. Pages start at 1.
+	GetQuoteHistory(ctx context.Context, filter QuoteHistoryFilter, page, pageSize int) ([]*QuoteHistory, error)
 }
 
 // APIDBWriter is the interface for writing to the database.
 type APIDBWriter interface {
-	// UpsertQuote upserts a quote in the database.
+	// UpsertQuote upserts a quote in the database and records it in the quote history.
 	UpsertQuote(ctx context.Context, quote *Quote) error
+	// DeleteQuotes deletes the quotes matching the filter and returns the deleted quotes.
+	// The filter must specify a relayer.
+	DeleteQuotes(ctx context.Context, filter QuoteFilter) ([]*Quote, error)
+	// DeleteExpiredQuotes deletes the quotes last updated before updatedBefore and returns the deleted quotes.
+	DeleteExpiredQuotes(ctx context.Context, updatedBefore time.Time) ([]*Quote, error)
 }
 
 // APIDB is the interface for the database service.
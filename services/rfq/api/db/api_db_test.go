@@ -1,6 +1,8 @@
 package db_test
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/synapsecns/sanguine/services/rfq/api/db"
 )
@@ -77,3 +79,167 @@ func (d *DBSuite) TestUpsertQuote() {
 		// Assert other fields if necessary
 	})
 }
+
+func (d *DBSuite) TestDeleteQuotes() {
+	d.RunOnAllDBs(func(testDB db.APIDB) {
+		relayer := "0x3f5CE5FBFe3E9af3971dD833D26bA9b5C936f0bE"
+		otherRelayer := "0x1111111111111111111111111111111111111111"
+		for _, quote := range []*db.Quote{
+			{OriginChainID: 1, OriginTokenAddr: "0xA", DestChainID: 10, DestTokenAddr: "0xB", RelayerAddr: relayer},
+			{OriginChainID: 1, OriginTokenAddr: "0xA", DestChainID: 42161, DestTokenAddr: "0xC", RelayerAddr: relayer},
+			{OriginChainID: 1, OriginTokenAddr: "0xA", DestChainID: 10, DestTokenAddr: "0xB", RelayerAddr: otherRelayer},
+		} {
+			err := testDB.UpsertQuote(d.GetTestContext(), quote)
+			d.Require().NoError(err)
+		}
+
+		// A relayer is required
+		_, err := testDB.DeleteQuotes(d.GetTestContext(), db.QuoteFilter{DestChainID: 10})
+		d.Require().Error(err)
+
+		// Only the relayer's quote on the route is deleted
+		deleted, err := testDB.DeleteQuotes(d.GetTestContext(), db.QuoteFilter{DestChainID: 10, RelayerAddr: relayer})
+		d.Require().NoError(err)
+		d.Require().Len(deleted, 1)
+		d.Equal(uint64(10), deleted[0].DestChainID)
+
+		quotes, err := testDB.GetQuotesByDestChainAndToken(d.GetTestContext(), 10, "0xB")
+		d.Require().NoError(err)
+		d.Require().Len(quotes, 1)
+		d.Equal(otherRelayer, quotes[0].RelayerAddr)
+
+		// Deleting without a route withdraws all of the relayer's quotes
+		deleted, err = testDB.DeleteQuotes(d.GetTestContext(), db.QuoteFilter{RelayerAddr: relayer})
+		d.Require().NoError(err)
+		d.Len(deleted, 1)
+
+		quotes, err = testDB.GetQuotesByRelayerAddress(d.GetTestContext(), relayer)
+		d.Require().NoError(err)
+		d.Empty(quotes)
+	})
+}
+
+func (d *DBSuite) TestDeleteExpiredQuotes() {
+	d.RunOnAllDBs(func(testDB db.APIDB) {
+		relayer := "0x3f5CE5FBFe3E9af3971dD833D26bA9b5C936f0bE"
+		for _, quote := range []*db.Quote{
+			{OriginChainID: 1, OriginTokenAddr: "0xA", DestChainID: 10, DestTokenAddr: "0xB", RelayerAddr: relayer, UpdatedAt: time.Now().Add(-time.Hour)},
+			{OriginChainID: 1, OriginTokenAddr: "0xA", DestChainID: 10, DestTokenAddr: "0xC", RelayerAddr: relayer},
+		} {
+			err := testDB.UpsertQuote(d.GetTestContext(), quote)
+			d.Require().NoError(err)
+		}
+
+		deleted, err := testDB.DeleteExpiredQuotes(d.GetTestContext(), time.Now().Add(-time.Minute))
+		d.Require().NoError(err)
+		d.Require().Len(deleted, 1)
+		d.Equal("0xB", deleted[0].DestTokenAddr)
+
+		quotes, err := testDB.GetQuotesByRelayerAddress(d.GetTestContext(), relayer)
+		d.Require().NoError(err)
+		d.Require().Len(quotes, 1)
+		d.Equal("0xC", quotes[0].DestTokenAddr)
+	})
+}
+
+func (d *DBSuite) TestQuoteHistory() {
+	d.RunOnAllDBs(func(testDB db.APIDB) {
+		relayer := "0x3f5CE5FBFe3E9af3971dD833D26bA9b5C936f0bE"
+		quote := &db.Quote{
+			OriginChainID:   1,
+			OriginTokenAddr: "0xA",
+			DestChainID:     10,
+			DestTokenAddr:   "0xB",
+			RelayerAddr:     relayer,
+		}
+
+		// Every upsert of the same quote is recorded
+		for i := int64(1); i <= 5; i++ {
+			quote.FixedFee = decimal.NewFromInt(i)
+			err := testDB.UpsertQuote(d.GetTestContext(), quote)
+			d.Require().NoError(err)
+		}
+		err := testDB.UpsertQuote(d.GetTestContext(), &db.Quote{OriginChainID: 1, OriginTokenAddr: "0xA", DestChainID: 42161, DestTokenAddr: "0xC", RelayerAddr: relayer})
+		d.Require().NoError(err)
+
+		filter := db.QuoteHistoryFilter{QuoteFilter: db.QuoteFilter{DestChainID: 10}}
+		history, err := testDB.GetQuoteHistory(d.GetTestContext(), filter, 1, 2)
+		d.Require().NoError(err)
+		d.Require().Len(history, 2)
+		// newest first
+		d.True(decimal.NewFromInt(5).Equal(history[0].FixedFee))
+		d.True(decimal.NewFromInt(4).Equal(history[1].FixedFee))
+
+		history, err = testDB.GetQuoteHistory(d.GetTestContext(), filter, 3, 2)
+		d.Require().NoError(err)
+		d.Require().Len(history, 1)
+		d.True(decimal.NewFromInt(1).Equal(history[0].FixedFee))
+
+		history, err = testDB.GetQuoteHistory(d.GetTestContext(), db.QuoteHistoryFilter{QuoteFilter: db.QuoteFilter{RelayerAddr: relayer}}, 1, 10)
+		d.Require().NoError(err)
+		d.Len(history, 6)
+
+		// time range
+		history, err = testDB.GetQuoteHistory(d.GetTestContext(), db.QuoteHistoryFilter{From: time.Now().Add(time.Hour)}, 1, 10)
+		d.Require().NoError(err)
+		d.Empty(history)
+
+		_, err = testDB.GetQuoteHistory(d.GetTestContext(), filter, 0, 10)
+		d.Require().Error(err)
+	})
+}
+
+func (d *DBSuite) TestQuoteAddressCase() {
+	d.RunOnAllDBs(func(testDB db.APIDB) {
+		relayer := "0x3f5CE5FBFe3E9af3971dD833D26bA9b5C936f0bE"
+		token := "0x1111111111111111111111111111111111111111"
+		checksummedToken := "0xaBcdEF1111111111111111111111111111111111"
+
+		// A quote stored with lowercase addresses
+		err := testDB.UpsertQuote(d.GetTestContext(), &db.Quote{OriginChainID: 1, OriginTokenAddr: token, DestChainID: 10, DestTokenAddr: "0xabcdef1111111111111111111111111111111111", RelayerAddr: "0x3f5ce5fbfe3e9af3971dd833d26ba9b5c936f0be", FixedFee: decimal.NewFromInt(1)})
+		d.Require().NoError(err)
+
+		// is found by checksummed addresses
+		quotes, err := testDB.GetQuotesByOriginAndDestination(d.GetTestContext(), 1, token, 10, checksummedToken)
+		d.Require().NoError(err)
+		d.Require().Len(quotes, 1)
+		quotes, err = testDB.GetQuotesByRelayerAddress(d.GetTestContext(), relayer)
+		d.Require().NoError(err)
+		d.Require().Len(quotes, 1)
+		quotes, err = testDB.GetQuotes(d.GetTestContext(), db.QuoteFilter{DestTokenAddr: checksummedToken, RelayerAddr: relayer}, time.Time{})
+		d.Require().NoError(err)
+		d.Require().Len(quotes, 1)
+
+		// and is replaced when it is put again checksummed
+		err = testDB.UpsertQuote(d.GetTestContext(), &db.Quote{OriginChainID: 1, OriginTokenAddr: token, DestChainID: 10, DestTokenAddr: checksummedToken, RelayerAddr: relayer, FixedFee: decimal.NewFromInt(2)})
+		d.Require().NoError(err)
+		quotes, err = testDB.GetQuotesByRelayerAddress(d.GetTestContext(), relayer)
+		d.Require().NoError(err)
+		d.Require().Len(quotes, 1)
+		d.True(decimal.NewFromInt(2).Equal(quotes[0].FixedFee))
+	})
+}
+
+func (d *DBSuite) TestGetQuotes() {
+	d.RunOnAllDBs(func(testDB db.APIDB) {
+		relayer := "0x3f5CE5FBFe3E9af3971dD833D26bA9b5C936f0bE"
+		for _, quote := range []*db.Quote{
+			{OriginChainID: 1, OriginTokenAddr: "0xA", DestChainID: 10, DestTokenAddr: "0xB", RelayerAddr: relayer, UpdatedAt: time.Now().Add(-time.Hour)},
+			{OriginChainID: 1, OriginTokenAddr: "0xA", DestChainID: 10, DestTokenAddr: "0xC", RelayerAddr: relayer},
+			{OriginChainID: 1, OriginTokenAddr: "0xA", DestChainID: 42161, DestTokenAddr: "0xC", RelayerAddr: relayer},
+		} {
+			err := testDB.UpsertQuote(d.GetTestContext(), quote)
+			d.Require().NoError(err)
+		}
+
+		quotes, err := testDB.GetQuotes(d.GetTestContext(), db.QuoteFilter{DestChainID: 10}, time.Time{})
+		d.Require().NoError(err)
+		d.Len(quotes, 2)
+
+		// quotes not updated since updatedAfter are left out
+		quotes, err = testDB.GetQuotes(d.GetTestContext(), db.QuoteFilter{DestChainID: 10}, time.Now().Add(-time.Minute))
+		d.Require().NoError(err)
+		d.Require().Len(quotes, 1)
+		d.Equal("0xC", quotes[0].DestTokenAddr)
+	})
+}
@@ -2,7 +2,11 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
+
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
 	"github.com/synapsecns/sanguine/services/rfq/api/db"
@@ -12,7 +16,7 @@
 func (s *Store) GetQuotesByDestChainAndToken(ctx context.Context, destChainID uint64, destTokenAddr string) ([]*db.Quote, error) {
 	var quotes []*db.Quote
 
-	result := s.db.WithContext(ctx).Where("dest_chain_id = ? AND dest_token = ?", destChainID, destTokenAddr).Find(&quotes)
+	result := s.db.WithContext(ctx).Where("dest_chain_id = ? AND lower(dest_token) = lower(?)", destChainID, destTokenAddr).Find(&quotes)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -23,7 +27,7 @@ func (s *Store) GetQuotesByDestChainAndToken(ctx context.Context, destChainID ui
 func (s *Store) GetQuotesByOriginAndDestination(ctx context.Context, originChainID uint64, originTokenAddr string, destChainID uint64, destTokenAddr string) ([]*db.Quote, error) {
 	var quotes []*db.Quote
 
-	result := s.db.WithContext(ctx).Where("origin_chain_id = ? AND origin_token = ? AND dest_chain_id = ? AND dest_token = ?", originChainID, originTokenAddr, destChainID, destTokenAddr).Find(&quotes)
+	result := s.db.WithContext(ctx).Where("origin_chain_id = ? AND lower(origin_token) = lower(?) AND dest_chain_id = ? AND lower(dest_token) = lower(?)", originChainID, originTokenAddr, destChainID, destTokenAddr).Find(&quotes)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -34,7 +38,7 @@ func (s *Store) GetQuotesByOriginAndDestination(ctx context.Context, originChain
 func (s *Store) GetQuotesByRelayerAddress(ctx context.Context, relayerAddr string) ([]*db.Quote, error) {
 	var quotes []*db.Quote
 
-	result := s.db.WithContext(ctx).Where("relayer_address = ?", relayerAddr).Find(&quotes)
+	result := s.db.WithContext(ctx).Where("lower(relayer_address) = lower(?)", relayerAddr).Find(&quotes)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -51,15 +55,138 @@ func (s *Store) GetAllQuotes(ctx context.Context) ([]*db.Quote, error) {
 	return quotes, nil
 }
 
+// GetQuotes gets the quotes matching the filter that were updated after updatedAfter, ignored if zero.
+func (s *Store) GetQuotes(ctx context.Context, filter db.QuoteFilter, updatedAfter time.Time) ([]*db.Quote, error) {
+	query := applyQuoteFilter(s.DB().WithContext(ctx), filter)
+	if !updatedAfter.IsZero() {
+		query = query.Where("updated_at > ?", updatedAfter)
+	}
+
+	var quotes []*db.Quote
+	result := query.Find(&quotes)
+	if result.Error != nil {
+		return nil, fmt.Errorf("could not get quotes: %w", result.Error)
+	}
+	return quotes, nil
+}
+
 // UpsertQuote inserts a new quote into the database or updates an existing one.
+// The quote is recorded in the quote history in the same transaction.
 func (s *Store) UpsertQuote(ctx context.Context, quote *db.Quote) error {
-	dbTx := s.DB().WithContext(ctx).
-		Clauses(clause.OnConflict{
+	err := s.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// addresses used to be stored in whatever case they were put in, so the quote may exist in another case.
+		dbTx := applyQuoteFilter(tx, db.QuoteFilter{
+			OriginChainID:   quote.OriginChainID,
+			OriginTokenAddr: quote.OriginTokenAddr,
+			DestChainID:     quote.DestChainID,
+			DestTokenAddr:   quote.DestTokenAddr,
+			RelayerAddr:     quote.RelayerAddr,
+		}).Where("NOT (origin_token = ? AND dest_token = ? AND relayer_address = ?)", quote.OriginTokenAddr, quote.DestTokenAddr, quote.RelayerAddr).Delete(&db.Quote{})
+		if dbTx.Error != nil {
+			return fmt.Errorf("could not replace quote: %w", dbTx.Error)
+		}
+
+		dbTx = tx.Clauses(clause.OnConflict{
 			UpdateAll: true,
 		}).Create(quote)
+		if dbTx.Error != nil {
+			return fmt.Errorf("could not update quote: %w", dbTx.Error)
+		}
 
-	if dbTx.Error != nil {
-		return fmt.Errorf("could not update quote: %w", dbTx.Error)
+		dbTx = tx.Create(db.QuoteHistoryFromQuote(quote))
+		if dbTx.Error != nil {
+			return fmt.Errorf("could not store quote history: %w", dbTx.Error)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not upsert quote: %w", err)
 	}
 	return nil
 }
+
+// DeleteQuotes deletes the quotes matching the filter and returns the deleted quotes.
+func (s *Store) DeleteQuotes(ctx context.Context, filter db.QuoteFilter) ([]*db.Quote, error) {
+	if filter.RelayerAddr == "" {
+		return nil, errors.New("relayer address is required to delete quotes")
+	}
+
+	return s.deleteQuotes(ctx, func(query *gorm.DB) *gorm.DB {
+		return applyQuoteFilter(query, filter)
+	})
+}
+
+// DeleteExpiredQuotes deletes the quotes last updated before updatedBefore and returns the deleted quotes.
+func (s *Store) DeleteExpiredQuotes(ctx context.Context, updatedBefore time.Time) ([]*db.Quote, error) {
+	return s.deleteQuotes(ctx, func(query *gorm.DB) *gorm.DB {
+		return query.Where("updated_at < ?", updatedBefore)
+	})
+}
+
+// deleteQuotes deletes the quotes matched by where and returns them.
+func (s *Store) deleteQuotes(ctx context.Context, where func(query *gorm.DB) *gorm.DB) (deleted []*db.Quote, err error) {
+	err = s.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		dbTx := where(tx).Find(&deleted)
+		if dbTx.Error != nil {
+			return fmt.Errorf("could not get quotes: %w", dbTx.Error)
+		}
+		if len(deleted) == 0 {
+			return nil
+		}
+
+		dbTx = where(tx).Delete(&db.Quote{})
+		if dbTx.Error != nil {
+			return fmt.Errorf("could not delete quotes: %w", dbTx.Error)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not delete quotes: %w", err)
+	}
+	return deleted, nil
+}
+
+// GetQuoteHistory gets a page of quote history, newest first.
+func (s *Store) GetQuoteHistory(ctx context.Context, filter db.QuoteHistoryFilter, page, pageSize int) ([]*db.QuoteHistory, error) {
+	if page < 1 {
+		return nil, fmt.Errorf("page must be at least 1, got %d", page)
+	}
+	if pageSize < 1 {
+		return nil, fmt.Errorf("page size must be at least 1, got %d", pageSize)
+	}
+
+	query := applyQuoteFilter(s.DB().WithContext(ctx), filter.QuoteFilter)
+	if !filter.From.IsZero() {
+		query = query.Where("created_at >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("created_at <= ?", filter.To)
+	}
+
+	var history []*db.QuoteHistory
+	result := query.Order("id desc").Offset((page - 1) * pageSize).Limit(pageSize).Find(&history)
+	if result.Error != nil {
+		return nil, fmt.Errorf("could not get quote history: %w", result.Error)
+	}
+	return history, nil
+}
+
+// applyQuoteFilter adds a where clause for every set field of the filter. Addresses are compared regardless of case.
+func applyQuoteFilter(query *gorm.DB, filter db.QuoteFilter) *gorm.DB {
+	if filter.OriginChainID != 0 {
+		query = query.Where("origin_chain_id = ?", filter.OriginChainID)
+	}
+	if filter.OriginTokenAddr != "" {
+		query = query.Where("lower(origin_token) = lower(?)", filter.OriginTokenAddr)
+	}
+	if filter.DestChainID != 0 {
+		query = query.Where("dest_chain_id = ?", filter.DestChainID)
+	}
+	if filter.DestTokenAddr != "" {
+		query = query.Where("lower(dest_token) = lower(?)", filter.DestTokenAddr)
+	}
+	if filter.RelayerAddr != "" {
+		query = query.Where("lower(relayer_address) = lower(?)", filter.RelayerAddr)
+	}
+	return query
+}
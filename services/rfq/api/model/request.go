@@ -13,6 +13,30 @@ type PutQuoteRequest struct {
 	DestFastBridgeAddress   string `json:"dest_fast_bridge_address"`
 }
 
+// DeleteQuotesRequest contains the schema for a DELETE /quotes request.
+// Empty fields match any value, so an empty request withdraws all of the relayer's quotes.
+type DeleteQuotesRequest struct {
+	OriginChainID   int    `json:"originChainId"`
+	OriginTokenAddr string `json:"originTokenAddr"`
+	DestChainID     int    `json:"destChainId"`
+	DestTokenAddr   string `json:"destTokenAddr"`
+}
+
+// GetQuoteHistoryRequest contains the schema for a GET /quotes/history request.
+type GetQuoteHistoryRequest struct {
+	OriginChainID   int    `json:"originChainId"`
+	OriginTokenAddr string `json:"originTokenAddr"`
+	DestChainID     int    `json:"destChainId"`
+	DestTokenAddr   string `json:"destTokenAddr"`
+	RelayerAddr     string `json:"relayerAddr"`
+	// From is the earliest unix timestamp to include
+	From int64 `json:"from"`
+	// To is the latest unix timestamp to include
+	To       int64 `json:"to"`
+	Page     int   `json:"page"`
+	PageSize int   `json:"pageSize"`
+}
+
 // GetQuoteSpecificRequest contains the schema for a GET /quote request with specific params.
 type GetQuoteSpecificRequest struct {
 	OriginChainID   int    `json:"originChainId"`
@@ -20,3 +44,24 @@ type GetQuoteSpecificRequest struct {
 	DestChainID     int    `json:"destChainId"`
 	DestTokenAddr   string `json:"destTokenAddr"`
 }
+
+// PutRFQRequest contains the schema for a POST /rfq request.
+type PutRFQRequest struct {
+	OriginChainID   int    `json:"origin_chain_id"`
+	OriginTokenAddr string `json:"origin_token_addr"`
+	DestChainID     int    `json:"dest_chain_id"`
+	DestTokenAddr   string `json:"dest_token_addr"`
+	OriginAmount    string `json:"origin_amount"`
+	// ExpirationWindow is how long to collect relayer responses for, in milliseconds
+	ExpirationWindow int64 `json:"expiration_window"`
+}
+
+// ActiveRFQResponse is a relayer's response to an ActiveRFQRequest, sent over the rfq stream.
+type ActiveRFQResponse struct {
+	// RequestID is the id of the request being quoted
+	RequestID string `json:"request_id"`
+	// DestAmount is the amount the relayer will deliver on the destination, provided in the destination token decimals
+	DestAmount string `json:"dest_amount"`
+	// Signature is the hex encoded eip-191 signature of ActiveRFQResponseMessage by the relayer
+	Signature string `json:"signature"`
+}
@@ -24,4 +24,72 @@ type GetQuoteResponse struct {
 	DestFastBridgeAddress string `json:"dest_fast_bridge_address"`
 	// UpdatedAt is the time that the quote was last upserted
 	UpdatedAt string `json:"updated_at"`
+	// Deleted is true if the quote was withdrawn or expired. This is only set on streamed quotes.
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+// DeleteQuotesResponse contains the schema for a DELETE /quotes response.
+type DeleteQuotesResponse struct {
+	// Deleted is the number of quotes withdrawn
+	Deleted int64 `json:"deleted"`
+}
+
+// GetQuoteHistoryResponse contains the schema for an entry of a GET /quotes/history response.
+type GetQuoteHistoryResponse struct {
+	// ID is the id of the history entry
+	ID uint64 `json:"id"`
+	// OriginChainID is the chain which the relayer is willing to relay from
+	OriginChainID int `json:"origin_chain_id"`
+	// OriginTokenAddr is the token address for which the relayer willing to relay from
+	OriginTokenAddr string `json:"origin_token_addr"`
+	// DestChainID is the chain which the relayer is willing to relay to
+	DestChainID int `json:"dest_chain_id"`
+	// DestToken is the token address for which the relayer willing to relay to
+	DestTokenAddr string `json:"dest_token_addr"`
+	// DestAmount is the max amount of liquidity which exists for a given destination token, provided in the destination token decimals
+	DestAmount string `json:"dest_amount"`
+	// MaxOriginAmount is the maximum amount of origin tokens bridgeable
+	MaxOriginAmount string `json:"max_origin_amount"`
+	// FixedFee is the fixed fee for the quote, provided in the destination token terms
+	FixedFee string `json:"fixed_fee"`
+	// Address of the relayer providing the quote
+	RelayerAddr string `json:"relayer_addr"`
+	// OriginFastBridgeAddress is the address of the fast bridge contract on the origin chain
+	OriginFastBridgeAddress string `json:"origin_fast_bridge_address"`
+	// DestFastBridgeAddress is the address of the fast bridge contract on the destination chain
+	DestFastBridgeAddress string `json:"dest_fast_bridge_address"`
+	// CreatedAt is the time that the quote was put
+	CreatedAt string `json:"created_at"`
+}
+
+// ActiveRFQRequest is broadcast to relayers connected to the rfq stream when a user requests a quote.
+type ActiveRFQRequest struct {
+	// RequestID uniquely identifies the request
+	RequestID string `json:"request_id"`
+	// OriginChainID is the chain the user is bridging from
+	OriginChainID int `json:"origin_chain_id"`
+	// OriginTokenAddr is the token the user is bridging from
+	OriginTokenAddr string `json:"origin_token_addr"`
+	// DestChainID is the chain the user is bridging to
+	DestChainID int `json:"dest_chain_id"`
+	// DestTokenAddr is the token the user is bridging to
+	DestTokenAddr string `json:"dest_token_addr"`
+	// OriginAmount is the amount the user is bridging, provided in the origin token decimals
+	OriginAmount string `json:"origin_amount"`
+	// Deadline is the unix timestamp in milliseconds after which responses are ignored
+	Deadline int64 `json:"deadline"`
+}
+
+// PutRFQResponse contains the schema for a POST /rfq response.
+type PutRFQResponse struct {
+	// Success is true if at least one relayer responded before the deadline
+	Success bool `json:"success"`
+	// Reason explains why the request was not successful
+	Reason string `json:"reason,omitempty"`
+	// RequestID is the id the request was broadcast with
+	RequestID string `json:"request_id"`
+	// DestAmount is the best amount quoted, provided in the destination token decimals
+	DestAmount string `json:"dest_amount,omitempty"`
+	// RelayerAddr is the address of the relayer with the best quote
+	RelayerAddr string `json:"relayer_addr,omitempty"`
 }
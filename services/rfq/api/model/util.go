@@ -1,6 +1,7 @@
 package model
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/synapsecns/sanguine/services/rfq/api/db"
@@ -22,3 +23,26 @@ func QuoteResponseFromDbQuote(dbQuote *db.Quote) *GetQuoteResponse {
 		UpdatedAt:               dbQuote.UpdatedAt.Format(time.RFC3339),
 	}
 }
+
+// QuoteHistoryResponseFromDbQuoteHistory converts a db.QuoteHistory to a GetQuoteHistoryResponse.
+func QuoteHistoryResponseFromDbQuoteHistory(dbQuote *db.QuoteHistory) *GetQuoteHistoryResponse {
+	return &GetQuoteHistoryResponse{
+		ID:                      dbQuote.ID,
+		OriginChainID:           int(dbQuote.OriginChainID),
+		OriginTokenAddr:         dbQuote.OriginTokenAddr,
+		DestChainID:             int(dbQuote.DestChainID),
+		DestTokenAddr:           dbQuote.DestTokenAddr,
+		DestAmount:              dbQuote.DestAmount.String(),
+		MaxOriginAmount:         dbQuote.MaxOriginAmount.String(),
+		FixedFee:                dbQuote.FixedFee.String(),
+		RelayerAddr:             dbQuote.RelayerAddr,
+		OriginFastBridgeAddress: dbQuote.OriginFastBridgeAddress,
+		DestFastBridgeAddress:   dbQuote.DestFastBridgeAddress,
+		CreatedAt:               dbQuote.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// ActiveRFQResponseMessage is the message a relayer signs when responding to an active rfq request.
+func ActiveRFQResponseMessage(requestID, destAmount string) string {
+	return fmt.Sprintf("%s:%s", requestID, destAmount)
+}
@@ -1,10 +1,10 @@
 package rest
 
 import (
-	"crypto/ecdsa"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -12,6 +12,33 @@
 	"github.com/gin-gonic/gin"
 )
 
+// AuthorizeFunc checks whether the recovered signer may make the request.
+// If it may not, it writes the response and returns false.
+type AuthorizeFunc func(c *gin.Context, signer common.Address) bool
+
+// SignerAuthMiddleware is the Gin authentication middleware that recovers the request signer using EIP191.
+// Authorizations signed more than expiry ago are rejected. The signer is checked with authorize
+// and stored in the context as relayerAddr.
+func SignerAuthMiddleware(expiry time.Duration, authorize AuthorizeFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		deadline := time.Now().Add(-expiry).Unix()
+		addressRecovered, err := EIP191Auth(c, deadline)
+		if err != nil {
+			// EIP191Auth has already written the response
+			c.Abort()
+			return
+		}
+
+		if !authorize(c, addressRecovered) {
+			c.Abort()
+			return
+		}
+
+		c.Set("relayerAddr", addressRecovered.Hex())
+		c.Next()
+	}
+}
+
 // EIP191Auth implements ethereum signed message authentication middleware for gin rest api
 // For auth, relayer should pass in eth signed message following eip-191 with the message
 // as the current unix timestamp in seconds
@@ -51,18 +78,25 @@ func EIP191Auth(c *gin.Context, deadline int64) (accountRecovered common.Address
 		return common.Address{}, err
 	}
 
-	data := "\x19Ethereum Signed Message:\n" + strconv.Itoa(len(s[0])) + s[0]
-	digest := crypto.Keccak256([]byte(data)) // TODO: check []byte(data) ok
-
-	var recovered *ecdsa.PublicKey
-	recovered, err = crypto.SigToPub(digest, signature)
+	signer, err := recoverEIP191Signer(s[0], signature)
 	if err != nil {
 		err = fmt.Errorf("failed to recover signer from authorization")
 		c.JSON(400, gin.H{"msg": err})
 		return common.Address{}, err
 	}
 
-	signer := crypto.PubkeyToAddress(*recovered)
-
 	return signer, nil
 }
+
+// recoverEIP191Signer recovers the account that signed message as an eth signed message.
+func recoverEIP191Signer(message string, signature []byte) (common.Address, error) {
+	data := "\x19Ethereum Signed Message:\n" + strconv.Itoa(len(message)) + message
+	digest := crypto.Keccak256([]byte(data))
+
+	recovered, err := crypto.SigToPub(digest, signature)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("could not recover signer: %w", err)
+	}
+
+	return crypto.PubkeyToAddress(*recovered), nil
+}
@@ -1,11 +1,16 @@
 package rest
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
 	"github.com/shopspring/decimal"
+	"github.com/synapsecns/sanguine/services/rfq/api/config"
 	"github.com/synapsecns/sanguine/services/rfq/api/db"
 	"github.com/synapsecns/sanguine/services/rfq/api/model"
 )
@@ -13,12 +18,21 @@
 // Handler is the REST API handler.
 type Handler struct {
 	db db.APIDB
+	// quoteTTL is how long quotes are served after they were last put, zero disables expiry
+	quoteTTL time.Duration
+	// quotes fans out quote upserts and deletions to stream subscribers
+	quotes *quoteBroadcaster
+	// rfqs routes active quote requests to connected relayers
+	rfqs *rfqHub
 }
 
 // NewHandler creates a new REST API handler.
-func NewHandler(db db.APIDB) *Handler {
+func NewHandler(db db.APIDB, cfg config.Config) *Handler {
 	return &Handler{
-		db: db, // Store the database connection in the handler
+		db:       db, // Store the database connection in the handler
+		quoteTTL: cfg.GetQuoteTTL(),
+		quotes:   newQuoteBroadcaster(),
+		rfqs:     newRFQHub(),
 	}
 }
 
@@ -62,9 +76,9 @@ func (h *Handler) ModifyQuote(c *gin.Context) {
 	// nolint: forcetypeassert
 	quote := &db.Quote{
 		OriginChainID:   uint64(putRequest.OriginChainID),
-		OriginTokenAddr: putRequest.OriginTokenAddr,
+		OriginTokenAddr: normalizeAddress(putRequest.OriginTokenAddr),
 		DestChainID:     uint64(putRequest.DestChainID),
-		DestTokenAddr:   putRequest.DestTokenAddr,
+		DestTokenAddr:   normalizeAddress(putRequest.DestTokenAddr),
 		DestAmount:      destAmount,
 		MaxOriginAmount: maxOriginAmount,
 		FixedFee:        fixedFee,
@@ -78,6 +92,7 @@ func (h *Handler) ModifyQuote(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
+	h.quotes.publish(model.QuoteResponseFromDbQuote(quote))
 	c.Status(http.StatusOK)
 }
 
@@ -108,13 +123,13 @@ func (h *Handler) GetQuotes(c *gin.Context) {
 			return
 		}
 
-		dbQuotes, err = h.db.GetQuotesByOriginAndDestination(c, originChainID, originTokenAddr, destChainID, destTokenAddr)
+		dbQuotes, err = h.db.GetQuotesByOriginAndDestination(c, originChainID, normalizeAddress(originTokenAddr), destChainID, normalizeAddress(destTokenAddr))
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
 	} else if relayerAddr != "" {
-		dbQuotes, err = h.db.GetQuotesByRelayerAddress(c, relayerAddr)
+		dbQuotes, err = h.db.GetQuotesByRelayerAddress(c, normalizeAddress(relayerAddr))
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
@@ -127,16 +142,216 @@ func (h *Handler) GetQuotes(c *gin.Context) {
 		}
 	}
 
-	// Convert quotes from db model to api model
-	quotes := make([]*model.GetQuoteResponse, len(dbQuotes))
-	for i, dbQuote := range dbQuotes {
-		quotes[i] = model.QuoteResponseFromDbQuote(dbQuote)
+	// Convert quotes from db model to api model, hiding expired quotes
+	quotes := make([]*model.GetQuoteResponse, 0, len(dbQuotes))
+	for _, dbQuote := range dbQuotes {
+		if h.isExpired(dbQuote) {
+			continue
+		}
+		quotes = append(quotes, model.QuoteResponseFromDbQuote(dbQuote))
 	}
 	c.JSON(http.StatusOK, quotes)
 }
 
-// GetFilteredQuotes retrieves filtered quotes from the database.
-// GET /quotes?destChainId=&destTokenAddr=&destAmount=.
+// isExpired returns true if the quote hasn't been put within the quote ttl.
+func (h *Handler) isExpired(quote *db.Quote) bool {
+	return h.quoteTTL > 0 && time.Since(quote.UpdatedAt) > h.quoteTTL
+}
+
+// DeleteQuotes withdraws the authenticated relayer's quotes, optionally limited to a route.
+//
+// DELETE /quotes?originChainId=&originTokenAddr=&destChainId=&destTokenAddr=
+// @dev Protected Method: Authentication is handled through middleware in server.go.
+func (h *Handler) DeleteQuotes(c *gin.Context) {
+	relayerAddr, exists := c.Get("relayerAddr")
+	if !exists {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "No relayer address recovered from signature"})
+		return
+	}
+
+	filter, err := parseQuoteFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	// relayers can only withdraw their own quotes
+	//nolint: forcetypeassert
+	filter.RelayerAddr = relayerAddr.(string)
+
+	deleted, err := h.db.DeleteQuotes(c, filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	h.quotes.publishDeleted(deleted)
+	c.JSON(http.StatusOK, model.DeleteQuotesResponse{Deleted: int64(len(deleted))})
+}
+
+// maxQuoteExpiryInterval is the longest time between removing expired quotes.
+const maxQuoteExpiryInterval = time.Minute
+
+// expireQuotes periodically deletes quotes that haven't been put within the quote ttl and publishes them as
+// deletions, so expired quotes are dropped by every reader and not just GetQuotes.
+func (h *Handler) expireQuotes(ctx context.Context) {
+	if h.quoteTTL <= 0 {
+		return
+	}
+
+	interval := h.quoteTTL
+	if interval > maxQuoteExpiryInterval {
+		interval = maxQuoteExpiryInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		expired, err := h.db.DeleteExpiredQuotes(ctx, time.Now().Add(-h.quoteTTL))
+		if err != nil {
+			logger.Warnf("could not delete expired quotes: %v", err)
+		}
+		h.quotes.publishDeleted(expired)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+const (
+	// defaultHistoryPageSize is the page size used if none is given.
+	defaultHistoryPageSize = 100
+	// maxHistoryPageSize is the largest page size that can be requested.
+	maxHistoryPageSize = 1000
+)
+
+// GetQuoteHistory retrieves a page of quote history, newest first.
+//
+// GET /quotes/history?originChainId=&originTokenAddr=&destChainId=&destTokenAddr=&relayerAddr=&from=&to=&page=&pageSize=
+// nolint: cyclop
+func (h *Handler) GetQuoteHistory(c *gin.Context) {
+	quoteFilter, err := parseQuoteFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	filter := db.QuoteHistoryFilter{QuoteFilter: quoteFilter}
+	filter.RelayerAddr = normalizeAddress(c.Query("relayerAddr"))
+
+	if fromStr := c.Query("from"); fromStr != "" {
+		from, err := strconv.ParseInt(fromStr, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid from"})
+			return
+		}
+		filter.From = time.Unix(from, 0)
+	}
+	if toStr := c.Query("to"); toStr != "" {
+		to, err := strconv.ParseInt(toStr, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid to"})
+			return
+		}
+		filter.To = time.Unix(to, 0)
+	}
+
+	page := 1
+	if pageStr := c.Query("page"); pageStr != "" {
+		page, err = strconv.Atoi(pageStr)
+		if err != nil || page < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page"})
+			return
+		}
+	}
+	pageSize := defaultHistoryPageSize
+	if pageSizeStr := c.Query("pageSize"); pageSizeStr != "" {
+		pageSize, err = strconv.Atoi(pageSizeStr)
+		if err != nil || pageSize < 1 || pageSize > maxHistoryPageSize {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("pageSize must be between 1 and %d", maxHistoryPageSize)})
+			return
+		}
+	}
+
+	dbHistory, err := h.db.GetQuoteHistory(c, filter, page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	history := make([]*model.GetQuoteHistoryResponse, len(dbHistory))
+	for i, dbQuote := range dbHistory {
+		history[i] = model.QuoteHistoryResponseFromDbQuoteHistory(dbQuote)
+	}
+	c.JSON(http.StatusOK, history)
+}
+
+// parseQuoteFilter parses the route of a quote filter from the query params.
+func parseQuoteFilter(c *gin.Context) (filter db.QuoteFilter, err error) {
+	if originChainIDStr := c.Query("originChainId"); originChainIDStr != "" {
+		filter.OriginChainID, err = strconv.ParseUint(originChainIDStr, 10, 64)
+		if err != nil {
+			return filter, fmt.Errorf("invalid originChainId: %w", err)
+		}
+	}
+	if destChainIDStr := c.Query("destChainId"); destChainIDStr != "" {
+		filter.DestChainID, err = strconv.ParseUint(destChainIDStr, 10, 64)
+		if err != nil {
+			return filter, fmt.Errorf("invalid destChainId: %w", err)
+		}
+	}
+	filter.OriginTokenAddr = normalizeAddress(c.Query("originTokenAddr"))
+	filter.DestTokenAddr = normalizeAddress(c.Query("destTokenAddr"))
+	return filter, nil
+}
+
+// normalizeAddress returns the checksummed form of an address so addresses can be compared regardless of case.
+// Anything that isn't an address is returned as is.
+func normalizeAddress(addr string) string {
+	if !common.IsHexAddress(addr) {
+		return addr
+	}
+	return common.HexToAddress(addr).Hex()
+}
+
+// GetFilteredQuotes retrieves the unexpired quotes matching a filter, optionally only those with at least destAmount
+// of liquidity.
+// GET /quotes/filter?originChainId=&originTokenAddr=&destChainId=&destTokenAddr=&relayerAddr=&destAmount=.
 func (h *Handler) GetFilteredQuotes(c *gin.Context) {
-	// Implement logic to fetch and return filtered quotes
+	filter, err := parseQuoteFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	filter.RelayerAddr = normalizeAddress(c.Query("relayerAddr"))
+
+	var minDestAmount *decimal.Decimal
+	if destAmountStr := c.Query("destAmount"); destAmountStr != "" {
+		destAmount, err := decimal.NewFromString(destAmountStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid destAmount"})
+			return
+		}
+		minDestAmount = &destAmount
+	}
+
+	var updatedAfter time.Time
+	if h.quoteTTL > 0 {
+		updatedAfter = time.Now().Add(-h.quoteTTL)
+	}
+	dbQuotes, err := h.db.GetQuotes(c, filter, updatedAfter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	quotes := make([]*model.GetQuoteResponse, 0, len(dbQuotes))
+	for _, dbQuote := range dbQuotes {
+		if minDestAmount != nil && dbQuote.DestAmount.LessThan(*minDestAmount) {
+			continue
+		}
+		quotes = append(quotes, model.QuoteResponseFromDbQuote(dbQuote))
+	}
+	c.JSON(http.StatusOK, quotes)
 }
@@ -0,0 +1,304 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/synapsecns/sanguine/services/rfq/api/model"
+)
+
+const (
+	// defaultRFQWindow is how long responses are collected for if the request doesn't specify a window.
+	defaultRFQWindow = time.Second
+	// maxRFQWindow is the longest a request can wait for responses.
+	maxRFQWindow = 30 * time.Second
+	// rfqRequestBuffer is the number of requests buffered per relayer connection.
+	rfqRequestBuffer = 100
+	// maxRFQResponseSize is the largest message a relayer can send on the rfq stream.
+	maxRFQResponseSize = 4096
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// relayerConn is a relayer connected to the rfq stream.
+type relayerConn struct {
+	addr common.Address
+	// chains are the destination chains the relayer has the relayer role on
+	chains   map[uint32]bool
+	requests chan *model.ActiveRFQRequest
+}
+
+// relayerQuote is a verified relayer response to an active request.
+type relayerQuote struct {
+	relayer    common.Address
+	destAmount *big.Int
+}
+
+// pendingRFQ is an active request that is still collecting responses.
+type pendingRFQ struct {
+	destChainID uint32
+	responses   chan relayerQuote
+}
+
+// rfqHub broadcasts active requests to connected relayers and routes their responses back.
+type rfqHub struct {
+	mux      sync.RWMutex
+	relayers map[*relayerConn]struct{}
+	pending  map[string]*pendingRFQ
+}
+
+func newRFQHub() *rfqHub {
+	return &rfqHub{
+		relayers: make(map[*relayerConn]struct{}),
+		pending:  make(map[string]*pendingRFQ),
+	}
+}
+
+func (r *rfqHub) register(conn *relayerConn) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.relayers[conn] = struct{}{}
+}
+
+func (r *rfqHub) unregister(conn *relayerConn) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	delete(r.relayers, conn)
+}
+
+// broadcast registers the request as pending and sends it to every relayer for the destination chain.
+// It returns the number of relayers the request was sent to.
+func (r *rfqHub) broadcast(request *model.ActiveRFQRequest, pending *pendingRFQ) int {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.pending[request.RequestID] = pending
+	sent := 0
+	for conn := range r.relayers {
+		if !conn.chains[pending.destChainID] {
+			continue
+		}
+		select {
+		case conn.requests <- request:
+			sent++
+		default:
+			logger.Warnf("dropping rfq request %s for slow relayer %s", request.RequestID, conn.addr)
+		}
+	}
+	return sent
+}
+
+func (r *rfqHub) finish(requestID string) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	delete(r.pending, requestID)
+}
+
+// respond verifies a relayer response and routes it to the pending request.
+func (r *rfqHub) respond(conn *relayerConn, resp *model.ActiveRFQResponse) error {
+	destAmount, ok := new(big.Int).SetString(resp.DestAmount, 10)
+	if !ok || destAmount.Sign() <= 0 {
+		return fmt.Errorf("invalid dest amount %s", resp.DestAmount)
+	}
+
+	signature, err := hexutil.Decode(resp.Signature)
+	if err != nil {
+		return fmt.Errorf("signature not hex encoded: %w", err)
+	}
+	signer, err := recoverEIP191Signer(model.ActiveRFQResponseMessage(resp.RequestID, resp.DestAmount), signature)
+	if err != nil {
+		return err
+	}
+	if signer != conn.addr {
+		return fmt.Errorf("response signed by %s, not %s", signer, conn.addr)
+	}
+
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+
+	pending, ok := r.pending[resp.RequestID]
+	if !ok {
+		return fmt.Errorf("request %s is not pending", resp.RequestID)
+	}
+	if !conn.chains[pending.destChainID] {
+		return fmt.Errorf("relayer %s is not a relayer on chain %d", conn.addr, pending.destChainID)
+	}
+
+	select {
+	case pending.responses <- relayerQuote{relayer: conn.addr, destAmount: destAmount}:
+	default:
+		return fmt.Errorf("too many responses for request %s", resp.RequestID)
+	}
+	return nil
+}
+
+// collect waits until the deadline and returns the response with the highest dest amount, or nil if there were none.
+func (p *pendingRFQ) collect(ctx context.Context, deadline time.Time) *relayerQuote {
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+
+	var best *relayerQuote
+	for {
+		select {
+		case <-ctx.Done():
+			return best
+		case <-timer.C:
+			return best
+		case quote := <-p.responses:
+			if best == nil || quote.destAmount.Cmp(best.destAmount) > 0 {
+				best = &quote
+			}
+		}
+	}
+}
+
+// PutRFQRequest broadcasts a request for a quote to connected relayers and returns the best response.
+// POST /rfq.
+func (h *Handler) PutRFQRequest(c *gin.Context) {
+	var req model.PutRFQRequest
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	originAmount, ok := new(big.Int).SetString(req.OriginAmount, 10)
+	if !ok || originAmount.Sign() <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid OriginAmount"})
+		return
+	}
+
+	window := time.Duration(req.ExpirationWindow) * time.Millisecond
+	if window <= 0 {
+		window = defaultRFQWindow
+	}
+	if window > maxRFQWindow {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("expiration window must be at most %s", maxRFQWindow)})
+		return
+	}
+	deadline := time.Now().Add(window)
+
+	request := &model.ActiveRFQRequest{
+		RequestID:       uuid.New().String(),
+		OriginChainID:   req.OriginChainID,
+		OriginTokenAddr: req.OriginTokenAddr,
+		DestChainID:     req.DestChainID,
+		DestTokenAddr:   req.DestTokenAddr,
+		OriginAmount:    originAmount.String(),
+		Deadline:        deadline.UnixMilli(),
+	}
+	pending := &pendingRFQ{
+		destChainID: uint32(req.DestChainID),
+		responses:   make(chan relayerQuote, rfqRequestBuffer),
+	}
+
+	sent := h.rfqs.broadcast(request, pending)
+	defer h.rfqs.finish(request.RequestID)
+
+	resp := model.PutRFQResponse{
+		RequestID: request.RequestID,
+	}
+	if sent == 0 {
+		resp.Reason = "no relayers connected"
+		c.JSON(http.StatusOK, resp)
+		return
+	}
+
+	best := pending.collect(c.Request.Context(), deadline)
+	if best == nil {
+		resp.Reason = "no relayer responded before the deadline"
+		c.JSON(http.StatusOK, resp)
+		return
+	}
+
+	resp.Success = true
+	resp.DestAmount = best.destAmount.String()
+	resp.RelayerAddr = best.relayer.Hex()
+	c.JSON(http.StatusOK, resp)
+}
+
+// StreamRFQRequests upgrades a relayer to a websocket, sends it active requests and reads back its signed responses.
+//
+// GET /rfq/stream
+// @dev Protected Method: Authentication is handled through middleware in server.go.
+func (h *Handler) StreamRFQRequests(c *gin.Context) {
+	relayerAddr, exists := c.Get("relayerAddr")
+	if !exists {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "No relayer address recovered from signature"})
+		return
+	}
+	relayerChains, exists := c.Get("relayerChains")
+	if !exists {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "No relayer chains found"})
+		return
+	}
+
+	ws, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		logger.Warnf("could not upgrade rfq stream for %s: %v", relayerAddr, err)
+		return
+	}
+	defer func() {
+		_ = ws.Close()
+	}()
+	ws.SetReadLimit(maxRFQResponseSize)
+
+	conn := &relayerConn{
+		//nolint: forcetypeassert
+		addr: common.HexToAddress(relayerAddr.(string)),
+		//nolint: forcetypeassert
+		chains:   relayerChains.(map[uint32]bool),
+		requests: make(chan *model.ActiveRFQRequest, rfqRequestBuffer),
+	}
+	h.rfqs.register(conn)
+	defer h.rfqs.unregister(conn)
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	// gorilla connections support one concurrent writer, so all writes happen here.
+	go func() {
+		// closing the connection unblocks the read loop below
+		defer func() {
+			_ = ws.Close()
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case request := <-conn.requests:
+				writeErr := ws.WriteJSON(request)
+				if writeErr != nil {
+					logger.Warnf("could not send rfq request to %s: %v", conn.addr, writeErr)
+					return
+				}
+			}
+		}
+	}()
+
+	for {
+		var resp model.ActiveRFQResponse
+		err = ws.ReadJSON(&resp)
+		if err != nil {
+			return
+		}
+		err = h.rfqs.respond(conn, &resp)
+		if err != nil {
+			logger.Warnf("invalid rfq response from %s: %v", conn.addr, err)
+		}
+	}
+}
@@ -5,7 +5,7 @@
 	"context"
 	"fmt"
 	"net/http"
-	"time"
+	"strconv"
 
 	"github.com/ipfs/go-log"
 	"github.com/synapsecns/sanguine/core/ginhelper"
@@ -77,9 +77,17 @@ func NewAPI(
 	}, nil
 }
 
-// QuoteRoute is the API endpoint for handling quote related requests.
 const (
+	// QuoteRoute is the API endpoint for handling quote related requests.
 	QuoteRoute = "/quotes"
+	// QuoteHistoryRoute is the API endpoint for querying quote history.
+	QuoteHistoryRoute = "/quotes/history"
+	// QuoteStreamRoute is the API endpoint for streaming quote upserts.
+	QuoteStreamRoute = "/quotes/stream"
+	// RFQRoute is the API endpoint for requesting an active quote.
+	RFQRoute = "/rfq"
+	// RFQStreamRoute is the API endpoint relayers connect to for receiving active quote requests.
+	RFQStreamRoute = "/rfq/stream"
 )
 
 var logger = log.Logger("rfq-api")
@@ -88,7 +96,7 @@ func NewAPI(
 func (r *QuoterAPIServer) Run(ctx context.Context) error {
 	// TODO: Use Gin Helper
 	engine := ginhelper.New(logger)
-	h := NewHandler(r.db)
+	h := NewHandler(r.db, r.cfg)
 
 	// Apply AuthMiddleware only to the PUT route
 	quotesPut := engine.Group(QuoteRoute)
@@ -98,9 +106,24 @@ func (r *QuoterAPIServer) Run(ctx context.Context) error {
 	// engine.PUT("/quotes", h.ModifyQuote)
 	engine.GET(QuoteRoute, h.GetQuotes)
 	engine.GET(fmt.Sprintf("%s/filter", QuoteRoute), h.GetFilteredQuotes)
+	engine.GET(QuoteStreamRoute, h.StreamQuotes)
+	engine.GET(QuoteHistoryRoute, h.GetQuoteHistory)
+
+	// Relayers can withdraw their own quotes
+	quotesDelete := engine.Group(QuoteRoute)
+	quotesDelete.Use(r.DeleteAuthMiddleware())
+	quotesDelete.DELETE("", h.DeleteQuotes)
+
+	// Active quoting: users post requests, authenticated relayers respond over a websocket
+	engine.POST(RFQRoute, h.PutRFQRequest)
+	rfqStream := engine.Group(RFQStreamRoute)
+	rfqStream.Use(r.RFQStreamAuthMiddleware())
+	rfqStream.GET("", h.StreamRFQRequests)
 
 	r.engine = engine
 
+	go h.expireQuotes(ctx)
+
 	connection := baseServer.Server{}
 	fmt.Printf("starting api at http://localhost:%s\n", r.cfg.Port)
 	err := connection.ListenAndServe(ctx, fmt.Sprintf(":%s", r.cfg.Port), r.engine)
@@ -113,48 +136,95 @@ func (r *QuoterAPIServer) Run(ctx context.Context) error {
 
 // AuthMiddleware is the Gin authentication middleware that authenticates requests using EIP191.
 func (r *QuoterAPIServer) AuthMiddleware() gin.HandlerFunc {
-	return func(c *gin.Context) {
+	return SignerAuthMiddleware(r.cfg.GetAuthExpiry(), func(c *gin.Context, signer common.Address) bool {
 		var req model.PutQuoteRequest
 		if err := c.BindJSON(&req); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-			c.Abort()
-			return
+			return false
 		}
 
 		bridge, ok := r.fastBridgeContracts[uint32(req.DestChainID)]
 		if !ok {
 			c.JSON(http.StatusBadRequest, gin.H{"msg": "dest chain id not supported"})
-			c.Abort()
-			return
+			return false
 		}
 
 		ops := &bind.CallOpts{Context: c}
 		relayerRole := crypto.Keccak256Hash([]byte("RELAYER_ROLE"))
+		has, err := bridge.HasRole(ops, relayerRole, signer)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"msg": "unable to check relayer role on-chain"})
+			return false
+		} else if !has {
+			c.JSON(http.StatusBadRequest, gin.H{"msg": "q.Relayer not an on-chain relayer"})
+			return false
+		}
 
-		// authenticate relayer signature with EIP191
-		deadline := time.Now().Unix() - 1000 // TODO: Replace with some type of r.cfg.AuthExpiryDelta
-		addressRecovered, err := EIP191Auth(c, deadline)
+		// Store the request in context after binding and validation
+		c.Set("putRequest", &req)
+		return true
+	})
+}
+
+// DeleteAuthMiddleware is the Gin authentication middleware that authenticates quote withdrawals using EIP191.
+// Like AuthMiddleware, the signer must have the relayer role, on the destination chain if one is given.
+func (r *QuoterAPIServer) DeleteAuthMiddleware() gin.HandlerFunc {
+	return SignerAuthMiddleware(r.cfg.GetAuthExpiry(), func(c *gin.Context, signer common.Address) bool {
+		chains, err := r.relayerChains(c, signer)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("unable to authenticate relayer: %v", err)})
-			c.Abort()
-			return
+			c.JSON(http.StatusBadRequest, gin.H{"msg": "unable to check relayer role on-chain"})
+			return false
 		}
 
-		has, err := bridge.HasRole(ops, relayerRole, addressRecovered)
+		isRelayer := len(chains) > 0
+		if destChainIDStr := c.Query("destChainId"); destChainIDStr != "" {
+			destChainID, err := strconv.ParseUint(destChainIDStr, 10, 32)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid destChainId"})
+				return false
+			}
+			isRelayer = chains[uint32(destChainID)]
+		}
+		if !isRelayer {
+			c.JSON(http.StatusBadRequest, gin.H{"msg": "q.Relayer not an on-chain relayer"})
+			return false
+		}
+		return true
+	})
+}
+
+// RFQStreamAuthMiddleware authenticates relayers connecting to the rfq stream using EIP191.
+// Relayers only receive requests for destination chains they have the relayer role on.
+func (r *QuoterAPIServer) RFQStreamAuthMiddleware() gin.HandlerFunc {
+	return SignerAuthMiddleware(r.cfg.GetAuthExpiry(), func(c *gin.Context, signer common.Address) bool {
+		chains, err := r.relayerChains(c, signer)
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"msg": "unable to check relayer role on-chain"})
-			c.Abort()
-			return
-		} else if !has {
+			return false
+		} else if len(chains) == 0 {
 			c.JSON(http.StatusBadRequest, gin.H{"msg": "q.Relayer not an on-chain relayer"})
-			c.Abort()
-			return
+			return false
 		}
 
-		// Log and pass to the next middleware if authentication succeeds
-		// Store the request in context after binding and validation
-		c.Set("putRequest", &req)
-		c.Set("relayerAddr", addressRecovered.Hex())
-		c.Next()
+		c.Set("relayerChains", chains)
+		return true
+	})
+}
+
+// relayerChains returns the chains the given address has the relayer role on.
+func (r *QuoterAPIServer) relayerChains(ctx context.Context, relayer common.Address) (map[uint32]bool, error) {
+	ops := &bind.CallOpts{Context: ctx}
+	relayerRole := crypto.Keccak256Hash([]byte("RELAYER_ROLE"))
+
+	chains := make(map[uint32]bool)
+	for chainID, bridge := range r.fastBridgeContracts {
+		has, err := bridge.HasRole(ops, relayerRole, relayer)
+		if err != nil {
+			return nil, fmt.Errorf("could not check relayer role on chain %d: %w", chainID, err)
+		}
+		if has {
+			chains[chainID] = true
+		}
 	}
+	return chains, nil
 }
@@ -1,18 +1,24 @@
 package rest_test
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gorilla/websocket"
 	"github.com/synapsecns/sanguine/ethergo/signer/wallet"
+	"github.com/synapsecns/sanguine/services/rfq/api/db"
 	"github.com/synapsecns/sanguine/services/rfq/api/model"
+	"github.com/synapsecns/sanguine/services/rfq/api/rest"
 )
 
 func (c *ServerSuite) TestNewQuoterAPIServer() {
@@ -95,6 +101,28 @@ func (c *ServerSuite) TestEIP191_UnsuccessfulSignature() {
 }
 
 // TestEIP191_SuccessfulPutSubmission tests a successful PUT request submission.
+func (c *ServerSuite) TestEIP191_ExpiredSignature() {
+	// Only accept authorizations signed in the last minute
+	c.cfg.AuthExpirySeconds = 60
+	var err error
+	c.QuoterAPIServer, err = rest.NewAPI(c.GetTestContext(), c.cfg, c.handler, c.omniRPCClient, c.database)
+	c.Require().NoError(err)
+	c.startQuoterAPIServer()
+
+	// Sign a timestamp from before the expiry
+	signedAt := strconv.Itoa(int(time.Now().Add(-2 * time.Minute).Unix()))
+	signature, err := c.signMessage(c.testWallet, signedAt)
+	c.Require().NoError(err)
+
+	resp, err := c.sendPutRequest(signedAt + ":" + signature)
+	c.Require().NoError(err)
+	defer func() {
+		err = resp.Body.Close()
+		c.Require().NoError(err)
+	}()
+	c.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
+
 func (c *ServerSuite) TestEIP191_SuccessfulPutSubmission() {
 	// Start the API server in a separate goroutine and wait for it to initialize.
 	c.startQuoterAPIServer()
@@ -203,6 +231,270 @@ func (c *ServerSuite) TestPutAndGetQuoteByRelayer() {
 	c.Assert().True(found, "Newly added quote not found")
 }
 
+func (c *ServerSuite) TestQuoteStream() {
+	c.startQuoterAPIServer()
+
+	// Subscribe to quotes on the route the test quote is put on
+	streamCtx, cancel := context.WithCancel(c.GetTestContext())
+	defer cancel()
+	req, err := http.NewRequestWithContext(streamCtx, http.MethodGet, fmt.Sprintf("http://localhost:%d/quotes/stream?originChainId=1&destChainId=42161", c.port), nil)
+	c.Require().NoError(err)
+	streamResp, err := http.DefaultClient.Do(req)
+	c.Require().NoError(err)
+	defer func() {
+		_ = streamResp.Body.Close()
+	}()
+	c.Require().Equal(http.StatusOK, streamResp.StatusCode)
+
+	header, err := c.prepareAuthHeader(c.testWallet)
+	c.Require().NoError(err)
+	putResp, err := c.sendPutRequest(header)
+	c.Require().NoError(err)
+	_ = putResp.Body.Close()
+	c.Require().Equal(http.StatusOK, putResp.StatusCode)
+
+	// Read events until the quote arrives
+	scanner := bufio.NewScanner(streamResp.Body)
+	var quote model.GetQuoteResponse
+	var event string
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "event:") {
+			event = strings.TrimPrefix(line, "event:")
+		}
+		if strings.HasPrefix(line, "data:") && event == "quote" {
+			err = json.Unmarshal([]byte(strings.TrimPrefix(line, "data:")), &quote)
+			c.Require().NoError(err)
+			break
+		}
+	}
+	c.Equal(1, quote.OriginChainID)
+	c.Equal(42161, quote.DestChainID)
+	c.Equal("10", quote.FixedFee)
+	c.Equal(c.testWallet.Address().Hex(), quote.RelayerAddr)
+}
+
+func (c *ServerSuite) TestActiveRFQ() {
+	c.startQuoterAPIServer()
+	streamURL := fmt.Sprintf("ws://localhost:%d/rfq/stream", c.port)
+
+	// Relayers without the relayer role are rejected
+	randomWallet, err := wallet.FromRandom()
+	c.Require().NoError(err)
+	header, err := c.prepareAuthHeader(randomWallet)
+	c.Require().NoError(err)
+	_, resp, err := websocket.DefaultDialer.DialContext(c.GetTestContext(), streamURL, http.Header{"Authorization": []string{header}})
+	c.Require().Error(err)
+	c.Require().NotNil(resp)
+	_ = resp.Body.Close()
+	c.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	// Connect as the relayer and quote every request
+	header, err = c.prepareAuthHeader(c.testWallet)
+	c.Require().NoError(err)
+	conn, resp, err := websocket.DefaultDialer.DialContext(c.GetTestContext(), streamURL, http.Header{"Authorization": []string{header}})
+	c.Require().NoError(err)
+	_ = resp.Body.Close()
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	destAmount := "999000"
+	go func() {
+		for {
+			var request model.ActiveRFQRequest
+			if readErr := conn.ReadJSON(&request); readErr != nil {
+				return
+			}
+			signature, signErr := c.signMessage(c.testWallet, model.ActiveRFQResponseMessage(request.RequestID, destAmount))
+			if signErr != nil {
+				return
+			}
+			_ = conn.WriteJSON(model.ActiveRFQResponse{
+				RequestID:  request.RequestID,
+				DestAmount: destAmount,
+				Signature:  signature,
+			})
+		}
+	}()
+
+	// The relayer registers asynchronously after the upgrade, so retry until it receives the request.
+	var rfqResp model.PutRFQResponse
+	c.Eventually(func() bool {
+		rfqResp = c.sendRFQRequest()
+		return rfqResp.Success
+	})
+
+	c.Equal(destAmount, rfqResp.DestAmount)
+	c.Equal(c.testWallet.Address().Hex(), rfqResp.RelayerAddr)
+	c.NotEmpty(rfqResp.RequestID)
+}
+
+func (c *ServerSuite) TestDeleteQuotes() {
+	c.startQuoterAPIServer()
+
+	header, err := c.prepareAuthHeader(c.testWallet)
+	c.Require().NoError(err)
+	putResp, err := c.sendPutRequest(header)
+	c.Require().NoError(err)
+	_ = putResp.Body.Close()
+	c.Require().Equal(http.StatusOK, putResp.StatusCode)
+
+	// Subscribe to the route so the withdrawal is streamed
+	streamCtx, cancel := context.WithCancel(c.GetTestContext())
+	defer cancel()
+	streamReq, err := http.NewRequestWithContext(streamCtx, http.MethodGet, fmt.Sprintf("http://localhost:%d/quotes/stream?destChainId=42161", c.port), nil)
+	c.Require().NoError(err)
+	streamResp, err := http.DefaultClient.Do(streamReq)
+	c.Require().NoError(err)
+	defer func() {
+		_ = streamResp.Body.Close()
+	}()
+	c.Require().Equal(http.StatusOK, streamResp.StatusCode)
+
+	// Only relayers can withdraw quotes
+	nonRelayer, err := wallet.FromRandom()
+	c.Require().NoError(err)
+	header, err = c.prepareAuthHeader(nonRelayer)
+	c.Require().NoError(err)
+	req, err := http.NewRequestWithContext(c.GetTestContext(), http.MethodDelete, fmt.Sprintf("http://localhost:%d/quotes?destChainId=42161", c.port), nil)
+	c.Require().NoError(err)
+	req.Header.Add("Authorization", header)
+	nonRelayerResp, err := http.DefaultClient.Do(req)
+	c.Require().NoError(err)
+	_ = nonRelayerResp.Body.Close()
+	c.Require().Equal(http.StatusBadRequest, nonRelayerResp.StatusCode)
+
+	// Withdraw the relayer's quotes on the test route, token addresses are matched regardless of case
+	header, err = c.prepareAuthHeader(c.testWallet)
+	c.Require().NoError(err)
+	req, err = http.NewRequestWithContext(c.GetTestContext(), http.MethodDelete, fmt.Sprintf("http://localhost:%d/quotes?destChainId=42161&destTokenAddr=%s", c.port, testDestTokenAddr), nil)
+	c.Require().NoError(err)
+	req.Header.Add("Authorization", header)
+	deleteResp, err := http.DefaultClient.Do(req)
+	c.Require().NoError(err)
+	defer func() {
+		_ = deleteResp.Body.Close()
+	}()
+	c.Require().Equal(http.StatusOK, deleteResp.StatusCode)
+
+	var result model.DeleteQuotesResponse
+	err = json.NewDecoder(deleteResp.Body).Decode(&result)
+	c.Require().NoError(err)
+	c.Equal(int64(1), result.Deleted)
+
+	// The withdrawn quote is streamed as a deletion
+	scanner := bufio.NewScanner(streamResp.Body)
+	var quote model.GetQuoteResponse
+	var event string
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "event:") {
+			event = strings.TrimPrefix(line, "event:")
+		}
+		if strings.HasPrefix(line, "data:") && event == "delete" {
+			err = json.Unmarshal([]byte(strings.TrimPrefix(line, "data:")), &quote)
+			c.Require().NoError(err)
+			break
+		}
+	}
+	c.True(quote.Deleted)
+	c.Equal(42161, quote.DestChainID)
+	c.Equal(c.testWallet.Address().Hex(), quote.RelayerAddr)
+
+	quotes, err := c.database.GetQuotesByRelayerAddress(c.GetTestContext(), c.testWallet.Address().Hex())
+	c.Require().NoError(err)
+	c.Empty(quotes)
+
+	// The history is kept
+	history, err := c.database.GetQuoteHistory(c.GetTestContext(), db.QuoteHistoryFilter{QuoteFilter: db.QuoteFilter{RelayerAddr: c.testWallet.Address().Hex()}}, 1, 10)
+	c.Require().NoError(err)
+	c.NotEmpty(history)
+}
+
+func (c *ServerSuite) TestQuoteExpiry() {
+	// Serve quotes for a minute
+	c.cfg.QuoteTTLSeconds = 60
+	var err error
+	c.QuoterAPIServer, err = rest.NewAPI(c.GetTestContext(), c.cfg, c.handler, c.omniRPCClient, c.database)
+	c.Require().NoError(err)
+
+	relayer := "0x2222222222222222222222222222222222222222"
+	for _, quote := range []*db.Quote{
+		{OriginChainID: 1, OriginTokenAddr: "0xA", DestChainID: 42161, DestTokenAddr: "0xB", RelayerAddr: relayer, UpdatedAt: time.Now().Add(-time.Hour)},
+		{OriginChainID: 1, OriginTokenAddr: "0xA", DestChainID: 42161, DestTokenAddr: "0xC", RelayerAddr: relayer},
+	} {
+		err = c.database.UpsertQuote(c.GetTestContext(), quote)
+		c.Require().NoError(err)
+	}
+	c.startQuoterAPIServer()
+
+	req, err := http.NewRequestWithContext(c.GetTestContext(), http.MethodGet, fmt.Sprintf("http://localhost:%d/quotes?relayerAddr=%s", c.port, relayer), nil)
+	c.Require().NoError(err)
+	resp, err := http.DefaultClient.Do(req)
+	c.Require().NoError(err)
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	c.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	var quotes []*model.GetQuoteResponse
+	err = json.NewDecoder(resp.Body).Decode(&quotes)
+	c.Require().NoError(err)
+	c.Require().Len(quotes, 1)
+	c.Equal("0xC", quotes[0].DestTokenAddr)
+
+	// Filtered quotes leave out expired quotes too
+	req, err = http.NewRequestWithContext(c.GetTestContext(), http.MethodGet, fmt.Sprintf("http://localhost:%d/quotes/filter?destChainId=42161&relayerAddr=%s", c.port, relayer), nil)
+	c.Require().NoError(err)
+	filterResp, err := http.DefaultClient.Do(req)
+	c.Require().NoError(err)
+	defer func() {
+		_ = filterResp.Body.Close()
+	}()
+	c.Require().Equal(http.StatusOK, filterResp.StatusCode)
+
+	err = json.NewDecoder(filterResp.Body).Decode(&quotes)
+	c.Require().NoError(err)
+	c.Require().Len(quotes, 1)
+	c.Equal("0xC", quotes[0].DestTokenAddr)
+
+	// Expired quotes are removed from the db as well
+	c.Eventually(func() bool {
+		dbQuotes, err := c.database.GetQuotesByRelayerAddress(c.GetTestContext(), relayer)
+		c.Require().NoError(err)
+		return len(dbQuotes) == 1
+	})
+}
+
+// sendRFQRequest posts an active quote request for the test route.
+func (c *ServerSuite) sendRFQRequest() model.PutRFQResponse {
+	jsonData, err := json.Marshal(model.PutRFQRequest{
+		OriginChainID:    1,
+		OriginTokenAddr:  "0xOriginTokenAddr",
+		DestChainID:      42161,
+		DestTokenAddr:    "0xDestTokenAddr",
+		OriginAmount:     "1000000",
+		ExpirationWindow: 500,
+	})
+	c.Require().NoError(err)
+
+	req, err := http.NewRequestWithContext(c.GetTestContext(), http.MethodPost, fmt.Sprintf("http://localhost:%d/rfq", c.port), bytes.NewBuffer(jsonData))
+	c.Require().NoError(err)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	c.Require().NoError(err)
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	c.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	var rfqResp model.PutRFQResponse
+	err = json.NewDecoder(resp.Body).Decode(&rfqResp)
+	c.Require().NoError(err)
+	return rfqResp
+}
+
 // startQuoterAPIServer starts the API server and waits for it to initialize.
 func (c *ServerSuite) startQuoterAPIServer() {
 	go func() {
@@ -217,8 +509,19 @@ func (c *ServerSuite) prepareAuthHeader(wallet wallet.Wallet) (string, error) {
 	// Get the current Unix timestamp as a string.
 	now := strconv.Itoa(int(time.Now().Unix()))
 
+	signature, err := c.signMessage(wallet, now)
+	if err != nil {
+		return "", err
+	}
+
+	// Return the combined header value.
+	return now + ":" + signature, nil
+}
+
+// signMessage signs the message as an eth signed message and returns the hex encoded signature.
+func (c *ServerSuite) signMessage(wallet wallet.Wallet, message string) (string, error) {
 	// Prepare the data to be signed.
-	data := "\x19Ethereum Signed Message:\n" + strconv.Itoa(len(now)) + now
+	data := "\x19Ethereum Signed Message:\n" + strconv.Itoa(len(message)) + message
 	digest := crypto.Keccak256([]byte(data))
 
 	// Sign the data with the provided private key.
@@ -226,21 +529,25 @@ func (c *ServerSuite) prepareAuthHeader(wallet wallet.Wallet) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("failed to sign data: %w", err)
 	}
-	signature := hexutil.Encode(sig)
-
-	// Return the combined header value.
-	return now + ":" + signature, nil
+	return hexutil.Encode(sig), nil
 }
 
+const (
+	// testOriginTokenAddr is the origin token of the test quote, not checksummed.
+	testOriginTokenAddr = "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+	// testDestTokenAddr is the destination token of the test quote, not checksummed.
+	testDestTokenAddr = "0xaf88d065e77c8cc2239327c5edb3a432268e5831"
+)
+
 // sendPutRequest sends a PUT request to the server with the given authorization header.
 func (c *ServerSuite) sendPutRequest(header string) (*http.Response, error) {
 	// Prepare the PUT request with JSON data.
 	client := &http.Client{}
 	putData := model.PutQuoteRequest{
 		OriginChainID:   1,
-		OriginTokenAddr: "0xOriginTokenAddr",
+		OriginTokenAddr: testOriginTokenAddr,
 		DestChainID:     42161,
-		DestTokenAddr:   "0xDestTokenAddr",
+		DestTokenAddr:   testDestTokenAddr,
 		DestAmount:      "100.0",
 		MaxOriginAmount: "200.0",
 		FixedFee:        "10.0",
@@ -0,0 +1,157 @@
+package rest
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/synapsecns/sanguine/services/rfq/api/db"
+	"github.com/synapsecns/sanguine/services/rfq/api/model"
+)
+
+// quoteSubscriptionBuffer is the number of quotes buffered per subscriber. Quotes are dropped for
+// subscribers that fall further behind than this.
+const quoteSubscriptionBuffer = 100
+
+// streamHeartbeatInterval is how often an idle stream is pinged so proxies don't close it.
+const streamHeartbeatInterval = 30 * time.Second
+
+// quoteFilter matches quotes on a route. Zero values match anything.
+type quoteFilter struct {
+	originChainID   int
+	originTokenAddr string
+	destChainID     int
+	destTokenAddr   string
+}
+
+func (f quoteFilter) matches(quote *model.GetQuoteResponse) bool {
+	if f.originChainID != 0 && f.originChainID != quote.OriginChainID {
+		return false
+	}
+	if f.originTokenAddr != "" && !strings.EqualFold(f.originTokenAddr, quote.OriginTokenAddr) {
+		return false
+	}
+	if f.destChainID != 0 && f.destChainID != quote.DestChainID {
+		return false
+	}
+	if f.destTokenAddr != "" && !strings.EqualFold(f.destTokenAddr, quote.DestTokenAddr) {
+		return false
+	}
+	return true
+}
+
+type quoteSubscription struct {
+	filter quoteFilter
+	quotes chan *model.GetQuoteResponse
+}
+
+// quoteBroadcaster fans out quote upserts and deletions to subscribers.
+type quoteBroadcaster struct {
+	mux           sync.RWMutex
+	subscriptions map[*quoteSubscription]struct{}
+}
+
+func newQuoteBroadcaster() *quoteBroadcaster {
+	return &quoteBroadcaster{
+		subscriptions: make(map[*quoteSubscription]struct{}),
+	}
+}
+
+func (b *quoteBroadcaster) subscribe(filter quoteFilter) *quoteSubscription {
+	sub := &quoteSubscription{
+		filter: filter,
+		quotes: make(chan *model.GetQuoteResponse, quoteSubscriptionBuffer),
+	}
+
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	b.subscriptions[sub] = struct{}{}
+	return sub
+}
+
+func (b *quoteBroadcaster) unsubscribe(sub *quoteSubscription) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	delete(b.subscriptions, sub)
+}
+
+// publishDeleted sends withdrawn or expired quotes to matching subscribers.
+func (b *quoteBroadcaster) publishDeleted(quotes []*db.Quote) {
+	for _, dbQuote := range quotes {
+		quote := model.QuoteResponseFromDbQuote(dbQuote)
+		quote.Deleted = true
+		b.publish(quote)
+	}
+}
+
+// publish sends a quote to every matching subscriber without blocking on slow ones.
+func (b *quoteBroadcaster) publish(quote *model.GetQuoteResponse) {
+	b.mux.RLock()
+	defer b.mux.RUnlock()
+
+	for sub := range b.subscriptions {
+		if !sub.filter.matches(quote) {
+			continue
+		}
+		select {
+		case sub.quotes <- quote:
+		default:
+			logger.Warnf("dropping quote for slow subscriber")
+		}
+	}
+}
+
+// StreamQuotes streams quote upserts as server sent "quote" events and withdrawn or expired quotes as "delete" events.
+// GET /quotes/stream?originChainId=&originTokenAddr=&destChainId=&destTokenAddr=.
+func (h *Handler) StreamQuotes(c *gin.Context) {
+	var filter quoteFilter
+	var err error
+	if originChainIDStr := c.Query("originChainId"); originChainIDStr != "" {
+		filter.originChainID, err = strconv.Atoi(originChainIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid originChainId"})
+			return
+		}
+	}
+	if destChainIDStr := c.Query("destChainId"); destChainIDStr != "" {
+		filter.destChainID, err = strconv.Atoi(destChainIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid destChainId"})
+			return
+		}
+	}
+	filter.originTokenAddr = c.Query("originTokenAddr")
+	filter.destTokenAddr = c.Query("destTokenAddr")
+
+	sub := h.quotes.subscribe(filter)
+	defer h.quotes.unsubscribe(sub)
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	// send the headers right away so clients know they're subscribed before the first event.
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case quote := <-sub.quotes:
+			if quote.Deleted {
+				c.SSEvent("delete", quote)
+			} else {
+				c.SSEvent("quote", quote)
+			}
+		case <-heartbeat.C:
+			c.SSEvent("ping", strconv.FormatInt(time.Now().Unix(), 10))
+		}
+		return true
+	})
+}
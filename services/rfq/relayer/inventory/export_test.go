@@ -0,0 +1,66 @@
+package inventory
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/relconfig"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb"
+)
+
+// TestToken is a token balance on a single chain used to test rebalance planning.
+type TestToken struct {
+	ChainID  int
+	Decimals uint8
+	Balance  *big.Int
+	Cfg      relconfig.TokenConfig
+}
+
+// ComputeRebalance exports computeRebalance for testing.
+// ok is false if no rebalance is needed.
+func ComputeRebalance(tokens []TestToken) (originChain, destChain int, originAmount, destAmount *big.Int, ok bool) {
+	rebalanceTokens := make([]rebalanceToken, len(tokens))
+	for i, token := range tokens {
+		rebalanceTokens[i] = rebalanceToken{
+			chainID:  token.ChainID,
+			decimals: token.Decimals,
+			balance:  token.Balance,
+			cfg:      token.Cfg,
+		}
+	}
+
+	plan := computeRebalance(rebalanceTokens)
+	if plan == nil {
+		return 0, 0, nil, nil, false
+	}
+	return plan.origin.chainID, plan.dest.chainID, plan.originAmount, plan.destAmount, true
+}
+
+// TrackRebalance exports trackRebalance for testing with the given rebalance methods.
+func TrackRebalance(ctx context.Context, db reldb.Service, txSubmitter submitter.TransactionSubmitter, clientFetcher submitter.ClientFetcher, methods map[string]RebalanceMethod, rebalance reldb.Rebalance) error {
+	r := &rebalancerImpl{
+		handler:       metrics.NewNullHandler(),
+		db:            db,
+		submitter:     txSubmitter,
+		clientFetcher: clientFetcher,
+		methods:       methods,
+	}
+	return r.trackRebalance(ctx, rebalance)
+}
+
+// RebalanceBalances exports rebalanceTokens for testing, returning token name -> chain id -> balance.
+func RebalanceBalances(cfg relconfig.Config, balances map[int]map[common.Address]*big.Int, inFlight []reldb.Rebalance) map[string]map[int]*big.Int {
+	r := &rebalancerImpl{cfg: cfg}
+	res := make(map[string]map[int]*big.Int)
+	for tokenName, tokens := range r.rebalanceTokens(balances, inFlight) {
+		res[tokenName] = make(map[int]*big.Int)
+		for _, token := range tokens {
+			res[tokenName][token.chainID] = token.balance
+		}
+	}
+	return res
+}
@@ -92,6 +92,12 @@ func (i *inventoryManagerImpl) GetCommittableBalances(ctx context.Context, optio
 		return nil, fmt.Errorf("could not get in flight quotes: %w", err)
 	}
 
+	// funds committed to a rebalance are still in our on-chain balance until the origin tx lands
+	outgoingRebalances, err := i.db.GetRebalancesByStatus(ctx, reldb.OutgoingRebalanceStatuses...)
+	if err != nil {
+		return nil, fmt.Errorf("could not get outgoing rebalances: %w", err)
+	}
+
 	// TODO: lock should be context aware
 	i.mux.RLock()
 	defer i.mux.RUnlock()
@@ -108,6 +114,11 @@ func (i *inventoryManagerImpl) GetCommittableBalances(ctx context.Context, optio
 					res[chainID][address] = new(big.Int).Sub(res[chainID][address], quote.Transaction.DestAmount)
 				}
 			}
+			for _, rebalance := range outgoingRebalances {
+				if rebalance.OriginToken == address && rebalance.OriginChainID == uint32(chainID) {
+					res[chainID][address] = new(big.Int).Sub(res[chainID][address], rebalance.OriginAmount)
+				}
+			}
 		}
 	}
 
@@ -0,0 +1,492 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/chain"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/relconfig"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Rebalancer moves inventory between chains to keep each chain's share of a token within its configured range.
+type Rebalancer interface {
+	// Start starts the rebalancer. Rebalance errors are logged rather than returned so the relayer keeps running.
+	Start(ctx context.Context) error
+}
+
+// RebalanceMethod moves inventory from one chain to another.
+type RebalanceMethod interface {
+	// DestAmount returns the amount expected on the destination for an origin amount already scaled
+	// to destination decimals, net of any fees the method charges.
+	DestAmount(amount *big.Int) *big.Int
+	// Execute starts a rebalance and returns it with its status updated.
+	Execute(ctx context.Context, rebalance reldb.Rebalance) (reldb.Rebalance, error)
+	// Track checks a rebalance whose origin tx is confirmed and returns it marked completed once the funds arrive.
+	Track(ctx context.Context, rebalance reldb.Rebalance) (reldb.Rebalance, error)
+}
+
+// CommittedMethod is a RebalanceMethod whose funds can't be reclaimed once they leave the origin chain.
+type CommittedMethod interface {
+	RebalanceMethod
+	// Committed returns true once the funds of the rebalance have left the origin chain. Committed
+	// rebalances stay in flight past the rebalance timeout until the funds arrive.
+	Committed(rebalance reldb.Rebalance) bool
+}
+
+// RefundableMethod is a RebalanceMethod whose funds can be reclaimed on the origin chain if they never arrive.
+type RefundableMethod interface {
+	RebalanceMethod
+	// Refund reclaims the funds of a rebalance that timed out. It returns the rebalance marked failed once the
+	// refund has landed, or completed if the funds arrived after all.
+	Refund(ctx context.Context, rebalance reldb.Rebalance) (reldb.Rebalance, error)
+}
+
+type rebalancerImpl struct {
+	// cfg is the config
+	cfg relconfig.Config
+	// handler is the metrics handler
+	handler metrics.Handler
+	// inventory is the inventory manager
+	inventory Manager
+	// db is the relayer db
+	db reldb.Service
+	// submitter is used to check the status of origin transactions
+	submitter submitter.TransactionSubmitter
+	// clientFetcher is used to check the receipts of origin transactions
+	clientFetcher submitter.ClientFetcher
+	// methods maps method name -> rebalance method
+	methods map[string]RebalanceMethod
+}
+
+// NewRebalancer creates a new rebalancer.
+func NewRebalancer(cfg relconfig.Config, handler metrics.Handler, clientFetcher submitter.ClientFetcher, inventory Manager, db reldb.Service, txSubmitter submitter.TransactionSubmitter, relayer common.Address) Rebalancer {
+	return &rebalancerImpl{
+		cfg:           cfg,
+		handler:       handler,
+		inventory:     inventory,
+		db:            db,
+		submitter:     txSubmitter,
+		clientFetcher: clientFetcher,
+		methods: map[string]RebalanceMethod{
+			relconfig.RebalanceMethodFastBridge: newFastBridgeMethod(cfg, clientFetcher, txSubmitter, relayer),
+			relconfig.RebalanceMethodCCTP:       newCCTPMethod(cfg, handler, clientFetcher, txSubmitter, relayer),
+			relconfig.RebalanceMethodManual:     manualMethod{},
+		},
+	}
+}
+
+func (r *rebalancerImpl) Start(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(r.cfg.GetRebalanceInterval()):
+			// track first so funds that arrived are no longer counted as in flight.
+			err := r.trackRebalances(ctx)
+			if err != nil {
+				logger.Errorf("could not track rebalances: %v", err)
+			}
+
+			err = r.planRebalances(ctx)
+			if err != nil {
+				logger.Errorf("could not plan rebalances: %v", err)
+			}
+		}
+	}
+}
+
+// trackRebalances moves each in flight rebalance forward.
+func (r *rebalancerImpl) trackRebalances(parentCtx context.Context) (err error) {
+	ctx, span := r.handler.Tracer().Start(parentCtx, "trackRebalances")
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	rebalances, err := r.db.GetRebalancesByStatus(ctx, reldb.InFlightRebalanceStatuses...)
+	if err != nil {
+		return fmt.Errorf("could not get in flight rebalances: %w", err)
+	}
+
+	for _, rebalance := range rebalances {
+		err = r.trackRebalance(ctx, rebalance)
+		if err != nil {
+			logger.Warnf("could not track rebalance %s: %v", rebalance.ID, err)
+		}
+	}
+	return nil
+}
+
+// trackRebalance moves a rebalance to its next status if possible.
+func (r *rebalancerImpl) trackRebalance(parentCtx context.Context, rebalance reldb.Rebalance) (err error) {
+	ctx, span := r.handler.Tracer().Start(parentCtx, "trackRebalance", trace.WithAttributes(
+		attribute.String("rebalance_id", rebalance.ID),
+		attribute.String("method", rebalance.Method),
+		attribute.String("status", rebalance.Status.String()),
+	))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	method, ok := r.methods[rebalance.Method]
+	if !ok {
+		return fmt.Errorf("unknown rebalance method %s", rebalance.Method)
+	}
+
+	updated := rebalance
+	switch rebalance.Status {
+	case reldb.RebalanceRequested:
+		// mark the rebalance before submitting so it's never executed twice if the update after fails.
+		submitting := rebalance
+		submitting.Status = reldb.RebalanceSubmitting
+		err = r.db.UpdateRebalance(ctx, submitting)
+		if err != nil {
+			return fmt.Errorf("could not update rebalance: %w", err)
+		}
+		rebalance = submitting
+
+		updated, err = method.Execute(ctx, rebalance)
+		if err != nil {
+			// nothing was submitted, so the rebalance can be retried.
+			rebalance.Status = reldb.RebalanceRequested
+			if updateErr := r.db.UpdateRebalance(ctx, rebalance); updateErr != nil {
+				logger.Warnf("could not reset rebalance %s: %v", rebalance.ID, updateErr)
+			}
+			return fmt.Errorf("could not execute rebalance: %w", err)
+		}
+	case reldb.RebalanceSubmitting:
+		logger.Warnf("rebalance %s was interrupted while submitting, it needs to be resolved by an operator", rebalance.ID)
+		return nil
+	case reldb.RebalanceSubmitted:
+		var status submitter.SubmissionStatus
+		status, err = r.submitter.GetSubmissionStatus(ctx, big.NewInt(int64(rebalance.OriginChainID)), rebalance.OriginNonce)
+		if err != nil {
+			return fmt.Errorf("could not get submission status: %w", err)
+		}
+		switch status.State() {
+		case submitter.Reverted, submitter.Cancelled:
+			// the funds never left the origin chain
+			logger.Warnf("origin tx of rebalance %s was %s, marking as failed", rebalance.ID, status.State())
+			updated.Status = reldb.RebalanceFailed
+		case submitter.Confirmed:
+			var succeeded bool
+			succeeded, err = r.originTxSucceeded(ctx, rebalance.OriginChainID, status.TxHash())
+			if err != nil {
+				return err
+			}
+			updated.OriginTxHash = status.TxHash()
+			if succeeded {
+				updated.Status = reldb.RebalanceInFlight
+			} else {
+				logger.Warnf("origin tx %s of rebalance %s failed, marking as failed", status.TxHash(), rebalance.ID)
+				updated.Status = reldb.RebalanceFailed
+			}
+		default:
+			// still waiting for the origin tx to land
+		}
+	case reldb.RebalanceInFlight:
+		updated, err = method.Track(ctx, rebalance)
+		if err != nil {
+			return fmt.Errorf("could not track rebalance: %w", err)
+		}
+		if updated.Status == reldb.RebalanceInFlight && time.Since(rebalance.CreatedAt) > r.cfg.GetRebalanceTimeout() {
+			committed, isCommittable := method.(CommittedMethod)
+			_, isRefundable := method.(RefundableMethod)
+			switch {
+			case isCommittable && committed.Committed(updated):
+				// the funds already left the origin chain, so they can only arrive
+				logger.Warnf("rebalance %s did not arrive after %s, still waiting for it", rebalance.ID, r.cfg.GetRebalanceTimeout())
+			case isRefundable:
+				logger.Warnf("rebalance %s did not arrive after %s, refunding", rebalance.ID, r.cfg.GetRebalanceTimeout())
+				updated.Status = reldb.RebalanceRefunding
+			default:
+				logger.Warnf("rebalance %s did not arrive after %s, marking as failed", rebalance.ID, r.cfg.GetRebalanceTimeout())
+				updated.Status = reldb.RebalanceFailed
+			}
+		}
+	case reldb.RebalanceRefunding:
+		refundable, ok := method.(RefundableMethod)
+		if !ok {
+			return fmt.Errorf("rebalance method %s can not refund", rebalance.Method)
+		}
+		updated, err = refundable.Refund(ctx, rebalance)
+		if err != nil {
+			return fmt.Errorf("could not refund rebalance: %w", err)
+		}
+	case reldb.RebalancePendingApproval:
+		// waiting on an operator
+		return nil
+	}
+
+	if updated.Status == rebalance.Status && updated.TrackingID == rebalance.TrackingID && updated.DestNonce == rebalance.DestNonce &&
+		updated.RefundNonce == rebalance.RefundNonce {
+		return nil
+	}
+
+	span.SetAttributes(attribute.String("new_status", updated.Status.String()))
+	err = r.db.UpdateRebalance(ctx, updated)
+	if err != nil {
+		return fmt.Errorf("could not update rebalance: %w", err)
+	}
+	return nil
+}
+
+// originTxSucceeded checks whether a confirmed origin tx of a rebalance executed successfully.
+func (r *rebalancerImpl) originTxSucceeded(ctx context.Context, chainID uint32, txHash common.Hash) (bool, error) {
+	client, err := r.clientFetcher.GetClient(ctx, big.NewInt(int64(chainID)))
+	if err != nil {
+		return false, fmt.Errorf("could not get origin client: %w", err)
+	}
+
+	receipt, err := client.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return false, fmt.Errorf("could not get origin receipt: %w", err)
+	}
+	return receipt.Status == types.ReceiptStatusSuccessful, nil
+}
+
+// rebalanceToken is a token that can be rebalanced on a chain.
+type rebalanceToken struct {
+	chainID  int
+	address  common.Address
+	decimals uint8
+	// balance includes in flight rebalances
+	balance *big.Int
+	cfg     relconfig.TokenConfig
+}
+
+// rebalancePlan is a planned transfer between two chains.
+type rebalancePlan struct {
+	origin, dest rebalanceToken
+	// originAmount is the amount to send in origin decimals
+	originAmount *big.Int
+	// destAmount is the same amount in dest decimals, before method fees
+	destAmount *big.Int
+}
+
+// planRebalances starts at most one rebalance per token.
+func (r *rebalancerImpl) planRebalances(parentCtx context.Context) (err error) {
+	ctx, span := r.handler.Tracer().Start(parentCtx, "planRebalances")
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	// committable balances already exclude funds committed to outgoing rebalances
+	balances, err := r.inventory.GetCommittableBalances(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get balances: %w", err)
+	}
+
+	// count funds headed to a chain as already being there, so they aren't rebalanced twice
+	inFlight, err := r.db.GetRebalancesByStatus(ctx, reldb.InFlightRebalanceStatuses...)
+	if err != nil {
+		return fmt.Errorf("could not get in flight rebalances: %w", err)
+	}
+
+	for tokenName, tokens := range r.rebalanceTokens(balances, inFlight) {
+		plan := computeRebalance(tokens)
+		if plan == nil {
+			continue
+		}
+
+		err = r.startRebalance(ctx, tokenName, *plan)
+		if err != nil {
+			logger.Warnf("could not rebalance %s: %v", tokenName, err)
+		}
+	}
+	return nil
+}
+
+// rebalanceTokens groups tokens with a rebalance method by token name.
+func (r *rebalancerImpl) rebalanceTokens(balances map[int]map[common.Address]*big.Int, inFlight []reldb.Rebalance) map[string][]rebalanceToken {
+	tokens := make(map[string][]rebalanceToken)
+	for chainID, chainCfg := range r.cfg.GetChains() {
+		for tokenName, tokenCfg := range chainCfg.Tokens {
+			if tokenCfg.RebalanceMethod == "" {
+				continue
+			}
+
+			address := common.HexToAddress(tokenCfg.Address)
+			if tokenName == chainCfg.NativeToken {
+				address = chain.EthAddress
+			}
+
+			balance, ok := balances[chainID][address]
+			if !ok {
+				continue
+			}
+			balance = new(big.Int).Set(balance)
+
+			for _, rebalance := range inFlight {
+				// refunded funds return to the origin chain instead of arriving on the destination
+				if rebalance.Status == reldb.RebalanceRefunding {
+					if int(rebalance.OriginChainID) == chainID && rebalance.OriginToken == address {
+						balance.Add(balance, rebalance.OriginAmount)
+					}
+					continue
+				}
+				if int(rebalance.DestChainID) == chainID && rebalance.DestToken == address {
+					balance.Add(balance, rebalance.DestAmount)
+				}
+			}
+
+			tokens[tokenName] = append(tokens[tokenName], rebalanceToken{
+				chainID:  chainID,
+				address:  address,
+				decimals: tokenCfg.Decimals,
+				balance:  balance,
+				cfg:      tokenCfg,
+			})
+		}
+	}
+	return tokens
+}
+
+// computeRebalance plans a transfer from the chain furthest above its target to the chain furthest below it.
+// nil is returned if every chain is within its min and max balance percentages.
+func computeRebalance(tokens []rebalanceToken) *rebalancePlan {
+	if len(tokens) < 2 {
+		return nil
+	}
+
+	// sort so ties are broken the same way every time
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].chainID < tokens[j].chainID
+	})
+
+	// balances are compared in whole units since decimals can differ across chains
+	units := make([]*big.Float, len(tokens))
+	total := new(big.Float)
+	for i, token := range tokens {
+		units[i] = toUnits(token.balance, token.decimals)
+		total.Add(total, units[i])
+	}
+	if total.Sign() <= 0 {
+		return nil
+	}
+
+	needsRebalance := false
+	originIdx, destIdx := -1, -1
+	var maxSurplus, maxDeficit *big.Float
+	for i, token := range tokens {
+		pct, _ := new(big.Float).Quo(new(big.Float).Mul(units[i], big.NewFloat(100)), total).Float64()
+		if pct < token.cfg.MinBalancePct || (token.cfg.MaxBalancePct > 0 && pct > token.cfg.MaxBalancePct) {
+			needsRebalance = true
+		}
+
+		// diff is how far the chain is above (positive) or below (negative) its target, in units
+		target := new(big.Float).Quo(new(big.Float).Mul(total, big.NewFloat(token.cfg.TargetBalancePct)), big.NewFloat(100))
+		diff := new(big.Float).Sub(units[i], target)
+		if diff.Sign() > 0 && (maxSurplus == nil || diff.Cmp(maxSurplus) > 0) {
+			originIdx, maxSurplus = i, diff
+		}
+		if diff.Sign() < 0 && (maxDeficit == nil || diff.Cmp(maxDeficit) < 0) {
+			destIdx, maxDeficit = i, diff
+		}
+	}
+
+	if !needsRebalance || originIdx == -1 || destIdx == -1 {
+		return nil
+	}
+
+	// move the smaller of the surplus and the deficit so neither chain overshoots its target
+	amount := new(big.Float).Neg(maxDeficit)
+	if maxSurplus.Cmp(amount) < 0 {
+		amount = maxSurplus
+	}
+
+	origin, dest := tokens[originIdx], tokens[destIdx]
+	plan := &rebalancePlan{
+		origin:       origin,
+		dest:         dest,
+		originAmount: fromUnits(amount, origin.decimals),
+		destAmount:   fromUnits(amount, dest.decimals),
+	}
+	if plan.originAmount.Sign() <= 0 || plan.destAmount.Sign() <= 0 {
+		return nil
+	}
+	return plan
+}
+
+// startRebalance records a planned rebalance and executes it.
+// The rebalance is stored before executing so the funds count as in flight even if execution fails.
+func (r *rebalancerImpl) startRebalance(parentCtx context.Context, tokenName string, plan rebalancePlan) (err error) {
+	methodName := plan.origin.cfg.RebalanceMethod
+	ctx, span := r.handler.Tracer().Start(parentCtx, "startRebalance", trace.WithAttributes(
+		attribute.String("token", tokenName),
+		attribute.String("method", methodName),
+		attribute.Int(metrics.Origin, plan.origin.chainID),
+		attribute.Int(metrics.Destination, plan.dest.chainID),
+		attribute.String("origin_amount", plan.originAmount.String()),
+	))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	method, ok := r.methods[methodName]
+	if !ok {
+		return fmt.Errorf("unknown rebalance method %s", methodName)
+	}
+
+	rebalance := reldb.Rebalance{
+		ID:            uuid.New().String(),
+		Method:        methodName,
+		OriginChainID: uint32(plan.origin.chainID),
+		DestChainID:   uint32(plan.dest.chainID),
+		OriginToken:   plan.origin.address,
+		DestToken:     plan.dest.address,
+		OriginAmount:  plan.originAmount,
+		DestAmount:    method.DestAmount(plan.destAmount),
+		Status:        reldb.RebalanceRequested,
+		CreatedAt:     time.Now(),
+	}
+
+	err = r.db.StoreRebalance(ctx, rebalance)
+	if err != nil {
+		return fmt.Errorf("could not store rebalance: %w", err)
+	}
+
+	return r.trackRebalance(ctx, rebalance)
+}
+
+// toUnits converts a token amount to whole units.
+func toUnits(amount *big.Int, decimals uint8) *big.Float {
+	factor := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	return new(big.Float).Quo(new(big.Float).SetInt(amount), factor)
+}
+
+// fromUnits converts whole units to a token amount, rounding down.
+func fromUnits(units *big.Float, decimals uint8) *big.Int {
+	factor := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	amount, _ := new(big.Float).Mul(units, factor).Int(nil)
+	return amount
+}
+
+// manualMethod queues rebalances for an operator, who moves the funds by hand and then marks
+// the rebalance as completed (or rejects it) through the relayer api.
+type manualMethod struct{}
+
+func (m manualMethod) DestAmount(amount *big.Int) *big.Int {
+	return amount
+}
+
+func (m manualMethod) Execute(_ context.Context, rebalance reldb.Rebalance) (reldb.Rebalance, error) {
+	logger.Warnf("rebalance %s needs approval: move %s of %s from chain %d to chain %d", rebalance.ID,
+		rebalance.OriginAmount, rebalance.OriginToken, rebalance.OriginChainID, rebalance.DestChainID)
+	rebalance.Status = reldb.RebalancePendingApproval
+	return rebalance, nil
+}
+
+func (m manualMethod) Track(_ context.Context, rebalance reldb.Rebalance) (reldb.Rebalance, error) {
+	return rebalance, nil
+}
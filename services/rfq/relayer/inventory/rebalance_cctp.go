@@ -0,0 +1,351 @@
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dubonzi/otelresty"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-resty/resty/v2"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/services/rfq/contracts/ierc20"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/relconfig"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb"
+)
+
+// cctpABI is the subset of the cctp TokenMessenger and MessageTransmitter used to rebalance.
+const cctpABI = `[
+	{"inputs":[{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"uint32","name":"destinationDomain","type":"uint32"},{"internalType":"bytes32","name":"mintRecipient","type":"bytes32"},{"internalType":"address","name":"burnToken","type":"address"}],"name":"depositForBurn","outputs":[{"internalType":"uint64","name":"_nonce","type":"uint64"}],"stateMutability":"nonpayable","type":"function"},
+	{"inputs":[{"internalType":"bytes","name":"message","type":"bytes"},{"internalType":"bytes","name":"attestation","type":"bytes"}],"name":"receiveMessage","outputs":[{"internalType":"bool","name":"success","type":"bool"}],"stateMutability":"nonpayable","type":"function"},
+	{"inputs":[{"internalType":"bytes32","name":"","type":"bytes32"}],"name":"usedNonces","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+	{"anonymous":false,"inputs":[{"indexed":false,"internalType":"bytes","name":"message","type":"bytes"}],"name":"MessageSent","type":"event"}
+]`
+
+// cctpMethod rebalances by burning through the cctp TokenMessenger and minting on the destination
+// once circle has attested to the burn.
+type cctpMethod struct {
+	cfg           relconfig.Config
+	clientFetcher submitter.ClientFetcher
+	submitter     submitter.TransactionSubmitter
+	relayer       common.Address
+	client        *resty.Client
+	// abi is the parsed cctp abi
+	abi abi.ABI
+	// abiErr is set if the abi could not be parsed
+	abiErr error
+	// approvals maps chain id -> token -> nonce of the last approval submitted for the token messenger
+	approvals map[uint32]map[common.Address]uint64
+	// approvalMux protects approvals
+	approvalMux sync.Mutex
+}
+
+func newCCTPMethod(cfg relconfig.Config, handler metrics.Handler, clientFetcher submitter.ClientFetcher, txSubmitter submitter.TransactionSubmitter, relayer common.Address) *cctpMethod {
+	client := resty.New().SetTimeout(time.Second * 10)
+	otelresty.TraceClient(client, otelresty.WithTracerProvider(handler.GetTracerProvider()))
+
+	parsedABI, err := abi.JSON(strings.NewReader(cctpABI))
+	return &cctpMethod{
+		cfg:           cfg,
+		clientFetcher: clientFetcher,
+		submitter:     txSubmitter,
+		relayer:       relayer,
+		client:        client,
+		abi:           parsedABI,
+		abiErr:        err,
+		approvals:     make(map[uint32]map[common.Address]uint64),
+	}
+}
+
+func (c *cctpMethod) DestAmount(amount *big.Int) *big.Int {
+	return amount
+}
+
+func (c *cctpMethod) Execute(ctx context.Context, rebalance reldb.Rebalance) (reldb.Rebalance, error) {
+	if c.abiErr != nil {
+		return rebalance, fmt.Errorf("could not parse cctp abi: %w", c.abiErr)
+	}
+
+	originCfg, ok := c.cfg.Chains[int(rebalance.OriginChainID)]
+	if !ok || originCfg.TokenMessenger == "" {
+		return rebalance, fmt.Errorf("no token messenger configured for chain %d", rebalance.OriginChainID)
+	}
+	destCfg, ok := c.cfg.Chains[int(rebalance.DestChainID)]
+	if !ok || destCfg.MessageTransmitter == "" {
+		return rebalance, fmt.Errorf("no message transmitter configured for chain %d", rebalance.DestChainID)
+	}
+
+	client, err := c.clientFetcher.GetClient(ctx, big.NewInt(int64(rebalance.OriginChainID)))
+	if err != nil {
+		return rebalance, fmt.Errorf("could not get origin client: %w", err)
+	}
+
+	tokenMessenger := common.HexToAddress(originCfg.TokenMessenger)
+	erc20, err := ierc20.NewIERC20(rebalance.OriginToken, client)
+	if err != nil {
+		return rebalance, fmt.Errorf("could not get erc20: %w", err)
+	}
+
+	allowance, err := erc20.Allowance(&bind.CallOpts{Context: ctx}, c.relayer, tokenMessenger)
+	if err != nil {
+		return rebalance, fmt.Errorf("could not get allowance: %w", err)
+	}
+
+	// the approval is submitted first, so the submitter nonce ordering lands it before the burn
+	if allowance.Cmp(rebalance.OriginAmount) < 0 {
+		err = c.approve(ctx, rebalance.OriginChainID, erc20, rebalance.OriginToken, tokenMessenger)
+		if err != nil {
+			return rebalance, err
+		}
+	}
+
+	messenger := bind.NewBoundContract(tokenMessenger, c.abi, client, client, client)
+	nonce, err := c.submitter.SubmitTransaction(ctx, big.NewInt(int64(rebalance.OriginChainID)), func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		tx, err = messenger.Transact(transactor, "depositForBurn", rebalance.OriginAmount, destCfg.CCTPDomain, common.BytesToHash(c.relayer.Bytes()), rebalance.OriginToken)
+		if err != nil {
+			return nil, fmt.Errorf("could not burn: %w", err)
+		}
+		return tx, nil
+	})
+	if err != nil {
+		return rebalance, fmt.Errorf("could not submit burn: %w", err)
+	}
+
+	rebalance.OriginNonce = nonce
+	rebalance.Status = reldb.RebalanceSubmitted
+	return rebalance, nil
+}
+
+// Track gets the burn message from the origin receipt, submits it along with circle's attestation
+// to the destination, and completes the rebalance once the message has been received.
+func (c *cctpMethod) Track(ctx context.Context, rebalance reldb.Rebalance) (reldb.Rebalance, error) {
+	if c.abiErr != nil {
+		return rebalance, fmt.Errorf("could not parse cctp abi: %w", c.abiErr)
+	}
+
+	if rebalance.TrackingID == "" {
+		message, err := c.getMessage(ctx, rebalance)
+		if err != nil {
+			return rebalance, err
+		}
+		rebalance.TrackingID = hexutil.Encode(message)
+	}
+
+	message, err := hexutil.Decode(rebalance.TrackingID)
+	if err != nil {
+		return rebalance, fmt.Errorf("could not decode message: %w", err)
+	}
+
+	client, err := c.clientFetcher.GetClient(ctx, big.NewInt(int64(rebalance.DestChainID)))
+	if err != nil {
+		return rebalance, fmt.Errorf("could not get dest client: %w", err)
+	}
+	transmitter := bind.NewBoundContract(common.HexToAddress(c.cfg.Chains[int(rebalance.DestChainID)].MessageTransmitter), c.abi, client, client, client)
+
+	// the mint status is checked before the message so a mint landing in between isn't submitted again.
+	var mintStatus submitter.SubmissionStatus
+	if rebalance.DestNonce != nil {
+		mintStatus, err = c.submitter.GetSubmissionStatus(ctx, big.NewInt(int64(rebalance.DestChainID)), *rebalance.DestNonce)
+		if err != nil {
+			return rebalance, fmt.Errorf("could not get mint status: %w", err)
+		}
+	}
+
+	received, err := c.isReceived(ctx, transmitter, message)
+	if err != nil {
+		return rebalance, err
+	}
+	if received {
+		rebalance.Status = reldb.RebalanceCompleted
+		return rebalance, nil
+	}
+
+	if rebalance.DestNonce != nil {
+		switch mintStatus.State() {
+		case submitter.Reverted, submitter.Cancelled, submitter.Confirmed:
+			// the mint landed without receiving the message or never will, try again
+			logger.Warnf("mint of rebalance %s did not receive the message (%s), resubmitting", rebalance.ID, mintStatus.State())
+			rebalance.DestNonce = nil
+		default:
+			// the mint has already been submitted, wait for it to land.
+			return rebalance, nil
+		}
+	}
+
+	attestation, ready, err := c.getAttestation(ctx, message)
+	if err != nil || !ready {
+		return rebalance, err
+	}
+
+	nonce, err := c.submitter.SubmitTransaction(ctx, big.NewInt(int64(rebalance.DestChainID)), func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		tx, err = transmitter.Transact(transactor, "receiveMessage", message, attestation)
+		if err != nil {
+			return nil, fmt.Errorf("could not receive message: %w", err)
+		}
+		return tx, nil
+	})
+	if err != nil {
+		return rebalance, fmt.Errorf("could not submit receive message: %w", err)
+	}
+
+	rebalance.DestNonce = &nonce
+	return rebalance, nil
+}
+
+// Committed returns true once the burn has landed, since burnt funds can only arrive by minting them.
+func (c *cctpMethod) Committed(rebalance reldb.Rebalance) bool {
+	return rebalance.TrackingID != ""
+}
+
+// approve approves the token messenger to burn the token. No approval is submitted while a previous one
+// for the token can still land.
+func (c *cctpMethod) approve(ctx context.Context, chainID uint32, erc20 *ierc20.IERC20, token, tokenMessenger common.Address) error {
+	c.approvalMux.Lock()
+	defer c.approvalMux.Unlock()
+
+	if nonce, ok := c.approvals[chainID][token]; ok {
+		status, err := c.submitter.GetSubmissionStatus(ctx, big.NewInt(int64(chainID)), nonce)
+		if err != nil {
+			return fmt.Errorf("could not get approval status: %w", err)
+		}
+		switch status.State() {
+		case submitter.Reverted, submitter.Cancelled, submitter.Confirmed:
+			// the approval won't change the allowance anymore
+		default:
+			return nil
+		}
+	}
+
+	nonce, err := c.submitter.SubmitTransaction(ctx, big.NewInt(int64(chainID)), func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		tx, err = erc20.Approve(transactor, tokenMessenger, abi.MaxInt256)
+		if err != nil {
+			return nil, fmt.Errorf("could not approve: %w", err)
+		}
+		return tx, nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not submit approval: %w", err)
+	}
+
+	if c.approvals[chainID] == nil {
+		c.approvals[chainID] = make(map[common.Address]uint64)
+	}
+	c.approvals[chainID][token] = nonce
+	return nil
+}
+
+// getMessage gets the cctp message from the MessageSent log of the origin tx.
+func (c *cctpMethod) getMessage(ctx context.Context, rebalance reldb.Rebalance) ([]byte, error) {
+	client, err := c.clientFetcher.GetClient(ctx, big.NewInt(int64(rebalance.OriginChainID)))
+	if err != nil {
+		return nil, fmt.Errorf("could not get origin client: %w", err)
+	}
+
+	receipt, err := client.TransactionReceipt(ctx, rebalance.OriginTxHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not get receipt: %w", err)
+	}
+
+	messageSent := c.abi.Events["MessageSent"]
+	for _, log := range receipt.Logs {
+		if len(log.Topics) == 0 || log.Topics[0] != messageSent.ID {
+			continue
+		}
+
+		unpacked, err := messageSent.Inputs.Unpack(log.Data)
+		if err != nil {
+			return nil, fmt.Errorf("could not unpack message: %w", err)
+		}
+
+		message, ok := unpacked[0].([]byte)
+		if !ok {
+			return nil, fmt.Errorf("unexpected message type %T", unpacked[0])
+		}
+		return message, nil
+	}
+	return nil, fmt.Errorf("no cctp message in tx %s", rebalance.OriginTxHash)
+}
+
+// cctp messages start with version (4 bytes), source domain (4 bytes), destination domain (4 bytes) and nonce (8 bytes).
+const (
+	sourceDomainStart = 4
+	sourceDomainEnd   = 8
+	nonceStart        = 12
+	nonceEnd          = 20
+)
+
+// isReceived checks if the message transmitter has used the message's nonce.
+func (c *cctpMethod) isReceived(ctx context.Context, transmitter *bind.BoundContract, message []byte) (bool, error) {
+	if len(message) < nonceEnd {
+		return false, fmt.Errorf("invalid cctp message of length %d", len(message))
+	}
+
+	// the used nonce key is keccak256(abi.encodePacked(sourceDomain, nonce))
+	key := crypto.Keccak256Hash(message[sourceDomainStart:sourceDomainEnd], message[nonceStart:nonceEnd])
+
+	var out []interface{}
+	err := transmitter.Call(&bind.CallOpts{Context: ctx}, &out, "usedNonces", key)
+	if err != nil {
+		return false, fmt.Errorf("could not check used nonces: %w", err)
+	}
+
+	used, ok := out[0].(*big.Int)
+	if !ok {
+		return false, fmt.Errorf("unexpected used nonces type %T", out[0])
+	}
+	return used.Sign() != 0, nil
+}
+
+// attestationResponse is the response from the circle attestation api.
+type attestationResponse struct {
+	Attestation string `json:"attestation"`
+	Status      string `json:"status"`
+}
+
+const attestationComplete = "complete"
+
+// getAttestation gets circle's attestation for a message. ready is false if it hasn't been attested yet.
+func (c *cctpMethod) getAttestation(ctx context.Context, message []byte) (attestation []byte, ready bool, err error) {
+	messageHash := crypto.Keccak256Hash(message)
+	res, err := c.client.R().
+		SetContext(ctx).
+		Get(fmt.Sprintf("%s/attestations/%s", strings.TrimSuffix(c.cfg.GetCCTPAttestationURL(), "/"), messageHash))
+	if err != nil {
+		return nil, false, fmt.Errorf("could not get attestation: %w", err)
+	}
+
+	// circle returns a 404 until the burn is picked up
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if res.IsError() {
+		return nil, false, fmt.Errorf("could not get attestation: %s", res.Status())
+	}
+
+	var attestationRes attestationResponse
+	err = json.Unmarshal(res.Body(), &attestationRes)
+	if err != nil {
+		return nil, false, fmt.Errorf("could not decode attestation: %w", err)
+	}
+
+	if attestationRes.Status != attestationComplete {
+		return nil, false, nil
+	}
+
+	attestation, err = hexutil.Decode(attestationRes.Attestation)
+	if err != nil {
+		return nil, false, fmt.Errorf("could not decode attestation: %w", err)
+	}
+	return attestation, true, nil
+}
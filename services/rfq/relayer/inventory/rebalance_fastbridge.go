@@ -0,0 +1,260 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/core"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/services/rfq/contracts/fastbridge"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/chain"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/relconfig"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb"
+)
+
+// fastBridgeMethod rebalances by bridging to ourselves through the FastBridge. Another relayer fills
+// the request on the destination, the relayer never fills its own requests.
+type fastBridgeMethod struct {
+	cfg           relconfig.Config
+	clientFetcher submitter.ClientFetcher
+	submitter     submitter.TransactionSubmitter
+	relayer       common.Address
+}
+
+func newFastBridgeMethod(cfg relconfig.Config, clientFetcher submitter.ClientFetcher, txSubmitter submitter.TransactionSubmitter, relayer common.Address) *fastBridgeMethod {
+	return &fastBridgeMethod{
+		cfg:           cfg,
+		clientFetcher: clientFetcher,
+		submitter:     txSubmitter,
+		relayer:       relayer,
+	}
+}
+
+const bpsDenominator = 10000
+
+func (f *fastBridgeMethod) DestAmount(amount *big.Int) *big.Int {
+	fee := new(big.Int).Div(new(big.Int).Mul(amount, big.NewInt(int64(f.cfg.GetFastBridgeRebalanceFeeBps()))), big.NewInt(bpsDenominator))
+	return new(big.Int).Sub(amount, fee)
+}
+
+func (f *fastBridgeMethod) Execute(ctx context.Context, rebalance reldb.Rebalance) (reldb.Rebalance, error) {
+	bridge, err := f.getBridge(ctx, rebalance.OriginChainID)
+	if err != nil {
+		return rebalance, err
+	}
+
+	params := fastbridge.IFastBridgeBridgeParams{
+		DstChainId:   rebalance.DestChainID,
+		Sender:       f.relayer,
+		To:           f.relayer,
+		OriginToken:  rebalance.OriginToken,
+		DestToken:    rebalance.DestToken,
+		OriginAmount: rebalance.OriginAmount,
+		DestAmount:   rebalance.DestAmount,
+		SendChainGas: false,
+		Deadline:     big.NewInt(time.Now().Add(f.cfg.GetFastBridgeRebalanceDeadline()).Unix()),
+	}
+
+	nonce, err := f.submitter.SubmitTransaction(ctx, big.NewInt(int64(rebalance.OriginChainID)), func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		if rebalance.OriginToken == chain.EthAddress {
+			transactor.Value = core.CopyBigInt(rebalance.OriginAmount)
+		}
+
+		tx, err = bridge.Bridge(transactor, params)
+		if err != nil {
+			return nil, fmt.Errorf("could not bridge: %w", err)
+		}
+		return tx, nil
+	})
+	if err != nil {
+		return rebalance, fmt.Errorf("could not submit bridge: %w", err)
+	}
+
+	rebalance.OriginNonce = nonce
+	rebalance.Status = reldb.RebalanceSubmitted
+	return rebalance, nil
+}
+
+// Track finds the bridge transaction id from the origin receipt, then completes the rebalance once the
+// request has been relayed on the destination.
+func (f *fastBridgeMethod) Track(ctx context.Context, rebalance reldb.Rebalance) (reldb.Rebalance, error) {
+	if rebalance.TrackingID == "" {
+		request, err := f.getBridgeRequest(ctx, rebalance)
+		if err != nil {
+			return rebalance, err
+		}
+		rebalance.TrackingID = hexutil.Encode(request.TransactionId[:])
+	}
+
+	transactionID, err := hexutil.Decode(rebalance.TrackingID)
+	if err != nil {
+		return rebalance, fmt.Errorf("could not decode transaction id: %w", err)
+	}
+
+	bridge, err := f.getBridge(ctx, rebalance.DestChainID)
+	if err != nil {
+		return rebalance, err
+	}
+
+	relayed, err := bridge.BridgeRelays(&bind.CallOpts{Context: ctx}, common.BytesToHash(transactionID))
+	if err != nil {
+		return rebalance, fmt.Errorf("could not check relay: %w", err)
+	}
+
+	if relayed {
+		rebalance.Status = reldb.RebalanceCompleted
+	}
+	return rebalance, nil
+}
+
+// Refund claims the origin refund of a request that was never relayed. The request can still be relayed until
+// the prove period past its deadline has elapsed, so the destination is checked first.
+func (f *fastBridgeMethod) Refund(ctx context.Context, rebalance reldb.Rebalance) (reldb.Rebalance, error) {
+	rebalance, err := f.Track(ctx, rebalance)
+	if err != nil || rebalance.Status == reldb.RebalanceCompleted {
+		return rebalance, err
+	}
+
+	transactionID, err := hexutil.Decode(rebalance.TrackingID)
+	if err != nil {
+		return rebalance, fmt.Errorf("could not decode transaction id: %w", err)
+	}
+
+	bridge, err := f.getBridge(ctx, rebalance.OriginChainID)
+	if err != nil {
+		return rebalance, err
+	}
+
+	status, err := bridge.BridgeStatuses(&bind.CallOpts{Context: ctx}, common.BytesToHash(transactionID))
+	if err != nil {
+		return rebalance, fmt.Errorf("could not get bridge status: %w", err)
+	}
+
+	switch fastbridge.BridgeStatus(status) {
+	case fastbridge.REFUNDED:
+		// the funds are back in the origin balance
+		rebalance.Status = reldb.RebalanceFailed
+		return rebalance, nil
+	case fastbridge.REQUESTED:
+	default:
+		// a relayer proved a relay the destination does not show yet, wait for it to land
+		return rebalance, nil
+	}
+
+	if rebalance.RefundNonce != nil {
+		var submissionStatus submitter.SubmissionStatus
+		submissionStatus, err = f.submitter.GetSubmissionStatus(ctx, big.NewInt(int64(rebalance.OriginChainID)), *rebalance.RefundNonce)
+		if err != nil {
+			return rebalance, fmt.Errorf("could not get refund status: %w", err)
+		}
+		if submissionStatus.State() != submitter.Reverted && submissionStatus.State() != submitter.Cancelled {
+			return rebalance, nil
+		}
+		// the refund never landed, try again
+		rebalance.RefundNonce = nil
+	}
+
+	request, err := f.getBridgeRequest(ctx, rebalance)
+	if err != nil {
+		return rebalance, err
+	}
+
+	refundable, err := f.isRefundable(ctx, bridge, rebalance.OriginChainID, request.Request)
+	if err != nil || !refundable {
+		return rebalance, err
+	}
+
+	nonce, err := f.submitter.SubmitTransaction(ctx, big.NewInt(int64(rebalance.OriginChainID)), func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		tx, err = bridge.Refund(transactor, request.Request)
+		if err != nil {
+			return nil, fmt.Errorf("could not refund: %w", err)
+		}
+		return tx, nil
+	})
+	if err != nil {
+		return rebalance, fmt.Errorf("could not submit refund: %w", err)
+	}
+
+	rebalance.RefundNonce = &nonce
+	return rebalance, nil
+}
+
+// isRefundable checks whether the prove period past the request deadline has elapsed on the origin chain.
+func (f *fastBridgeMethod) isRefundable(ctx context.Context, bridge *fastbridge.FastBridgeRef, chainID uint32, request []byte) (bool, error) {
+	bridgeTx, err := bridge.GetBridgeTransaction(&bind.CallOpts{Context: ctx}, request)
+	if err != nil {
+		return false, fmt.Errorf("could not decode bridge request: %w", err)
+	}
+
+	provePeriod, err := bridge.PROVEPERIOD(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return false, fmt.Errorf("could not get prove period: %w", err)
+	}
+
+	client, err := f.clientFetcher.GetClient(ctx, big.NewInt(int64(chainID)))
+	if err != nil {
+		return false, fmt.Errorf("could not get origin client: %w", err)
+	}
+
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("could not get latest header: %w", err)
+	}
+
+	refundableAt := new(big.Int).Add(bridgeTx.Deadline, provePeriod)
+	return new(big.Int).SetUint64(header.Time).Cmp(refundableAt) > 0, nil
+}
+
+// getBridgeRequest gets the BridgeRequested log of the origin tx.
+func (f *fastBridgeMethod) getBridgeRequest(ctx context.Context, rebalance reldb.Rebalance) (*fastbridge.FastBridgeBridgeRequested, error) {
+	client, err := f.clientFetcher.GetClient(ctx, big.NewInt(int64(rebalance.OriginChainID)))
+	if err != nil {
+		return nil, fmt.Errorf("could not get origin client: %w", err)
+	}
+
+	receipt, err := client.TransactionReceipt(ctx, rebalance.OriginTxHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not get receipt: %w", err)
+	}
+
+	parser, err := fastbridge.NewParser(common.HexToAddress(f.cfg.Chains[int(rebalance.OriginChainID)].Bridge))
+	if err != nil {
+		return nil, fmt.Errorf("could not get parser: %w", err)
+	}
+
+	for _, log := range receipt.Logs {
+		_, parsedEvent, ok := parser.ParseEvent(*log)
+		if !ok {
+			continue
+		}
+
+		if event, ok := parsedEvent.(*fastbridge.FastBridgeBridgeRequested); ok {
+			return event, nil
+		}
+	}
+	return nil, fmt.Errorf("no bridge request in tx %s", rebalance.OriginTxHash)
+}
+
+func (f *fastBridgeMethod) getBridge(ctx context.Context, chainID uint32) (*fastbridge.FastBridgeRef, error) {
+	chainCfg, ok := f.cfg.Chains[int(chainID)]
+	if !ok {
+		return nil, fmt.Errorf("no chain config for chain %d", chainID)
+	}
+
+	client, err := f.clientFetcher.GetClient(ctx, big.NewInt(int64(chainID)))
+	if err != nil {
+		return nil, fmt.Errorf("could not get client for chain %d: %w", chainID, err)
+	}
+
+	bridge, err := fastbridge.NewFastBridgeRef(common.HexToAddress(chainCfg.Bridge), client)
+	if err != nil {
+		return nil, fmt.Errorf("could not get bridge on chain %d: %w", chainID, err)
+	}
+	return bridge, nil
+}
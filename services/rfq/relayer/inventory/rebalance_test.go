@@ -0,0 +1,355 @@
+package inventory_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Flaque/filet"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/core/testsuite"
+	clientMocks "github.com/synapsecns/sanguine/ethergo/client/mocks"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	fetcherMocks "github.com/synapsecns/sanguine/ethergo/submitter/mocks"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/inventory"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/relconfig"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb/sqlite"
+)
+
+func usdc(amount int64, decimals uint8) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+}
+
+func TestComputeRebalance(t *testing.T) {
+	halfCfg := relconfig.TokenConfig{TargetBalancePct: 50, MinBalancePct: 20}
+
+	t.Run("within range", func(t *testing.T) {
+		_, _, _, _, ok := inventory.ComputeRebalance([]inventory.TestToken{
+			{ChainID: 1, Decimals: 6, Balance: usdc(600, 6), Cfg: halfCfg},
+			{ChainID: 2, Decimals: 6, Balance: usdc(400, 6), Cfg: halfCfg},
+		})
+		assert.False(t, ok)
+	})
+
+	t.Run("below min", func(t *testing.T) {
+		origin, dest, originAmount, destAmount, ok := inventory.ComputeRebalance([]inventory.TestToken{
+			{ChainID: 2, Decimals: 6, Balance: usdc(100, 6), Cfg: halfCfg},
+			{ChainID: 1, Decimals: 6, Balance: usdc(900, 6), Cfg: halfCfg},
+		})
+		assert.True(t, ok)
+		assert.Equal(t, 1, origin)
+		assert.Equal(t, 2, dest)
+		assert.Equal(t, usdc(400, 6), originAmount)
+		assert.Equal(t, usdc(400, 6), destAmount)
+	})
+
+	t.Run("mixed decimals", func(t *testing.T) {
+		origin, dest, originAmount, destAmount, ok := inventory.ComputeRebalance([]inventory.TestToken{
+			{ChainID: 1, Decimals: 6, Balance: usdc(100, 6), Cfg: halfCfg},
+			{ChainID: 56, Decimals: 18, Balance: usdc(900, 18), Cfg: halfCfg},
+		})
+		assert.True(t, ok)
+		assert.Equal(t, 56, origin)
+		assert.Equal(t, 1, dest)
+		assert.Equal(t, usdc(400, 18), originAmount)
+		assert.Equal(t, usdc(400, 6), destAmount)
+	})
+
+	t.Run("above max", func(t *testing.T) {
+		cfg := relconfig.TokenConfig{TargetBalancePct: 33, MinBalancePct: 10, MaxBalancePct: 50}
+		origin, dest, originAmount, _, ok := inventory.ComputeRebalance([]inventory.TestToken{
+			{ChainID: 1, Decimals: 6, Balance: usdc(600, 6), Cfg: cfg},
+			{ChainID: 2, Decimals: 6, Balance: usdc(250, 6), Cfg: cfg},
+			{ChainID: 3, Decimals: 6, Balance: usdc(150, 6), Cfg: cfg},
+		})
+		assert.True(t, ok)
+		assert.Equal(t, 1, origin)
+		assert.Equal(t, 3, dest)
+		// chain 3 is 180 below its target, chain 1 is 270 above it
+		assert.Equal(t, usdc(180, 6), originAmount)
+	})
+
+	t.Run("zero balance", func(t *testing.T) {
+		_, _, _, _, ok := inventory.ComputeRebalance([]inventory.TestToken{
+			{ChainID: 1, Decimals: 6, Balance: big.NewInt(0), Cfg: halfCfg},
+			{ChainID: 2, Decimals: 6, Balance: big.NewInt(0), Cfg: halfCfg},
+		})
+		assert.False(t, ok)
+	})
+}
+
+// testMethod is a rebalance method that records the stored status of the rebalances it executes.
+type testMethod struct {
+	db         reldb.Service
+	executeErr error
+	// executedStatuses are the statuses stored in the db while each rebalance was executed
+	executedStatuses []reldb.RebalanceStatus
+}
+
+func (m *testMethod) DestAmount(amount *big.Int) *big.Int {
+	return amount
+}
+
+func (m *testMethod) Execute(ctx context.Context, rebalance reldb.Rebalance) (reldb.Rebalance, error) {
+	stored, err := m.db.GetRebalanceByID(ctx, rebalance.ID)
+	if err != nil {
+		return rebalance, err
+	}
+	m.executedStatuses = append(m.executedStatuses, stored.Status)
+
+	if m.executeErr != nil {
+		return rebalance, m.executeErr
+	}
+	rebalance.OriginNonce = 1
+	rebalance.Status = reldb.RebalanceSubmitted
+	return rebalance, nil
+}
+
+func (m *testMethod) Track(_ context.Context, rebalance reldb.Rebalance) (reldb.Rebalance, error) {
+	return rebalance, nil
+}
+
+// refundableMethod is a test method that can refund rebalances.
+type refundableMethod struct {
+	testMethod
+	// refunded is whether the refund has landed
+	refunded bool
+}
+
+func (m *refundableMethod) Refund(_ context.Context, rebalance reldb.Rebalance) (reldb.Rebalance, error) {
+	if m.refunded {
+		rebalance.Status = reldb.RebalanceFailed
+	}
+	return rebalance, nil
+}
+
+// committedMethod is a test method whose rebalances are committed once they have a tracking id.
+type committedMethod struct {
+	testMethod
+}
+
+func (m *committedMethod) Committed(rebalance reldb.Rebalance) bool {
+	return rebalance.TrackingID != ""
+}
+
+// testStatus is a fixed submission status.
+type testStatus struct {
+	state  submitter.SubmissionState
+	txHash common.Hash
+}
+
+func (s testStatus) State() submitter.SubmissionState {
+	return s.state
+}
+
+func (s testStatus) HasTx() bool {
+	return s.state == submitter.Confirmed
+}
+
+func (s testStatus) TxHash() common.Hash {
+	return s.txHash
+}
+
+func (s testStatus) RevertReason() string {
+	return ""
+}
+
+// statusSubmitter is a submitter that reports the same status for every nonce.
+type statusSubmitter struct {
+	submitter.TransactionSubmitter
+	status testStatus
+}
+
+func (s *statusSubmitter) GetSubmissionStatus(_ context.Context, _ *big.Int, _ uint64) (submitter.SubmissionStatus, error) {
+	return s.status, nil
+}
+
+func TestTrackRequestedRebalance(t *testing.T) {
+	ctx := context.Background()
+	db, err := sqlite.NewSqliteStore(ctx, filet.TmpDir(t, ""), metrics.NewNullHandler())
+	require.NoError(t, err)
+
+	newRebalance := func(status reldb.RebalanceStatus) reldb.Rebalance {
+		rebalance := reldb.Rebalance{
+			ID:            uuid.New().String(),
+			Method:        relconfig.RebalanceMethodFastBridge,
+			OriginChainID: 1,
+			DestChainID:   2,
+			OriginAmount:  usdc(100, 6),
+			DestAmount:    usdc(100, 6),
+			Status:        status,
+			CreatedAt:     time.Now(),
+		}
+		require.NoError(t, db.StoreRebalance(ctx, rebalance))
+		return rebalance
+	}
+
+	getStatus := func(id string) reldb.RebalanceStatus {
+		rebalance, err := db.GetRebalanceByID(ctx, id)
+		require.NoError(t, err)
+		return rebalance.Status
+	}
+
+	t.Run("submitted", func(t *testing.T) {
+		method := &testMethod{db: db}
+		rebalance := newRebalance(reldb.RebalanceRequested)
+
+		err := inventory.TrackRebalance(ctx, db, nil, nil, map[string]inventory.RebalanceMethod{rebalance.Method: method}, rebalance)
+		require.NoError(t, err)
+		// the rebalance is marked before it's submitted so it can't be executed twice
+		assert.Equal(t, []reldb.RebalanceStatus{reldb.RebalanceSubmitting}, method.executedStatuses)
+		assert.Equal(t, reldb.RebalanceSubmitted, getStatus(rebalance.ID))
+	})
+
+	t.Run("execute failed", func(t *testing.T) {
+		method := &testMethod{db: db, executeErr: errors.New("could not submit")}
+		rebalance := newRebalance(reldb.RebalanceRequested)
+
+		err := inventory.TrackRebalance(ctx, db, nil, nil, map[string]inventory.RebalanceMethod{rebalance.Method: method}, rebalance)
+		require.Error(t, err)
+		assert.Equal(t, reldb.RebalanceRequested, getStatus(rebalance.ID))
+	})
+
+	t.Run("interrupted", func(t *testing.T) {
+		method := &testMethod{db: db}
+		rebalance := newRebalance(reldb.RebalanceSubmitting)
+
+		err := inventory.TrackRebalance(ctx, db, nil, nil, map[string]inventory.RebalanceMethod{rebalance.Method: method}, rebalance)
+		require.NoError(t, err)
+		assert.Empty(t, method.executedStatuses)
+		assert.Equal(t, reldb.RebalanceSubmitting, getStatus(rebalance.ID))
+	})
+
+	t.Run("origin tx", func(t *testing.T) {
+		method := &testMethod{db: db}
+		methods := map[string]inventory.RebalanceMethod{relconfig.RebalanceMethodFastBridge: method}
+		successHash := common.HexToHash("0x01")
+		failedHash := common.HexToHash("0x02")
+
+		client := new(clientMocks.EVM)
+		client.On(testsuite.GetFunctionName(client.TransactionReceipt), mock.Anything, successHash).Return(&types.Receipt{Status: types.ReceiptStatusSuccessful}, nil)
+		client.On(testsuite.GetFunctionName(client.TransactionReceipt), mock.Anything, failedHash).Return(&types.Receipt{Status: types.ReceiptStatusFailed}, nil)
+		clientFetcher := new(fetcherMocks.ClientFetcher)
+		clientFetcher.On(testsuite.GetFunctionName(clientFetcher.GetClient), mock.Anything, big.NewInt(1)).Return(client, nil)
+
+		for _, tc := range []struct {
+			name   string
+			status testStatus
+			want   reldb.RebalanceStatus
+		}{
+			{name: "pending", status: testStatus{state: submitter.Pending}, want: reldb.RebalanceSubmitted},
+			// the nonce was used by a cancellation tx, whose hash isn't the origin tx
+			{name: "cancelled", status: testStatus{state: submitter.Cancelled, txHash: successHash}, want: reldb.RebalanceFailed},
+			{name: "reverted", status: testStatus{state: submitter.Reverted}, want: reldb.RebalanceFailed},
+			{name: "confirmed", status: testStatus{state: submitter.Confirmed, txHash: successHash}, want: reldb.RebalanceInFlight},
+			{name: "confirmed failed", status: testStatus{state: submitter.Confirmed, txHash: failedHash}, want: reldb.RebalanceFailed},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				rebalance := newRebalance(reldb.RebalanceSubmitted)
+				err := inventory.TrackRebalance(ctx, db, &statusSubmitter{status: tc.status}, clientFetcher, methods, rebalance)
+				require.NoError(t, err)
+				assert.Equal(t, tc.want, getStatus(rebalance.ID))
+			})
+		}
+	})
+
+	t.Run("timed out", func(t *testing.T) {
+		method := &testMethod{db: db}
+		rebalance := newRebalance(reldb.RebalanceInFlight)
+		rebalance.CreatedAt = time.Now().Add(-24 * time.Hour)
+
+		err := inventory.TrackRebalance(ctx, db, nil, nil, map[string]inventory.RebalanceMethod{rebalance.Method: method}, rebalance)
+		require.NoError(t, err)
+		assert.Equal(t, reldb.RebalanceFailed, getStatus(rebalance.ID))
+	})
+
+	t.Run("timed out refundable", func(t *testing.T) {
+		method := &refundableMethod{testMethod: testMethod{db: db}}
+		rebalance := newRebalance(reldb.RebalanceInFlight)
+		rebalance.CreatedAt = time.Now().Add(-24 * time.Hour)
+
+		err := inventory.TrackRebalance(ctx, db, nil, nil, map[string]inventory.RebalanceMethod{rebalance.Method: method}, rebalance)
+		require.NoError(t, err)
+		assert.Equal(t, reldb.RebalanceRefunding, getStatus(rebalance.ID))
+	})
+
+	t.Run("timed out committed", func(t *testing.T) {
+		method := &committedMethod{testMethod: testMethod{db: db}}
+		methods := map[string]inventory.RebalanceMethod{relconfig.RebalanceMethodFastBridge: method}
+
+		// funds that never left the origin chain are failed
+		rebalance := newRebalance(reldb.RebalanceInFlight)
+		rebalance.CreatedAt = time.Now().Add(-24 * time.Hour)
+		err := inventory.TrackRebalance(ctx, db, nil, nil, methods, rebalance)
+		require.NoError(t, err)
+		assert.Equal(t, reldb.RebalanceFailed, getStatus(rebalance.ID))
+
+		// funds that left the origin chain stay in flight until they arrive
+		rebalance = newRebalance(reldb.RebalanceInFlight)
+		rebalance.CreatedAt = time.Now().Add(-24 * time.Hour)
+		rebalance.TrackingID = "0x01"
+		err = inventory.TrackRebalance(ctx, db, nil, nil, methods, rebalance)
+		require.NoError(t, err)
+		assert.Equal(t, reldb.RebalanceInFlight, getStatus(rebalance.ID))
+	})
+
+	t.Run("refunded", func(t *testing.T) {
+		method := &refundableMethod{testMethod: testMethod{db: db}}
+		rebalance := newRebalance(reldb.RebalanceRefunding)
+		methods := map[string]inventory.RebalanceMethod{rebalance.Method: method}
+
+		// the funds stay pending until the refund lands
+		err := inventory.TrackRebalance(ctx, db, nil, nil, methods, rebalance)
+		require.NoError(t, err)
+		assert.Equal(t, reldb.RebalanceRefunding, getStatus(rebalance.ID))
+
+		method.refunded = true
+		err = inventory.TrackRebalance(ctx, db, nil, nil, methods, rebalance)
+		require.NoError(t, err)
+		assert.Equal(t, reldb.RebalanceFailed, getStatus(rebalance.ID))
+	})
+}
+
+func TestRebalanceBalances(t *testing.T) {
+	token := common.HexToAddress("0x1")
+	cfg := relconfig.Config{
+		Chains: map[int]relconfig.ChainConfig{
+			1: {Tokens: map[string]relconfig.TokenConfig{"USDC": {Address: token.String(), Decimals: 6, RebalanceMethod: relconfig.RebalanceMethodFastBridge}}},
+			2: {Tokens: map[string]relconfig.TokenConfig{"USDC": {Address: token.String(), Decimals: 6, RebalanceMethod: relconfig.RebalanceMethodFastBridge}}},
+		},
+	}
+	balances := map[int]map[common.Address]*big.Int{
+		1: {token: usdc(1000, 6)},
+		2: {token: usdc(1000, 6)},
+	}
+	newRebalance := func(status reldb.RebalanceStatus) reldb.Rebalance {
+		return reldb.Rebalance{
+			OriginChainID: 1,
+			DestChainID:   2,
+			OriginToken:   token,
+			DestToken:     token,
+			OriginAmount:  usdc(100, 6),
+			DestAmount:    usdc(99, 6),
+			Status:        status,
+		}
+	}
+
+	// in flight funds are counted on the destination
+	res := inventory.RebalanceBalances(cfg, balances, []reldb.Rebalance{newRebalance(reldb.RebalanceInFlight)})
+	assert.Equal(t, usdc(1000, 6), res["USDC"][1])
+	assert.Equal(t, usdc(1099, 6), res["USDC"][2])
+
+	// refunding funds are counted on the origin until the refund lands
+	res = inventory.RebalanceBalances(cfg, balances, []reldb.Rebalance{newRebalance(reldb.RebalanceRefunding)})
+	assert.Equal(t, usdc(1100, 6), res["USDC"][1])
+	assert.Equal(t, usdc(1000, 6), res["USDC"][2])
+}
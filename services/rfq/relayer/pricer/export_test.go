@@ -0,0 +1,14 @@
+package pricer
+
+import (
+	"time"
+)
+
+// AggregatorABI exports aggregatorABI for testing.
+const AggregatorABI = aggregatorABI
+
+// SetNow overrides the clock of a cached price oracle for testing.
+func SetNow(oracle PriceOracle, now func() time.Time) {
+	//nolint: forcetypeassert
+	oracle.(*cachedOracle).now = now
+}
@@ -3,12 +3,18 @@
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/jellydator/ttlcache/v3"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/ethergo/submitter"
+	submitterConfig "github.com/synapsecns/sanguine/ethergo/submitter/config"
+	"github.com/synapsecns/sanguine/services/rfq/contracts/fastbridge"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/relconfig"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -34,8 +40,8 @@ type feePricer struct {
 	config relconfig.Config
 	// gasPriceCache maps chainID -> gas price
 	gasPriceCache *ttlcache.Cache[uint32, *big.Int]
-	// tokenPriceCache maps token name -> token price
-	tokenPriceCache *ttlcache.Cache[string, *big.Int]
+	// priceOracle is used to get token prices.
+	priceOracle PriceOracle
 	// clientFetcher is used to fetch clients.
 	clientFetcher submitter.ClientFetcher
 	// handler is the metrics handler.
@@ -49,11 +55,11 @@ func NewFeePricer(config relconfig.Config, clientFetcher submitter.ClientFetcher
 		ttlcache.WithDisableTouchOnHit[uint32, *big.Int](),
 	)
 	return &feePricer{
-		config:          config,
-		gasPriceCache:   gasPriceCache,
-		tokenPriceCache: ttlcache.New[string, *big.Int](ttlcache.WithTTL[string, *big.Int](time.Second * time.Duration(config.GetFeePricer().TokenPriceCacheTTLSeconds))),
-		clientFetcher:   clientFetcher,
-		handler:         handler,
+		config:        config,
+		gasPriceCache: gasPriceCache,
+		priceOracle:   NewPriceOracle(config, clientFetcher, handler),
+		clientFetcher: clientFetcher,
+		handler:       handler,
 	}
 }
 
@@ -65,10 +71,6 @@ func (f *feePricer) Start(ctx context.Context) {
 		f.gasPriceCache.Start()
 		return nil
 	})
-	g.Go(func() error {
-		f.tokenPriceCache.Start()
-		return nil
-	})
 }
 
 var nativeDecimalsFactor = new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(18)), nil)
@@ -92,12 +94,11 @@ func (f *feePricer) GetOriginFee(parentCtx context.Context, origin, destination
 	}
 
 	// If specified, calculate and add the L1 fee
-	l1ChainID, l1GasEstimate, useL1Fee := f.config.GetL1FeeParams(origin, true)
-	if useL1Fee {
-		l1Fee, err := f.getFee(ctx, l1ChainID, destination, l1GasEstimate, denomToken, useMultiplier)
-		if err != nil {
-			return nil, err
-		}
+	l1Fee, err := f.getL1Fee(ctx, origin, destination, denomToken, useMultiplier, true)
+	if err != nil {
+		return nil, err
+	}
+	if l1Fee != nil {
 		fee = new(big.Int).Add(fee, l1Fee)
 		span.SetAttributes(attribute.String("l1_fee", l1Fee.String()))
 	}
@@ -123,12 +124,11 @@ func (f *feePricer) GetDestinationFee(parentCtx context.Context, _, destination
 	}
 
 	// If specified, calculate and add the L1 fee
-	l1ChainID, l1GasEstimate, useL1Fee := f.config.GetL1FeeParams(destination, false)
-	if useL1Fee {
-		l1Fee, err := f.getFee(ctx, l1ChainID, destination, l1GasEstimate, denomToken, useMultiplier)
-		if err != nil {
-			return nil, err
-		}
+	l1Fee, err := f.getL1Fee(ctx, destination, destination, denomToken, useMultiplier, false)
+	if err != nil {
+		return nil, err
+	}
+	if l1Fee != nil {
 		fee = new(big.Int).Add(fee, l1Fee)
 		span.SetAttributes(attribute.String("l1_fee", l1Fee.String()))
 	}
@@ -187,6 +187,136 @@ func (f *feePricer) getFee(parentCtx context.Context, gasChain, denomChain uint3
 	if err != nil {
 		return nil, err
 	}
+	span.SetAttributes(attribute.String("gas_price", gasPrice.String()))
+
+	feeWei := new(big.Float).Mul(new(big.Float).SetInt(gasPrice), new(big.Float).SetFloat64(float64(gasEstimate)))
+	return f.denominateFee(ctx, gasChain, denomChain, feeWei, denomToken, useMultiplier)
+}
+
+var (
+	maxAddress = common.HexToAddress("0xffffffffffffffffffffffffffffffffffffffff")
+	maxHash    = common.HexToHash("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+)
+
+// maxBridgeTransaction is a bridge transaction with every field at its max value, so that the relayer txs built
+// from it have the largest calldata a real request can have.
+var maxBridgeTransaction = fastbridge.IFastBridgeBridgeTransaction{
+	OriginChainId:   math.MaxUint32,
+	DestChainId:     math.MaxUint32,
+	OriginSender:    maxAddress,
+	DestRecipient:   maxAddress,
+	OriginToken:     maxAddress,
+	DestToken:       maxAddress,
+	OriginAmount:    abi.MaxUint256,
+	DestAmount:      abi.MaxUint256,
+	OriginFeeAmount: abi.MaxUint256,
+	SendChainGas:    true,
+	Deadline:        abi.MaxUint256,
+	Nonce:           abi.MaxUint256,
+}
+
+// relayerCalldata returns the calldata of the relayer tx whose l1 fee is charged on a chain:
+// prove on the origin chain (claim has the same size) and relay on the destination chain.
+func relayerCalldata(origin bool) ([]byte, error) {
+	fastBridgeABI, err := fastbridge.FastBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("could not get fastbridge abi: %w", err)
+	}
+
+	request, err := fastBridgeABI.Methods["getBridgeTransaction"].Outputs.Pack(maxBridgeTransaction)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode bridge transaction: %w", err)
+	}
+
+	if origin {
+		return fastBridgeABI.Pack("prove", request, maxHash)
+	}
+	return fastBridgeABI.Pack("relay", request)
+}
+
+// getL1Fee returns the l1 data fee of a relayer tx on gasChain, denominated in denomToken.
+// the configured l1 fee params are used if set, otherwise the fee estimator of the submitter is used on rollups.
+// nil is returned if the chain has no l1 fee.
+func (f *feePricer) getL1Fee(parentCtx context.Context, gasChain, denomChain uint32, denomToken string, useMultiplier, origin bool) (_ *big.Int, err error) {
+	l1ChainID, l1GasEstimate, useL1Fee := f.config.GetL1FeeParams(gasChain, origin)
+	if useL1Fee {
+		return f.getFee(parentCtx, l1ChainID, denomChain, l1GasEstimate, denomToken, useMultiplier)
+	}
+
+	feeModel := f.config.SubmitterConfig.GetFeeModel(int(gasChain))
+	if feeModel != submitterConfig.OPStackFeeModel && feeModel != submitterConfig.ArbitrumFeeModel {
+		return nil, nil
+	}
+
+	ctx, span := f.handler.Tracer().Start(parentCtx, "getL1Fee", trace.WithAttributes(
+		attribute.Int("gas_chain", int(gasChain)),
+		attribute.String("fee_model", feeModel),
+	))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	estimator, err := submitter.NewFeeEstimator(&f.config.SubmitterConfig, int(gasChain))
+	if err != nil {
+		return nil, fmt.Errorf("could not get fee estimator: %w", err)
+	}
+
+	client, err := f.clientFetcher.GetClient(ctx, big.NewInt(int64(gasChain)))
+	if err != nil {
+		return nil, fmt.Errorf("could not get client: %w", err)
+	}
+
+	gasPrice, err := f.GetGasPrice(ctx, gasChain)
+	if err != nil {
+		return nil, err
+	}
+
+	gasEstimate := f.config.GetDestGasEstimate(gasChain)
+	if origin {
+		gasEstimate = f.config.GetOriginGasEstimate(gasChain)
+	}
+
+	calldata, err := relayerCalldata(origin)
+	if err != nil {
+		return nil, err
+	}
+
+	// the l1 fee only depends on the encoded tx, so the relayer calldata with max signature values
+	// is priced like the signed tx. The zero address has no code, so the call can't revert when it's executed.
+	relayerTx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(int64(gasChain)),
+		Gas:       uint64(gasEstimate),
+		GasFeeCap: gasPrice,
+		GasTipCap: big.NewInt(0),
+		To:        &common.Address{},
+		Data:      calldata,
+		V:         big.NewInt(1),
+		R:         abi.MaxUint256,
+		S:         abi.MaxUint256,
+	})
+
+	estimate, err := estimator.EstimateFee(ctx, client, common.Address{}, relayerTx)
+	if err != nil {
+		return nil, fmt.Errorf("could not estimate l1 fee: %w", err)
+	}
+
+	dataFee := estimate.DataFee()
+	span.SetAttributes(attribute.String("l1_fee_wei", dataFee.String()))
+	return f.denominateFee(ctx, gasChain, denomChain, new(big.Float).SetInt(dataFee), denomToken, useMultiplier)
+}
+
+// denominateFee converts a fee in the native token of gasChain to denomToken on denomChain.
+func (f *feePricer) denominateFee(parentCtx context.Context, gasChain, denomChain uint32, feeWei *big.Float, denomToken string, useMultiplier bool) (_ *big.Int, err error) {
+	ctx, span := f.handler.Tracer().Start(parentCtx, "denominateFee", trace.WithAttributes(
+		attribute.Int("gas_chain", int(gasChain)),
+		attribute.Int("denom_chain", int(denomChain)),
+		attribute.String("denom_token", denomToken),
+	))
+
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
 	nativeToken, err := f.config.GetNativeToken(gasChain)
 	if err != nil {
 		return nil, err
@@ -205,23 +335,23 @@ func (f *feePricer) getFee(parentCtx context.Context, gasChain, denomChain uint3
 	}
 	denomDecimalsFactor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(denomTokenDecimals)), nil)
 
-	// Compute the fee.
-	var feeDenom *big.Float
-	feeWei := new(big.Float).Mul(new(big.Float).SetInt(gasPrice), new(big.Float).SetFloat64(float64(gasEstimate)))
+	// Compute the fee with rationals, so that it is exact until it's rounded at the end.
+	feeWeiRat, _ := feeWei.Rat(nil)
+	var feeDenom *big.Rat
 	if denomToken == nativeToken {
 		// Denomination token is native token, so no need for unit conversion.
-		feeDenom = feeWei
+		feeDenom = feeWeiRat
 	} else {
 		// Convert the fee from ETH to denomToken terms.
-		feeEth := new(big.Float).Quo(feeWei, new(big.Float).SetInt(nativeDecimalsFactor))
-		feeUSD := new(big.Float).Mul(feeEth, new(big.Float).SetFloat64(nativeTokenPrice))
-		feeUSDC := new(big.Float).Mul(feeUSD, new(big.Float).SetFloat64(denomTokenPrice))
-		feeDenom = new(big.Float).Mul(feeUSDC, new(big.Float).SetInt(denomDecimalsFactor))
+		feeEth := new(big.Rat).Quo(feeWeiRat, new(big.Rat).SetInt(nativeDecimalsFactor))
+		feeUSD := new(big.Rat).Mul(feeEth, new(big.Rat).SetFloat64(nativeTokenPrice))
+		feeUSDC := new(big.Rat).Mul(feeUSD, new(big.Rat).SetFloat64(denomTokenPrice))
+		feeDenom = new(big.Rat).Mul(feeUSDC, new(big.Rat).SetInt(denomDecimalsFactor))
 		span.SetAttributes(
 			attribute.String("fee_wei", feeWei.String()),
-			attribute.String("fee_eth", feeEth.String()),
-			attribute.String("fee_usd", feeUSD.String()),
-			attribute.String("fee_usdc", feeUSDC.String()),
+			attribute.String("fee_eth", feeEth.FloatString(18)),
+			attribute.String("fee_usd", feeUSD.FloatString(18)),
+			attribute.String("fee_usdc", feeUSDC.FloatString(18)),
 		)
 	}
 
@@ -233,14 +363,14 @@ func (f *feePricer) getFee(parentCtx context.Context, gasChain, denomChain uint3
 	// Apply the fixed fee multiplier.
 	// Note that this step rounds towards zero- we may need to apply rounding here if
 	// we want to be conservative and lean towards overestimating fees.
-	feeUSDCDecimalsScaled, _ := new(big.Float).Mul(feeDenom, new(big.Float).SetFloat64(multiplier)).Int(nil)
+	feeDenom = new(big.Rat).Mul(feeDenom, new(big.Rat).SetFloat64(multiplier))
+	feeUSDCDecimalsScaled := new(big.Int).Quo(feeDenom.Num(), feeDenom.Denom())
 	span.SetAttributes(
-		attribute.String("gas_price", gasPrice.String()),
 		attribute.Float64("native_token_price", nativeTokenPrice),
 		attribute.Float64("denom_token_price", denomTokenPrice),
 		attribute.Int("denom_token_decimals", int(denomTokenDecimals)),
 		attribute.String("fee_wei", feeWei.String()),
-		attribute.String("fee_denom", feeDenom.String()),
+		attribute.String("fee_denom", feeDenom.FloatString(int(denomTokenDecimals))),
 		attribute.String("fee_usdc_decimals_scaled", feeUSDCDecimalsScaled.String()),
 	)
 	return feeUSDCDecimalsScaled, nil
@@ -270,13 +400,11 @@ func (f *feePricer) GetGasPrice(ctx context.Context, chainID uint32) (*big.Int,
 }
 
 // getTokenPrice returns the price of a token in USD.
+// ErrStalePrice is returned if the latest price is too old to quote against.
 func (f *feePricer) getTokenPrice(ctx context.Context, token string) (float64, error) {
-	for _, chainConfig := range f.config.GetChains() {
-		for tokenName, tokenConfig := range chainConfig.Tokens {
-			if token == tokenName {
-				return tokenConfig.PriceUSD, nil
-			}
-		}
+	price, _, err := f.priceOracle.GetPrice(ctx, token)
+	if err != nil {
+		return 0, fmt.Errorf("could not get price for token %s: %w", token, err)
 	}
-	return 0, fmt.Errorf("could not get price for token: %s", token)
+	return price, nil
 }
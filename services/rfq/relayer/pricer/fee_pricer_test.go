@@ -4,11 +4,13 @@
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/core/testsuite"
 	clientMocks "github.com/synapsecns/sanguine/ethergo/client/mocks"
+	submitterConfig "github.com/synapsecns/sanguine/ethergo/submitter/config"
 	fetcherMocks "github.com/synapsecns/sanguine/ethergo/submitter/mocks"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/pricer"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/relconfig"
@@ -99,6 +101,40 @@ func (s *PricerSuite) TestGetOriginFeeWithOverrides() {
 	s.Equal(expectedFee, fee)
 }
 
+func (s *PricerSuite) TestGetOriginFeeWithRollupL1Fee() {
+	// Estimate the l1 fee of the origin chain with the op stack fee model.
+	s.config.SubmitterConfig.Chains = map[int]submitterConfig.ChainConfig{
+		int(s.origin): {FeeModel: submitterConfig.OPStackFeeModel},
+	}
+
+	// Build a new FeePricer with a mocked client for fetching gas price and the l1 fee.
+	clientFetcher := new(fetcherMocks.ClientFetcher)
+	client := new(clientMocks.EVM)
+	currentHeader := &types.Header{BaseFee: big.NewInt(100_000_000_000)} // 100 gwei
+	client.On(testsuite.GetFunctionName(client.HeaderByNumber), mock.Anything, mock.Anything).Return(currentHeader, nil)
+	l1Fee := common.LeftPadBytes(big.NewInt(1_000_000_000_000_000).Bytes(), 32) // 0.001 eth
+	client.On(testsuite.GetFunctionName(client.CallContract), mock.Anything, mock.Anything, mock.Anything).Return(l1Fee, nil)
+	clientFetcher.On(testsuite.GetFunctionName(clientFetcher.GetClient), mock.Anything, mock.Anything).Return(client, nil)
+	feePricer := pricer.NewFeePricer(s.config, clientFetcher, metrics.NewNullHandler())
+	go func() { feePricer.Start(s.GetTestContext()) }()
+
+	// Calculate the origin fee.
+	fee, err := feePricer.GetOriginFee(s.GetTestContext(), s.origin, s.destination, "USDC", true)
+	s.NoError(err)
+
+	/*
+		The expected fee should be:
+		fee_denom = (((100e9 * 500000 / 1e18) * 2000) * 1) * 1e6 = 100_000_000
+
+		Then, add the l1 fee reported by the gas price oracle:
+		fee_denom = (((1e15 / 1e18) * 2000) * 1) * 1e6 = 2_000_000
+
+		So, the total is: 102_000_000
+	*/
+
+	s.Equal(big.NewInt(102_000_000), fee) // 102 usd
+}
+
 func (s *PricerSuite) TestGetDestinationFee() {
 	// Build a new FeePricer with a mocked client for fetching gas price.
 	clientFetcher := new(fetcherMocks.ClientFetcher)
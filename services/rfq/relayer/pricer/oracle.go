@@ -0,0 +1,424 @@
+package pricer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dubonzi/otelresty"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-resty/resty/v2"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/relconfig"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
+)
+
+// ErrStalePrice is returned when the latest known price of a token is older than the max staleness.
+var ErrStalePrice = errors.New("token price is stale")
+
+// PriceOracle gets token prices in USD.
+type PriceOracle interface {
+	// GetPrice returns the USD price of a token along with when the price was last updated.
+	GetPrice(ctx context.Context, token string) (price float64, updatedAt time.Time, err error)
+}
+
+// tokenPrice is a price fetched from a price source.
+type tokenPrice struct {
+	// price is the USD price
+	price float64
+	// updatedAt is when the source last updated the price
+	updatedAt time.Time
+	// fetchedAt is when the price was fetched from the source
+	fetchedAt time.Time
+}
+
+// fetchFailure tracks failed fetches of a token's price so sources aren't retried on every call during an outage.
+type fetchFailure struct {
+	// err is the error of the last fetch
+	err error
+	// attempts is the number of consecutive failed fetches
+	attempts int
+	// retryAt is when the price is fetched again
+	retryAt time.Time
+}
+
+const (
+	// minFetchBackoff is how long to wait before refetching after the first failed fetch.
+	minFetchBackoff = time.Second
+	// maxFetchBackoff is the max time to wait before refetching a price.
+	maxFetchBackoff = time.Minute
+)
+
+// fetchBackoff returns how long to wait before refetching after attempts consecutive failures.
+func fetchBackoff(attempts int) time.Duration {
+	backoff := minFetchBackoff
+	for i := 1; i < attempts && backoff < maxFetchBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxFetchBackoff {
+		return maxFetchBackoff
+	}
+	return backoff
+}
+
+// cachedOracle tries each source in order and caches the result. If every source fails, the last known
+// price is used until it becomes stale.
+type cachedOracle struct {
+	// sources are the price sources in order of preference
+	sources []namedOracle
+	// ttl is how long a fetched price is used before fetching again
+	ttl time.Duration
+	// maxStaleness is the max age of a price before ErrStalePrice is returned
+	maxStaleness time.Duration
+	// handler is the metrics handler
+	handler metrics.Handler
+	// mux protects prices
+	mux sync.RWMutex
+	// prices maps token name -> last known price
+	prices map[string]tokenPrice
+	// failures maps token name -> failed fetches since the last successful one
+	failures map[string]fetchFailure
+	// requests dedupes concurrent fetches of the same token's price
+	requests singleflight.Group
+	// now is used to get the current time, overridable for testing
+	now func() time.Time
+}
+
+// namedOracle is a price source along with its name for tracing.
+type namedOracle struct {
+	name   string
+	oracle PriceOracle
+}
+
+// NewPriceOracle creates a cached price oracle from the configured price sources.
+func NewPriceOracle(config relconfig.Config, clientFetcher submitter.ClientFetcher, handler metrics.Handler) PriceOracle {
+	oracle := &cachedOracle{
+		ttl:          time.Second * time.Duration(config.GetFeePricer().TokenPriceCacheTTLSeconds),
+		maxStaleness: config.GetMaxPriceStaleness(),
+		handler:      handler,
+		prices:       make(map[string]tokenPrice),
+		failures:     make(map[string]fetchFailure),
+		now:          time.Now,
+	}
+
+	for _, source := range config.GetPriceSources() {
+		var sourceOracle PriceOracle
+		switch source {
+		case relconfig.PriceSourceStatic:
+			sourceOracle = newStaticOracle(config)
+		case relconfig.PriceSourceHTTP:
+			sourceOracle = newHTTPOracle(config, handler)
+		case relconfig.PriceSourceChainlink:
+			sourceOracle = newChainlinkOracle(config, clientFetcher)
+		default:
+			sourceOracle = unknownOracle{source: source}
+		}
+		oracle.sources = append(oracle.sources, namedOracle{name: source, oracle: sourceOracle})
+	}
+
+	return oracle
+}
+
+func (c *cachedOracle) GetPrice(parentCtx context.Context, token string) (price float64, updatedAt time.Time, err error) {
+	ctx, span := c.handler.Tracer().Start(parentCtx, "getTokenPrice", trace.WithAttributes(
+		attribute.String("token", token),
+	))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	c.mux.RLock()
+	cached, ok := c.prices[token]
+	failure, failed := c.failures[token]
+	c.mux.RUnlock()
+
+	if !ok || c.now().Sub(cached.fetchedAt) >= c.ttl {
+		var fetchErr error
+		if failed && c.now().Before(failure.retryAt) {
+			// back off from sources that are failing
+			fetchErr = failure.err
+		} else {
+			var res interface{}
+			res, fetchErr, _ = c.requests.Do(token, func() (interface{}, error) {
+				return c.refreshPrice(ctx, token)
+			})
+			if fetchErr == nil {
+				cached, ok = res.(tokenPrice), true
+			}
+		}
+
+		switch {
+		case fetchErr == nil:
+		case !ok:
+			return 0, time.Time{}, fetchErr
+		default:
+			// fall back to the last known price, the staleness check below stops it from being used for too long
+			span.AddEvent("could not refresh price", trace.WithAttributes(attribute.String("error", fetchErr.Error())))
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Float64("price", cached.price),
+		attribute.String("updated_at", cached.updatedAt.String()),
+	)
+
+	if age := c.now().Sub(cached.updatedAt); age > c.maxStaleness {
+		return 0, time.Time{}, fmt.Errorf("%w: price of %s was last updated %s ago", ErrStalePrice, token, age)
+	}
+
+	return cached.price, cached.updatedAt, nil
+}
+
+// refreshPrice fetches a token's price and records the result.
+func (c *cachedOracle) refreshPrice(ctx context.Context, token string) (tokenPrice, error) {
+	fetched, err := c.fetchPrice(ctx, token)
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if err != nil {
+		failure := c.failures[token]
+		failure.err = err
+		failure.attempts++
+		failure.retryAt = c.now().Add(fetchBackoff(failure.attempts))
+		c.failures[token] = failure
+		return tokenPrice{}, err
+	}
+
+	c.prices[token] = fetched
+	delete(c.failures, token)
+	return fetched, nil
+}
+
+// fetchPrice gets the price from the first source that has it.
+func (c *cachedOracle) fetchPrice(ctx context.Context, token string) (tokenPrice, error) {
+	var errs []string
+	for _, source := range c.sources {
+		price, updatedAt, err := source.oracle.GetPrice(ctx, token)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", source.name, err))
+			continue
+		}
+
+		if price <= 0 {
+			errs = append(errs, fmt.Sprintf("%s: invalid price %f", source.name, price))
+			continue
+		}
+
+		return tokenPrice{price: price, updatedAt: updatedAt, fetchedAt: c.now()}, nil
+	}
+	return tokenPrice{}, fmt.Errorf("could not get price for token %s: %s", token, strings.Join(errs, ", "))
+}
+
+// unknownOracle is used for misconfigured sources so the error surfaces when pricing.
+type unknownOracle struct {
+	source string
+}
+
+func (u unknownOracle) GetPrice(_ context.Context, _ string) (float64, time.Time, error) {
+	return 0, time.Time{}, fmt.Errorf("unknown price source: %s", u.source)
+}
+
+// staticOracle returns the price_usd from the token config. Static prices are never stale.
+type staticOracle struct {
+	config relconfig.Config
+}
+
+func newStaticOracle(config relconfig.Config) *staticOracle {
+	return &staticOracle{config: config}
+}
+
+func (s *staticOracle) GetPrice(_ context.Context, token string) (float64, time.Time, error) {
+	_, tokenConfig, err := s.config.GetTokenConfigByName(token)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("could not get price for token: %w", err)
+	}
+	return tokenConfig.PriceUSD, time.Now(), nil
+}
+
+// priceIDPlaceholder is replaced with the token's price id in the http url and paths.
+const priceIDPlaceholder = "{id}"
+
+// httpOracle reads prices from a generic http json api.
+type httpOracle struct {
+	config relconfig.Config
+	client *resty.Client
+}
+
+func newHTTPOracle(config relconfig.Config, handler metrics.Handler) *httpOracle {
+	client := resty.New().SetTimeout(time.Second * 10)
+	otelresty.TraceClient(client, otelresty.WithTracerProvider(handler.GetTracerProvider()))
+
+	return &httpOracle{
+		config: config,
+		client: client,
+	}
+}
+
+func (h *httpOracle) GetPrice(ctx context.Context, token string) (float64, time.Time, error) {
+	oracleConfig := h.config.GetFeePricer().PriceOracle
+
+	priceID, err := h.config.GetTokenPriceID(token)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("could not get price id: %w", err)
+	}
+
+	res, err := h.client.R().
+		SetContext(ctx).
+		Get(strings.ReplaceAll(oracleConfig.HTTPURL, priceIDPlaceholder, priceID))
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("could not get price: %w", err)
+	}
+	if res.IsError() {
+		return 0, time.Time{}, fmt.Errorf("could not get price: %s", res.Status())
+	}
+
+	var body interface{}
+	err = json.Unmarshal(res.Body(), &body)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("could not decode response: %w", err)
+	}
+
+	price, err := jsonPathFloat(body, strings.ReplaceAll(oracleConfig.HTTPPricePath, priceIDPlaceholder, priceID))
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("could not parse price: %w", err)
+	}
+
+	if oracleConfig.HTTPTimestampPath == "" {
+		return price, time.Now(), nil
+	}
+
+	timestamp, err := jsonPathFloat(body, strings.ReplaceAll(oracleConfig.HTTPTimestampPath, priceIDPlaceholder, priceID))
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("could not parse timestamp: %w", err)
+	}
+
+	return price, time.Unix(int64(timestamp), 0), nil
+}
+
+// jsonPathFloat gets a number (or numeric string) from decoded json using a dot separated path.
+func jsonPathFloat(body interface{}, path string) (float64, error) {
+	value := body
+	for _, key := range strings.Split(path, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return 0, fmt.Errorf("expected object at %s", key)
+		}
+		value, ok = object[key]
+		if !ok {
+			return 0, fmt.Errorf("key %s not found", key)
+		}
+	}
+
+	switch typed := value.(type) {
+	case float64:
+		return typed, nil
+	case string:
+		parsed, err := strconv.ParseFloat(typed, 64)
+		if err != nil {
+			return 0, fmt.Errorf("could not parse %s: %w", typed, err)
+		}
+		return parsed, nil
+	default:
+		return 0, fmt.Errorf("expected number at %s, got %T", path, value)
+	}
+}
+
+// aggregatorABI is the subset of the chainlink AggregatorV3Interface used to read prices.
+const aggregatorABI = `[
+	{"inputs":[],"name":"decimals","outputs":[{"internalType":"uint8","name":"","type":"uint8"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"latestRoundData","outputs":[{"internalType":"uint80","name":"roundId","type":"uint80"},{"internalType":"int256","name":"answer","type":"int256"},{"internalType":"uint256","name":"startedAt","type":"uint256"},{"internalType":"uint256","name":"updatedAt","type":"uint256"},{"internalType":"uint80","name":"answeredInRound","type":"uint80"}],"stateMutability":"view","type":"function"}
+]`
+
+// chainlinkOracle reads prices from chainlink-style aggregators through omnirpc.
+type chainlinkOracle struct {
+	config        relconfig.Config
+	clientFetcher submitter.ClientFetcher
+	// abi is the parsed aggregator abi
+	abi abi.ABI
+	// abiErr is set if the abi could not be parsed
+	abiErr error
+}
+
+func newChainlinkOracle(config relconfig.Config, clientFetcher submitter.ClientFetcher) *chainlinkOracle {
+	parsedABI, err := abi.JSON(strings.NewReader(aggregatorABI))
+	return &chainlinkOracle{
+		config:        config,
+		clientFetcher: clientFetcher,
+		abi:           parsedABI,
+		abiErr:        err,
+	}
+}
+
+func (c *chainlinkOracle) GetPrice(ctx context.Context, token string) (float64, time.Time, error) {
+	if c.abiErr != nil {
+		return 0, time.Time{}, fmt.Errorf("could not parse aggregator abi: %w", c.abiErr)
+	}
+
+	chainID, feed, err := c.config.GetTokenPriceFeed(token)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("could not get price feed: %w", err)
+	}
+
+	client, err := c.clientFetcher.GetClient(ctx, big.NewInt(int64(chainID)))
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("could not get client for chain %d: %w", chainID, err)
+	}
+
+	decimalsData, err := c.call(ctx, client, feed, "decimals")
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	decimals, ok := decimalsData[0].(uint8)
+	if !ok {
+		return 0, time.Time{}, fmt.Errorf("unexpected decimals type %T", decimalsData[0])
+	}
+
+	roundData, err := c.call(ctx, client, feed, "latestRoundData")
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	answer, ok := roundData[1].(*big.Int)
+	if !ok {
+		return 0, time.Time{}, fmt.Errorf("unexpected answer type %T", roundData[1])
+	}
+	updatedAt, ok := roundData[3].(*big.Int)
+	if !ok {
+		return 0, time.Time{}, fmt.Errorf("unexpected updated at type %T", roundData[3])
+	}
+
+	decimalsFactor := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	price, _ := new(big.Float).Quo(new(big.Float).SetInt(answer), decimalsFactor).Float64()
+
+	return price, time.Unix(updatedAt.Int64(), 0), nil
+}
+
+// call calls a view method on an aggregator and unpacks the result.
+func (c *chainlinkOracle) call(ctx context.Context, client ethereum.ContractCaller, feed common.Address, method string) ([]interface{}, error) {
+	data, err := c.abi.Pack(method)
+	if err != nil {
+		return nil, fmt.Errorf("could not pack %s: %w", method, err)
+	}
+
+	res, err := client.CallContract(ctx, ethereum.CallMsg{To: &feed, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not call %s on %s: %w", method, feed, err)
+	}
+
+	unpacked, err := c.abi.Unpack(method, res)
+	if err != nil {
+		return nil, fmt.Errorf("could not unpack %s: %w", method, err)
+	}
+	return unpacked, nil
+}
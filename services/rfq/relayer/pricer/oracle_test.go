@@ -0,0 +1,264 @@
+package pricer_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/core/testsuite"
+	clientMocks "github.com/synapsecns/sanguine/ethergo/client/mocks"
+	fetcherMocks "github.com/synapsecns/sanguine/ethergo/submitter/mocks"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/pricer"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/relconfig"
+)
+
+// newPriceServer creates an http price source. If fail is set, the server returns a 500.
+func (s *PricerSuite) newPriceServer(price string, updatedAt time.Time, fail *bool) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail != nil && *fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		id := strings.TrimPrefix(r.URL.Path, "/")
+		_, _ = fmt.Fprintf(w, `{"coins":{"%s":{"price":%s,"timestamp":%d}}}`, id, price, updatedAt.Unix())
+	}))
+	s.T().Cleanup(server.Close)
+	return server.URL
+}
+
+func (s *PricerSuite) setPriceOracle(oracleConfig relconfig.PriceOracleConfig) {
+	s.config.FeePricer.PriceOracle = oracleConfig
+	for chainID, chainConfig := range s.config.Chains {
+		for tokenName, tokenConfig := range chainConfig.Tokens {
+			tokenConfig.PriceID = strings.ToLower(tokenName)
+			chainConfig.Tokens[tokenName] = tokenConfig
+		}
+		s.config.Chains[chainID] = chainConfig
+	}
+}
+
+func (s *PricerSuite) TestStaticOracle() {
+	oracle := pricer.NewPriceOracle(s.config, new(fetcherMocks.ClientFetcher), metrics.NewNullHandler())
+
+	price, _, err := oracle.GetPrice(s.GetTestContext(), "ETH")
+	s.NoError(err)
+	s.Equal(2000., price)
+
+	_, _, err = oracle.GetPrice(s.GetTestContext(), "UNKNOWN")
+	s.Error(err)
+}
+
+func (s *PricerSuite) TestHTTPOracle() {
+	url := s.newPriceServer(`"2500.5"`, time.Now(), nil)
+	s.setPriceOracle(relconfig.PriceOracleConfig{
+		Sources:           []string{relconfig.PriceSourceHTTP},
+		HTTPURL:           url + "/{id}",
+		HTTPPricePath:     "coins.{id}.price",
+		HTTPTimestampPath: "coins.{id}.timestamp",
+	})
+	oracle := pricer.NewPriceOracle(s.config, new(fetcherMocks.ClientFetcher), metrics.NewNullHandler())
+
+	price, _, err := oracle.GetPrice(s.GetTestContext(), "ETH")
+	s.NoError(err)
+	s.Equal(2500.5, price)
+}
+
+func (s *PricerSuite) TestStalePrice() {
+	url := s.newPriceServer("2500", time.Now().Add(-time.Hour*2), nil)
+	s.setPriceOracle(relconfig.PriceOracleConfig{
+		Sources:             []string{relconfig.PriceSourceHTTP},
+		MaxStalenessSeconds: 3600,
+		HTTPURL:             url + "/{id}",
+		HTTPPricePath:       "coins.{id}.price",
+		HTTPTimestampPath:   "coins.{id}.timestamp",
+	})
+	oracle := pricer.NewPriceOracle(s.config, new(fetcherMocks.ClientFetcher), metrics.NewNullHandler())
+
+	_, _, err := oracle.GetPrice(s.GetTestContext(), "ETH")
+	s.ErrorIs(err, pricer.ErrStalePrice)
+
+	// fees can't be priced off a stale price either
+	clientFetcher := new(fetcherMocks.ClientFetcher)
+	client := new(clientMocks.EVM)
+	client.On(testsuite.GetFunctionName(client.HeaderByNumber), mock.Anything, mock.Anything).Return(&types.Header{BaseFee: big.NewInt(100_000_000_000)}, nil)
+	clientFetcher.On(testsuite.GetFunctionName(clientFetcher.GetClient), mock.Anything, mock.Anything).Return(client, nil)
+	feePricer := pricer.NewFeePricer(s.config, clientFetcher, metrics.NewNullHandler())
+
+	_, err = feePricer.GetOriginFee(s.GetTestContext(), s.origin, s.destination, "USDC", true)
+	s.ErrorIs(err, pricer.ErrStalePrice)
+}
+
+func (s *PricerSuite) TestStaticFallback() {
+	fail := true
+	url := s.newPriceServer("2500", time.Now(), &fail)
+	s.setPriceOracle(relconfig.PriceOracleConfig{
+		Sources:       []string{relconfig.PriceSourceHTTP, relconfig.PriceSourceStatic},
+		HTTPURL:       url + "/{id}",
+		HTTPPricePath: "coins.{id}.price",
+	})
+	oracle := pricer.NewPriceOracle(s.config, new(fetcherMocks.ClientFetcher), metrics.NewNullHandler())
+
+	price, _, err := oracle.GetPrice(s.GetTestContext(), "ETH")
+	s.NoError(err)
+	s.Equal(2000., price)
+}
+
+func (s *PricerSuite) TestLastKnownPrice() {
+	fail := false
+	url := s.newPriceServer("2500", time.Now(), &fail)
+	s.setPriceOracle(relconfig.PriceOracleConfig{
+		Sources:             []string{relconfig.PriceSourceHTTP},
+		MaxStalenessSeconds: 3600,
+		HTTPURL:             url + "/{id}",
+		HTTPPricePath:       "coins.{id}.price",
+		HTTPTimestampPath:   "coins.{id}.timestamp",
+	})
+	oracle := pricer.NewPriceOracle(s.config, new(fetcherMocks.ClientFetcher), metrics.NewNullHandler())
+
+	price, _, err := oracle.GetPrice(s.GetTestContext(), "ETH")
+	s.NoError(err)
+	s.Equal(2500., price)
+
+	// the source goes down after the cache expires, the last known price is used until it's stale
+	fail = true
+	start := time.Now()
+	pricer.SetNow(oracle, func() time.Time { return start.Add(time.Minute * 30) })
+	price, _, err = oracle.GetPrice(s.GetTestContext(), "ETH")
+	s.NoError(err)
+	s.Equal(2500., price)
+
+	pricer.SetNow(oracle, func() time.Time { return start.Add(time.Hour * 2) })
+	_, _, err = oracle.GetPrice(s.GetTestContext(), "ETH")
+	s.ErrorIs(err, pricer.ErrStalePrice)
+}
+
+func (s *PricerSuite) TestFailedFetchBackoff() {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	s.T().Cleanup(server.Close)
+
+	s.setPriceOracle(relconfig.PriceOracleConfig{
+		Sources:       []string{relconfig.PriceSourceHTTP},
+		HTTPURL:       server.URL + "/{id}",
+		HTTPPricePath: "coins.{id}.price",
+	})
+	oracle := pricer.NewPriceOracle(s.config, new(fetcherMocks.ClientFetcher), metrics.NewNullHandler())
+	start := time.Now()
+	pricer.SetNow(oracle, func() time.Time { return start })
+
+	// the source isn't refetched until the backoff passes
+	for i := 0; i < 5; i++ {
+		_, _, err := oracle.GetPrice(s.GetTestContext(), "ETH")
+		s.Error(err)
+	}
+	s.Equal(int64(1), requests.Load())
+
+	pricer.SetNow(oracle, func() time.Time { return start.Add(time.Second) })
+	_, _, err := oracle.GetPrice(s.GetTestContext(), "ETH")
+	s.Error(err)
+	s.Equal(int64(2), requests.Load())
+
+	// the backoff doubles after each failure
+	pricer.SetNow(oracle, func() time.Time { return start.Add(time.Second * 2) })
+	_, _, err = oracle.GetPrice(s.GetTestContext(), "ETH")
+	s.Error(err)
+	s.Equal(int64(2), requests.Load())
+
+	pricer.SetNow(oracle, func() time.Time { return start.Add(time.Second * 3) })
+	_, _, err = oracle.GetPrice(s.GetTestContext(), "ETH")
+	s.Error(err)
+	s.Equal(int64(3), requests.Load())
+}
+
+func (s *PricerSuite) TestConcurrentFetchesDeduped() {
+	var requests atomic.Int64
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		_, _ = fmt.Fprintf(w, `{"coins":{"eth":{"price":2500,"timestamp":%d}}}`, time.Now().Unix())
+	}))
+	s.T().Cleanup(server.Close)
+
+	s.setPriceOracle(relconfig.PriceOracleConfig{
+		Sources:           []string{relconfig.PriceSourceHTTP},
+		HTTPURL:           server.URL + "/{id}",
+		HTTPPricePath:     "coins.{id}.price",
+		HTTPTimestampPath: "coins.{id}.timestamp",
+	})
+	oracle := pricer.NewPriceOracle(s.config, new(fetcherMocks.ClientFetcher), metrics.NewNullHandler())
+
+	const callers = 10
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			price, _, err := oracle.GetPrice(s.GetTestContext(), "ETH")
+			s.NoError(err)
+			s.Equal(2500., price)
+		}()
+	}
+
+	s.Eventually(func() bool {
+		return requests.Load() > 0
+	})
+	// give the other callers a chance to miss the cache before the fetch finishes
+	time.Sleep(time.Millisecond * 100)
+	close(release)
+	wg.Wait()
+
+	s.Equal(int64(1), requests.Load())
+}
+
+func (s *PricerSuite) TestChainlinkOracle() {
+	feedChain := s.config.Chains[int(s.l1ChainID)]
+	ethConfig := feedChain.Tokens["ETH"]
+	ethConfig.PriceFeed = "0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419"
+	feedChain.Tokens["ETH"] = ethConfig
+	s.config.Chains[int(s.l1ChainID)] = feedChain
+	s.config.FeePricer.PriceOracle.Sources = []string{relconfig.PriceSourceChainlink}
+
+	aggregator, err := abi.JSON(strings.NewReader(pricer.AggregatorABI))
+	s.Require().NoError(err)
+
+	decimals, err := aggregator.Methods["decimals"].Outputs.Pack(uint8(8))
+	s.Require().NoError(err)
+	roundData, err := aggregator.Methods["latestRoundData"].Outputs.Pack(
+		big.NewInt(1), big.NewInt(2345_67000000), big.NewInt(time.Now().Unix()), big.NewInt(time.Now().Unix()), big.NewInt(1),
+	)
+	s.Require().NoError(err)
+
+	clientFetcher := new(fetcherMocks.ClientFetcher)
+	client := new(clientMocks.EVM)
+	client.On(testsuite.GetFunctionName(client.CallContract), mock.Anything, mock.MatchedBy(func(call ethereum.CallMsg) bool {
+		return strings.HasPrefix(hexutil.Encode(call.Data), hexutil.Encode(aggregator.Methods["decimals"].ID))
+	}), mock.Anything).Return(decimals, nil)
+	client.On(testsuite.GetFunctionName(client.CallContract), mock.Anything, mock.MatchedBy(func(call ethereum.CallMsg) bool {
+		return strings.HasPrefix(hexutil.Encode(call.Data), hexutil.Encode(aggregator.Methods["latestRoundData"].ID))
+	}), mock.Anything).Return(roundData, nil)
+	clientFetcher.On(testsuite.GetFunctionName(clientFetcher.GetClient), mock.Anything, big.NewInt(int64(s.l1ChainID))).Return(client, nil)
+
+	oracle := pricer.NewPriceOracle(s.config, clientFetcher, metrics.NewNullHandler())
+	price, _, err := oracle.GetPrice(s.GetTestContext(), "ETH")
+	s.NoError(err)
+	s.InDelta(2345.67, price, 1e-9)
+
+	// tokens without a feed can't be priced
+	_, _, err = oracle.GetPrice(s.GetTestContext(), "USDC")
+	s.Error(err)
+}
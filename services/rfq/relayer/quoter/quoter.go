@@ -186,6 +186,7 @@ func (m *Manager) isProfitableQuote(parentCtx context.Context, quote reldb.Quote
 	if err != nil {
 		return false, fmt.Errorf("error getting dest token ID: %w", err)
 	}
+	// a stale price returns an error so the request is retried once prices are fresh again
 	fee, err := m.feePricer.GetTotalFee(ctx, quote.Transaction.OriginChainId, quote.Transaction.DestChainId, destTokenID, false)
 	if err != nil {
 		return false, fmt.Errorf("error getting total fee: %w", err)
@@ -279,7 +280,13 @@ func (m *Manager) generateQuotes(ctx context.Context, chainID int, address commo
 					return nil, fmt.Errorf("error getting dest token ID: %w", err)
 				}
 				fee, err := m.feePricer.GetTotalFee(ctx, uint32(origin), uint32(chainID), destToken, true)
-				if err != nil {
+				// don't quote if a price is stale. The quote is zeroed rather than skipped so a quote
+				// priced off an older price isn't left up.
+				if errors.Is(err, pricer.ErrStalePrice) {
+					logger.Warnf("not quoting %s from chain %d to chain %d: %v", destToken, origin, chainID, err)
+					quoteAmount = big.NewInt(0)
+					fee = big.NewInt(0)
+				} else if err != nil {
 					return nil, fmt.Errorf("error getting total fee: %w", err)
 				}
 				originChainCfg, ok := m.config.Chains[origin]
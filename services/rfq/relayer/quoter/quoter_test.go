@@ -1,13 +1,19 @@
 package quoter_test
 
 import (
+	"fmt"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/core/testsuite"
+	clientMocks "github.com/synapsecns/sanguine/ethergo/client/mocks"
 	fetcherMocks "github.com/synapsecns/sanguine/ethergo/submitter/mocks"
 	"github.com/synapsecns/sanguine/services/rfq/api/model"
 	"github.com/synapsecns/sanguine/services/rfq/contracts/fastbridge"
@@ -15,6 +21,7 @@
 	inventoryMocks "github.com/synapsecns/sanguine/services/rfq/relayer/inventory/mocks"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/pricer"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/quoter"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/relconfig"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb"
 )
 
@@ -246,3 +253,49 @@ func (s *QuoterSuite) TestGetDestAmount() {
 	expectedAmount = balance
 	s.Equal(expectedAmount, destAmount)
 }
+
+func (s *QuoterSuite) TestGenerateQuotesStalePrice() {
+	// Serve prices that were last updated a day ago.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"price":1,"timestamp":%d}`, time.Now().Add(-time.Hour*24).Unix())
+	}))
+	defer server.Close()
+
+	s.config.FeePricer.PriceOracle = relconfig.PriceOracleConfig{
+		Sources:             []string{relconfig.PriceSourceHTTP},
+		MaxStalenessSeconds: 3600,
+		HTTPURL:             server.URL + "/{id}",
+		HTTPPricePath:       "price",
+		HTTPTimestampPath:   "timestamp",
+	}
+	for chainID, chainConfig := range s.config.Chains {
+		for tokenName, tokenConfig := range chainConfig.Tokens {
+			tokenConfig.PriceID = strings.ToLower(tokenName)
+			chainConfig.Tokens[tokenName] = tokenConfig
+		}
+		s.config.Chains[chainID] = chainConfig
+	}
+
+	clientFetcher := new(fetcherMocks.ClientFetcher)
+	client := new(clientMocks.EVM)
+	currentHeader := &types.Header{BaseFee: big.NewInt(100_000_000_000)} // 100 gwei
+	client.On(testsuite.GetFunctionName(client.HeaderByNumber), mock.Anything, mock.Anything).Return(currentHeader, nil)
+	clientFetcher.On(testsuite.GetFunctionName(clientFetcher.GetClient), mock.Anything, mock.Anything).Return(client, nil)
+	feePricer := pricer.NewFeePricer(s.config, clientFetcher, metrics.NewNullHandler())
+
+	inventoryManager := new(inventoryMocks.Manager)
+	inventoryManager.On(testsuite.GetFunctionName(inventoryManager.HasSufficientGas), mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	mgr, err := quoter.NewQuoterManager(s.config, metrics.NewNullHandler(), inventoryManager, nil, feePricer)
+	s.Require().NoError(err)
+	manager, ok := mgr.(*quoter.Manager)
+	s.Require().True(ok)
+
+	// The route should still be quoted, but with a zero amount.
+	balance := big.NewInt(1000_000_000) // 1000 USDC
+	quotes, err := manager.GenerateQuotes(s.GetTestContext(), int(s.destination), common.HexToAddress("0x0b2c639c533813f4aa9d7837caf62653d097ff85"), balance)
+	s.Require().NoError(err)
+	s.Require().Len(quotes, 1)
+	s.Equal("0", quotes[0].DestAmount)
+	s.Equal("0", quotes[0].MaxOriginAmount)
+	s.Equal("0", quotes[0].FixedFee)
+}
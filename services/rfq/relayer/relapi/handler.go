@@ -1,6 +1,7 @@
 package relapi
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -9,6 +10,7 @@
 	"github.com/gin-gonic/gin"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/chain"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb"
+	"golang.org/x/exp/slices"
 )
 
 // Handler is the REST API handler.
@@ -124,3 +126,72 @@ func (h *Handler) GetTxRetry(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, resp)
 }
+
+// GetRebalances gets all rebalances that are in flight, including ones waiting on an operator.
+func (h *Handler) GetRebalances(c *gin.Context) {
+	rebalances, err := h.db.GetRebalancesByStatus(c, reldb.InFlightRebalanceStatuses...)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp := make([]GetRebalanceResponse, len(rebalances))
+	for i, rebalance := range rebalances {
+		resp[i] = GetRebalanceResponse{
+			ID:            rebalance.ID,
+			Method:        rebalance.Method,
+			Status:        rebalance.Status.String(),
+			OriginChainID: rebalance.OriginChainID,
+			DestChainID:   rebalance.DestChainID,
+			OriginToken:   rebalance.OriginToken.String(),
+			DestToken:     rebalance.DestToken.String(),
+			OriginAmount:  rebalance.OriginAmount.String(),
+			DestAmount:    rebalance.DestAmount.String(),
+			OriginTxHash:  rebalance.OriginTxHash.String(),
+		}
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// PutRebalanceComplete marks a manual rebalance, or one whose submission was interrupted, as completed
+// once an operator has checked the funds arrived.
+func (h *Handler) PutRebalanceComplete(c *gin.Context) {
+	h.resolveRebalance(c, reldb.RebalanceCompleted, reldb.RebalancePendingApproval, reldb.RebalanceSubmitting)
+}
+
+// PutRebalanceReject rejects a rebalance that hasn't been submitted on chain yet.
+func (h *Handler) PutRebalanceReject(c *gin.Context) {
+	h.resolveRebalance(c, reldb.RebalanceFailed, reldb.RebalancePendingApproval, reldb.RebalanceRequested, reldb.RebalanceSubmitting)
+}
+
+// resolveRebalance moves a rebalance to a terminal status if it is in one of the allowed statuses.
+func (h *Handler) resolveRebalance(c *gin.Context, status reldb.RebalanceStatus, allowedStatuses ...reldb.RebalanceStatus) {
+	id := c.Query("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Must specify 'id'"})
+		return
+	}
+
+	rebalance, err := h.db.GetRebalanceByID(c, id)
+	if errors.Is(err, reldb.ErrNoRebalanceForID) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !slices.Contains(allowedStatuses, rebalance.Status) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("rebalance is %s", rebalance.Status)})
+		return
+	}
+
+	rebalance.Status = status
+	err = h.db.UpdateRebalance(c, *rebalance)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": status.String()})
+}
@@ -15,3 +15,17 @@ type GetTxRetryResponse struct {
 	Nonce     uint64 `json:"nonce"`
 	GasAmount string `json:"gas_amount"`
 }
+
+// GetRebalanceResponse contains the schema for a rebalance in a GET /rebalances response.
+type GetRebalanceResponse struct {
+	ID            string `json:"id"`
+	Method        string `json:"method"`
+	Status        string `json:"status"`
+	OriginChainID uint32 `json:"origin_chain_id"`
+	DestChainID   uint32 `json:"dest_chain_id"`
+	OriginToken   string `json:"origin_token"`
+	DestToken     string `json:"dest_token"`
+	OriginAmount  string `json:"origin_amount"`
+	DestAmount    string `json:"dest_amount"`
+	OriginTxHash  string `json:"origin_tx_hash"`
+}
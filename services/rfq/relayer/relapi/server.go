@@ -4,6 +4,7 @@
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/ipfs/go-log"
 	"github.com/synapsecns/sanguine/core/ginhelper"
@@ -14,6 +15,7 @@
 	"github.com/synapsecns/sanguine/core/metrics"
 	baseServer "github.com/synapsecns/sanguine/core/server"
 	omniClient "github.com/synapsecns/sanguine/services/omnirpc/client"
+	"github.com/synapsecns/sanguine/services/rfq/api/rest"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/chain"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/listener"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/relconfig"
@@ -28,6 +30,8 @@ type RelayerAPIServer struct {
 	engine  *gin.Engine
 	handler metrics.Handler
 	chains  map[uint32]*chain.Chain
+	// relayerAddress is the address of the relayer's signer.
+	relayerAddress common.Address
 }
 
 // NewRelayerAPI holds the configuration, database connection, gin engine, RPC client, metrics handler, and fast bridge contracts.
@@ -39,6 +43,7 @@ func NewRelayerAPI(
 	omniRPCClient omniClient.RPCClient,
 	store reldb.Service,
 	submitter submitter.TransactionSubmitter,
+	relayerAddress common.Address,
 ) (*RelayerAPIServer, error) {
 	if ctx == nil {
 		return nil, fmt.Errorf("context is nil")
@@ -74,6 +79,8 @@ func NewRelayerAPI(
 		db:      store,
 		handler: handler,
 		chains:  chains,
+
+		relayerAddress: relayerAddress,
 	}, nil
 }
 
@@ -82,6 +89,9 @@ func NewRelayerAPI(
 	getQuoteStatusByTxHashRoute = "/status"
 	getQuoteStatusByTxIDRoute   = "/status/by_tx_id"
 	getRetryRoute               = "/retry"
+	getRebalancesRoute          = "/rebalances"
+	putRebalanceCompleteRoute   = "/rebalance/complete"
+	putRebalanceRejectRoute     = "/rebalance/reject"
 )
 
 var logger = log.Logger("relayer-api")
@@ -97,6 +107,13 @@ func (r *RelayerAPIServer) Run(ctx context.Context) error {
 	engine.GET(getQuoteStatusByTxHashRoute, h.GetQuoteRequestStatusByTxHash)
 	engine.GET(getQuoteStatusByTxIDRoute, h.GetQuoteRequestStatusByTxID)
 	engine.GET(getRetryRoute, h.GetTxRetry)
+	engine.GET(getRebalancesRoute, h.GetRebalances)
+
+	// Assign PUT routes, resolving rebalances is restricted to the relayer
+	rebalanceGroup := engine.Group("")
+	rebalanceGroup.Use(r.AuthMiddleware())
+	rebalanceGroup.PUT(putRebalanceCompleteRoute, h.PutRebalanceComplete)
+	rebalanceGroup.PUT(putRebalanceRejectRoute, h.PutRebalanceReject)
 
 	r.engine = engine
 
@@ -109,3 +126,15 @@ func (r *RelayerAPIServer) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// AuthMiddleware is the Gin authentication middleware that authenticates requests using EIP191.
+// Only the relayer's own signer can make authenticated requests.
+func (r *RelayerAPIServer) AuthMiddleware() gin.HandlerFunc {
+	return rest.SignerAuthMiddleware(r.cfg.GetRelayerAPIAuthExpiry(), func(c *gin.Context, signer common.Address) bool {
+		if signer != r.relayerAddress {
+			c.JSON(http.StatusUnauthorized, gin.H{"msg": "signer is not this relayer"})
+			return false
+		}
+		return true
+	})
+}
@@ -6,12 +6,16 @@
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/synapsecns/sanguine/core/retry"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer/localsigner"
+	"github.com/synapsecns/sanguine/ethergo/signer/wallet"
 	submitterdb "github.com/synapsecns/sanguine/ethergo/submitter/db"
 	"github.com/synapsecns/sanguine/services/rfq/contracts/fastbridge"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/relapi"
@@ -142,6 +146,99 @@ func (c *RelayerServerSuite) TestGetTxRetry() {
 	c.Equal(status, submitterdb.Stored)
 }
 
+func (c *RelayerServerSuite) TestRebalances() {
+	c.startQuoterAPIServer()
+
+	// Insert a manual rebalance awaiting approval
+	rebalance := reldb.Rebalance{
+		ID:            "test-rebalance",
+		Method:        "manual",
+		OriginChainID: 1,
+		DestChainID:   2,
+		OriginToken:   common.HexToAddress("0x1"),
+		DestToken:     common.HexToAddress("0x2"),
+		OriginAmount:  big.NewInt(100),
+		DestAmount:    big.NewInt(100),
+		Status:        reldb.RebalancePendingApproval,
+	}
+	err := c.database.StoreRebalance(c.GetTestContext(), rebalance)
+	c.Require().NoError(err)
+
+	// List the rebalances
+	client := &http.Client{}
+	req, err := http.NewRequestWithContext(c.GetTestContext(), http.MethodGet, fmt.Sprintf("http://localhost:%d/rebalances", c.port), nil)
+	c.Require().NoError(err)
+	resp, err := client.Do(req)
+	c.Require().NoError(err)
+	var result []relapi.GetRebalanceResponse
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	c.Require().NoError(err)
+	c.Require().NoError(resp.Body.Close())
+	c.Equal(http.StatusOK, resp.StatusCode)
+	c.Require().Len(result, 1)
+	c.Equal(rebalance.ID, result[0].ID)
+	c.Equal(reldb.RebalancePendingApproval.String(), result[0].Status)
+	c.Equal("100", result[0].OriginAmount)
+
+	// Resolving a rebalance requires the relayer's signature
+	req, err = http.NewRequestWithContext(c.GetTestContext(), http.MethodPut, fmt.Sprintf("http://localhost:%d/rebalance/complete?id=%s", c.port, rebalance.ID), nil)
+	c.Require().NoError(err)
+	resp, err = client.Do(req)
+	c.Require().NoError(err)
+	c.Require().NoError(resp.Body.Close())
+	c.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	nonRelayer, err := wallet.FromRandom()
+	c.Require().NoError(err)
+	req, err = http.NewRequestWithContext(c.GetTestContext(), http.MethodPut, fmt.Sprintf("http://localhost:%d/rebalance/complete?id=%s", c.port, rebalance.ID), nil)
+	c.Require().NoError(err)
+	req.Header.Set("Authorization", c.authHeader(nonRelayer))
+	resp, err = client.Do(req)
+	c.Require().NoError(err)
+	c.Require().NoError(resp.Body.Close())
+	c.Equal(http.StatusUnauthorized, resp.StatusCode)
+
+	// Other on-chain relayers can't resolve this relayer's rebalances
+	req, err = http.NewRequestWithContext(c.GetTestContext(), http.MethodPut, fmt.Sprintf("http://localhost:%d/rebalance/complete?id=%s", c.port, rebalance.ID), nil)
+	c.Require().NoError(err)
+	req.Header.Set("Authorization", c.authHeader(c.testWallet))
+	resp, err = client.Do(req)
+	c.Require().NoError(err)
+	c.Require().NoError(resp.Body.Close())
+	c.Equal(http.StatusUnauthorized, resp.StatusCode)
+
+	// Complete the rebalance
+	req, err = http.NewRequestWithContext(c.GetTestContext(), http.MethodPut, fmt.Sprintf("http://localhost:%d/rebalance/complete?id=%s", c.port, rebalance.ID), nil)
+	c.Require().NoError(err)
+	req.Header.Set("Authorization", c.authHeader(c.wallet))
+	resp, err = client.Do(req)
+	c.Require().NoError(err)
+	c.Require().NoError(resp.Body.Close())
+	c.Equal(http.StatusOK, resp.StatusCode)
+
+	stored, err := c.database.GetRebalanceByID(c.GetTestContext(), rebalance.ID)
+	c.Require().NoError(err)
+	c.Equal(reldb.RebalanceCompleted, stored.Status)
+
+	// A completed rebalance can no longer be rejected
+	req, err = http.NewRequestWithContext(c.GetTestContext(), http.MethodPut, fmt.Sprintf("http://localhost:%d/rebalance/reject?id=%s", c.port, rebalance.ID), nil)
+	c.Require().NoError(err)
+	req.Header.Set("Authorization", c.authHeader(c.wallet))
+	resp, err = client.Do(req)
+	c.Require().NoError(err)
+	c.Require().NoError(resp.Body.Close())
+	c.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	// Unknown rebalances are not found
+	req, err = http.NewRequestWithContext(c.GetTestContext(), http.MethodPut, fmt.Sprintf("http://localhost:%d/rebalance/reject?id=unknown", c.port), nil)
+	c.Require().NoError(err)
+	req.Header.Set("Authorization", c.authHeader(c.wallet))
+	resp, err = client.Do(req)
+	c.Require().NoError(err)
+	c.Require().NoError(resp.Body.Close())
+	c.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
 // startQuoterAPIServer starts the API server and waits for it to initialize.
 func (c *RelayerServerSuite) startQuoterAPIServer() {
 	go func() {
@@ -155,18 +252,24 @@ func (c *RelayerServerSuite) startQuoterAPIServer() {
 		req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://localhost:%d/health", c.port), nil)
 		c.Require().NoError(err)
 		resp, err := client.Do(req)
-		defer func() {
-			closeErr := resp.Body.Close()
-			c.NoError(closeErr)
-		}()
 		if err != nil {
 			return fmt.Errorf("server not ready: %w", err)
 		}
+		c.NoError(resp.Body.Close())
 		return nil
 	}, retry.WithMaxTotalTime(60*time.Second))
 	c.Require().NoError(err)
 }
 
+// authHeader creates an eip-191 authorization header signed by the given wallet.
+func (c *RelayerServerSuite) authHeader(signingWallet wallet.Wallet) string {
+	now := strconv.Itoa(int(time.Now().Unix()))
+	data := "\x19Ethereum Signed Message:\n" + strconv.Itoa(len(now)) + now
+	sig, err := localsigner.NewSigner(signingWallet.PrivateKey()).SignMessage(c.GetTestContext(), []byte(data), true)
+	c.Require().NoError(err)
+	return fmt.Sprintf("%s:%s", now, hexutil.Encode(signer.Encode(sig)))
+}
+
 func (c *RelayerServerSuite) getTestQuoteRequest(status reldb.QuoteRequestStatus) reldb.QuoteRequest {
 	txIDRaw := hexutil.Encode(crypto.Keccak256([]byte("test")))
 	var txID [32]byte
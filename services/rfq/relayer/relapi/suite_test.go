@@ -99,7 +99,7 @@ func (c *RelayerServerSuite) SetupTest() {
 	submitterCfg := &submitterConfig.Config{}
 	ts := submitter.NewTransactionSubmitter(c.handler, signer, omniRPCClient, c.database.SubmitterDB(), submitterCfg)
 
-	server, err := relapi.NewRelayerAPI(c.GetTestContext(), c.cfg, c.handler, c.omniRPCClient, c.database, ts)
+	server, err := relapi.NewRelayerAPI(c.GetTestContext(), c.cfg, c.handler, c.omniRPCClient, c.database, ts, c.wallet.Address())
 	c.Require().NoError(err)
 	c.RelayerAPIServer = server
 }
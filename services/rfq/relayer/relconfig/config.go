@@ -31,6 +31,8 @@ type Config struct {
 	RfqAPIURL string `yaml:"rfq_url"`
 	// RelayerAPIPort is the port of the relayer API.
 	RelayerAPIPort string `yaml:"relayer_api_port"`
+	// RelayerAPIAuthExpirySeconds is how long an eip-191 authorization to the relayer API is accepted after it was signed.
+	RelayerAPIAuthExpirySeconds int `yaml:"relayer_api_auth_expiry_seconds"`
 	// Database is the database config.
 	Database DatabaseConfig `yaml:"database"`
 	// QuotableTokens is a map of token -> list of quotable tokens.
@@ -51,6 +53,8 @@ type Config struct {
 	ScreenerAPIUrl string `yaml:"screener_api_url"`
 	// BaseDeadlineBufferSeconds is the deadline buffer for relaying a transaction.
 	BaseDeadlineBufferSeconds int `yaml:"base_deadline_buffer_seconds"`
+	// Rebalance is the inventory rebalancer config.
+	Rebalance RebalanceConfig `yaml:"rebalance"`
 }
 
 // ChainConfig represents the configuration for a chain.
@@ -65,6 +69,12 @@ type ChainConfig struct {
 	NativeToken string `yaml:"native_token"`
 	// DeadlineBufferSeconds is the deadline buffer for relaying a transaction.
 	DeadlineBufferSeconds int `yaml:"deadline_buffer_seconds"`
+	// CCTPDomain is the circle domain of the chain, used by the cctp rebalance method.
+	CCTPDomain uint32 `yaml:"cctp_domain"`
+	// TokenMessenger is the address of the cctp TokenMessenger, used by the cctp rebalance method.
+	TokenMessenger string `yaml:"token_messenger"`
+	// MessageTransmitter is the address of the cctp MessageTransmitter, used by the cctp rebalance method.
+	MessageTransmitter string `yaml:"message_transmitter"`
 }
 
 // TokenConfig represents the configuration for a token.
@@ -73,10 +83,23 @@ type TokenConfig struct {
 	Address string `yaml:"address"`
 	// Decimals is the token decimals.
 	Decimals uint8 `yaml:"decimals"`
-	// For now, specify the USD price of the token in the config.
+	// PriceUSD is the static USD price of the token, used by the static price source.
 	PriceUSD float64 `yaml:"price_usd"`
+	// PriceID is the id of the token in the http price source, e.g. a coingecko id.
+	PriceID string `yaml:"price_id"`
+	// PriceFeed is the address of a chainlink-style USD aggregator for the token on this chain.
+	PriceFeed string `yaml:"price_feed"`
 	// MinQuoteAmount is the minimum amount to quote for this token in human-readable units.
 	MinQuoteAmount string `yaml:"min_quote_amount"`
+	// RebalanceMethod is how inventory is moved to and from this chain: fastbridge, cctp or manual.
+	// Tokens without a rebalance method are never rebalanced.
+	RebalanceMethod string `yaml:"rebalance_method"`
+	// TargetBalancePct is the percent of the token's total inventory (across chains) this chain should hold.
+	TargetBalancePct float64 `yaml:"target_balance_pct"`
+	// MinBalancePct is the percent of total inventory below which this chain is rebalanced back to its target.
+	MinBalancePct float64 `yaml:"min_balance_pct"`
+	// MaxBalancePct is the percent of total inventory above which this chain is rebalanced back to its target.
+	MaxBalancePct float64 `yaml:"max_balance_pct"`
 }
 
 // DatabaseConfig represents the configuration for the database.
@@ -99,6 +122,37 @@ type FeePricerConfig struct {
 	TokenPriceCacheTTLSeconds int `yaml:"token_price_cache_ttl"`
 	// ChainFeeParams are parameters that correspond to specific chains.
 	ChainFeeParams map[uint32]ChainFeeParams `yaml:"chain_fee_params"`
+	// PriceOracle is the token price oracle config.
+	PriceOracle PriceOracleConfig `yaml:"price_oracle"`
+}
+
+// PriceOracleConfig represents the configuration for the token price oracle.
+type PriceOracleConfig struct {
+	// Sources are the price sources to try in order: chainlink, http or static.
+	Sources []string `yaml:"sources"`
+	// MaxStalenessSeconds is the max age of a price before the token stops being quoted.
+	MaxStalenessSeconds int `yaml:"max_staleness_seconds"`
+	// HTTPURL is the url of the http price source. {id} is replaced with the token's price id.
+	HTTPURL string `yaml:"http_url"`
+	// HTTPPricePath is the dot separated path to the price in the http response. {id} is replaced with the token's price id.
+	HTTPPricePath string `yaml:"http_price_path"`
+	// HTTPTimestampPath is the dot separated path to the unix timestamp of the price in the http response.
+	// If empty, prices are considered updated when they are fetched.
+	HTTPTimestampPath string `yaml:"http_timestamp_path"`
+}
+
+// RebalanceConfig represents the configuration for the inventory rebalancer.
+type RebalanceConfig struct {
+	// IntervalSeconds is how often balances are checked and in flight rebalances are tracked.
+	IntervalSeconds int `yaml:"interval_seconds"`
+	// TimeoutSeconds is how long a rebalance can be in flight before it's marked as failed.
+	TimeoutSeconds int `yaml:"timeout_seconds"`
+	// FastBridgeFeeBps is the fee paid to relayers on fastbridge rebalances, in basis points of the origin amount.
+	FastBridgeFeeBps int `yaml:"fastbridge_fee_bps"`
+	// FastBridgeDeadlineSeconds is the deadline of fastbridge rebalance requests.
+	FastBridgeDeadlineSeconds int `yaml:"fastbridge_deadline_seconds"`
+	// CCTPAttestationURL is the base url of the circle attestation api.
+	CCTPAttestationURL string `yaml:"cctp_attestation_url"`
 }
 
 // ChainFeeParams represents the chain fee params.
@@ -321,6 +375,130 @@ func (c Config) GetL1FeeParams(chainID uint32, origin bool) (uint32, int, bool)
 	return chainFeeParams.L1FeeChainID, gasEstimate, true
 }
 
+// PriceSourceStatic uses the price_usd set in the token config.
+const PriceSourceStatic = "static"
+
+// PriceSourceHTTP uses a generic http json price source.
+const PriceSourceHTTP = "http"
+
+// PriceSourceChainlink uses a chainlink-style aggregator read through omnirpc.
+const PriceSourceChainlink = "chainlink"
+
+// GetPriceSources returns the price oracle sources in the order they should be tried.
+func (c Config) GetPriceSources() []string {
+	if len(c.FeePricer.PriceOracle.Sources) == 0 {
+		return []string{PriceSourceStatic}
+	}
+	return c.FeePricer.PriceOracle.Sources
+}
+
+const defaultMaxPriceStalenessSeconds = 3600
+
+// GetMaxPriceStaleness returns the max age of a token price before the token stops being quoted.
+func (c Config) GetMaxPriceStaleness() time.Duration {
+	maxStalenessSeconds := c.FeePricer.PriceOracle.MaxStalenessSeconds
+	if maxStalenessSeconds <= 0 {
+		maxStalenessSeconds = defaultMaxPriceStalenessSeconds
+	}
+	return time.Duration(maxStalenessSeconds) * time.Second
+}
+
+// GetTokenConfigByName returns the first token config with the given token name along with its chain id.
+func (c Config) GetTokenConfigByName(token string) (chainID int, tokenConfig TokenConfig, err error) {
+	for chainID, chainConfig := range c.Chains {
+		for tokenName, tokenConfig := range chainConfig.Tokens {
+			if token == tokenName {
+				return chainID, tokenConfig, nil
+			}
+		}
+	}
+	return 0, TokenConfig{}, fmt.Errorf("could not get token config for token %s", token)
+}
+
+// GetTokenPriceFeed returns the chain and address of the price feed for the given token.
+func (c Config) GetTokenPriceFeed(token string) (chainID uint32, feed common.Address, err error) {
+	for chainID, chainConfig := range c.Chains {
+		tokenConfig, ok := chainConfig.Tokens[token]
+		if ok && tokenConfig.PriceFeed != "" {
+			return uint32(chainID), common.HexToAddress(tokenConfig.PriceFeed), nil
+		}
+	}
+	return 0, common.Address{}, fmt.Errorf("no price feed for token %s", token)
+}
+
+// GetTokenPriceID returns the http price source id for the given token.
+func (c Config) GetTokenPriceID(token string) (string, error) {
+	for _, chainConfig := range c.Chains {
+		tokenConfig, ok := chainConfig.Tokens[token]
+		if ok && tokenConfig.PriceID != "" {
+			return tokenConfig.PriceID, nil
+		}
+	}
+	return "", fmt.Errorf("no price id for token %s", token)
+}
+
+// RebalanceMethodFastBridge rebalances by bridging to ourselves through the FastBridge.
+const RebalanceMethodFastBridge = "fastbridge"
+
+// RebalanceMethodCCTP rebalances by burning through cctp and minting on the destination.
+const RebalanceMethodCCTP = "cctp"
+
+// RebalanceMethodManual queues rebalances for an operator to move the funds by hand.
+const RebalanceMethodManual = "manual"
+
+const defaultRebalanceIntervalSeconds = 60
+
+// GetRebalanceInterval returns how often the rebalancer runs.
+func (c Config) GetRebalanceInterval() time.Duration {
+	intervalSeconds := c.Rebalance.IntervalSeconds
+	if intervalSeconds <= 0 {
+		intervalSeconds = defaultRebalanceIntervalSeconds
+	}
+	return time.Duration(intervalSeconds) * time.Second
+}
+
+const defaultRebalanceTimeoutSeconds = 60 * 60 * 24
+
+// GetRebalanceTimeout returns how long a rebalance can be in flight before it's marked as failed.
+func (c Config) GetRebalanceTimeout() time.Duration {
+	timeoutSeconds := c.Rebalance.TimeoutSeconds
+	if timeoutSeconds <= 0 {
+		timeoutSeconds = defaultRebalanceTimeoutSeconds
+	}
+	return time.Duration(timeoutSeconds) * time.Second
+}
+
+const defaultFastBridgeFeeBps = 10
+
+// GetFastBridgeRebalanceFeeBps returns the fee paid to relayers on fastbridge rebalances, in basis points.
+func (c Config) GetFastBridgeRebalanceFeeBps() int {
+	if c.Rebalance.FastBridgeFeeBps <= 0 {
+		return defaultFastBridgeFeeBps
+	}
+	return c.Rebalance.FastBridgeFeeBps
+}
+
+const defaultFastBridgeDeadlineSeconds = 60 * 60
+
+// GetFastBridgeRebalanceDeadline returns the deadline of fastbridge rebalance requests.
+func (c Config) GetFastBridgeRebalanceDeadline() time.Duration {
+	deadlineSeconds := c.Rebalance.FastBridgeDeadlineSeconds
+	if deadlineSeconds <= 0 {
+		deadlineSeconds = defaultFastBridgeDeadlineSeconds
+	}
+	return time.Duration(deadlineSeconds) * time.Second
+}
+
+const defaultCCTPAttestationURL = "https://iris-api.circle.com"
+
+// GetCCTPAttestationURL returns the base url of the circle attestation api.
+func (c Config) GetCCTPAttestationURL() string {
+	if c.Rebalance.CCTPAttestationURL == "" {
+		return defaultCCTPAttestationURL
+	}
+	return c.Rebalance.CCTPAttestationURL
+}
+
 const defaultQuotePct = 100.
 
 // GetQuotePct returns the quote percentage.
@@ -391,4 +569,15 @@ func (c Config) GetDeadlineBuffer(chainID int) time.Duration {
 	return time.Duration(deadlineBufferSeconds) * time.Second
 }
 
+const defaultRelayerAPIAuthExpirySeconds = 1000
+
+// GetRelayerAPIAuthExpiry returns how long an eip-191 authorization to the relayer API is accepted after it was signed.
+func (c Config) GetRelayerAPIAuthExpiry() time.Duration {
+	expirySeconds := c.RelayerAPIAuthExpirySeconds
+	if expirySeconds <= 0 {
+		expirySeconds = defaultRelayerAPIAuthExpirySeconds
+	}
+	return time.Duration(expirySeconds) * time.Second
+}
+
 var _ IConfig = &Config{}
@@ -46,6 +46,26 @@ type IConfig interface {
 	GetDestGasEstimate(chainID uint32) int
 	// GetL1FeeParams returns the L1 fee params for the given chain.
 	GetL1FeeParams(chainID uint32, origin bool) (uint32, int, bool)
+	// GetPriceSources returns the price oracle sources in the order they should be tried.
+	GetPriceSources() []string
+	// GetMaxPriceStaleness returns the max age of a token price before the token stops being quoted.
+	GetMaxPriceStaleness() time.Duration
+	// GetTokenConfigByName returns the first token config with the given token name along with its chain id.
+	GetTokenConfigByName(token string) (chainID int, tokenConfig TokenConfig, err error)
+	// GetTokenPriceFeed returns the chain and address of the price feed for the given token.
+	GetTokenPriceFeed(token string) (chainID uint32, feed common.Address, err error)
+	// GetTokenPriceID returns the http price source id for the given token.
+	GetTokenPriceID(token string) (string, error)
+	// GetRebalanceInterval returns how often the rebalancer runs.
+	GetRebalanceInterval() time.Duration
+	// GetRebalanceTimeout returns how long a rebalance can be in flight before it's marked as failed.
+	GetRebalanceTimeout() time.Duration
+	// GetFastBridgeRebalanceFeeBps returns the fee paid to relayers on fastbridge rebalances, in basis points.
+	GetFastBridgeRebalanceFeeBps() int
+	// GetFastBridgeRebalanceDeadline returns the deadline of fastbridge rebalance requests.
+	GetFastBridgeRebalanceDeadline() time.Duration
+	// GetCCTPAttestationURL returns the base url of the circle attestation api.
+	GetCCTPAttestationURL() string
 	// GetQuotePct returns the quote percentage.
 	GetQuotePct() float64
 	// GetQuoteOffsetBps returns the quote offset in basis points.
@@ -55,4 +75,6 @@ type IConfig interface {
 	GetMinQuoteAmount(chainID int, addr common.Address) *big.Int
 	// GetDeadlineBuffer returns the deadline buffer for relaying a transaction.
 	GetDeadlineBuffer(chainID int) time.Duration
+	// GetRelayerAPIAuthExpiry returns how long an eip-191 authorization to the relayer API is accepted after it was signed.
+	GetRelayerAPIAuthExpiry() time.Duration
 }
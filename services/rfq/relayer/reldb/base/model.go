@@ -25,6 +25,11 @@ func init() {
 	transactionIDFieldName = namer.GetConsistentName("TransactionID")
 	originTxHashFieldName = namer.GetConsistentName("OriginTxHash")
 	destTxHashFieldName = namer.GetConsistentName("DestTxHash")
+	rebalanceIDFieldName = namer.GetConsistentName("RebalanceID")
+	originTxNonceFieldName = namer.GetConsistentName("OriginTxNonce")
+	trackingIDFieldName = namer.GetConsistentName("TrackingID")
+	destTxNonceFieldName = namer.GetConsistentName("DestTxNonce")
+	refundTxNonceFieldName = namer.GetConsistentName("RefundTxNonce")
 }
 
 var (
@@ -40,6 +45,16 @@ func init() {
 	originTxHashFieldName string
 	// destTxHashFieldName is the dest tx hash field name.
 	destTxHashFieldName string
+	// rebalanceIDFieldName is the rebalance id field name.
+	rebalanceIDFieldName string
+	// originTxNonceFieldName is the origin tx nonce field name.
+	originTxNonceFieldName string
+	// trackingIDFieldName is the rebalance tracking id field name.
+	trackingIDFieldName string
+	// destTxNonceFieldName is the rebalance dest tx nonce field name.
+	destTxNonceFieldName string
+	// refundTxNonceFieldName is the rebalance refund tx nonce field name.
+	refundTxNonceFieldName string
 )
 
 // LastIndexed is used to make sure we haven't missed any events while offline.
@@ -141,6 +156,42 @@ func FromQuoteRequest(request reldb.QuoteRequest) RequestForQuote {
 	}
 }
 
+// Rebalance is a transfer of relayer inventory between chains.
+type Rebalance struct {
+	// CreatedAt is the creation time
+	CreatedAt time.Time
+	// UpdatedAt is the update time
+	UpdatedAt time.Time
+	// RebalanceID is the id of the rebalance
+	RebalanceID string `gorm:"column:rebalance_id;primaryKey"`
+	// Method is the rebalance method
+	Method string
+	// OriginChainID is the chain funds are moved from
+	OriginChainID uint32
+	// DestChainID is the chain funds are moved to
+	DestChainID uint32
+	// OriginToken is the origin token address
+	OriginToken string
+	// DestToken is the destination token address
+	DestToken string
+	// OriginAmount is the origin amount
+	OriginAmount string
+	// DestAmount is the expected destination amount
+	DestAmount string
+	// Status is the current status of the rebalance
+	Status reldb.RebalanceStatus
+	// OriginTxNonce is the submitter nonce of the origin tx
+	OriginTxNonce uint64
+	// OriginTxHash is the origin tx hash
+	OriginTxHash sql.NullString
+	// TrackingID is the method specific tracking id
+	TrackingID string
+	// DestTxNonce is the submitter nonce of the destination tx, if any
+	DestTxNonce sql.NullInt64
+	// RefundTxNonce is the submitter nonce of the origin refund tx, if any
+	RefundTxNonce sql.NullInt64
+}
+
 func stringToNullString(s string) sql.NullString {
 	if s == "" {
 		return sql.NullString{Valid: false}
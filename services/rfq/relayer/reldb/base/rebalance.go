@@ -0,0 +1,160 @@
+package base
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb"
+	"gorm.io/gorm"
+)
+
+// StoreRebalance stores a rebalance.
+func (s Store) StoreRebalance(ctx context.Context, rebalance reldb.Rebalance) error {
+	rb := FromRebalance(rebalance)
+	dbTx := s.DB().WithContext(ctx).Create(&rb)
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not store rebalance: %w", dbTx.Error)
+	}
+	return nil
+}
+
+// UpdateRebalance updates the status and tracking data of a rebalance.
+func (s Store) UpdateRebalance(ctx context.Context, rebalance reldb.Rebalance) error {
+	rb := FromRebalance(rebalance)
+	tx := s.DB().WithContext(ctx).Model(&Rebalance{}).
+		Where(fmt.Sprintf("%s = ?", rebalanceIDFieldName), rb.RebalanceID).
+		Updates(map[string]interface{}{
+			statusFieldName:        rb.Status,
+			originTxNonceFieldName: rb.OriginTxNonce,
+			originTxHashFieldName:  rb.OriginTxHash,
+			trackingIDFieldName:    rb.TrackingID,
+			destTxNonceFieldName:   rb.DestTxNonce,
+			refundTxNonceFieldName: rb.RefundTxNonce,
+		})
+	if tx.Error != nil {
+		return fmt.Errorf("could not update rebalance: %w", tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return reldb.ErrNoRebalanceForID
+	}
+	return nil
+}
+
+// GetRebalanceByID gets a rebalance by id. Should return ErrNoRebalanceForID if not found.
+func (s Store) GetRebalanceByID(ctx context.Context, id string) (*reldb.Rebalance, error) {
+	var modelResult Rebalance
+	tx := s.DB().WithContext(ctx).Where(fmt.Sprintf("%s = ?", rebalanceIDFieldName), id).First(&modelResult)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, reldb.ErrNoRebalanceForID
+	}
+
+	if tx.Error != nil {
+		return nil, fmt.Errorf("could not get rebalance: %w", tx.Error)
+	}
+
+	return modelResult.ToRebalance()
+}
+
+// GetRebalancesByStatus gets rebalances by status.
+func (s Store) GetRebalancesByStatus(ctx context.Context, matchStatuses ...reldb.RebalanceStatus) (res []reldb.Rebalance, _ error) {
+	var rebalances []Rebalance
+
+	inArgs := make([]int, len(matchStatuses))
+	for i := range matchStatuses {
+		inArgs[i] = int(matchStatuses[i].Int())
+	}
+
+	tx := s.DB().WithContext(ctx).Model(&Rebalance{}).Where(fmt.Sprintf("%s IN ?", statusFieldName), inArgs).Find(&rebalances)
+	if tx.Error != nil {
+		return []reldb.Rebalance{}, fmt.Errorf("could not get db results: %w", tx.Error)
+	}
+
+	for _, result := range rebalances {
+		rebalance, err := result.ToRebalance()
+		if err != nil {
+			return []reldb.Rebalance{}, err
+		}
+		res = append(res, *rebalance)
+	}
+	return res, nil
+}
+
+// FromRebalance converts a rebalance to an object that can be stored in the db.
+func FromRebalance(rebalance reldb.Rebalance) Rebalance {
+	rb := Rebalance{
+		CreatedAt:     rebalance.CreatedAt,
+		RebalanceID:   rebalance.ID,
+		Method:        rebalance.Method,
+		OriginChainID: rebalance.OriginChainID,
+		DestChainID:   rebalance.DestChainID,
+		OriginToken:   rebalance.OriginToken.String(),
+		DestToken:     rebalance.DestToken.String(),
+		OriginAmount:  rebalance.OriginAmount.String(),
+		DestAmount:    rebalance.DestAmount.String(),
+		Status:        rebalance.Status,
+		OriginTxNonce: rebalance.OriginNonce,
+		TrackingID:    rebalance.TrackingID,
+	}
+
+	if rebalance.OriginTxHash != (common.Hash{}) {
+		rb.OriginTxHash = stringToNullString(rebalance.OriginTxHash.String())
+	}
+
+	if rebalance.DestNonce != nil {
+		rb.DestTxNonce = sql.NullInt64{Int64: int64(*rebalance.DestNonce), Valid: true}
+	}
+
+	if rebalance.RefundNonce != nil {
+		rb.RefundTxNonce = sql.NullInt64{Int64: int64(*rebalance.RefundNonce), Valid: true}
+	}
+
+	return rb
+}
+
+// ToRebalance converts a db object to a rebalance.
+func (r Rebalance) ToRebalance() (*reldb.Rebalance, error) {
+	originAmount, ok := new(big.Int).SetString(r.OriginAmount, 10)
+	if !ok {
+		return nil, fmt.Errorf("could not parse origin amount %s", r.OriginAmount)
+	}
+
+	destAmount, ok := new(big.Int).SetString(r.DestAmount, 10)
+	if !ok {
+		return nil, fmt.Errorf("could not parse dest amount %s", r.DestAmount)
+	}
+
+	rebalance := &reldb.Rebalance{
+		ID:            r.RebalanceID,
+		Method:        r.Method,
+		OriginChainID: r.OriginChainID,
+		DestChainID:   r.DestChainID,
+		OriginToken:   common.HexToAddress(r.OriginToken),
+		DestToken:     common.HexToAddress(r.DestToken),
+		OriginAmount:  originAmount,
+		DestAmount:    destAmount,
+		Status:        r.Status,
+		OriginNonce:   r.OriginTxNonce,
+		TrackingID:    r.TrackingID,
+		CreatedAt:     r.CreatedAt,
+	}
+
+	if r.OriginTxHash.Valid {
+		rebalance.OriginTxHash = common.HexToHash(r.OriginTxHash.String)
+	}
+
+	if r.DestTxNonce.Valid {
+		destNonce := uint64(r.DestTxNonce.Int64)
+		rebalance.DestNonce = &destNonce
+	}
+
+	if r.RefundTxNonce.Valid {
+		refundNonce := uint64(r.RefundTxNonce.Int64)
+		rebalance.RefundNonce = &refundNonce
+	}
+
+	return rebalance, nil
+}
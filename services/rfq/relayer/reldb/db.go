@@ -5,11 +5,14 @@
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/synapsecns/sanguine/core/dbcommon"
 	submitterDB "github.com/synapsecns/sanguine/ethergo/submitter/db"
 	"github.com/synapsecns/sanguine/services/rfq/contracts/fastbridge"
+	"golang.org/x/exp/slices"
 )
 
 // Writer is the interface for writing to the database.
@@ -23,6 +26,10 @@ type Writer interface {
 	UpdateQuoteRequestStatus(ctx context.Context, id [32]byte, status QuoteRequestStatus) error
 	// UpdateDestTxHash updates the dest tx hash of a quote request
 	UpdateDestTxHash(ctx context.Context, id [32]byte, destTxHash common.Hash) error
+	// StoreRebalance stores a new rebalance.
+	StoreRebalance(ctx context.Context, rebalance Rebalance) error
+	// UpdateRebalance updates the status and tracking data of a rebalance.
+	UpdateRebalance(ctx context.Context, rebalance Rebalance) error
 }
 
 // Reader is the interface for reading from the database.
@@ -35,6 +42,10 @@ type Reader interface {
 	GetQuoteRequestByOriginTxHash(ctx context.Context, txHash common.Hash) (*QuoteRequest, error)
 	// GetQuoteResultsByStatus gets quote results by status
 	GetQuoteResultsByStatus(ctx context.Context, matchStatuses ...QuoteRequestStatus) (res []QuoteRequest, _ error)
+	// GetRebalanceByID gets a rebalance by id. Should return ErrNoRebalanceForID if not found
+	GetRebalanceByID(ctx context.Context, id string) (*Rebalance, error)
+	// GetRebalancesByStatus gets rebalances by status
+	GetRebalancesByStatus(ctx context.Context, matchStatuses ...RebalanceStatus) (res []Rebalance, _ error)
 }
 
 // Service is the interface for the database service.
@@ -52,6 +63,8 @@ type Service interface {
 	ErrNoQuoteForID = errors.New("no quote found for tx id")
 	// ErrNoQuoteForTxHash means the quote was not found.
 	ErrNoQuoteForTxHash = errors.New("no quote found for tx hash")
+	// ErrNoRebalanceForID means the rebalance was not found.
+	ErrNoRebalanceForID = errors.New("no rebalance found for id")
 )
 
 // QuoteRequest is the quote request object.
@@ -149,3 +162,109 @@ func (q QuoteRequestStatus) Value() (driver.Value, error) {
 }
 
 var _ dbcommon.Enum = (*QuoteRequestStatus)(nil)
+
+// Rebalance is a transfer of relayer inventory from one chain to another.
+type Rebalance struct {
+	// ID is the unique id of the rebalance
+	ID string
+	// Method is the rebalance method used to move the funds
+	Method string
+	// OriginChainID is the chain funds are moved from
+	OriginChainID uint32
+	// DestChainID is the chain funds are moved to
+	DestChainID uint32
+	// OriginToken is the token sent on the origin chain
+	OriginToken common.Address
+	// DestToken is the token received on the destination chain
+	DestToken common.Address
+	// OriginAmount is the amount sent on the origin chain
+	OriginAmount *big.Int
+	// DestAmount is the amount expected on the destination chain
+	DestAmount *big.Int
+	// Status is the rebalance status
+	Status RebalanceStatus
+	// OriginNonce is the submitter nonce of the origin transaction
+	OriginNonce uint64
+	// OriginTxHash is the origin transaction hash, set once the transaction is confirmed
+	OriginTxHash common.Hash
+	// TrackingID is the method specific id used to track the transfer, e.g. the fastbridge transaction id
+	TrackingID string
+	// DestNonce is the submitter nonce of the destination transaction if the method needs one
+	DestNonce *uint64
+	// RefundNonce is the submitter nonce of the origin refund transaction if the funds did not arrive
+	RefundNonce *uint64
+	// CreatedAt is when the rebalance was created
+	CreatedAt time.Time
+}
+
+// RebalanceStatus is the status of a rebalance in the db.
+//
+//go:generate go run golang.org/x/tools/cmd/stringer -type=RebalanceStatus
+type RebalanceStatus uint8
+
+const (
+	// RebalancePendingApproval means the rebalance is waiting for an operator to move the funds.
+	RebalancePendingApproval RebalanceStatus = iota + 1
+	// RebalanceRequested means the rebalance has been planned, but the origin transaction has not been submitted.
+	RebalanceRequested
+	// RebalanceSubmitted means the origin transaction has been submitted, but is not yet confirmed on chain.
+	RebalanceSubmitted
+	// RebalanceInFlight means the origin transaction is confirmed and the funds have not arrived on the destination.
+	RebalanceInFlight
+	// RebalanceCompleted means the funds have arrived on the destination.
+	// This is a terminal state.
+	RebalanceCompleted
+	// RebalanceFailed means the rebalance was rejected or the funds did not arrive in time.
+	// This is a terminal state.
+	RebalanceFailed
+	// RebalanceSubmitting means the origin transaction is being submitted. A rebalance left in this status
+	// may or may not have been submitted, so it is never executed again and needs to be resolved by an operator.
+	RebalanceSubmitting
+	// RebalanceRefunding means the funds did not arrive in time and are being refunded on the origin chain.
+	RebalanceRefunding
+)
+
+// OutgoingRebalanceStatuses are the statuses of rebalances whose funds have not yet left the origin chain.
+var OutgoingRebalanceStatuses = []RebalanceStatus{RebalancePendingApproval, RebalanceRequested, RebalanceSubmitting, RebalanceSubmitted}
+
+// IsOutgoing returns true if the funds have not yet left the origin chain, but are committed to the rebalance.
+func (r RebalanceStatus) IsOutgoing() bool {
+	return slices.Contains(OutgoingRebalanceStatuses, r)
+}
+
+// IsInFlight returns true if the rebalance has not reached a terminal state.
+func (r RebalanceStatus) IsInFlight() bool {
+	return r != RebalanceCompleted && r != RebalanceFailed
+}
+
+// InFlightRebalanceStatuses are the statuses of rebalances that have not reached a terminal state.
+var InFlightRebalanceStatuses = []RebalanceStatus{RebalancePendingApproval, RebalanceRequested, RebalanceSubmitting, RebalanceSubmitted, RebalanceInFlight, RebalanceRefunding}
+
+// Int returns the int value of the rebalance status.
+func (r RebalanceStatus) Int() uint8 {
+	return uint8(r)
+}
+
+// GormDataType implements the gorm common interface for enums.
+func (r RebalanceStatus) GormDataType() string {
+	return dbcommon.EnumDataType
+}
+
+// Scan implements the gorm common interface for enums.
+func (r *RebalanceStatus) Scan(src any) error {
+	res, err := dbcommon.EnumScan(src)
+	if err != nil {
+		return fmt.Errorf("could not scan %w", err)
+	}
+	newStatus := RebalanceStatus(res)
+	*r = newStatus
+	return nil
+}
+
+// Value implements the gorm common interface for enums.
+func (r RebalanceStatus) Value() (driver.Value, error) {
+	// nolint: wrapcheck
+	return dbcommon.EnumValue(r)
+}
+
+var _ dbcommon.Enum = (*RebalanceStatus)(nil)
@@ -2,6 +2,9 @@
 
 import (
 	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb"
 )
 
@@ -28,3 +31,64 @@ func (d *DBSuite) TestBlock() {
 		d.Equal(lastHeight, uint64(testHeight))
 	})
 }
+
+func (d *DBSuite) TestRebalance() {
+	d.RunOnAllDBs(func(testDB reldb.Service) {
+		_, err := testDB.GetRebalanceByID(d.GetTestContext(), "missing")
+		d.True(errors.Is(err, reldb.ErrNoRebalanceForID))
+
+		rebalance := reldb.Rebalance{
+			ID:            "test-rebalance",
+			Method:        "fastbridge",
+			OriginChainID: 1,
+			DestChainID:   10,
+			OriginToken:   common.HexToAddress("0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"),
+			DestToken:     common.HexToAddress("0x0b2c639c533813f4aa9d7837caf62653d097ff85"),
+			OriginAmount:  big.NewInt(1_000_000),
+			DestAmount:    big.NewInt(999_000),
+			Status:        reldb.RebalanceRequested,
+		}
+		err = testDB.StoreRebalance(d.GetTestContext(), rebalance)
+		d.Require().NoError(err)
+
+		stored, err := testDB.GetRebalanceByID(d.GetTestContext(), rebalance.ID)
+		d.Require().NoError(err)
+		d.Equal(rebalance.OriginAmount, stored.OriginAmount)
+		d.Equal(rebalance.DestToken, stored.DestToken)
+		d.Nil(stored.DestNonce)
+
+		inFlight, err := testDB.GetRebalancesByStatus(d.GetTestContext(), reldb.InFlightRebalanceStatuses...)
+		d.Require().NoError(err)
+		d.Len(inFlight, 1)
+
+		destNonce := uint64(4)
+		stored.Status = reldb.RebalanceInFlight
+		stored.OriginNonce = 3
+		stored.OriginTxHash = common.HexToHash("0x1234")
+		stored.TrackingID = "0xabcd"
+		stored.DestNonce = &destNonce
+		err = testDB.UpdateRebalance(d.GetTestContext(), *stored)
+		d.Require().NoError(err)
+
+		updated, err := testDB.GetRebalanceByID(d.GetTestContext(), rebalance.ID)
+		d.Require().NoError(err)
+		d.Equal(reldb.RebalanceInFlight, updated.Status)
+		d.Equal(uint64(3), updated.OriginNonce)
+		d.Equal(stored.OriginTxHash, updated.OriginTxHash)
+		d.Equal("0xabcd", updated.TrackingID)
+		d.Require().NotNil(updated.DestNonce)
+		d.Equal(destNonce, *updated.DestNonce)
+
+		stored.Status = reldb.RebalanceCompleted
+		err = testDB.UpdateRebalance(d.GetTestContext(), *stored)
+		d.Require().NoError(err)
+
+		inFlight, err = testDB.GetRebalancesByStatus(d.GetTestContext(), reldb.InFlightRebalanceStatuses...)
+		d.Require().NoError(err)
+		d.Empty(inFlight)
+
+		stored.ID = "missing"
+		err = testDB.UpdateRebalance(d.GetTestContext(), *stored)
+		d.True(errors.Is(err, reldb.ErrNoRebalanceForID))
+	})
+}
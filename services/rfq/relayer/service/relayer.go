@@ -35,6 +35,7 @@ type Relayer struct {
 	chainListeners map[int]listener.ContractListener
 	apiServer      *relapi.RelayerAPIServer
 	inventory      inventory.Manager
+	rebalancer     inventory.Rebalancer
 	quoter         quoter.Quoter
 	submitter      submitter.TransactionSubmitter
 	signer         signer.Signer
@@ -96,7 +97,9 @@ func NewRelayer(ctx context.Context, metricHandler metrics.Handler, cfg relconfi
 
 	sm := submitter.NewTransactionSubmitter(metricHandler, sg, omniClient, store.SubmitterDB(), &cfg.SubmitterConfig)
 
-	apiServer, err := relapi.NewRelayerAPI(ctx, cfg, metricHandler, omniClient, store, sm)
+	rebalancer := inventory.NewRebalancer(cfg, metricHandler, omniClient, im, store, sm, sg.Address())
+
+	apiServer, err := relapi.NewRelayerAPI(ctx, cfg, metricHandler, omniClient, store, sm, sg.Address())
 	if err != nil {
 		return nil, fmt.Errorf("could not get api server: %w", err)
 	}
@@ -110,6 +113,7 @@ func NewRelayer(ctx context.Context, metricHandler metrics.Handler, cfg relconfi
 		claimCache:     cache,
 		cfg:            cfg,
 		inventory:      im,
+		rebalancer:     rebalancer,
 		submitter:      sm,
 		signer:         sg,
 		chainListeners: chainListeners,
@@ -186,6 +190,14 @@ func (r *Relayer) Start(ctx context.Context) error {
 		return nil
 	})
 
+	g.Go(func() error {
+		err := r.rebalancer.Start(ctx)
+		if err != nil {
+			return fmt.Errorf("could not start rebalancer: %w", err)
+		}
+		return nil
+	})
+
 	g.Go(func() error {
 		err := r.apiServer.Run(ctx)
 		if err != nil {
@@ -9,6 +9,7 @@
 	graphqlModel "github.com/synapsecns/sanguine/services/sinner/graphql/server/graph/model"
 	"github.com/synapsecns/sanguine/services/sinner/types"
 	"math/big"
+	"time"
 )
 
 //nolint:cyclop
@@ -139,3 +140,89 @@ func (t *APISuite) TestPendingMessageStatus() {
 	NotNil(t.T(), desResult)
 	Equal(t.T(), 2, len(completedMessagesResult.Response))
 }
+
+func (t *APISuite) TestGetMessageLifecycle() {
+	ctx := t.GetTestContext()
+	originChainID := gofakeit.Uint32()
+	destinationChainID := originChainID + 1
+	synChainID := originChainID + 2
+	messageHash := common.BigToHash(big.NewInt(gofakeit.Int64())).String()
+	snapshotRoot := common.BigToHash(big.NewInt(gofakeit.Int64())).String()
+	now := uint64(time.Now().Unix())
+
+	err := t.db.StoreOriginSent(ctx, &model.OriginSent{
+		TxHash:             common.BigToHash(big.NewInt(gofakeit.Int64())).String(),
+		MessageHash:        messageHash,
+		ChainID:            originChainID,
+		DestinationChainID: destinationChainID,
+		Nonce:              3,
+		OptimisticSeconds:  60,
+		Timestamp:          now - 1000,
+	})
+	Nil(t.T(), err)
+
+	// Only sent so far.
+	result, err := t.sinnerAPI.GetMessageLifecycle(ctx, core.PtrTo(messageHash), nil, nil)
+	Nil(t.T(), err)
+	Equal(t.T(), graphqlModel.MessageStageSent, *result.Response.Stage)
+	Equal(t.T(), 1, len(result.Response.Timeline))
+	GreaterOrEqual(t.T(), *result.Response.Timeline[0].SecondsInStage, 1000)
+
+	err = t.db.StoreSnapshotStates(ctx, []model.SnapshotState{
+		{ChainID: synChainID, TxHash: common.BigToHash(big.NewInt(1)).String(), Timestamp: now - 900, SnapshotRoot: common.BigToHash(big.NewInt(2)).String(), OriginChainID: originChainID, Nonce: 3},
+		{ChainID: synChainID, TxHash: common.BigToHash(big.NewInt(3)).String(), Timestamp: now - 800, SnapshotRoot: snapshotRoot, AgentDomain: destinationChainID, OriginChainID: originChainID, Nonce: 3},
+	})
+	Nil(t.T(), err)
+	err = t.db.StoreAttestation(ctx, &model.Attestation{ChainID: destinationChainID, TxHash: common.BigToHash(big.NewInt(4)).String(), Timestamp: now - 700, SnapshotRoot: snapshotRoot})
+	Nil(t.T(), err)
+	err = t.db.StoreExecuted(ctx, &model.Executed{
+		TxHash:        common.BigToHash(big.NewInt(5)).String(),
+		MessageHash:   messageHash,
+		ChainID:       destinationChainID,
+		Timestamp:     now - 500,
+		Success:       false,
+		FailureReason: "paused",
+	})
+	Nil(t.T(), err)
+
+	result, err = t.sinnerAPI.GetMessageLifecycle(ctx, core.PtrTo(messageHash), nil, nil)
+	Nil(t.T(), err)
+	Equal(t.T(), graphqlModel.MessageStageFailed, *result.Response.Stage)
+	Equal(t.T(), "paused", *result.Response.RevertReason)
+
+	stages := []graphqlModel.MessageStage{graphqlModel.MessageStageSent, graphqlModel.MessageStageSnapshotted, graphqlModel.MessageStageAttested, graphqlModel.MessageStageExecutable, graphqlModel.MessageStageFailed}
+	secondsInStage := []int{100, 200, 60, 140}
+	Equal(t.T(), len(stages), len(result.Response.Timeline))
+	for i, stage := range stages {
+		Equal(t.T(), stage, *result.Response.Timeline[i].Stage)
+		if i < len(secondsInStage) {
+			Equal(t.T(), secondsInStage[i], *result.Response.Timeline[i].SecondsInStage)
+		}
+	}
+}
+
+func (t *APISuite) TestGetStuckMessageCounts() {
+	originChainID := gofakeit.Uint32()
+	destinationChainID := originChainID + 1
+
+	err := t.db.StoreOriginSent(t.GetTestContext(), &model.OriginSent{
+		TxHash:             common.BigToHash(big.NewInt(gofakeit.Int64())).String(),
+		MessageHash:        common.BigToHash(big.NewInt(gofakeit.Int64())).String(),
+		ChainID:            originChainID,
+		DestinationChainID: destinationChainID,
+		OptimisticSeconds:  60,
+		Timestamp:          uint64(time.Now().Unix()) - 1000,
+	})
+	Nil(t.T(), err)
+
+	result, err := t.sinnerAPI.GetStuckMessageCounts(t.GetTestContext(), 0)
+	Nil(t.T(), err)
+	found := false
+	for _, count := range result.Response {
+		if *count.OriginChainID == int(originChainID) && *count.DestinationChainID == int(destinationChainID) {
+			found = true
+			Equal(t.T(), 1, *count.Count)
+		}
+	}
+	True(t.T(), found)
+}
@@ -23,6 +23,10 @@
 	OriginType ContractType = iota // origin
 	// ExecutionHubType is the ContractType for the execution hub contract.
 	ExecutionHubType // execution_hub
+	// InboxType is the ContractType for the inbox contract on the synchain.
+	InboxType // inbox
+	// LightInboxType is the ContractType for the light inbox contract on remote chains.
+	LightInboxType // light_inbox
 	// UnknownType is the ContractType for an unknown contract.
 	UnknownType // unknown
 )
@@ -34,6 +38,10 @@ func ContractTypeFromString(s string) (ContractType, error) {
 		return OriginType, nil
 	case ExecutionHubType.String():
 		return ExecutionHubType, nil
+	case InboxType.String():
+		return InboxType, nil
+	case LightInboxType.String():
+		return LightInboxType, nil
 	default:
 		return UnknownType, fmt.Errorf("unknown contract type: %s", s)
 	}
@@ -53,6 +61,8 @@ type Config struct {
 	DBType string `yaml:"db_type"`
 	// SkipMigrations skips db migrations.
 	SkipMigrations bool `yaml:"skip_migrations"`
+	// RPCURL is the url of an omnirpc instance, if set failed executions are simulated to get their revert reason.
+	RPCURL string `yaml:"rpc_url"`
 }
 
 // ChainConfig is the configuration for a chain.
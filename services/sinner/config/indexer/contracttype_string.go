@@ -10,12 +10,14 @@ func _() {
 	var x [1]struct{}
 	_ = x[OriginType-0]
 	_ = x[ExecutionHubType-1]
-	_ = x[UnknownType-2]
+	_ = x[InboxType-2]
+	_ = x[LightInboxType-3]
+	_ = x[UnknownType-4]
 }
 
-const _ContractType_name = "originexecution_hubunknown"
+const _ContractType_name = "originexecution_hubinboxlight_inboxunknown"
 
-var _ContractType_index = [...]uint8{0, 6, 19, 26}
+var _ContractType_index = [...]uint8{0, 6, 19, 24, 35, 42}
 
 func (i ContractType) String() string {
 	if i < 0 || i >= ContractType(len(_ContractType_index)-1) {
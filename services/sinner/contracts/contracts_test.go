@@ -2,8 +2,10 @@
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	. "github.com/stretchr/testify/assert"
 	"github.com/synapsecns/sanguine/services/sinner/contracts/destination"
+	"github.com/synapsecns/sanguine/services/sinner/contracts/inbox"
 	"github.com/synapsecns/sanguine/services/sinner/contracts/origin"
 
 	sinnerTypes "github.com/synapsecns/sanguine/services/sinner/types"
@@ -26,14 +28,32 @@ func (t *ContractsSuite) TestDestinationNewParser() {
 	// Mock values for test
 	addr := common.Address{}
 
-	_, err := destination.NewParser(addr, t.db, t.originChainID)
+	_, err := destination.NewParser(addr, t.db, t.originChainID, nil)
 	Nil(t.T(), err)
 }
 
 func (t *ContractsSuite) TestDestinationParseAndStore() {
-	parser, err := destination.NewParser(common.Address{}, t.db, t.originChainID)
+	parser, err := destination.NewParser(common.Address{}, t.db, t.originChainID, nil)
 	Nil(t.T(), err)
 
 	err = parser.ParseAndStore(t.GetTestContext(), t.desTestLog, sinnerTypes.TxSupplementalInfo{})
 	Nil(t.T(), err)
 }
+
+func (t *ContractsSuite) TestInboxNewParser() {
+	_, err := inbox.NewParser(common.Address{}, t.db, t.originChainID)
+	Nil(t.T(), err)
+}
+
+func (t *ContractsSuite) TestDecodeRevert() {
+	// Error("not enough tokens")
+	errorRevert := hexutil.MustDecode("0x08c379a0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000116e6f7420656e6f75676820746f6b656e73000000000000000000000000000000")
+	Equal(t.T(), "not enough tokens", destination.DecodeRevert(errorRevert))
+
+	// Panic(0x11), an arithmetic overflow.
+	panicRevert := hexutil.MustDecode("0x4e487b710000000000000000000000000000000000000000000000000000000000000011")
+	Equal(t.T(), "panic: 0x11", destination.DecodeRevert(panicRevert))
+
+	Equal(t.T(), "custom error: 0x12345678", destination.DecodeRevert(hexutil.MustDecode("0x12345678")))
+	Equal(t.T(), "reverted without data", destination.DecodeRevert(nil))
+}
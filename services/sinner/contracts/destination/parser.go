@@ -7,6 +7,7 @@
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/synapsecns/sanguine/agents/contracts/destination"
@@ -25,10 +26,13 @@ type parserImpl struct {
 	db db.EventDB
 	// chainID is the chain ID
 	chainID uint32
+	// caller is used to simulate failed executions, nil if failures are not simulated.
+	caller bind.ContractCaller
 }
 
-// NewParser creates a new parser for the origin contract.
-func NewParser(destinationAddress common.Address, db db.EventDB, chainID uint32) (types.EventParser, error) {
+// NewParser creates a new parser for the execution hub contract. If a caller is given, failed executions are
+// simulated against it to get their revert reason.
+func NewParser(destinationAddress common.Address, db db.EventDB, chainID uint32, caller bind.ContractCaller) (types.EventParser, error) {
 	// Get agents parser to utilize event type parsing.
 	agentsParser, err := destination.NewParser(destinationAddress)
 	if err != nil {
@@ -46,6 +50,7 @@ func NewParser(destinationAddress common.Address, db db.EventDB, chainID uint32)
 		parser:   agentsParser,
 		db:       db,
 		chainID:  chainID,
+		caller:   caller,
 	}
 	return parser, nil
 }
@@ -63,6 +68,14 @@ func (p *parserImpl) ParseAndStore(ctx context.Context, log ethTypes.Log, tx typ
 			return fmt.Errorf("error while parsing executed event. Err: %w", err)
 		}
 
+		if !executedEvent.Success && p.caller != nil {
+			// A failed simulation should not stop indexing, the failure is stored without a reason.
+			executedEvent.FailureReason, err = p.simulateFailure(ctx, executedEvent)
+			if err != nil {
+				logger.ReportSinnerError(fmt.Errorf("could not simulate failed execution: %w", err), p.chainID, logger.SinnerIndexingFailure)
+			}
+		}
+
 		g, storeCtx := errgroup.WithContext(ctx)
 		// If the message was successfully executed, store the executed event in the message status table.
 		if executedEvent.Success {
@@ -0,0 +1,136 @@
+package destination
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/synapsecns/sanguine/agents/contracts/destination"
+	"github.com/synapsecns/sanguine/agents/types"
+	"github.com/synapsecns/sanguine/services/sinner/db/model"
+)
+
+var (
+	// errorSelector is the selector of Error(string) reverts.
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	// panicSelector is the selector of Panic(uint256) reverts.
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// recipientABI is the abi of the message recipient, used to pack the call made by the execution hub.
+var recipientABI abi.ABI
+
+func init() {
+	parsedABI, err := abi.JSON(strings.NewReader(destination.IMessageRecipientMetaData.ABI))
+	if err != nil {
+		panic(err)
+	}
+	recipientABI = parsedABI
+}
+
+// simulateFailure replays the call the execution hub made to the recipient of a failed execution at the block before
+// the execution and returns the decoded revert. It returns an empty reason if the message is not indexed yet or is
+// not a base message.
+func (p *parserImpl) simulateFailure(ctx context.Context, executed *model.Executed) (string, error) {
+	originSents, err := p.db.RetrieveOriginSent(ctx, model.OriginSent{MessageHash: executed.MessageHash})
+	if err != nil {
+		return "", fmt.Errorf("could not retrieve origin sent: %w", err)
+	}
+	if len(originSents) == 0 || originSents[0].Message == "" {
+		return "", nil
+	}
+	originSent := originSents[0]
+
+	message, err := types.DecodeMessage(common.Hex2Bytes(originSent.Message))
+	if err != nil {
+		return "", fmt.Errorf("could not decode message: %w", err)
+	}
+	if message.Header().Flag() != types.MessageFlagBase {
+		return "", nil
+	}
+
+	// proof maturity is measured from when the snapshot root was accepted on the destination.
+	proofMaturity := uint64(originSent.OptimisticSeconds)
+	attestation, err := p.db.RetrieveDestinationAttestation(ctx, p.chainID, originSent.ChainID, originSent.Nonce)
+	if err != nil {
+		return "", fmt.Errorf("could not retrieve attestation: %w", err)
+	}
+	if attestation != nil && executed.Timestamp > attestation.Timestamp {
+		proofMaturity = executed.Timestamp - attestation.Timestamp
+	}
+
+	baseMessage := message.BaseMessage()
+	payload, err := recipientABI.Pack("receiveBaseMessage",
+		message.OriginDomain(),
+		message.Nonce(),
+		baseMessage.Sender(),
+		new(big.Int).SetUint64(proofMaturity),
+		baseMessage.Request().Version(),
+		baseMessage.Content(),
+	)
+	if err != nil {
+		return "", fmt.Errorf("could not pack recipient call: %w", err)
+	}
+
+	recipient := baseMessage.Recipient()
+	to := common.BytesToAddress(recipient[:])
+	var blockNumber *big.Int
+	if executed.BlockNumber > 0 {
+		blockNumber = new(big.Int).SetUint64(executed.BlockNumber - 1)
+	}
+
+	_, err = p.caller.CallContract(ctx, ethereum.CallMsg{
+		From: common.HexToAddress(executed.ContractAddress),
+		To:   &to,
+		Gas:  baseMessage.Request().GasLimit(),
+		Data: payload,
+	}, blockNumber)
+	if err == nil {
+		return "recipient call did not revert in simulation", nil
+	}
+
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		// out of gas and similar errors have no revert data.
+		return err.Error(), nil
+	}
+	revertData, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return err.Error(), nil
+	}
+	data, err := hexutil.Decode(revertData)
+	if err != nil {
+		return "", fmt.Errorf("could not decode revert data: %w", err)
+	}
+	return DecodeRevert(data), nil
+}
+
+// DecodeRevert decodes revert data into a readable reason. Error(string) reverts return their message, Panic(uint256)
+// reverts their code and custom errors their selector.
+func DecodeRevert(data []byte) string {
+	switch {
+	case len(data) == 0:
+		return "reverted without data"
+	case len(data) >= 4 && bytes.Equal(data[:4], errorSelector):
+		reason, err := abi.UnpackRevert(data)
+		if err != nil {
+			return fmt.Sprintf("reverted with malformed error: %s", hexutil.Encode(data))
+		}
+		return reason
+	case len(data) == 36 && bytes.Equal(data[:4], panicSelector):
+		return fmt.Sprintf("panic: 0x%x", new(big.Int).SetBytes(data[4:]))
+	case len(data) >= 4:
+		return fmt.Sprintf("custom error: %s", hexutil.Encode(data[:4]))
+	default:
+		return fmt.Sprintf("reverted with malformed data: %s", hexutil.Encode(data))
+	}
+}
@@ -0,0 +1,146 @@
+// Package inbox is the inbox and light inbox contract parser.
+package inbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/agents/contracts/inbox"
+	"github.com/synapsecns/sanguine/agents/types"
+	"github.com/synapsecns/sanguine/services/sinner/db"
+	"github.com/synapsecns/sanguine/services/sinner/db/model"
+	"github.com/synapsecns/sanguine/services/sinner/logger"
+	sinnerTypes "github.com/synapsecns/sanguine/services/sinner/types"
+)
+
+// parserImpl is the parser for the inbox and light inbox contracts. The light inbox only emits AttestationAccepted,
+// which has the same signature on both contracts.
+type parserImpl struct {
+	filterer *inbox.InboxFilterer
+	// db is the database
+	db db.EventDB
+	// chainID is the chainID of the underlying chain
+	chainID uint32
+}
+
+// attestationAcceptedTopic is the topic of the AttestationAccepted event.
+var attestationAcceptedTopic common.Hash
+
+func init() {
+	parsedInbox, err := inbox.InboxMetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	attestationAcceptedTopic = parsedInbox.Events["AttestationAccepted"].ID
+}
+
+// NewParser creates a new parser for the inbox or light inbox contract.
+func NewParser(inboxAddress common.Address, db db.EventDB, chainID uint32) (sinnerTypes.EventParser, error) {
+	// Get filterer to get ABI IFace from abi.
+	filter, err := inbox.NewInboxFilterer(inboxAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create %T: %w", inbox.InboxFilterer{}, err)
+	}
+
+	parser := &parserImpl{
+		filterer: filter,
+		db:       db,
+		chainID:  chainID,
+	}
+	return parser, nil
+}
+
+// ParseAndStore parses and stores the log.
+func (p *parserImpl) ParseAndStore(ctx context.Context, log ethTypes.Log, tx sinnerTypes.TxSupplementalInfo) error {
+	if len(log.Topics) == 0 {
+		logger.ReportSinnerError(fmt.Errorf("unknown inbox log topic"), 0, logger.UnknownTopic)
+		return nil
+	}
+
+	switch log.Topics[0] {
+	case inbox.SnapshotAcceptedTopic:
+		states, err := p.parseSnapshotAccepted(log, tx)
+		if err != nil {
+			return fmt.Errorf("error while parsing snapshot accepted event. Err: %w", err)
+		}
+		err = p.db.StoreSnapshotStates(ctx, states)
+		if err != nil {
+			return fmt.Errorf("error while storing snapshot accepted event. Err: %w", err)
+		}
+	case attestationAcceptedTopic:
+		attestation, err := p.parseAttestationAccepted(log, tx)
+		if err != nil {
+			return fmt.Errorf("error while parsing attestation accepted event. Err: %w", err)
+		}
+		err = p.db.StoreAttestation(ctx, attestation)
+		if err != nil {
+			return fmt.Errorf("error while storing attestation accepted event. Err: %w", err)
+		}
+	}
+	return nil
+}
+
+// parseSnapshotAccepted parses the snapshot accepted event into the states of the snapshot.
+func (p *parserImpl) parseSnapshotAccepted(log ethTypes.Log, tx sinnerTypes.TxSupplementalInfo) ([]model.SnapshotState, error) {
+	iFace, err := p.filterer.ParseSnapshotAccepted(log)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse snapshot accepted log. err: %w", err)
+	}
+
+	snapshot, err := types.DecodeSnapshot(iFace.SnapPayload)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode snapshot. err: %w", err)
+	}
+	snapshotRoot, _, err := snapshot.SnapshotRootAndProofs()
+	if err != nil {
+		return nil, fmt.Errorf("could not get snapshot root. err: %w", err)
+	}
+
+	states := make([]model.SnapshotState, len(snapshot.States()))
+	for i, state := range snapshot.States() {
+		states[i] = model.SnapshotState{
+			ChainID:         p.chainID,
+			ContractAddress: iFace.Raw.Address.String(),
+			BlockNumber:     iFace.Raw.BlockNumber,
+			TxHash:          iFace.Raw.TxHash.String(),
+			Timestamp:       uint64(tx.Timestamp),
+			SnapshotRoot:    common.Bytes2Hex(snapshotRoot[:]),
+			AgentDomain:     iFace.Domain,
+			Agent:           iFace.Agent.String(),
+			OriginChainID:   state.Origin(),
+			Nonce:           state.Nonce(),
+			StateIndex:      uint32(i),
+		}
+	}
+	return states, nil
+}
+
+// parseAttestationAccepted parses the attestation accepted event.
+func (p *parserImpl) parseAttestationAccepted(log ethTypes.Log, tx sinnerTypes.TxSupplementalInfo) (*model.Attestation, error) {
+	iFace, err := p.filterer.ParseAttestationAccepted(log)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse attestation accepted log. err: %w", err)
+	}
+
+	attestation, err := types.DecodeAttestation(iFace.AttPayload)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode attestation. err: %w", err)
+	}
+	snapshotRoot := attestation.SnapshotRoot()
+
+	return &model.Attestation{
+		ChainID:         p.chainID,
+		ContractAddress: iFace.Raw.Address.String(),
+		BlockNumber:     iFace.Raw.BlockNumber,
+		TxHash:          iFace.Raw.TxHash.String(),
+		Timestamp:       uint64(tx.Timestamp),
+		SnapshotRoot:    common.Bytes2Hex(snapshotRoot[:]),
+		Nonce:           attestation.Nonce(),
+		AgentDomain:     iFace.Domain,
+		Notary:          iFace.Notary.String(),
+	}, nil
+}
+
+var _ sinnerTypes.EventParser = &parserImpl{}
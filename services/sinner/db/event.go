@@ -21,6 +21,10 @@ type EventDBWriter interface {
 	StoreLastIndexed(ctx context.Context, contractAddress common.Address, chainID uint32, blockNumber uint64) error
 	// StoreOrUpdateMessageStatus stores/updates the status of a message.
 	StoreOrUpdateMessageStatus(ctx context.Context, txHash string, messageID string, messageType types.MessageType) error
+	// StoreSnapshotStates stores the states of an accepted snapshot.
+	StoreSnapshotStates(ctx context.Context, states []model.SnapshotState) error
+	// StoreAttestation stores an accepted attestation.
+	StoreAttestation(ctx context.Context, attestation *model.Attestation) error
 }
 
 // EventDBReader is an interface for reading events from a database.
@@ -37,6 +41,15 @@ type EventDBReader interface {
 	RetrieveLastStoredBlock(ctx context.Context, chainID uint32, address common.Address) (uint64, error)
 	// RetrieveMessagesByStatus gets messages by their status (pending/completed).
 	RetrieveMessagesByStatus(ctx context.Context, messageStatus graphqlModel.MessageState, page int) ([]*graphqlModel.MessageStatus, error)
+	// RetrieveSnapshotInclusion gets the first guard snapshot state that includes a message, or nil if there is none yet.
+	RetrieveSnapshotInclusion(ctx context.Context, originChainID uint32, nonce uint32) (*model.SnapshotState, error)
+	// RetrieveDestinationAttestation gets the first attestation on the destination of a message of the first snapshot
+	// of the destination's notaries that includes the message, or nil if there is none yet.
+	RetrieveDestinationAttestation(ctx context.Context, destinationChainID uint32, originChainID uint32, nonce uint32) (*model.Attestation, error)
+	// RetrieveStuckMessageCounts gets the amount of unexecuted messages per origin and destination that were attested
+	// on their destination more than their optimistic seconds plus the grace seconds before now. Only messages sent
+	// in the last 30 days are counted.
+	RetrieveStuckMessageCounts(ctx context.Context, now uint64, graceSeconds uint64) ([]model.StuckMessageCount, error)
 }
 
 // EventDB stores events.
@@ -11,7 +11,7 @@
 
 // GetAllModels gets all models to migrate.
 func GetAllModels() (allModels []interface{}) {
-	return []interface{}{&OriginSent{}, &Executed{}, &MessageStatus{}, &LastIndexed{}}
+	return []interface{}{&OriginSent{}, &Executed{}, &MessageStatus{}, &LastIndexed{}, &SnapshotState{}, &Attestation{}}
 }
 
 func init() {
@@ -22,6 +22,7 @@ func init() {
 	BlockNumberFieldName = namer.GetConsistentName("BlockNumber")
 	ContractAddressFieldName = namer.GetConsistentName("ContractAddress")
 	MessageHashFieldName = namer.GetConsistentName("MessageHash")
+	SnapshotRootFieldName = namer.GetConsistentName("SnapshotRoot")
 
 	OriginTxHashFieldName = namer.GetConsistentName("OriginTxHash")
 	DestinationTxHashFieldName = namer.GetConsistentName("DestinationTxHash")
@@ -38,6 +39,8 @@ func init() {
 	ContractAddressFieldName string
 	// MessageHashFieldName is the name of the message hash field.
 	MessageHashFieldName string
+	// SnapshotRootFieldName is the name of the snapshot root field.
+	SnapshotRootFieldName string
 	// OriginTxHashFieldName is the name of the origin tx hash field.
 	OriginTxHashFieldName string
 	// DestinationTxHashFieldName is the name of the destination tx hash field.
@@ -128,6 +131,68 @@ type Executed struct {
 	Sender string `gorm:"column:sender"`
 	// Timestamp is the timestamp of the tx.
 	Timestamp uint64 `gorm:"column:timestamp"`
+	// FailureReason is the decoded revert of a failed execution, if it could be simulated.
+	FailureReason string `gorm:"column:failure_reason"`
+}
+
+// SnapshotState is a single origin state included in a snapshot accepted by the inbox on the synchain.
+type SnapshotState struct {
+	// ChainID is the chain id of the inbox.
+	ChainID uint32 `gorm:"column:chain_id;primaryKey"`
+	// ContractAddress is the address of the inbox.
+	ContractAddress string `gorm:"column:contract_address"`
+	// BlockNumber is the block number in which the snapshot was accepted.
+	BlockNumber uint64 `gorm:"column:block_number"`
+	// TxHash is the hash of the tx.
+	TxHash string `gorm:"column:tx_hash;primaryKey"`
+	// Timestamp is the timestamp of the tx.
+	Timestamp uint64 `gorm:"column:timestamp"`
+	// SnapshotRoot is the root of the snapshot the state is part of.
+	SnapshotRoot string `gorm:"column:snapshot_root;index:idx_snapshot_root"`
+	// AgentDomain is the domain of the agent that submitted the snapshot, 0 for guards.
+	AgentDomain uint32 `gorm:"column:agent_domain"`
+	// Agent is the address of the agent that submitted the snapshot.
+	Agent string `gorm:"column:agent;primaryKey"`
+	// OriginChainID is the chain id of the origin the state is for.
+	OriginChainID uint32 `gorm:"column:origin_chain_id;primaryKey;index:idx_snapshot_state_origin,priority:1"`
+	// Nonce is the amount of messages sent from the origin at the time of the state.
+	Nonce uint32 `gorm:"column:nonce;index:idx_snapshot_state_origin,priority:2"`
+	// StateIndex is the index of the state in the snapshot.
+	StateIndex uint32 `gorm:"column:state_index"`
+}
+
+// Attestation is an attestation accepted by an inbox, registering a snapshot root on the chain of the inbox.
+type Attestation struct {
+	// ChainID is the chain id of the inbox.
+	ChainID uint32 `gorm:"column:chain_id;primaryKey"`
+	// ContractAddress is the address of the inbox.
+	ContractAddress string `gorm:"column:contract_address"`
+	// BlockNumber is the block number in which the attestation was accepted.
+	BlockNumber uint64 `gorm:"column:block_number"`
+	// TxHash is the hash of the tx.
+	TxHash string `gorm:"column:tx_hash;primaryKey"`
+	// Timestamp is the timestamp of the tx.
+	Timestamp uint64 `gorm:"column:timestamp"`
+	// SnapshotRoot is the root of the attested snapshot.
+	SnapshotRoot string `gorm:"column:snapshot_root;primaryKey"`
+	// Nonce is the attestation nonce.
+	Nonce uint32 `gorm:"column:nonce"`
+	// AgentDomain is the domain of the notary.
+	AgentDomain uint32 `gorm:"column:agent_domain"`
+	// Notary is the address of the notary that submitted the attestation.
+	Notary string `gorm:"column:notary"`
+}
+
+// StuckMessageCount is the amount of messages between two chains that are unexecuted past their optimistic period.
+type StuckMessageCount struct {
+	// OriginChainID is the chain id of the origin.
+	OriginChainID uint32 `gorm:"column:chain_id"`
+	// DestinationChainID is the chain id of the destination.
+	DestinationChainID uint32 `gorm:"column:destination_chain_id"`
+	// Count is the amount of stuck messages.
+	Count int `gorm:"column:count"`
+	// OldestSentTimestamp is the timestamp of the oldest stuck message.
+	OldestSentTimestamp uint64 `gorm:"column:oldest_sent_timestamp"`
 }
 
 // LastIndexed contains information on when a contract was last indexed.
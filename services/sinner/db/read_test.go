@@ -182,3 +182,143 @@ func (t *DBSuite) TestRetrieveExecuted() {
 		Equal(t.T(), messageHash, retrievedRecords[0].MessageHash)
 	})
 }
+
+func (t *DBSuite) TestRetrieveLifecycleEvents() {
+	t.RunOnAllDBs(func(testDB db.TestEventDB) {
+		ctx := t.GetTestContext()
+		originChainID := gofakeit.Uint32()
+		destinationChainID := originChainID + 1
+		synChainID := originChainID + 2
+		guard := common.BigToAddress(big.NewInt(gofakeit.Int64())).String()
+		notary := common.BigToAddress(big.NewInt(gofakeit.Int64())).String()
+		guardRoot := common.BigToHash(big.NewInt(gofakeit.Int64())).String()
+		notaryRoot := common.BigToHash(big.NewInt(gofakeit.Int64())).String()
+
+		// Nothing has been snapshotted yet.
+		inclusion, err := testDB.RetrieveSnapshotInclusion(ctx, originChainID, 5)
+		Nil(t.T(), err)
+		Nil(t.T(), inclusion)
+
+		err = testDB.StoreSnapshotStates(ctx, []model.SnapshotState{
+			{ChainID: synChainID, TxHash: common.BigToHash(big.NewInt(1)).String(), Timestamp: 100, SnapshotRoot: guardRoot, Agent: guard, OriginChainID: originChainID, Nonce: 4},
+			{ChainID: synChainID, TxHash: common.BigToHash(big.NewInt(2)).String(), Timestamp: 200, SnapshotRoot: guardRoot, Agent: guard, OriginChainID: originChainID, Nonce: 6},
+			{ChainID: synChainID, TxHash: common.BigToHash(big.NewInt(3)).String(), Timestamp: 300, SnapshotRoot: notaryRoot, AgentDomain: destinationChainID, Agent: notary, OriginChainID: originChainID, Nonce: 6},
+		})
+		Nil(t.T(), err)
+
+		// The first guard state at or past the nonce includes the message.
+		inclusion, err = testDB.RetrieveSnapshotInclusion(ctx, originChainID, 5)
+		Nil(t.T(), err)
+		NotNil(t.T(), inclusion)
+		Equal(t.T(), uint64(200), inclusion.Timestamp)
+
+		attestation, err := testDB.RetrieveDestinationAttestation(ctx, destinationChainID, originChainID, 5)
+		Nil(t.T(), err)
+		Nil(t.T(), attestation)
+
+		// An attestation of the notary snapshot on another chain is not used.
+		err = testDB.StoreAttestation(ctx, &model.Attestation{ChainID: synChainID, TxHash: common.BigToHash(big.NewInt(4)).String(), Timestamp: 400, SnapshotRoot: notaryRoot})
+		Nil(t.T(), err)
+		err = testDB.StoreAttestation(ctx, &model.Attestation{ChainID: destinationChainID, TxHash: common.BigToHash(big.NewInt(5)).String(), Timestamp: 500, SnapshotRoot: notaryRoot, Notary: notary})
+		Nil(t.T(), err)
+
+		attestation, err = testDB.RetrieveDestinationAttestation(ctx, destinationChainID, originChainID, 5)
+		Nil(t.T(), err)
+		NotNil(t.T(), attestation)
+		Equal(t.T(), uint64(500), attestation.Timestamp)
+		Equal(t.T(), notary, attestation.Notary)
+
+		// Later messages are not attested yet.
+		attestation, err = testDB.RetrieveDestinationAttestation(ctx, destinationChainID, originChainID, 7)
+		Nil(t.T(), err)
+		Nil(t.T(), attestation)
+	})
+}
+
+func (t *DBSuite) TestRetrieveStuckMessageCounts() {
+	t.RunOnAllDBs(func(testDB db.TestEventDB) {
+		ctx := t.GetTestContext()
+		originChainID := gofakeit.Uint32()
+		destinationChainID := originChainID + 1
+		synChainID := originChainID + 2
+		notary := common.BigToAddress(big.NewInt(gofakeit.Int64())).String()
+		firstRoot := common.BigToHash(big.NewInt(gofakeit.Int64())).String()
+		secondRoot := common.BigToHash(big.NewInt(gofakeit.Int64())).String()
+		otherRoot := common.BigToHash(big.NewInt(gofakeit.Int64())).String()
+		thirdRoot := common.BigToHash(big.NewInt(gofakeit.Int64())).String()
+
+		storeSent := func(nonce uint32, timestamp uint64, optimisticSeconds uint32) string {
+			messageHash := common.BigToHash(big.NewInt(gofakeit.Int64())).String()
+			err := testDB.StoreOriginSent(ctx, &model.OriginSent{
+				TxHash:             common.BigToHash(big.NewInt(gofakeit.Int64())).String(),
+				MessageHash:        messageHash,
+				ChainID:            originChainID,
+				DestinationChainID: destinationChainID,
+				Nonce:              nonce,
+				Timestamp:          timestamp,
+				OptimisticSeconds:  optimisticSeconds,
+			})
+			Nil(t.T(), err)
+			return messageHash
+		}
+
+		// Messages up to nonce 5 are attested on the destination at 300, nonce 6 only at 995 and nonce 7 at 500, as
+		// the snapshot of another domain's notary including it is never attested on the destination.
+		err := testDB.StoreSnapshotStates(ctx, []model.SnapshotState{
+			{ChainID: synChainID, TxHash: common.BigToHash(big.NewInt(1)).String(), Timestamp: 200, SnapshotRoot: firstRoot, AgentDomain: destinationChainID, Agent: notary, OriginChainID: originChainID, Nonce: 5},
+			{ChainID: synChainID, TxHash: common.BigToHash(big.NewInt(2)).String(), Timestamp: 900, SnapshotRoot: secondRoot, AgentDomain: destinationChainID, Agent: notary, OriginChainID: originChainID, Nonce: 6},
+			{ChainID: synChainID, TxHash: common.BigToHash(big.NewInt(5)).String(), Timestamp: 350, SnapshotRoot: otherRoot, AgentDomain: synChainID, Agent: notary, OriginChainID: originChainID, Nonce: 7},
+			{ChainID: synChainID, TxHash: common.BigToHash(big.NewInt(6)).String(), Timestamp: 450, SnapshotRoot: thirdRoot, AgentDomain: destinationChainID, Agent: notary, OriginChainID: originChainID, Nonce: 8},
+		})
+		Nil(t.T(), err)
+		err = testDB.StoreAttestation(ctx, &model.Attestation{ChainID: destinationChainID, TxHash: common.BigToHash(big.NewInt(3)).String(), Timestamp: 300, SnapshotRoot: firstRoot, Notary: notary})
+		Nil(t.T(), err)
+		err = testDB.StoreAttestation(ctx, &model.Attestation{ChainID: destinationChainID, TxHash: common.BigToHash(big.NewInt(4)).String(), Timestamp: 995, SnapshotRoot: secondRoot, Notary: notary})
+		Nil(t.T(), err)
+		err = testDB.StoreAttestation(ctx, &model.Attestation{ChainID: synChainID, TxHash: common.BigToHash(big.NewInt(7)).String(), Timestamp: 400, SnapshotRoot: otherRoot, Notary: notary})
+		Nil(t.T(), err)
+		err = testDB.StoreAttestation(ctx, &model.Attestation{ChainID: destinationChainID, TxHash: common.BigToHash(big.NewInt(8)).String(), Timestamp: 500, SnapshotRoot: thirdRoot, Notary: notary})
+		Nil(t.T(), err)
+
+		// Stuck.
+		storeSent(1, 100, 10)
+		storeSent(2, 150, 10)
+		// Still in the optimistic period after the attestation.
+		storeSent(3, 200, 800)
+		// Executed successfully.
+		executedHash := storeSent(4, 50, 10)
+		err = testDB.StoreExecuted(ctx, &model.Executed{MessageHash: executedHash, ChainID: destinationChainID, Success: true})
+		Nil(t.T(), err)
+		// Failed executions are still stuck.
+		failedHash := storeSent(5, 200, 300)
+		err = testDB.StoreExecuted(ctx, &model.Executed{MessageHash: failedHash, ChainID: destinationChainID, Success: false})
+		Nil(t.T(), err)
+		// Sent long ago but attested recently.
+		storeSent(6, 100, 10)
+		// Stuck since its destination's notaries included it.
+		storeSent(7, 100, 10)
+		// Not attested yet.
+		storeSent(9, 100, 10)
+
+		counts, err := testDB.RetrieveStuckMessageCounts(ctx, 1000, 0)
+		Nil(t.T(), err)
+		Equal(t.T(), 1, len(counts))
+		Equal(t.T(), originChainID, counts[0].OriginChainID)
+		Equal(t.T(), destinationChainID, counts[0].DestinationChainID)
+		Equal(t.T(), 4, counts[0].Count)
+		Equal(t.T(), uint64(100), counts[0].OldestSentTimestamp)
+
+		// The grace period excludes recently stuck messages.
+		counts, err = testDB.RetrieveStuckMessageCounts(ctx, 1000, 500)
+		Nil(t.T(), err)
+		Equal(t.T(), 1, len(counts))
+		Equal(t.T(), 2, counts[0].Count)
+
+		// Messages sent more than 30 days ago are not counted.
+		counts, err = testDB.RetrieveStuckMessageCounts(ctx, 30*24*60*60+150, 0)
+		Nil(t.T(), err)
+		Equal(t.T(), 1, len(counts))
+		Equal(t.T(), 3, counts[0].Count)
+		Equal(t.T(), uint64(150), counts[0].OldestSentTimestamp)
+	})
+}
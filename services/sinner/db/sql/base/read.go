@@ -138,3 +138,115 @@ func (s Store) RetrieveExecuted(ctx context.Context, filter model.Executed) ([]m
 	}
 	return executedRecord, nil
 }
+
+// RetrieveSnapshotInclusion gets the first guard snapshot state that includes a message.
+func (s Store) RetrieveSnapshotInclusion(ctx context.Context, originChainID uint32, nonce uint32) (*model.SnapshotState, error) {
+	var state model.SnapshotState
+	// the first snapshot including the message has the lowest nonce at or past it, which the origin index seeks to.
+	err := s.DB().WithContext(ctx).
+		Model(&model.SnapshotState{}).
+		Where("agent_domain = 0 AND origin_chain_id = ? AND nonce >= ?", originChainID, nonce).
+		Order("nonce ASC, timestamp ASC").
+		First(&state).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve snapshot inclusion: %w", err)
+	}
+	return &state, nil
+}
+
+// firstNotaryNonce selects the nonce of the first snapshot state of an origin at or past a nonce submitted by a
+// notary of the destination, i.e. the first snapshot including the message with that nonce that can be attested on
+// its destination.
+func (s Store) firstNotaryNonce(destinationChainID, originChainID, nonce interface{}) *gorm.DB {
+	return s.DB().
+		Model(&model.SnapshotState{}).
+		Select("MIN(nonce)").
+		Where("agent_domain = ? AND origin_chain_id = ? AND nonce >= ?", destinationChainID, originChainID, nonce)
+}
+
+// RetrieveDestinationAttestation gets the first attestation on a destination of the first snapshot of its notaries
+// including a message.
+func (s Store) RetrieveDestinationAttestation(ctx context.Context, destinationChainID uint32, originChainID uint32, nonce uint32) (*model.Attestation, error) {
+	snapshotRoots := s.DB().
+		Model(&model.SnapshotState{}).
+		Select(model.SnapshotRootFieldName).
+		Where("agent_domain = ? AND origin_chain_id = ? AND nonce = (?)", destinationChainID, originChainID, s.firstNotaryNonce(destinationChainID, originChainID, nonce))
+
+	var attestation model.Attestation
+	err := s.DB().WithContext(ctx).
+		Model(&model.Attestation{}).
+		Where("chain_id = ? AND snapshot_root IN (?)", destinationChainID, snapshotRoots).
+		Order("timestamp ASC").
+		First(&attestation).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve destination attestation: %w", err)
+	}
+	return &attestation, nil
+}
+
+// stuckMessageRetention is how many seconds back sent messages are checked for being stuck. Older messages are not
+// counted, so the query doesn't grow with all of history.
+const stuckMessageRetention = 30 * 24 * 60 * 60
+
+// RetrieveStuckMessageCounts gets the amount of messages per origin and destination that have not been successfully
+// executed more than their optimistic seconds plus the grace seconds after being attested on their destination.
+// Messages that are not attested on their destination yet, or were sent more than stuckMessageRetention seconds ago,
+// are not counted.
+func (s Store) RetrieveStuckMessageCounts(ctx context.Context, now uint64, graceSeconds uint64) ([]model.StuckMessageCount, error) {
+	var sentAfter uint64
+	if now > stuckMessageRetention {
+		sentAfter = now - stuckMessageRetention
+	}
+
+	executed := s.DB().
+		Model(&model.Executed{}).
+		Select(model.MessageHashFieldName).
+		Where("success = ?", true)
+
+	// each recent message with the nonce of the first snapshot of its destination's notaries including it.
+	sent := s.DB().
+		Model(&model.OriginSent{}).
+		Select("message_hash, chain_id, destination_chain_id, nonce, timestamp, optimistic_seconds").
+		Where("timestamp >= ?", sentAfter)
+	included := s.DB().
+		Table("(?) AS sent", sent).
+		Select("sent.*, (?) AS snapshot_nonce", s.firstNotaryNonce(gorm.Expr("sent.destination_chain_id"), gorm.Expr("sent.chain_id"), gorm.Expr("sent.nonce")))
+	// the roots of the notary snapshots, once per notary domain and origin.
+	snapshots := s.DB().
+		Model(&model.SnapshotState{}).
+		Select("agent_domain, origin_chain_id, snapshot_root, MIN(nonce) AS nonce").
+		Where("agent_domain != 0").
+		Group("agent_domain, origin_chain_id, snapshot_root")
+
+	// the first attestation on the destination of the snapshot each message is first included in, as in
+	// RetrieveDestinationAttestation.
+	attested := s.DB().
+		Table("(?) AS sent", included).
+		Select("sent.message_hash, sent.chain_id, sent.destination_chain_id, sent.timestamp, sent.optimistic_seconds, MIN(attestation.timestamp) AS attested_timestamp").
+		Joins("JOIN (?) AS state ON state.agent_domain = sent.destination_chain_id AND state.origin_chain_id = sent.chain_id AND state.nonce = sent.snapshot_nonce", snapshots).
+		Joins("JOIN (?) AS attestation ON attestation.chain_id = sent.destination_chain_id AND attestation.snapshot_root = state.snapshot_root", s.DB().Model(&model.Attestation{})).
+		Group("sent.message_hash, sent.chain_id, sent.destination_chain_id, sent.timestamp, sent.optimistic_seconds")
+
+	var counts []model.StuckMessageCount
+	err := s.DB().WithContext(ctx).
+		Table("(?) AS attested", attested).
+		Select("chain_id, destination_chain_id, COUNT(*) AS count, MIN(timestamp) AS oldest_sent_timestamp").
+		Where("attested_timestamp + optimistic_seconds + ? < ?", graceSeconds, now).
+		Where("message_hash NOT IN (?)", executed).
+		Group("chain_id, destination_chain_id").
+		Order("chain_id, destination_chain_id").
+		Scan(&counts).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve stuck message counts: %w", err)
+	}
+	return counts, nil
+}
@@ -28,15 +28,21 @@ func (s Store) StoreOriginSent(ctx context.Context, originSent *model.OriginSent
 	return nil
 }
 
-// StoreExecuted stores an origin event.
+// StoreExecuted stores an executed event. A failed execution can be retried, so a successful execution replaces a
+// failed one.
 func (s Store) StoreExecuted(ctx context.Context, executedEvent *model.Executed) error {
-	dbTx := s.DB().WithContext(ctx)
-	dbTx = dbTx.Clauses(clause.OnConflict{
+	onConflict := clause.OnConflict{
 		Columns: []clause.Column{
 			{Name: model.ChainIDFieldName}, {Name: model.MessageHashFieldName},
 		},
 		DoNothing: true,
-	}).Create(executedEvent)
+	}
+	if executedEvent.Success {
+		onConflict.DoNothing = false
+		onConflict.UpdateAll = true
+	}
+
+	dbTx := s.DB().WithContext(ctx).Clauses(onConflict).Create(executedEvent)
 
 	if dbTx.Error != nil {
 		return fmt.Errorf("could not store log: %w", dbTx.Error)
@@ -133,3 +139,34 @@ func (s Store) StoreOrUpdateMessageStatus(ctx context.Context, txHash string, me
 
 	return nil
 }
+
+// StoreSnapshotStates stores the states of a snapshot.
+func (s Store) StoreSnapshotStates(ctx context.Context, states []model.SnapshotState) error {
+	if len(states) == 0 {
+		return nil
+	}
+
+	dbTx := s.DB().WithContext(ctx).Clauses(clause.OnConflict{
+		DoNothing: true,
+	}).Create(&states)
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not store snapshot states: %w", dbTx.Error)
+	}
+
+	return nil
+}
+
+// StoreAttestation stores an attestation.
+func (s Store) StoreAttestation(ctx context.Context, attestation *model.Attestation) error {
+	dbTx := s.DB().WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: model.ChainIDFieldName}, {Name: model.TxHashFieldName}, {Name: model.SnapshotRootFieldName},
+		},
+		DoNothing: true,
+	}).Create(attestation)
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not store attestation: %w", dbTx.Error)
+	}
+
+	return nil
+}
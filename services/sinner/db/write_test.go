@@ -209,3 +209,42 @@ func (t *DBSuite) TestStoreOrUpdateMessageStatus() {
 		Equal(t.T(), newTxHash2, updatedStatus2.DestinationTxHash)
 	})
 }
+
+func (t *DBSuite) TestStoreExecutedRetry() {
+	t.RunOnAllDBs(func(testDB db.TestEventDB) {
+		ctx := t.GetTestContext()
+		messageHash := common.BigToHash(big.NewInt(gofakeit.Int64())).String()
+		chainID := gofakeit.Uint32()
+
+		failed := &model.Executed{
+			TxHash:        common.BigToHash(big.NewInt(gofakeit.Int64())).String(),
+			MessageHash:   messageHash,
+			ChainID:       chainID,
+			Success:       false,
+			FailureReason: "insufficient balance",
+		}
+		err := testDB.StoreExecuted(ctx, failed)
+		Nil(t.T(), err)
+
+		// A successful retry replaces the failure.
+		succeeded := &model.Executed{
+			TxHash:      common.BigToHash(big.NewInt(gofakeit.Int64())).String(),
+			MessageHash: messageHash,
+			ChainID:     chainID,
+			Success:     true,
+		}
+		err = testDB.StoreExecuted(ctx, succeeded)
+		Nil(t.T(), err)
+
+		// A failure indexed after the success does not replace it.
+		err = testDB.StoreExecuted(ctx, failed)
+		Nil(t.T(), err)
+
+		executed, err := testDB.RetrieveExecuted(ctx, model.Executed{MessageHash: messageHash})
+		Nil(t.T(), err)
+		Equal(t.T(), 1, len(executed))
+		True(t.T(), executed[0].Success)
+		Equal(t.T(), succeeded.TxHash, executed[0].TxHash)
+		Equal(t.T(), "", executed[0].FailureReason)
+	})
+}
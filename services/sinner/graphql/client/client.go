@@ -19,10 +19,12 @@ func NewClient(cli *http.Client, baseURL string, options ...client.HTTPRequestOp
 }
 
 type Query struct {
-	GetMessageStatus    *model.MessageStatus     "json:\"getMessageStatus\" graphql:\"getMessageStatus\""
-	GetMessagesByStatus []*model.MessageStatus   "json:\"getMessagesByStatus\" graphql:\"getMessagesByStatus\""
-	GetOriginInfo       []*model.OriginInfo      "json:\"getOriginInfo\" graphql:\"getOriginInfo\""
-	GetDestinationInfo  []*model.DestinationInfo "json:\"getDestinationInfo\" graphql:\"getDestinationInfo\""
+	GetMessageStatus      *model.MessageStatus       "json:\"getMessageStatus\" graphql:\"getMessageStatus\""
+	GetMessagesByStatus   []*model.MessageStatus     "json:\"getMessagesByStatus\" graphql:\"getMessagesByStatus\""
+	GetOriginInfo         []*model.OriginInfo        "json:\"getOriginInfo\" graphql:\"getOriginInfo\""
+	GetDestinationInfo    []*model.DestinationInfo   "json:\"getDestinationInfo\" graphql:\"getDestinationInfo\""
+	GetMessageLifecycle   *model.MessageLifecycle    "json:\"getMessageLifecycle\" graphql:\"getMessageLifecycle\""
+	GetStuckMessageCounts []*model.StuckMessageCount "json:\"getStuckMessageCounts\" graphql:\"getStuckMessageCounts\""
 }
 type GetMessageStatus struct {
 	Response *struct {
@@ -75,6 +77,31 @@ type GetDestinationInfo struct {
 		Success         *bool   "json:\"success\" graphql:\"success\""
 	} "json:\"response\" graphql:\"response\""
 }
+type GetMessageLifecycle struct {
+	Response *struct {
+		MessageHash        *string             "json:\"messageHash\" graphql:\"messageHash\""
+		OriginChainID      *int                "json:\"originChainID\" graphql:\"originChainID\""
+		DestinationChainID *int                "json:\"destinationChainID\" graphql:\"destinationChainID\""
+		OptimisticSeconds  *int                "json:\"optimisticSeconds\" graphql:\"optimisticSeconds\""
+		Stage              *model.MessageStage "json:\"stage\" graphql:\"stage\""
+		RevertReason       *string             "json:\"revertReason\" graphql:\"revertReason\""
+		Timeline           []*struct {
+			Stage          *model.MessageStage "json:\"stage\" graphql:\"stage\""
+			ChainID        *int                "json:\"chainID\" graphql:\"chainID\""
+			TxHash         *string             "json:\"txHash\" graphql:\"txHash\""
+			Timestamp      *int                "json:\"timestamp\" graphql:\"timestamp\""
+			SecondsInStage *int                "json:\"secondsInStage\" graphql:\"secondsInStage\""
+		} "json:\"timeline\" graphql:\"timeline\""
+	} "json:\"response\" graphql:\"response\""
+}
+type GetStuckMessageCounts struct {
+	Response []*struct {
+		OriginChainID       *int "json:\"originChainID\" graphql:\"originChainID\""
+		DestinationChainID  *int "json:\"destinationChainID\" graphql:\"destinationChainID\""
+		Count               *int "json:\"count\" graphql:\"count\""
+		OldestSentTimestamp *int "json:\"oldestSentTimestamp\" graphql:\"oldestSentTimestamp\""
+	} "json:\"response\" graphql:\"response\""
+}
 
 const GetMessageStatusDocument = `query GetMessageStatus ($messageHash: String, $originChainID: Int, $originTxHash: String) {
 	response: getMessageStatus(messageHash: $messageHash, originChainID: $originChainID, originTxHash: $originTxHash) {
@@ -193,3 +220,60 @@ func (c *Client) GetDestinationInfo(ctx context.Context, messageHash *string, ch
 
 	return &res, nil
 }
+
+const GetMessageLifecycleDocument = `query GetMessageLifecycle ($messageHash: String, $originChainID: Int, $originTxHash: String) {
+	response: getMessageLifecycle(messageHash: $messageHash, originChainID: $originChainID, originTxHash: $originTxHash) {
+		messageHash
+		originChainID
+		destinationChainID
+		optimisticSeconds
+		stage
+		revertReason
+		timeline {
+			stage
+			chainID
+			txHash
+			timestamp
+			secondsInStage
+		}
+	}
+}
+`
+
+func (c *Client) GetMessageLifecycle(ctx context.Context, messageHash *string, originChainID *int, originTxHash *string, httpRequestOptions ...client.HTTPRequestOption) (*GetMessageLifecycle, error) {
+	vars := map[string]interface{}{
+		"messageHash":   messageHash,
+		"originChainID": originChainID,
+		"originTxHash":  originTxHash,
+	}
+
+	var res GetMessageLifecycle
+	if err := c.Client.Post(ctx, "GetMessageLifecycle", GetMessageLifecycleDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetStuckMessageCountsDocument = `query GetStuckMessageCounts ($graceSeconds: Int! = 0) {
+	response: getStuckMessageCounts(graceSeconds: $graceSeconds) {
+		originChainID
+		destinationChainID
+		count
+		oldestSentTimestamp
+	}
+}
+`
+
+func (c *Client) GetStuckMessageCounts(ctx context.Context, graceSeconds int, httpRequestOptions ...client.HTTPRequestOption) (*GetStuckMessageCounts, error) {
+	vars := map[string]interface{}{
+		"graceSeconds": graceSeconds,
+	}
+
+	var res GetStuckMessageCounts
+	if err := c.Client.Post(ctx, "GetStuckMessageCounts", GetStuckMessageCountsDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
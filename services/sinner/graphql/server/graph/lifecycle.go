@@ -0,0 +1,111 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/synapsecns/sanguine/core"
+	"github.com/synapsecns/sanguine/services/sinner/db/model"
+	graphqlModel "github.com/synapsecns/sanguine/services/sinner/graphql/server/graph/model"
+)
+
+// getMessageLifecycle joins the events of a message across the origin, the synchain and the destination into its
+// lifecycle. Stages that have not been indexed are left out of the timeline, and the current stage accumulates time
+// until now.
+func (r *queryResolver) getMessageLifecycle(ctx context.Context, originSent model.OriginSent, now uint64) (*graphqlModel.MessageLifecycle, error) {
+	timeline := []*graphqlModel.MessageStageInfo{
+		newStageInfo(graphqlModel.MessageStageSent, originSent.ChainID, originSent.TxHash, originSent.Timestamp),
+	}
+
+	inclusion, err := r.DB.RetrieveSnapshotInclusion(ctx, originSent.ChainID, originSent.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving snapshot inclusion: %w", err)
+	}
+	if inclusion != nil {
+		timeline = append(timeline, newStageInfo(graphqlModel.MessageStageSnapshotted, inclusion.ChainID, inclusion.TxHash, inclusion.Timestamp))
+	}
+
+	attestation, err := r.DB.RetrieveDestinationAttestation(ctx, originSent.DestinationChainID, originSent.ChainID, originSent.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving attestation: %w", err)
+	}
+	if attestation != nil {
+		timeline = append(timeline, newStageInfo(graphqlModel.MessageStageAttested, attestation.ChainID, attestation.TxHash, attestation.Timestamp))
+		// the message can be executed once the optimistic period after the attestation has passed.
+		executableAt := attestation.Timestamp + uint64(originSent.OptimisticSeconds)
+		if executableAt <= now {
+			timeline = append(timeline, newStageInfo(graphqlModel.MessageStageExecutable, originSent.DestinationChainID, "", executableAt))
+		}
+	}
+
+	executed, err := r.DB.RetrieveExecuted(ctx, model.Executed{MessageHash: originSent.MessageHash, ChainID: originSent.DestinationChainID})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving destination info: %w", err)
+	}
+
+	var revertReason *string
+	if len(executed) > 0 {
+		stage := graphqlModel.MessageStageExecuted
+		if !executed[0].Success {
+			stage = graphqlModel.MessageStageFailed
+			revertReason = &executed[0].FailureReason
+		}
+		timeline = append(timeline, newStageInfo(stage, executed[0].ChainID, executed[0].TxHash, executed[0].Timestamp))
+	}
+
+	setSecondsInStage(timeline, now)
+	return &graphqlModel.MessageLifecycle{
+		MessageHash:        &originSent.MessageHash,
+		OriginChainID:      core.PtrTo(int(originSent.ChainID)),
+		DestinationChainID: core.PtrTo(int(originSent.DestinationChainID)),
+		OptimisticSeconds:  core.PtrTo(int(originSent.OptimisticSeconds)),
+		Stage:              timeline[len(timeline)-1].Stage,
+		RevertReason:       revertReason,
+		Timeline:           timeline,
+	}, nil
+}
+
+func newStageInfo(stage graphqlModel.MessageStage, chainID uint32, txHash string, timestamp uint64) *graphqlModel.MessageStageInfo {
+	stageInfo := &graphqlModel.MessageStageInfo{
+		Stage:     &stage,
+		ChainID:   core.PtrTo(int(chainID)),
+		Timestamp: core.PtrTo(int(timestamp)),
+	}
+	if txHash != "" {
+		stageInfo.TxHash = &txHash
+	}
+	return stageInfo
+}
+
+// setSecondsInStage sets the time spent in each stage of a timeline: until the next stage, or until now for the
+// current stage. Execution is final, so no time is spent in it.
+func setSecondsInStage(timeline []*graphqlModel.MessageStageInfo, now uint64) {
+	for i, stageInfo := range timeline {
+		end := int(now)
+		switch {
+		case i+1 < len(timeline):
+			end = *timeline[i+1].Timestamp
+		case *stageInfo.Stage == graphqlModel.MessageStageExecuted:
+			end = *stageInfo.Timestamp
+		}
+		// stages can be indexed with slightly out of order timestamps across chains.
+		seconds := end - *stageInfo.Timestamp
+		if seconds < 0 {
+			seconds = 0
+		}
+		stageInfo.SecondsInStage = &seconds
+	}
+}
+
+func dbToGraphqlModelStuckMessageCounts(counts []model.StuckMessageCount) []*graphqlModel.StuckMessageCount {
+	output := make([]*graphqlModel.StuckMessageCount, len(counts))
+	for i, count := range counts {
+		output[i] = &graphqlModel.StuckMessageCount{
+			OriginChainID:       core.PtrTo(int(count.OriginChainID)),
+			DestinationChainID:  core.PtrTo(int(count.DestinationChainID)),
+			Count:               core.PtrTo(count.Count),
+			OldestSentTimestamp: core.PtrTo(int(count.OldestSentTimestamp)),
+		}
+	}
+	return output
+}
@@ -22,6 +22,26 @@ type DestinationInfo struct {
 	OriginInfo      []*OriginInfo  `json:"originInfo,omitempty"`
 }
 
+// MessageLifecycle gives the timeline of a message from dispatch to execution.
+type MessageLifecycle struct {
+	MessageHash        *string             `json:"messageHash,omitempty"`
+	OriginChainID      *int                `json:"originChainID,omitempty"`
+	DestinationChainID *int                `json:"destinationChainID,omitempty"`
+	OptimisticSeconds  *int                `json:"optimisticSeconds,omitempty"`
+	Stage              *MessageStage       `json:"stage,omitempty"`
+	RevertReason       *string             `json:"revertReason,omitempty"`
+	Timeline           []*MessageStageInfo `json:"timeline,omitempty"`
+}
+
+// MessageStageInfo gives when a message reached a stage and how long it has been in it.
+type MessageStageInfo struct {
+	Stage          *MessageStage `json:"stage,omitempty"`
+	ChainID        *int          `json:"chainID,omitempty"`
+	TxHash         *string       `json:"txHash,omitempty"`
+	Timestamp      *int          `json:"timestamp,omitempty"`
+	SecondsInStage *int          `json:"secondsInStage,omitempty"`
+}
+
 // MessageStatus gives the status of a message.
 type MessageStatus struct {
 	MessageHash       *string               `json:"messageHash,omitempty"`
@@ -57,6 +77,64 @@ type OriginInfo struct {
 	DestinationInfo    []*DestinationInfo `json:"destinationInfo,omitempty"`
 }
 
+// StuckMessageCount gives the amount of messages between two chains that are not executed past their optimistic period.
+type StuckMessageCount struct {
+	OriginChainID       *int `json:"originChainID,omitempty"`
+	DestinationChainID  *int `json:"destinationChainID,omitempty"`
+	Count               *int `json:"count,omitempty"`
+	OldestSentTimestamp *int `json:"oldestSentTimestamp,omitempty"`
+}
+
+// MessageStage is a stage of the message lifecycle.
+type MessageStage string
+
+const (
+	MessageStageSent        MessageStage = "SENT"
+	MessageStageSnapshotted MessageStage = "SNAPSHOTTED"
+	MessageStageAttested    MessageStage = "ATTESTED"
+	MessageStageExecutable  MessageStage = "EXECUTABLE"
+	MessageStageExecuted    MessageStage = "EXECUTED"
+	MessageStageFailed      MessageStage = "FAILED"
+)
+
+var AllMessageStage = []MessageStage{
+	MessageStageSent,
+	MessageStageSnapshotted,
+	MessageStageAttested,
+	MessageStageExecutable,
+	MessageStageExecuted,
+	MessageStageFailed,
+}
+
+func (e MessageStage) IsValid() bool {
+	switch e {
+	case MessageStageSent, MessageStageSnapshotted, MessageStageAttested, MessageStageExecutable, MessageStageExecuted, MessageStageFailed:
+		return true
+	}
+	return false
+}
+
+func (e MessageStage) String() string {
+	return string(e)
+}
+
+func (e *MessageStage) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MessageStage(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MessageStage", str)
+	}
+	return nil
+}
+
+func (e MessageStage) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // MessageState gives the current state of a message.
 type MessageState string
 
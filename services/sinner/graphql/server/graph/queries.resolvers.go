@@ -7,6 +7,7 @@
 import (
 	"context"
 	"fmt"
+	"time"
 
 	dbModel "github.com/synapsecns/sanguine/services/sinner/db/model"
 	"github.com/synapsecns/sanguine/services/sinner/graphql/server/graph/model"
@@ -91,6 +92,43 @@ func (r *queryResolver) GetDestinationInfo(ctx context.Context, messageHash *str
 	return dbToGraphqlModelDestinationMultiple(executedTxs), nil
 }
 
+// GetMessageLifecycle is the resolver for the getMessageLifecycle field.
+func (r *queryResolver) GetMessageLifecycle(ctx context.Context, messageHash *string, originChainID *int, originTxHash *string) (*model.MessageLifecycle, error) {
+	var filter dbModel.OriginSent
+	switch {
+	case messageHash != nil:
+		filter.MessageHash = *messageHash
+	case originChainID != nil && originTxHash != nil:
+		filter.ChainID = ifNilUint32(originChainID)
+		filter.TxHash = *originTxHash
+	default:
+		return nil, fmt.Errorf("either messageHash or both originChainID and originTxHash must be provided")
+	}
+
+	originTxs, err := r.DB.RetrieveOriginSent(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving origin sent data: %w", err)
+	}
+	if len(originTxs) == 0 {
+		return nil, fmt.Errorf("no origin sent data found for message")
+	}
+
+	return r.getMessageLifecycle(ctx, originTxs[0], uint64(time.Now().Unix()))
+}
+
+// GetStuckMessageCounts is the resolver for the getStuckMessageCounts field.
+func (r *queryResolver) GetStuckMessageCounts(ctx context.Context, graceSeconds int) ([]*model.StuckMessageCount, error) {
+	if graceSeconds < 0 {
+		return nil, fmt.Errorf("graceSeconds must not be negative")
+	}
+
+	counts, err := r.DB.RetrieveStuckMessageCounts(ctx, uint64(time.Now().Unix()), uint64(graceSeconds))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving stuck message counts: %w", err)
+	}
+	return dbToGraphqlModelStuckMessageCounts(counts), nil
+}
+
 // Query returns resolvers.QueryResolver implementation.
 func (r *Resolver) Query() resolvers.QueryResolver { return &queryResolver{r} }
 
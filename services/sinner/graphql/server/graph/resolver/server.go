@@ -59,6 +59,24 @@ type ComplexityRoot struct {
 		TxIndex         func(childComplexity int) int
 	}
 
+	MessageLifecycle struct {
+		DestinationChainID func(childComplexity int) int
+		MessageHash        func(childComplexity int) int
+		OptimisticSeconds  func(childComplexity int) int
+		OriginChainID      func(childComplexity int) int
+		RevertReason       func(childComplexity int) int
+		Stage              func(childComplexity int) int
+		Timeline           func(childComplexity int) int
+	}
+
+	MessageStageInfo struct {
+		ChainID        func(childComplexity int) int
+		SecondsInStage func(childComplexity int) int
+		Stage          func(childComplexity int) int
+		Timestamp      func(childComplexity int) int
+		TxHash         func(childComplexity int) int
+	}
+
 	MessageStatus struct {
 		DestinationInfo   func(childComplexity int) int
 		DestinationTxHash func(childComplexity int) int
@@ -93,10 +111,19 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
-		GetDestinationInfo  func(childComplexity int, messageHash *string, txHash *string, chainID *int) int
-		GetMessageStatus    func(childComplexity int, messageHash *string, originChainID *int, originTxHash *string) int
-		GetMessagesByStatus func(childComplexity int, messageStatus model.MessageState, page int) int
-		GetOriginInfo       func(childComplexity int, messageHash *string, txHash *string, chainID *int) int
+		GetDestinationInfo    func(childComplexity int, messageHash *string, txHash *string, chainID *int) int
+		GetMessageLifecycle   func(childComplexity int, messageHash *string, originChainID *int, originTxHash *string) int
+		GetMessageStatus      func(childComplexity int, messageHash *string, originChainID *int, originTxHash *string) int
+		GetMessagesByStatus   func(childComplexity int, messageStatus model.MessageState, page int) int
+		GetOriginInfo         func(childComplexity int, messageHash *string, txHash *string, chainID *int) int
+		GetStuckMessageCounts func(childComplexity int, graceSeconds int) int
+	}
+
+	StuckMessageCount struct {
+		Count               func(childComplexity int) int
+		DestinationChainID  func(childComplexity int) int
+		OldestSentTimestamp func(childComplexity int) int
+		OriginChainID       func(childComplexity int) int
 	}
 }
 
@@ -117,6 +144,8 @@ type QueryResolver interface {
 	GetMessagesByStatus(ctx context.Context, messageStatus model.MessageState, page int) ([]*model.MessageStatus, error)
 	GetOriginInfo(ctx context.Context, messageHash *string, txHash *string, chainID *int) ([]*model.OriginInfo, error)
 	GetDestinationInfo(ctx context.Context, messageHash *string, txHash *string, chainID *int) ([]*model.DestinationInfo, error)
+	GetMessageLifecycle(ctx context.Context, messageHash *string, originChainID *int, originTxHash *string) (*model.MessageLifecycle, error)
+	GetStuckMessageCounts(ctx context.Context, graceSeconds int) ([]*model.StuckMessageCount, error)
 }
 
 type executableSchema struct {
@@ -204,6 +233,90 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.DestinationInfo.TxIndex(childComplexity), true
 
+	case "MessageLifecycle.destinationChainID":
+		if e.complexity.MessageLifecycle.DestinationChainID == nil {
+			break
+		}
+
+		return e.complexity.MessageLifecycle.DestinationChainID(childComplexity), true
+
+	case "MessageLifecycle.messageHash":
+		if e.complexity.MessageLifecycle.MessageHash == nil {
+			break
+		}
+
+		return e.complexity.MessageLifecycle.MessageHash(childComplexity), true
+
+	case "MessageLifecycle.optimisticSeconds":
+		if e.complexity.MessageLifecycle.OptimisticSeconds == nil {
+			break
+		}
+
+		return e.complexity.MessageLifecycle.OptimisticSeconds(childComplexity), true
+
+	case "MessageLifecycle.originChainID":
+		if e.complexity.MessageLifecycle.OriginChainID == nil {
+			break
+		}
+
+		return e.complexity.MessageLifecycle.OriginChainID(childComplexity), true
+
+	case "MessageLifecycle.revertReason":
+		if e.complexity.MessageLifecycle.RevertReason == nil {
+			break
+		}
+
+		return e.complexity.MessageLifecycle.RevertReason(childComplexity), true
+
+	case "MessageLifecycle.stage":
+		if e.complexity.MessageLifecycle.Stage == nil {
+			break
+		}
+
+		return e.complexity.MessageLifecycle.Stage(childComplexity), true
+
+	case "MessageLifecycle.timeline":
+		if e.complexity.MessageLifecycle.Timeline == nil {
+			break
+		}
+
+		return e.complexity.MessageLifecycle.Timeline(childComplexity), true
+
+	case "MessageStageInfo.chainID":
+		if e.complexity.MessageStageInfo.ChainID == nil {
+			break
+		}
+
+		return e.complexity.MessageStageInfo.ChainID(childComplexity), true
+
+	case "MessageStageInfo.secondsInStage":
+		if e.complexity.MessageStageInfo.SecondsInStage == nil {
+			break
+		}
+
+		return e.complexity.MessageStageInfo.SecondsInStage(childComplexity), true
+
+	case "MessageStageInfo.stage":
+		if e.complexity.MessageStageInfo.Stage == nil {
+			break
+		}
+
+		return e.complexity.MessageStageInfo.Stage(childComplexity), true
+
+	case "MessageStageInfo.timestamp":
+		if e.complexity.MessageStageInfo.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.MessageStageInfo.Timestamp(childComplexity), true
+
+	case "MessageStageInfo.txHash":
+		if e.complexity.MessageStageInfo.TxHash == nil {
+			break
+		}
+
+		return e.complexity.MessageStageInfo.TxHash(childComplexity), true
+
 	case "MessageStatus.destinationInfo":
 		if e.complexity.MessageStatus.DestinationInfo == nil {
 			break
@@ -405,6 +518,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetDestinationInfo(childComplexity, args["messageHash"].(*string), args["txHash"].(*string), args["chainID"].(*int)), true
 
+	case "Query.getMessageLifecycle":
+		if e.complexity.Query.GetMessageLifecycle == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getMessageLifecycle_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetMessageLifecycle(childComplexity, args["messageHash"].(*string), args["originChainID"].(*int), args["originTxHash"].(*string)), true
+
 	case "Query.getMessageStatus":
 		if e.complexity.Query.GetMessageStatus == nil {
 			break
@@ -441,6 +566,46 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetOriginInfo(childComplexity, args["messageHash"].(*string), args["txHash"].(*string), args["chainID"].(*int)), true
 
+	case "Query.getStuckMessageCounts":
+		if e.complexity.Query.GetStuckMessageCounts == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getStuckMessageCounts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetStuckMessageCounts(childComplexity, args["graceSeconds"].(int)), true
+
+	case "StuckMessageCount.count":
+		if e.complexity.StuckMessageCount.Count == nil {
+			break
+		}
+
+		return e.complexity.StuckMessageCount.Count(childComplexity), true
+
+	case "StuckMessageCount.destinationChainID":
+		if e.complexity.StuckMessageCount.DestinationChainID == nil {
+			break
+		}
+
+		return e.complexity.StuckMessageCount.DestinationChainID(childComplexity), true
+
+	case "StuckMessageCount.oldestSentTimestamp":
+		if e.complexity.StuckMessageCount.OldestSentTimestamp == nil {
+			break
+		}
+
+		return e.complexity.StuckMessageCount.OldestSentTimestamp(childComplexity), true
+
+	case "StuckMessageCount.originChainID":
+		if e.complexity.StuckMessageCount.OriginChainID == nil {
+			break
+		}
+
+		return e.complexity.StuckMessageCount.OriginChainID(childComplexity), true
+
 	}
 	return 0, false
 }
@@ -569,6 +734,18 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
     chainID: Int
   ): [DestinationInfo]
 
+  """ Gets the lifecycle of a message from dispatch to execution: when each stage was reached, the current stage and the time spent in each stage. """
+  getMessageLifecycle(
+    messageHash: String
+    originChainID: Int
+    originTxHash: String
+  ): MessageLifecycle
+
+  """ Gets the amount of messages per origin and destination that are not executed more than their optimistic seconds plus the grace seconds after being attested on their destination. """
+  getStuckMessageCounts(
+    graceSeconds: Int! = 0
+  ): [StuckMessageCount]
+
 }
 
 
@@ -645,6 +822,51 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
   UNKNOWN
 }
 
+"""
+MessageStage is a stage of the message lifecycle.
+"""
+enum MessageStage{
+  SENT
+  SNAPSHOTTED
+  ATTESTED
+  EXECUTABLE
+  EXECUTED
+  FAILED
+}
+
+"""
+MessageStageInfo gives when a message reached a stage and how long it has been in it.
+"""
+type MessageStageInfo {
+  stage: MessageStage
+  chainID: Int
+  txHash: String
+  timestamp: Int
+  secondsInStage: Int
+}
+
+"""
+MessageLifecycle gives the timeline of a message from dispatch to execution.
+"""
+type MessageLifecycle {
+  messageHash: String
+  originChainID: Int
+  destinationChainID: Int
+  optimisticSeconds: Int
+  stage: MessageStage
+  revertReason: String
+  timeline: [MessageStageInfo]
+}
+
+"""
+StuckMessageCount gives the amount of messages between two chains that are not executed past their optimistic period.
+"""
+type StuckMessageCount {
+  originChainID: Int
+  destinationChainID: Int
+  count: Int
+  oldestSentTimestamp: Int
+}
 `, BuiltIn: false},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
@@ -701,6 +923,39 @@ func (ec *executionContext) field_Query_getDestinationInfo_args(ctx context.Cont
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_getMessageLifecycle_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["messageHash"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("messageHash"))
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["messageHash"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["originChainID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("originChainID"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["originChainID"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["originTxHash"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("originTxHash"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["originTxHash"] = arg2
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_getMessageStatus_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -791,6 +1046,21 @@ func (ec *executionContext) field_Query_getOriginInfo_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_getStuckMessageCounts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["graceSeconds"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("graceSeconds"))
+		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["graceSeconds"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1297,8 +1567,8 @@ func (ec *executionContext) fieldContext_DestinationInfo_originInfo(ctx context.
 	return fc, nil
 }
 
-func (ec *executionContext) _MessageStatus_messageHash(ctx context.Context, field graphql.CollectedField, obj *model.MessageStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MessageStatus_messageHash(ctx, field)
+func (ec *executionContext) _MessageLifecycle_messageHash(ctx context.Context, field graphql.CollectedField, obj *model.MessageLifecycle) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageLifecycle_messageHash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1325,9 +1595,9 @@ func (ec *executionContext) _MessageStatus_messageHash(ctx context.Context, fiel
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MessageStatus_messageHash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageLifecycle_messageHash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MessageStatus",
+		Object:     "MessageLifecycle",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1338,8 +1608,8 @@ func (ec *executionContext) fieldContext_MessageStatus_messageHash(ctx context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _MessageStatus_lastSeen(ctx context.Context, field graphql.CollectedField, obj *model.MessageStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MessageStatus_lastSeen(ctx, field)
+func (ec *executionContext) _MessageLifecycle_originChainID(ctx context.Context, field graphql.CollectedField, obj *model.MessageLifecycle) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageLifecycle_originChainID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1352,7 +1622,7 @@ func (ec *executionContext) _MessageStatus_lastSeen(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastSeen, nil
+		return obj.OriginChainID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1361,26 +1631,26 @@ func (ec *executionContext) _MessageStatus_lastSeen(ctx context.Context, field g
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.MessageStateLastSeen)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOMessageStateLastSeen2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageStateLastSeen(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MessageStatus_lastSeen(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageLifecycle_originChainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MessageStatus",
+		Object:     "MessageLifecycle",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type MessageStateLastSeen does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MessageStatus_originTxHash(ctx context.Context, field graphql.CollectedField, obj *model.MessageStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MessageStatus_originTxHash(ctx, field)
+func (ec *executionContext) _MessageLifecycle_destinationChainID(ctx context.Context, field graphql.CollectedField, obj *model.MessageLifecycle) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageLifecycle_destinationChainID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1393,7 +1663,7 @@ func (ec *executionContext) _MessageStatus_originTxHash(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OriginTxHash, nil
+		return obj.DestinationChainID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1402,26 +1672,26 @@ func (ec *executionContext) _MessageStatus_originTxHash(ctx context.Context, fie
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MessageStatus_originTxHash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageLifecycle_destinationChainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MessageStatus",
+		Object:     "MessageLifecycle",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MessageStatus_destinationTxHash(ctx context.Context, field graphql.CollectedField, obj *model.MessageStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MessageStatus_destinationTxHash(ctx, field)
+func (ec *executionContext) _MessageLifecycle_optimisticSeconds(ctx context.Context, field graphql.CollectedField, obj *model.MessageLifecycle) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageLifecycle_optimisticSeconds(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1434,7 +1704,7 @@ func (ec *executionContext) _MessageStatus_destinationTxHash(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DestinationTxHash, nil
+		return obj.OptimisticSeconds, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1443,26 +1713,26 @@ func (ec *executionContext) _MessageStatus_destinationTxHash(ctx context.Context
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MessageStatus_destinationTxHash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageLifecycle_optimisticSeconds(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MessageStatus",
+		Object:     "MessageLifecycle",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MessageStatus_originInfo(ctx context.Context, field graphql.CollectedField, obj *model.MessageStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MessageStatus_originInfo(ctx, field)
+func (ec *executionContext) _MessageLifecycle_stage(ctx context.Context, field graphql.CollectedField, obj *model.MessageLifecycle) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageLifecycle_stage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1475,7 +1745,7 @@ func (ec *executionContext) _MessageStatus_originInfo(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.MessageStatus().OriginInfo(rctx, obj)
+		return obj.Stage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1484,70 +1754,26 @@ func (ec *executionContext) _MessageStatus_originInfo(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.OriginInfo)
+	res := resTmp.(*model.MessageStage)
 	fc.Result = res
-	return ec.marshalOOriginInfo2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐOriginInfo(ctx, field.Selections, res)
+	return ec.marshalOMessageStage2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageStage(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MessageStatus_originInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageLifecycle_stage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MessageStatus",
+		Object:     "MessageLifecycle",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "messageHash":
-				return ec.fieldContext_OriginInfo_messageHash(ctx, field)
-			case "contractAddress":
-				return ec.fieldContext_OriginInfo_contractAddress(ctx, field)
-			case "blockNumber":
-				return ec.fieldContext_OriginInfo_blockNumber(ctx, field)
-			case "originTxHash":
-				return ec.fieldContext_OriginInfo_originTxHash(ctx, field)
-			case "sender":
-				return ec.fieldContext_OriginInfo_sender(ctx, field)
-			case "recipient":
-				return ec.fieldContext_OriginInfo_recipient(ctx, field)
-			case "originChainID":
-				return ec.fieldContext_OriginInfo_originChainID(ctx, field)
-			case "destinationChainID":
-				return ec.fieldContext_OriginInfo_destinationChainID(ctx, field)
-			case "nonce":
-				return ec.fieldContext_OriginInfo_nonce(ctx, field)
-			case "message":
-				return ec.fieldContext_OriginInfo_message(ctx, field)
-			case "optimisticSeconds":
-				return ec.fieldContext_OriginInfo_optimisticSeconds(ctx, field)
-			case "messageFlag":
-				return ec.fieldContext_OriginInfo_messageFlag(ctx, field)
-			case "summitTip":
-				return ec.fieldContext_OriginInfo_summitTip(ctx, field)
-			case "attestationTip":
-				return ec.fieldContext_OriginInfo_attestationTip(ctx, field)
-			case "executionTip":
-				return ec.fieldContext_OriginInfo_executionTip(ctx, field)
-			case "deliveryTip":
-				return ec.fieldContext_OriginInfo_deliveryTip(ctx, field)
-			case "version":
-				return ec.fieldContext_OriginInfo_version(ctx, field)
-			case "gasLimit":
-				return ec.fieldContext_OriginInfo_gasLimit(ctx, field)
-			case "gasDrop":
-				return ec.fieldContext_OriginInfo_gasDrop(ctx, field)
-			case "messageStatus":
-				return ec.fieldContext_OriginInfo_messageStatus(ctx, field)
-			case "destinationInfo":
-				return ec.fieldContext_OriginInfo_destinationInfo(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type OriginInfo", field.Name)
+			return nil, errors.New("field of type MessageStage does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MessageStatus_destinationInfo(ctx context.Context, field graphql.CollectedField, obj *model.MessageStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MessageStatus_destinationInfo(ctx, field)
+func (ec *executionContext) _MessageLifecycle_revertReason(ctx context.Context, field graphql.CollectedField, obj *model.MessageLifecycle) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageLifecycle_revertReason(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1560,7 +1786,7 @@ func (ec *executionContext) _MessageStatus_destinationInfo(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.MessageStatus().DestinationInfo(rctx, obj)
+		return obj.RevertReason, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1569,48 +1795,26 @@ func (ec *executionContext) _MessageStatus_destinationInfo(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.DestinationInfo)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalODestinationInfo2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐDestinationInfo(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MessageStatus_destinationInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageLifecycle_revertReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MessageStatus",
+		Object:     "MessageLifecycle",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "contractAddress":
-				return ec.fieldContext_DestinationInfo_contractAddress(ctx, field)
-			case "blockNumber":
-				return ec.fieldContext_DestinationInfo_blockNumber(ctx, field)
-			case "txHash":
-				return ec.fieldContext_DestinationInfo_txHash(ctx, field)
-			case "txIndex":
-				return ec.fieldContext_DestinationInfo_txIndex(ctx, field)
-			case "messageHash":
-				return ec.fieldContext_DestinationInfo_messageHash(ctx, field)
-			case "chainID":
-				return ec.fieldContext_DestinationInfo_chainID(ctx, field)
-			case "remoteDomain":
-				return ec.fieldContext_DestinationInfo_remoteDomain(ctx, field)
-			case "success":
-				return ec.fieldContext_DestinationInfo_success(ctx, field)
-			case "messageStatus":
-				return ec.fieldContext_DestinationInfo_messageStatus(ctx, field)
-			case "originInfo":
-				return ec.fieldContext_DestinationInfo_originInfo(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DestinationInfo", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_messageHash(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_messageHash(ctx, field)
+func (ec *executionContext) _MessageLifecycle_timeline(ctx context.Context, field graphql.CollectedField, obj *model.MessageLifecycle) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageLifecycle_timeline(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1623,7 +1827,7 @@ func (ec *executionContext) _OriginInfo_messageHash(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MessageHash, nil
+		return obj.Timeline, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1632,26 +1836,38 @@ func (ec *executionContext) _OriginInfo_messageHash(ctx context.Context, field g
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.MessageStageInfo)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOMessageStageInfo2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageStageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_messageHash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageLifecycle_timeline(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OriginInfo",
+		Object:     "MessageLifecycle",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "stage":
+				return ec.fieldContext_MessageStageInfo_stage(ctx, field)
+			case "chainID":
+				return ec.fieldContext_MessageStageInfo_chainID(ctx, field)
+			case "txHash":
+				return ec.fieldContext_MessageStageInfo_txHash(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_MessageStageInfo_timestamp(ctx, field)
+			case "secondsInStage":
+				return ec.fieldContext_MessageStageInfo_secondsInStage(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MessageStageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_contractAddress(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_contractAddress(ctx, field)
+func (ec *executionContext) _MessageStageInfo_stage(ctx context.Context, field graphql.CollectedField, obj *model.MessageStageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageStageInfo_stage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1664,7 +1880,7 @@ func (ec *executionContext) _OriginInfo_contractAddress(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContractAddress, nil
+		return obj.Stage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1673,26 +1889,26 @@ func (ec *executionContext) _OriginInfo_contractAddress(ctx context.Context, fie
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.MessageStage)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOMessageStage2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageStage(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_contractAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageStageInfo_stage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OriginInfo",
+		Object:     "MessageStageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type MessageStage does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_blockNumber(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_blockNumber(ctx, field)
+func (ec *executionContext) _MessageStageInfo_chainID(ctx context.Context, field graphql.CollectedField, obj *model.MessageStageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageStageInfo_chainID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1705,7 +1921,7 @@ func (ec *executionContext) _OriginInfo_blockNumber(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BlockNumber, nil
+		return obj.ChainID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1719,9 +1935,9 @@ func (ec *executionContext) _OriginInfo_blockNumber(ctx context.Context, field g
 	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_blockNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageStageInfo_chainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OriginInfo",
+		Object:     "MessageStageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1732,8 +1948,8 @@ func (ec *executionContext) fieldContext_OriginInfo_blockNumber(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_originTxHash(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_originTxHash(ctx, field)
+func (ec *executionContext) _MessageStageInfo_txHash(ctx context.Context, field graphql.CollectedField, obj *model.MessageStageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageStageInfo_txHash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1746,7 +1962,7 @@ func (ec *executionContext) _OriginInfo_originTxHash(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OriginTxHash, nil
+		return obj.TxHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1760,9 +1976,9 @@ func (ec *executionContext) _OriginInfo_originTxHash(ctx context.Context, field
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_originTxHash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageStageInfo_txHash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OriginInfo",
+		Object:     "MessageStageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1773,8 +1989,8 @@ func (ec *executionContext) fieldContext_OriginInfo_originTxHash(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_sender(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_sender(ctx, field)
+func (ec *executionContext) _MessageStageInfo_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.MessageStageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageStageInfo_timestamp(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1787,7 +2003,7 @@ func (ec *executionContext) _OriginInfo_sender(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Sender, nil
+		return obj.Timestamp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1796,26 +2012,26 @@ func (ec *executionContext) _OriginInfo_sender(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_sender(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageStageInfo_timestamp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OriginInfo",
+		Object:     "MessageStageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_recipient(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_recipient(ctx, field)
+func (ec *executionContext) _MessageStageInfo_secondsInStage(ctx context.Context, field graphql.CollectedField, obj *model.MessageStageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageStageInfo_secondsInStage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1828,7 +2044,7 @@ func (ec *executionContext) _OriginInfo_recipient(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Recipient, nil
+		return obj.SecondsInStage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1837,26 +2053,26 @@ func (ec *executionContext) _OriginInfo_recipient(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_recipient(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageStageInfo_secondsInStage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OriginInfo",
+		Object:     "MessageStageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_originChainID(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_originChainID(ctx, field)
+func (ec *executionContext) _MessageStatus_messageHash(ctx context.Context, field graphql.CollectedField, obj *model.MessageStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageStatus_messageHash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1869,7 +2085,7 @@ func (ec *executionContext) _OriginInfo_originChainID(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OriginChainID, nil
+		return obj.MessageHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1878,26 +2094,26 @@ func (ec *executionContext) _OriginInfo_originChainID(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_originChainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageStatus_messageHash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OriginInfo",
+		Object:     "MessageStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_destinationChainID(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_destinationChainID(ctx, field)
+func (ec *executionContext) _MessageStatus_lastSeen(ctx context.Context, field graphql.CollectedField, obj *model.MessageStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageStatus_lastSeen(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1910,7 +2126,7 @@ func (ec *executionContext) _OriginInfo_destinationChainID(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DestinationChainID, nil
+		return obj.LastSeen, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1919,26 +2135,26 @@ func (ec *executionContext) _OriginInfo_destinationChainID(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*model.MessageStateLastSeen)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOMessageStateLastSeen2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageStateLastSeen(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_destinationChainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageStatus_lastSeen(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OriginInfo",
+		Object:     "MessageStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type MessageStateLastSeen does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_nonce(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_nonce(ctx, field)
+func (ec *executionContext) _MessageStatus_originTxHash(ctx context.Context, field graphql.CollectedField, obj *model.MessageStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageStatus_originTxHash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1951,7 +2167,7 @@ func (ec *executionContext) _OriginInfo_nonce(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Nonce, nil
+		return obj.OriginTxHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1960,26 +2176,26 @@ func (ec *executionContext) _OriginInfo_nonce(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_nonce(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageStatus_originTxHash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OriginInfo",
+		Object:     "MessageStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_message(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_message(ctx, field)
+func (ec *executionContext) _MessageStatus_destinationTxHash(ctx context.Context, field graphql.CollectedField, obj *model.MessageStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageStatus_destinationTxHash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1992,7 +2208,7 @@ func (ec *executionContext) _OriginInfo_message(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.DestinationTxHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2006,9 +2222,9 @@ func (ec *executionContext) _OriginInfo_message(ctx context.Context, field graph
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_message(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageStatus_destinationTxHash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OriginInfo",
+		Object:     "MessageStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2019,8 +2235,8 @@ func (ec *executionContext) fieldContext_OriginInfo_message(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_optimisticSeconds(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_optimisticSeconds(ctx, field)
+func (ec *executionContext) _MessageStatus_originInfo(ctx context.Context, field graphql.CollectedField, obj *model.MessageStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageStatus_originInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2033,7 +2249,7 @@ func (ec *executionContext) _OriginInfo_optimisticSeconds(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OptimisticSeconds, nil
+		return ec.resolvers.MessageStatus().OriginInfo(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2042,26 +2258,70 @@ func (ec *executionContext) _OriginInfo_optimisticSeconds(ctx context.Context, f
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.([]*model.OriginInfo)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOOriginInfo2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐOriginInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_optimisticSeconds(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageStatus_originInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OriginInfo",
+		Object:     "MessageStatus",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "messageHash":
+				return ec.fieldContext_OriginInfo_messageHash(ctx, field)
+			case "contractAddress":
+				return ec.fieldContext_OriginInfo_contractAddress(ctx, field)
+			case "blockNumber":
+				return ec.fieldContext_OriginInfo_blockNumber(ctx, field)
+			case "originTxHash":
+				return ec.fieldContext_OriginInfo_originTxHash(ctx, field)
+			case "sender":
+				return ec.fieldContext_OriginInfo_sender(ctx, field)
+			case "recipient":
+				return ec.fieldContext_OriginInfo_recipient(ctx, field)
+			case "originChainID":
+				return ec.fieldContext_OriginInfo_originChainID(ctx, field)
+			case "destinationChainID":
+				return ec.fieldContext_OriginInfo_destinationChainID(ctx, field)
+			case "nonce":
+				return ec.fieldContext_OriginInfo_nonce(ctx, field)
+			case "message":
+				return ec.fieldContext_OriginInfo_message(ctx, field)
+			case "optimisticSeconds":
+				return ec.fieldContext_OriginInfo_optimisticSeconds(ctx, field)
+			case "messageFlag":
+				return ec.fieldContext_OriginInfo_messageFlag(ctx, field)
+			case "summitTip":
+				return ec.fieldContext_OriginInfo_summitTip(ctx, field)
+			case "attestationTip":
+				return ec.fieldContext_OriginInfo_attestationTip(ctx, field)
+			case "executionTip":
+				return ec.fieldContext_OriginInfo_executionTip(ctx, field)
+			case "deliveryTip":
+				return ec.fieldContext_OriginInfo_deliveryTip(ctx, field)
+			case "version":
+				return ec.fieldContext_OriginInfo_version(ctx, field)
+			case "gasLimit":
+				return ec.fieldContext_OriginInfo_gasLimit(ctx, field)
+			case "gasDrop":
+				return ec.fieldContext_OriginInfo_gasDrop(ctx, field)
+			case "messageStatus":
+				return ec.fieldContext_OriginInfo_messageStatus(ctx, field)
+			case "destinationInfo":
+				return ec.fieldContext_OriginInfo_destinationInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OriginInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_messageFlag(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_messageFlag(ctx, field)
+func (ec *executionContext) _MessageStatus_destinationInfo(ctx context.Context, field graphql.CollectedField, obj *model.MessageStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageStatus_destinationInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2074,7 +2334,7 @@ func (ec *executionContext) _OriginInfo_messageFlag(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MessageFlag, nil
+		return ec.resolvers.MessageStatus().DestinationInfo(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2083,26 +2343,48 @@ func (ec *executionContext) _OriginInfo_messageFlag(ctx context.Context, field g
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.([]*model.DestinationInfo)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalODestinationInfo2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐDestinationInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_messageFlag(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageStatus_destinationInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OriginInfo",
+		Object:     "MessageStatus",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "contractAddress":
+				return ec.fieldContext_DestinationInfo_contractAddress(ctx, field)
+			case "blockNumber":
+				return ec.fieldContext_DestinationInfo_blockNumber(ctx, field)
+			case "txHash":
+				return ec.fieldContext_DestinationInfo_txHash(ctx, field)
+			case "txIndex":
+				return ec.fieldContext_DestinationInfo_txIndex(ctx, field)
+			case "messageHash":
+				return ec.fieldContext_DestinationInfo_messageHash(ctx, field)
+			case "chainID":
+				return ec.fieldContext_DestinationInfo_chainID(ctx, field)
+			case "remoteDomain":
+				return ec.fieldContext_DestinationInfo_remoteDomain(ctx, field)
+			case "success":
+				return ec.fieldContext_DestinationInfo_success(ctx, field)
+			case "messageStatus":
+				return ec.fieldContext_DestinationInfo_messageStatus(ctx, field)
+			case "originInfo":
+				return ec.fieldContext_DestinationInfo_originInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DestinationInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_summitTip(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_summitTip(ctx, field)
+func (ec *executionContext) _OriginInfo_messageHash(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_messageHash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2115,7 +2397,7 @@ func (ec *executionContext) _OriginInfo_summitTip(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SummitTip, nil
+		return obj.MessageHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2129,7 +2411,7 @@ func (ec *executionContext) _OriginInfo_summitTip(ctx context.Context, field gra
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_summitTip(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OriginInfo_messageHash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "OriginInfo",
 		Field:      field,
@@ -2142,8 +2424,8 @@ func (ec *executionContext) fieldContext_OriginInfo_summitTip(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_attestationTip(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_attestationTip(ctx, field)
+func (ec *executionContext) _OriginInfo_contractAddress(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_contractAddress(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2156,7 +2438,7 @@ func (ec *executionContext) _OriginInfo_attestationTip(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AttestationTip, nil
+		return obj.ContractAddress, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2170,7 +2452,7 @@ func (ec *executionContext) _OriginInfo_attestationTip(ctx context.Context, fiel
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_attestationTip(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OriginInfo_contractAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "OriginInfo",
 		Field:      field,
@@ -2183,8 +2465,8 @@ func (ec *executionContext) fieldContext_OriginInfo_attestationTip(ctx context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_executionTip(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_executionTip(ctx, field)
+func (ec *executionContext) _OriginInfo_blockNumber(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_blockNumber(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2197,7 +2479,7 @@ func (ec *executionContext) _OriginInfo_executionTip(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExecutionTip, nil
+		return obj.BlockNumber, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2206,26 +2488,26 @@ func (ec *executionContext) _OriginInfo_executionTip(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_executionTip(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OriginInfo_blockNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "OriginInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_deliveryTip(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_deliveryTip(ctx, field)
+func (ec *executionContext) _OriginInfo_originTxHash(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_originTxHash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2238,7 +2520,7 @@ func (ec *executionContext) _OriginInfo_deliveryTip(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeliveryTip, nil
+		return obj.OriginTxHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2252,7 +2534,7 @@ func (ec *executionContext) _OriginInfo_deliveryTip(ctx context.Context, field g
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_deliveryTip(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OriginInfo_originTxHash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "OriginInfo",
 		Field:      field,
@@ -2265,8 +2547,8 @@ func (ec *executionContext) fieldContext_OriginInfo_deliveryTip(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_version(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_version(ctx, field)
+func (ec *executionContext) _OriginInfo_sender(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_sender(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2279,7 +2561,7 @@ func (ec *executionContext) _OriginInfo_version(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Version, nil
+		return obj.Sender, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2288,26 +2570,26 @@ func (ec *executionContext) _OriginInfo_version(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OriginInfo_sender(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "OriginInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_gasLimit(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_gasLimit(ctx, field)
+func (ec *executionContext) _OriginInfo_recipient(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_recipient(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2320,7 +2602,7 @@ func (ec *executionContext) _OriginInfo_gasLimit(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.GasLimit, nil
+		return obj.Recipient, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2329,26 +2611,26 @@ func (ec *executionContext) _OriginInfo_gasLimit(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_gasLimit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OriginInfo_recipient(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "OriginInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_gasDrop(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_gasDrop(ctx, field)
+func (ec *executionContext) _OriginInfo_originChainID(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_originChainID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2361,7 +2643,7 @@ func (ec *executionContext) _OriginInfo_gasDrop(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.GasDrop, nil
+		return obj.OriginChainID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2370,26 +2652,26 @@ func (ec *executionContext) _OriginInfo_gasDrop(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_gasDrop(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OriginInfo_originChainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "OriginInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_messageStatus(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_messageStatus(ctx, field)
+func (ec *executionContext) _OriginInfo_destinationChainID(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_destinationChainID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2402,7 +2684,7 @@ func (ec *executionContext) _OriginInfo_messageStatus(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.OriginInfo().MessageStatus(rctx, obj)
+		return obj.DestinationChainID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2411,40 +2693,26 @@ func (ec *executionContext) _OriginInfo_messageStatus(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.MessageStatus)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOMessageStatus2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageStatus(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_messageStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OriginInfo_destinationChainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "OriginInfo",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "messageHash":
-				return ec.fieldContext_MessageStatus_messageHash(ctx, field)
-			case "lastSeen":
-				return ec.fieldContext_MessageStatus_lastSeen(ctx, field)
-			case "originTxHash":
-				return ec.fieldContext_MessageStatus_originTxHash(ctx, field)
-			case "destinationTxHash":
-				return ec.fieldContext_MessageStatus_destinationTxHash(ctx, field)
-			case "originInfo":
-				return ec.fieldContext_MessageStatus_originInfo(ctx, field)
-			case "destinationInfo":
-				return ec.fieldContext_MessageStatus_destinationInfo(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type MessageStatus", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OriginInfo_destinationInfo(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OriginInfo_destinationInfo(ctx, field)
+func (ec *executionContext) _OriginInfo_nonce(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_nonce(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2457,7 +2725,7 @@ func (ec *executionContext) _OriginInfo_destinationInfo(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.OriginInfo().DestinationInfo(rctx, obj)
+		return obj.Nonce, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2466,48 +2734,26 @@ func (ec *executionContext) _OriginInfo_destinationInfo(ctx context.Context, fie
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.DestinationInfo)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalODestinationInfo2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐDestinationInfo(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OriginInfo_destinationInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OriginInfo_nonce(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "OriginInfo",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "contractAddress":
-				return ec.fieldContext_DestinationInfo_contractAddress(ctx, field)
-			case "blockNumber":
-				return ec.fieldContext_DestinationInfo_blockNumber(ctx, field)
-			case "txHash":
-				return ec.fieldContext_DestinationInfo_txHash(ctx, field)
-			case "txIndex":
-				return ec.fieldContext_DestinationInfo_txIndex(ctx, field)
-			case "messageHash":
-				return ec.fieldContext_DestinationInfo_messageHash(ctx, field)
-			case "chainID":
-				return ec.fieldContext_DestinationInfo_chainID(ctx, field)
-			case "remoteDomain":
-				return ec.fieldContext_DestinationInfo_remoteDomain(ctx, field)
-			case "success":
-				return ec.fieldContext_DestinationInfo_success(ctx, field)
-			case "messageStatus":
-				return ec.fieldContext_DestinationInfo_messageStatus(ctx, field)
-			case "originInfo":
-				return ec.fieldContext_DestinationInfo_originInfo(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DestinationInfo", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getMessageStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getMessageStatus(ctx, field)
+func (ec *executionContext) _OriginInfo_message(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2520,7 +2766,7 @@ func (ec *executionContext) _Query_getMessageStatus(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetMessageStatus(rctx, fc.Args["messageHash"].(*string), fc.Args["originChainID"].(*int), fc.Args["originTxHash"].(*string))
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2529,51 +2775,26 @@ func (ec *executionContext) _Query_getMessageStatus(ctx context.Context, field g
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.MessageStatus)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOMessageStatus2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageStatus(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getMessageStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OriginInfo_message(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "OriginInfo",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "messageHash":
-				return ec.fieldContext_MessageStatus_messageHash(ctx, field)
-			case "lastSeen":
-				return ec.fieldContext_MessageStatus_lastSeen(ctx, field)
-			case "originTxHash":
-				return ec.fieldContext_MessageStatus_originTxHash(ctx, field)
-			case "destinationTxHash":
-				return ec.fieldContext_MessageStatus_destinationTxHash(ctx, field)
-			case "originInfo":
-				return ec.fieldContext_MessageStatus_originInfo(ctx, field)
-			case "destinationInfo":
-				return ec.fieldContext_MessageStatus_destinationInfo(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type MessageStatus", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getMessageStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getMessagesByStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getMessagesByStatus(ctx, field)
+func (ec *executionContext) _OriginInfo_optimisticSeconds(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_optimisticSeconds(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2586,7 +2807,7 @@ func (ec *executionContext) _Query_getMessagesByStatus(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetMessagesByStatus(rctx, fc.Args["messageStatus"].(model.MessageState), fc.Args["page"].(int))
+		return obj.OptimisticSeconds, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2595,51 +2816,26 @@ func (ec *executionContext) _Query_getMessagesByStatus(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.MessageStatus)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOMessageStatus2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageStatus(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getMessagesByStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OriginInfo_optimisticSeconds(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "OriginInfo",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "messageHash":
-				return ec.fieldContext_MessageStatus_messageHash(ctx, field)
-			case "lastSeen":
-				return ec.fieldContext_MessageStatus_lastSeen(ctx, field)
-			case "originTxHash":
-				return ec.fieldContext_MessageStatus_originTxHash(ctx, field)
-			case "destinationTxHash":
-				return ec.fieldContext_MessageStatus_destinationTxHash(ctx, field)
-			case "originInfo":
-				return ec.fieldContext_MessageStatus_originInfo(ctx, field)
-			case "destinationInfo":
-				return ec.fieldContext_MessageStatus_destinationInfo(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type MessageStatus", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getMessagesByStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getOriginInfo(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getOriginInfo(ctx, field)
+func (ec *executionContext) _OriginInfo_messageFlag(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_messageFlag(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2652,7 +2848,7 @@ func (ec *executionContext) _Query_getOriginInfo(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetOriginInfo(rctx, fc.Args["messageHash"].(*string), fc.Args["txHash"].(*string), fc.Args["chainID"].(*int))
+		return obj.MessageFlag, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2661,63 +2857,783 @@ func (ec *executionContext) _Query_getOriginInfo(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.OriginInfo)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOOriginInfo2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐOriginInfo(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getOriginInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OriginInfo_messageFlag(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "OriginInfo",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OriginInfo_summitTip(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_summitTip(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SummitTip, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_OriginInfo_summitTip(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OriginInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OriginInfo_attestationTip(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_attestationTip(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AttestationTip, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_OriginInfo_attestationTip(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OriginInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OriginInfo_executionTip(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_executionTip(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExecutionTip, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_OriginInfo_executionTip(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OriginInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OriginInfo_deliveryTip(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_deliveryTip(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeliveryTip, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_OriginInfo_deliveryTip(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OriginInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OriginInfo_version(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_version(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Version, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_OriginInfo_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OriginInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OriginInfo_gasLimit(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_gasLimit(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GasLimit, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_OriginInfo_gasLimit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OriginInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OriginInfo_gasDrop(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_gasDrop(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GasDrop, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_OriginInfo_gasDrop(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OriginInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OriginInfo_messageStatus(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_messageStatus(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.OriginInfo().MessageStatus(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.MessageStatus)
+	fc.Result = res
+	return ec.marshalOMessageStatus2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_OriginInfo_messageStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OriginInfo",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "messageHash":
+				return ec.fieldContext_MessageStatus_messageHash(ctx, field)
+			case "lastSeen":
+				return ec.fieldContext_MessageStatus_lastSeen(ctx, field)
+			case "originTxHash":
+				return ec.fieldContext_MessageStatus_originTxHash(ctx, field)
+			case "destinationTxHash":
+				return ec.fieldContext_MessageStatus_destinationTxHash(ctx, field)
+			case "originInfo":
+				return ec.fieldContext_MessageStatus_originInfo(ctx, field)
+			case "destinationInfo":
+				return ec.fieldContext_MessageStatus_destinationInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MessageStatus", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OriginInfo_destinationInfo(ctx context.Context, field graphql.CollectedField, obj *model.OriginInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OriginInfo_destinationInfo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.OriginInfo().DestinationInfo(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.DestinationInfo)
+	fc.Result = res
+	return ec.marshalODestinationInfo2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐDestinationInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_OriginInfo_destinationInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OriginInfo",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "contractAddress":
+				return ec.fieldContext_DestinationInfo_contractAddress(ctx, field)
+			case "blockNumber":
+				return ec.fieldContext_DestinationInfo_blockNumber(ctx, field)
+			case "txHash":
+				return ec.fieldContext_DestinationInfo_txHash(ctx, field)
+			case "txIndex":
+				return ec.fieldContext_DestinationInfo_txIndex(ctx, field)
+			case "messageHash":
+				return ec.fieldContext_DestinationInfo_messageHash(ctx, field)
+			case "chainID":
+				return ec.fieldContext_DestinationInfo_chainID(ctx, field)
+			case "remoteDomain":
+				return ec.fieldContext_DestinationInfo_remoteDomain(ctx, field)
+			case "success":
+				return ec.fieldContext_DestinationInfo_success(ctx, field)
+			case "messageStatus":
+				return ec.fieldContext_DestinationInfo_messageStatus(ctx, field)
+			case "originInfo":
+				return ec.fieldContext_DestinationInfo_originInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DestinationInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMessageStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getMessageStatus(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetMessageStatus(rctx, fc.Args["messageHash"].(*string), fc.Args["originChainID"].(*int), fc.Args["originTxHash"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.MessageStatus)
+	fc.Result = res
+	return ec.marshalOMessageStatus2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getMessageStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "messageHash":
+				return ec.fieldContext_MessageStatus_messageHash(ctx, field)
+			case "lastSeen":
+				return ec.fieldContext_MessageStatus_lastSeen(ctx, field)
+			case "originTxHash":
+				return ec.fieldContext_MessageStatus_originTxHash(ctx, field)
+			case "destinationTxHash":
+				return ec.fieldContext_MessageStatus_destinationTxHash(ctx, field)
+			case "originInfo":
+				return ec.fieldContext_MessageStatus_originInfo(ctx, field)
+			case "destinationInfo":
+				return ec.fieldContext_MessageStatus_destinationInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MessageStatus", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMessageStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMessagesByStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getMessagesByStatus(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetMessagesByStatus(rctx, fc.Args["messageStatus"].(model.MessageState), fc.Args["page"].(int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.MessageStatus)
+	fc.Result = res
+	return ec.marshalOMessageStatus2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getMessagesByStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "messageHash":
+				return ec.fieldContext_MessageStatus_messageHash(ctx, field)
+			case "lastSeen":
+				return ec.fieldContext_MessageStatus_lastSeen(ctx, field)
+			case "originTxHash":
+				return ec.fieldContext_MessageStatus_originTxHash(ctx, field)
+			case "destinationTxHash":
+				return ec.fieldContext_MessageStatus_destinationTxHash(ctx, field)
+			case "originInfo":
+				return ec.fieldContext_MessageStatus_originInfo(ctx, field)
+			case "destinationInfo":
+				return ec.fieldContext_MessageStatus_destinationInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MessageStatus", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMessagesByStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getOriginInfo(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getOriginInfo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetOriginInfo(rctx, fc.Args["messageHash"].(*string), fc.Args["txHash"].(*string), fc.Args["chainID"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.OriginInfo)
+	fc.Result = res
+	return ec.marshalOOriginInfo2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐOriginInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getOriginInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "messageHash":
+				return ec.fieldContext_OriginInfo_messageHash(ctx, field)
+			case "contractAddress":
+				return ec.fieldContext_OriginInfo_contractAddress(ctx, field)
+			case "blockNumber":
+				return ec.fieldContext_OriginInfo_blockNumber(ctx, field)
+			case "originTxHash":
+				return ec.fieldContext_OriginInfo_originTxHash(ctx, field)
+			case "sender":
+				return ec.fieldContext_OriginInfo_sender(ctx, field)
+			case "recipient":
+				return ec.fieldContext_OriginInfo_recipient(ctx, field)
+			case "originChainID":
+				return ec.fieldContext_OriginInfo_originChainID(ctx, field)
+			case "destinationChainID":
+				return ec.fieldContext_OriginInfo_destinationChainID(ctx, field)
+			case "nonce":
+				return ec.fieldContext_OriginInfo_nonce(ctx, field)
+			case "message":
+				return ec.fieldContext_OriginInfo_message(ctx, field)
+			case "optimisticSeconds":
+				return ec.fieldContext_OriginInfo_optimisticSeconds(ctx, field)
+			case "messageFlag":
+				return ec.fieldContext_OriginInfo_messageFlag(ctx, field)
+			case "summitTip":
+				return ec.fieldContext_OriginInfo_summitTip(ctx, field)
+			case "attestationTip":
+				return ec.fieldContext_OriginInfo_attestationTip(ctx, field)
+			case "executionTip":
+				return ec.fieldContext_OriginInfo_executionTip(ctx, field)
+			case "deliveryTip":
+				return ec.fieldContext_OriginInfo_deliveryTip(ctx, field)
+			case "version":
+				return ec.fieldContext_OriginInfo_version(ctx, field)
+			case "gasLimit":
+				return ec.fieldContext_OriginInfo_gasLimit(ctx, field)
+			case "gasDrop":
+				return ec.fieldContext_OriginInfo_gasDrop(ctx, field)
+			case "messageStatus":
+				return ec.fieldContext_OriginInfo_messageStatus(ctx, field)
+			case "destinationInfo":
+				return ec.fieldContext_OriginInfo_destinationInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OriginInfo", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getOriginInfo_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getDestinationInfo(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getDestinationInfo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetDestinationInfo(rctx, fc.Args["messageHash"].(*string), fc.Args["txHash"].(*string), fc.Args["chainID"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.DestinationInfo)
+	fc.Result = res
+	return ec.marshalODestinationInfo2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐDestinationInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getDestinationInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "contractAddress":
+				return ec.fieldContext_DestinationInfo_contractAddress(ctx, field)
+			case "blockNumber":
+				return ec.fieldContext_DestinationInfo_blockNumber(ctx, field)
+			case "txHash":
+				return ec.fieldContext_DestinationInfo_txHash(ctx, field)
+			case "txIndex":
+				return ec.fieldContext_DestinationInfo_txIndex(ctx, field)
+			case "messageHash":
+				return ec.fieldContext_DestinationInfo_messageHash(ctx, field)
+			case "chainID":
+				return ec.fieldContext_DestinationInfo_chainID(ctx, field)
+			case "remoteDomain":
+				return ec.fieldContext_DestinationInfo_remoteDomain(ctx, field)
+			case "success":
+				return ec.fieldContext_DestinationInfo_success(ctx, field)
+			case "messageStatus":
+				return ec.fieldContext_DestinationInfo_messageStatus(ctx, field)
+			case "originInfo":
+				return ec.fieldContext_DestinationInfo_originInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DestinationInfo", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDestinationInfo_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMessageLifecycle(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getMessageLifecycle(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetMessageLifecycle(rctx, fc.Args["messageHash"].(*string), fc.Args["originChainID"].(*int), fc.Args["originTxHash"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.MessageLifecycle)
+	fc.Result = res
+	return ec.marshalOMessageLifecycle2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageLifecycle(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getMessageLifecycle(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "messageHash":
-				return ec.fieldContext_OriginInfo_messageHash(ctx, field)
-			case "contractAddress":
-				return ec.fieldContext_OriginInfo_contractAddress(ctx, field)
-			case "blockNumber":
-				return ec.fieldContext_OriginInfo_blockNumber(ctx, field)
-			case "originTxHash":
-				return ec.fieldContext_OriginInfo_originTxHash(ctx, field)
-			case "sender":
-				return ec.fieldContext_OriginInfo_sender(ctx, field)
-			case "recipient":
-				return ec.fieldContext_OriginInfo_recipient(ctx, field)
+				return ec.fieldContext_MessageLifecycle_messageHash(ctx, field)
 			case "originChainID":
-				return ec.fieldContext_OriginInfo_originChainID(ctx, field)
+				return ec.fieldContext_MessageLifecycle_originChainID(ctx, field)
 			case "destinationChainID":
-				return ec.fieldContext_OriginInfo_destinationChainID(ctx, field)
-			case "nonce":
-				return ec.fieldContext_OriginInfo_nonce(ctx, field)
-			case "message":
-				return ec.fieldContext_OriginInfo_message(ctx, field)
+				return ec.fieldContext_MessageLifecycle_destinationChainID(ctx, field)
 			case "optimisticSeconds":
-				return ec.fieldContext_OriginInfo_optimisticSeconds(ctx, field)
-			case "messageFlag":
-				return ec.fieldContext_OriginInfo_messageFlag(ctx, field)
-			case "summitTip":
-				return ec.fieldContext_OriginInfo_summitTip(ctx, field)
-			case "attestationTip":
-				return ec.fieldContext_OriginInfo_attestationTip(ctx, field)
-			case "executionTip":
-				return ec.fieldContext_OriginInfo_executionTip(ctx, field)
-			case "deliveryTip":
-				return ec.fieldContext_OriginInfo_deliveryTip(ctx, field)
-			case "version":
-				return ec.fieldContext_OriginInfo_version(ctx, field)
-			case "gasLimit":
-				return ec.fieldContext_OriginInfo_gasLimit(ctx, field)
-			case "gasDrop":
-				return ec.fieldContext_OriginInfo_gasDrop(ctx, field)
-			case "messageStatus":
-				return ec.fieldContext_OriginInfo_messageStatus(ctx, field)
-			case "destinationInfo":
-				return ec.fieldContext_OriginInfo_destinationInfo(ctx, field)
+				return ec.fieldContext_MessageLifecycle_optimisticSeconds(ctx, field)
+			case "stage":
+				return ec.fieldContext_MessageLifecycle_stage(ctx, field)
+			case "revertReason":
+				return ec.fieldContext_MessageLifecycle_revertReason(ctx, field)
+			case "timeline":
+				return ec.fieldContext_MessageLifecycle_timeline(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type OriginInfo", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type MessageLifecycle", field.Name)
 		},
 	}
 	defer func() {
@@ -2727,15 +3643,15 @@ func (ec *executionContext) fieldContext_Query_getOriginInfo(ctx context.Context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getOriginInfo_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_getMessageLifecycle_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDestinationInfo(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getDestinationInfo(ctx, field)
+func (ec *executionContext) _Query_getStuckMessageCounts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getStuckMessageCounts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2748,7 +3664,7 @@ func (ec *executionContext) _Query_getDestinationInfo(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetDestinationInfo(rctx, fc.Args["messageHash"].(*string), fc.Args["txHash"].(*string), fc.Args["chainID"].(*int))
+		return ec.resolvers.Query().GetStuckMessageCounts(rctx, fc.Args["graceSeconds"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2757,12 +3673,12 @@ func (ec *executionContext) _Query_getDestinationInfo(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.DestinationInfo)
+	res := resTmp.([]*model.StuckMessageCount)
 	fc.Result = res
-	return ec.marshalODestinationInfo2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐDestinationInfo(ctx, field.Selections, res)
+	return ec.marshalOStuckMessageCount2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐStuckMessageCount(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getDestinationInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getStuckMessageCounts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -2770,28 +3686,16 @@ func (ec *executionContext) fieldContext_Query_getDestinationInfo(ctx context.Co
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "contractAddress":
-				return ec.fieldContext_DestinationInfo_contractAddress(ctx, field)
-			case "blockNumber":
-				return ec.fieldContext_DestinationInfo_blockNumber(ctx, field)
-			case "txHash":
-				return ec.fieldContext_DestinationInfo_txHash(ctx, field)
-			case "txIndex":
-				return ec.fieldContext_DestinationInfo_txIndex(ctx, field)
-			case "messageHash":
-				return ec.fieldContext_DestinationInfo_messageHash(ctx, field)
-			case "chainID":
-				return ec.fieldContext_DestinationInfo_chainID(ctx, field)
-			case "remoteDomain":
-				return ec.fieldContext_DestinationInfo_remoteDomain(ctx, field)
-			case "success":
-				return ec.fieldContext_DestinationInfo_success(ctx, field)
-			case "messageStatus":
-				return ec.fieldContext_DestinationInfo_messageStatus(ctx, field)
-			case "originInfo":
-				return ec.fieldContext_DestinationInfo_originInfo(ctx, field)
+			case "originChainID":
+				return ec.fieldContext_StuckMessageCount_originChainID(ctx, field)
+			case "destinationChainID":
+				return ec.fieldContext_StuckMessageCount_destinationChainID(ctx, field)
+			case "count":
+				return ec.fieldContext_StuckMessageCount_count(ctx, field)
+			case "oldestSentTimestamp":
+				return ec.fieldContext_StuckMessageCount_oldestSentTimestamp(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type DestinationInfo", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type StuckMessageCount", field.Name)
 		},
 	}
 	defer func() {
@@ -2801,7 +3705,7 @@ func (ec *executionContext) fieldContext_Query_getDestinationInfo(ctx context.Co
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDestinationInfo_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_getStuckMessageCounts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
@@ -2914,24 +3818,188 @@ func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, fie
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StuckMessageCount_originChainID(ctx context.Context, field graphql.CollectedField, obj *model.StuckMessageCount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StuckMessageCount_originChainID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OriginChainID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StuckMessageCount_originChainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StuckMessageCount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StuckMessageCount_destinationChainID(ctx context.Context, field graphql.CollectedField, obj *model.StuckMessageCount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StuckMessageCount_destinationChainID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DestinationChainID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StuckMessageCount_destinationChainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StuckMessageCount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StuckMessageCount_count(ctx context.Context, field graphql.CollectedField, obj *model.StuckMessageCount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StuckMessageCount_count(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Count, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StuckMessageCount_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StuckMessageCount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StuckMessageCount_oldestSentTimestamp(ctx context.Context, field graphql.CollectedField, obj *model.StuckMessageCount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StuckMessageCount_oldestSentTimestamp(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OldestSentTimestamp, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StuckMessageCount_oldestSentTimestamp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StuckMessageCount",
+		Field:      field,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
@@ -4834,6 +5902,98 @@ func (ec *executionContext) _DestinationInfo(ctx context.Context, sel ast.Select
 	return out
 }
 
+var messageLifecycleImplementors = []string{"MessageLifecycle"}
+
+func (ec *executionContext) _MessageLifecycle(ctx context.Context, sel ast.SelectionSet, obj *model.MessageLifecycle) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, messageLifecycleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MessageLifecycle")
+		case "messageHash":
+			out.Values[i] = ec._MessageLifecycle_messageHash(ctx, field, obj)
+		case "originChainID":
+			out.Values[i] = ec._MessageLifecycle_originChainID(ctx, field, obj)
+		case "destinationChainID":
+			out.Values[i] = ec._MessageLifecycle_destinationChainID(ctx, field, obj)
+		case "optimisticSeconds":
+			out.Values[i] = ec._MessageLifecycle_optimisticSeconds(ctx, field, obj)
+		case "stage":
+			out.Values[i] = ec._MessageLifecycle_stage(ctx, field, obj)
+		case "revertReason":
+			out.Values[i] = ec._MessageLifecycle_revertReason(ctx, field, obj)
+		case "timeline":
+			out.Values[i] = ec._MessageLifecycle_timeline(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var messageStageInfoImplementors = []string{"MessageStageInfo"}
+
+func (ec *executionContext) _MessageStageInfo(ctx context.Context, sel ast.SelectionSet, obj *model.MessageStageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, messageStageInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MessageStageInfo")
+		case "stage":
+			out.Values[i] = ec._MessageStageInfo_stage(ctx, field, obj)
+		case "chainID":
+			out.Values[i] = ec._MessageStageInfo_chainID(ctx, field, obj)
+		case "txHash":
+			out.Values[i] = ec._MessageStageInfo_txHash(ctx, field, obj)
+		case "timestamp":
+			out.Values[i] = ec._MessageStageInfo_timestamp(ctx, field, obj)
+		case "secondsInStage":
+			out.Values[i] = ec._MessageStageInfo_secondsInStage(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var messageStatusImplementors = []string{"MessageStatus"}
 
 func (ec *executionContext) _MessageStatus(ctx context.Context, sel ast.SelectionSet, obj *model.MessageStatus) graphql.Marshaler {
@@ -5174,6 +6334,44 @@ func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getMessageLifecycle":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getMessageLifecycle(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getStuckMessageCounts":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getStuckMessageCounts(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "__type":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
@@ -5206,6 +6404,48 @@ func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 	return out
 }
 
+var stuckMessageCountImplementors = []string{"StuckMessageCount"}
+
+func (ec *executionContext) _StuckMessageCount(ctx context.Context, sel ast.SelectionSet, obj *model.StuckMessageCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, stuckMessageCountImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StuckMessageCount")
+		case "originChainID":
+			out.Values[i] = ec._StuckMessageCount_originChainID(ctx, field, obj)
+		case "destinationChainID":
+			out.Values[i] = ec._StuckMessageCount_destinationChainID(ctx, field, obj)
+		case "count":
+			out.Values[i] = ec._StuckMessageCount_count(ctx, field, obj)
+		case "oldestSentTimestamp":
+			out.Values[i] = ec._StuckMessageCount_oldestSentTimestamp(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var __DirectiveImplementors = []string{"__Directive"}
 
 func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
@@ -5930,6 +7170,77 @@ func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.Sele
 	return res
 }
 
+func (ec *executionContext) marshalOMessageLifecycle2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageLifecycle(ctx context.Context, sel ast.SelectionSet, v *model.MessageLifecycle) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._MessageLifecycle(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOMessageStage2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageStage(ctx context.Context, v interface{}) (*model.MessageStage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.MessageStage)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOMessageStage2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageStage(ctx context.Context, sel ast.SelectionSet, v *model.MessageStage) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOMessageStageInfo2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageStageInfo(ctx context.Context, sel ast.SelectionSet, v []*model.MessageStageInfo) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOMessageStageInfo2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageStageInfo(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
+func (ec *executionContext) marshalOMessageStageInfo2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageStageInfo(ctx context.Context, sel ast.SelectionSet, v *model.MessageStageInfo) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._MessageStageInfo(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOMessageStateLastSeen2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageStateLastSeen(ctx context.Context, v interface{}) (*model.MessageStateLastSeen, error) {
 	if v == nil {
 		return nil, nil
@@ -6096,6 +7407,54 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return res
 }
 
+func (ec *executionContext) marshalOStuckMessageCount2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐStuckMessageCount(ctx context.Context, sel ast.SelectionSet, v []*model.StuckMessageCount) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOStuckMessageCount2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐStuckMessageCount(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
+func (ec *executionContext) marshalOStuckMessageCount2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋsinnerᚋgraphqlᚋserverᚋgraphᚋmodelᚐStuckMessageCount(ctx context.Context, sel ast.SelectionSet, v *model.StuckMessageCount) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._StuckMessageCount(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -111,6 +111,8 @@ func (c ChainIndexer) createEventParser(contract indexerConfig.ContractConfig) (
 		return c.parsers.OriginParser, nil
 	case indexerConfig.ExecutionHubType:
 		return c.parsers.DestinationParser, nil
+	case indexerConfig.InboxType, indexerConfig.LightInboxType:
+		return c.parsers.InboxParser, nil
 	case indexerConfig.UnknownType:
 		return nil, fmt.Errorf("could not create event parser for unknown contract type: %s", contract.ContractType)
 	default:
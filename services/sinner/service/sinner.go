@@ -9,10 +9,13 @@
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/synapsecns/sanguine/core/metrics"
 	indexerConfig "github.com/synapsecns/sanguine/services/sinner/config/indexer"
 	"github.com/synapsecns/sanguine/services/sinner/contracts/destination"
+	"github.com/synapsecns/sanguine/services/sinner/contracts/inbox"
 	"github.com/synapsecns/sanguine/services/sinner/contracts/origin"
 	"github.com/synapsecns/sanguine/services/sinner/db"
 	fetcherpkg "github.com/synapsecns/sanguine/services/sinner/fetcher"
@@ -37,6 +40,8 @@ type Parsers struct {
 	OriginParser sinnerTypes.EventParser
 	// DestinationParser parses logs from the execution hub contract.
 	DestinationParser sinnerTypes.EventParser
+	// InboxParser parses logs from the inbox or light inbox contract.
+	InboxParser sinnerTypes.EventParser
 }
 
 // NewSinner creates a new sinner indexer service.
@@ -55,7 +60,16 @@ func NewSinner(eventDB db.EventDB, config indexerConfig.Config, handler metrics.
 	refreshRate := time.Duration(config.DefaultRefreshRate) * time.Second
 	// Initialize each chain backfiller.
 	for _, chainConfig := range config.Chains {
-		chainIndexer, err := getChainIndexer(eventDB, chainConfig.ChainID, fetcher, chainConfig, refreshRate)
+		var caller bind.ContractCaller
+		if config.RPCURL != "" {
+			client, err := ethclient.Dial(fmt.Sprintf("%s/%d", config.RPCURL, chainConfig.ChainID))
+			if err != nil {
+				return nil, fmt.Errorf("could not create rpc client for chain %d: %w", chainConfig.ChainID, err)
+			}
+			caller = client
+		}
+
+		chainIndexer, err := getChainIndexer(eventDB, chainConfig.ChainID, fetcher, chainConfig, refreshRate, caller)
 		if err != nil {
 			return nil, fmt.Errorf("could not get chain indexer: %w", err)
 		}
@@ -121,7 +135,7 @@ func (e Sinner) Index(ctx context.Context) error {
 }
 
 // nolint gocognit,cyclop
-func getChainIndexer(eventDB db.EventDB, chainID uint32, fetcher fetcherpkg.ScribeFetcher, chainConfig indexerConfig.ChainConfig, refreshRate time.Duration) (*ChainIndexer, error) {
+func getChainIndexer(eventDB db.EventDB, chainID uint32, fetcher fetcherpkg.ScribeFetcher, chainConfig indexerConfig.ChainConfig, refreshRate time.Duration, caller bind.ContractCaller) (*ChainIndexer, error) {
 	parsers := Parsers{
 		ChainID: chainID,
 	}
@@ -134,11 +148,17 @@ func getChainIndexer(eventDB db.EventDB, chainID uint32, fetcher fetcherpkg.Scri
 			}
 			parsers.OriginParser = originParser
 		case "execution_hub":
-			destinationParser, err := destination.NewParser(common.HexToAddress(chainConfig.Contracts[i].Address), eventDB, chainID)
+			destinationParser, err := destination.NewParser(common.HexToAddress(chainConfig.Contracts[i].Address), eventDB, chainID, caller)
 			if err != nil {
 				return nil, fmt.Errorf("could not create execution_hub parser: %w", err)
 			}
 			parsers.DestinationParser = destinationParser
+		case "inbox", "light_inbox":
+			inboxParser, err := inbox.NewParser(common.HexToAddress(chainConfig.Contracts[i].Address), eventDB, chainID)
+			if err != nil {
+				return nil, fmt.Errorf("could not create %s parser: %w", chainConfig.Contracts[i].ContractType, err)
+			}
+			parsers.InboxParser = inboxParser
 		}
 	}
 
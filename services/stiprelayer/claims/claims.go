@@ -0,0 +1,172 @@
+package claims
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/synapsecns/sanguine/core/merkle"
+)
+
+// TreeHeight is the height of the merkle tree of each epoch's claims.
+const TreeHeight uint32 = 20
+
+// Claim is the amount of ARB (in wei) an address can claim in an epoch.
+type Claim struct {
+	// Index is the index of the claim's leaf in the tree.
+	Index uint32
+	// Address is the address that can claim.
+	Address common.Address
+	// Amount is the amount that can be claimed, in wei.
+	Amount *big.Int
+	// Proof is the merkle proof of the claim's leaf.
+	Proof []common.Hash
+}
+
+// Epoch is the merkle tree of the claims in an epoch.
+type Epoch struct {
+	// Epoch is the epoch number.
+	Epoch uint64
+	// Root is the merkle root of the claims.
+	Root common.Hash
+	// Total is the sum of the claims, in wei.
+	Total *big.Int
+	// Claims are the claims, ordered by address.
+	Claims []Claim
+}
+
+// Leaf returns the leaf of a claim: keccak256(abi.encodePacked(uint256 epoch, uint256 index, address account, uint256 amount)).
+func Leaf(epoch uint64, index uint32, account common.Address, amount *big.Int) []byte {
+	return crypto.Keccak256(
+		math.U256Bytes(new(big.Int).SetUint64(epoch)),
+		math.U256Bytes(big.NewInt(int64(index))),
+		account.Bytes(),
+		math.U256Bytes(new(big.Int).Set(amount)),
+	)
+}
+
+// NewEpoch builds the merkle tree of an epoch from the amount each address can claim.
+func NewEpoch(epoch uint64, amounts map[common.Address]*big.Int) (*Epoch, error) {
+	if uint64(len(amounts)) > uint64(1)<<TreeHeight {
+		return nil, fmt.Errorf("too many claims: %d", len(amounts))
+	}
+
+	addresses := make([]common.Address, 0, len(amounts))
+	for address, amount := range amounts {
+		if amount.Sign() <= 0 {
+			return nil, fmt.Errorf("claim for %s must be positive, got %s", address, amount)
+		}
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	leafs := make([][]byte, len(addresses))
+	for i, address := range addresses {
+		leafs[i] = Leaf(epoch, uint32(i), address, amounts[address])
+	}
+	tree := merkle.NewTreeFromItems(leafs, TreeHeight)
+
+	count := uint32(len(leafs))
+	root, err := tree.Root(count)
+	if err != nil {
+		return nil, fmt.Errorf("could not get root: %w", err)
+	}
+
+	result := &Epoch{
+		Epoch:  epoch,
+		Root:   common.BytesToHash(root),
+		Total:  big.NewInt(0),
+		Claims: make([]Claim, len(addresses)),
+	}
+	for i, address := range addresses {
+		proof, err := tree.MerkleProof(uint32(i), count)
+		if err != nil {
+			return nil, fmt.Errorf("could not get proof for %s: %w", address, err)
+		}
+
+		result.Claims[i] = Claim{
+			Index:   uint32(i),
+			Address: address,
+			Amount:  new(big.Int).Set(amounts[address]),
+			Proof:   make([]common.Hash, len(proof)),
+		}
+		for h := range proof {
+			result.Claims[i].Proof[h] = common.BytesToHash(proof[h])
+		}
+		result.Total.Add(result.Total, amounts[address])
+	}
+	return result, nil
+}
+
+// Verify checks the claim's proof against the root of its epoch.
+func Verify(root common.Hash, epoch uint64, claim Claim) bool {
+	proof := make([][]byte, len(claim.Proof))
+	for i := range claim.Proof {
+		proof[i] = claim.Proof[i].Bytes()
+	}
+	return merkle.VerifyMerkleProof(root.Bytes(), Leaf(epoch, claim.Index, claim.Address, claim.Amount), claim.Index, proof, TreeHeight)
+}
+
+// Export is the audit record of an epoch.
+type Export struct {
+	Epoch     uint64        `json:"epoch"`
+	Root      string        `json:"root"`
+	StartTime time.Time     `json:"start_time"`
+	EndTime   time.Time     `json:"end_time"`
+	Total     string        `json:"total"`
+	Nonce     uint64        `json:"nonce"`
+	Claims    []ExportClaim `json:"claims"`
+}
+
+// ExportClaim is the audit record of a claim.
+type ExportClaim struct {
+	Index   uint32   `json:"index"`
+	Address string   `json:"address"`
+	Amount  string   `json:"amount"`
+	Proof   []string `json:"proof"`
+	// Transactions are the hashes of the rebated transactions the claim is made up of.
+	Transactions []string `json:"transactions"`
+}
+
+// ExportFileName returns the name of the export file of an epoch.
+func ExportFileName(epoch uint64) string {
+	return fmt.Sprintf("epoch-%d.json", epoch)
+}
+
+// WriteExport writes the export to its file in the directory, creating the directory if needed.
+func WriteExport(dir string, export Export) error {
+	err := os.MkdirAll(dir, 0750)
+	if err != nil {
+		return fmt.Errorf("could not create export directory: %w", err)
+	}
+
+	encoded, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal export: %w", err)
+	}
+
+	err = os.WriteFile(filepath.Join(dir, ExportFileName(export.Epoch)), encoded, 0600)
+	if err != nil {
+		return fmt.Errorf("could not write export: %w", err)
+	}
+	return nil
+}
+
+// HashesToStrings converts hashes to hex strings.
+func HashesToStrings(hashes []common.Hash) []string {
+	strs := make([]string, len(hashes))
+	for i := range hashes {
+		strs[i] = hashes[i].Hex()
+	}
+	return strs
+}
@@ -0,0 +1,87 @@
+package claims_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Flaque/filet"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/services/stiprelayer/claims"
+)
+
+func (c *ClaimsSuite) TestNewEpoch() {
+	amounts := map[common.Address]*big.Int{
+		common.HexToAddress("0x3"): big.NewInt(300),
+		common.HexToAddress("0x1"): big.NewInt(100),
+		common.HexToAddress("0x2"): big.NewInt(200),
+	}
+
+	epoch, err := claims.NewEpoch(7, amounts)
+	c.Require().NoError(err)
+	c.Equal(uint64(7), epoch.Epoch)
+	c.Equal(big.NewInt(600), epoch.Total)
+	c.Require().Len(epoch.Claims, 3)
+
+	for i, claim := range epoch.Claims {
+		// claims are ordered by address
+		c.Equal(uint32(i), claim.Index)
+		c.Equal(common.BigToAddress(big.NewInt(int64(i+1))), claim.Address)
+		c.Len(claim.Proof, int(claims.TreeHeight))
+		c.True(claims.Verify(epoch.Root, epoch.Epoch, claim))
+
+		// a proof is only valid for its own amount and epoch
+		tampered := claim
+		tampered.Amount = new(big.Int).Add(claim.Amount, big.NewInt(1))
+		c.False(claims.Verify(epoch.Root, epoch.Epoch, tampered))
+		c.False(claims.Verify(epoch.Root, epoch.Epoch+1, claim))
+	}
+
+	// the tree doesn't depend on map ordering
+	again, err := claims.NewEpoch(7, amounts)
+	c.Require().NoError(err)
+	c.Equal(epoch.Root, again.Root)
+
+	_, err = claims.NewEpoch(7, map[common.Address]*big.Int{common.HexToAddress("0x1"): big.NewInt(0)})
+	c.Require().Error(err)
+}
+
+func (c *ClaimsSuite) TestWriteExport() {
+	epoch, err := claims.NewEpoch(1, map[common.Address]*big.Int{common.HexToAddress("0x1"): big.NewInt(100)})
+	c.Require().NoError(err)
+
+	start := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+	export := claims.Export{
+		Epoch:     epoch.Epoch,
+		Root:      epoch.Root.Hex(),
+		StartTime: start,
+		EndTime:   start.Add(24 * time.Hour),
+		Total:     epoch.Total.String(),
+		Nonce:     5,
+		Claims: []claims.ExportClaim{{
+			Index:        epoch.Claims[0].Index,
+			Address:      epoch.Claims[0].Address.Hex(),
+			Amount:       epoch.Claims[0].Amount.String(),
+			Proof:        claims.HashesToStrings(epoch.Claims[0].Proof),
+			Transactions: []string{"0xa", "0xb"},
+		}},
+	}
+
+	dir := filepath.Join(filet.TmpDir(c.T(), ""), "epochs")
+	c.Require().NoError(claims.WriteExport(dir, export))
+
+	//nolint: gosec
+	encoded, err := os.ReadFile(filepath.Join(dir, claims.ExportFileName(1)))
+	c.Require().NoError(err)
+
+	var decoded claims.Export
+	c.Require().NoError(json.Unmarshal(encoded, &decoded))
+	c.Equal(epoch.Root.Hex(), decoded.Root)
+	c.Equal("100", decoded.Total)
+	c.Equal(uint64(5), decoded.Nonce)
+	c.Require().Len(decoded.Claims, 1)
+	c.Equal([]string{"0xa", "0xb"}, decoded.Claims[0].Transactions)
+	c.Equal(claims.HashesToStrings(epoch.Claims[0].Proof), decoded.Claims[0].Proof)
+}
@@ -0,0 +1,2 @@
+// Package claims builds the merkle trees that rebates are claimed from in merkle mode.
+package claims
@@ -0,0 +1,25 @@
+package claims_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/synapsecns/sanguine/core/testsuite"
+)
+
+// ClaimsSuite is the claims test suite.
+type ClaimsSuite struct {
+	*testsuite.TestSuite
+}
+
+// NewClaimsSuite creates a new claims test suite.
+func NewClaimsSuite(tb testing.TB) *ClaimsSuite {
+	tb.Helper()
+	return &ClaimsSuite{
+		TestSuite: testsuite.NewTestSuite(tb),
+	}
+}
+
+func TestClaimsSuite(t *testing.T) {
+	suite.Run(t, NewClaimsSuite(t))
+}
@@ -0,0 +1,132 @@
+package base
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/synapsecns/sanguine/services/stiprelayer/db"
+	"gorm.io/gorm"
+)
+
+// GetLatestEpoch gets the latest merkle epoch.
+func (s *Store) GetLatestEpoch(ctx context.Context) (*db.STIPEpoch, error) {
+	var epoch db.STIPEpoch
+	result := s.db.WithContext(ctx).Order("epoch DESC").First(&epoch)
+	if result.Error != nil {
+		return nil, fmt.Errorf("could not get latest epoch: %w", result.Error)
+	}
+	return &epoch, nil
+}
+
+// GetEpoch gets a merkle epoch.
+func (s *Store) GetEpoch(ctx context.Context, epoch uint64) (*db.STIPEpoch, error) {
+	var stipEpoch db.STIPEpoch
+	result := s.db.WithContext(ctx).Where("epoch = ?", epoch).First(&stipEpoch)
+	if result.Error != nil {
+		return nil, fmt.Errorf("could not get epoch %d: %w", epoch, result.Error)
+	}
+	return &stipEpoch, nil
+}
+
+// GetClaimsByAddress gets the claims of an address in every epoch, oldest first.
+func (s *Store) GetClaimsByAddress(ctx context.Context, address string) ([]db.STIPClaim, error) {
+	var claims []db.STIPClaim
+	result := s.db.WithContext(ctx).Where("address = ?", address).Order("epoch").Find(&claims)
+	if result.Error != nil {
+		return nil, fmt.Errorf("could not get claims: %w", result.Error)
+	}
+	return claims, nil
+}
+
+// GetEpochClaims gets the claims of an epoch, ordered by index.
+func (s *Store) GetEpochClaims(ctx context.Context, epoch uint64) ([]db.STIPClaim, error) {
+	var claims []db.STIPClaim
+	result := s.db.WithContext(ctx).Where("epoch = ?", epoch).Order("claim_index").Find(&claims)
+	if result.Error != nil {
+		return nil, fmt.Errorf("could not get claims of epoch %d: %w", epoch, result.Error)
+	}
+	return claims, nil
+}
+
+// GetUnfinishedEpochs gets the epochs that were not submitted or not exported yet, oldest first.
+func (s *Store) GetUnfinishedEpochs(ctx context.Context) ([]db.STIPEpoch, error) {
+	var epochs []db.STIPEpoch
+	result := s.db.WithContext(ctx).Where("submitted = ? OR exported = ?", false, false).Order("epoch").Find(&epochs)
+	if result.Error != nil {
+		return nil, fmt.Errorf("could not get unfinished epochs: %w", result.Error)
+	}
+	return epochs, nil
+}
+
+// StoreEpoch stores a merkle epoch with its claims and marks the transactions in it as rebated.
+func (s *Store) StoreEpoch(ctx context.Context, epoch db.STIPEpoch, claims []db.STIPClaim, rebated []*db.STIPTransactions) error {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Create(&epoch)
+		if result.Error != nil {
+			return fmt.Errorf("could not store epoch: %w", result.Error)
+		}
+
+		if len(claims) > 0 {
+			result = tx.CreateInBatches(claims, 50)
+			if result.Error != nil {
+				return fmt.Errorf("could not store claims: %w", result.Error)
+			}
+		}
+
+		for _, transaction := range rebated {
+			result = tx.Model(&db.STIPTransactions{}).
+				Where("hash = ?", transaction.Hash).
+				Updates(map[string]interface{}{
+					"rebated":            true,
+					"arb_amount_rebated": transaction.ArbAmountRebated,
+				})
+			if result.Error != nil {
+				return fmt.Errorf("could not mark %s as rebated: %w", transaction.Hash, result.Error)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not store epoch %d: %w", epoch.Epoch, err)
+	}
+	return nil
+}
+
+// UpdateEpochSubmitted marks an epoch as submitted and sets the nonce of the epoch and of its transactions.
+func (s *Store) UpdateEpochSubmitted(ctx context.Context, epoch uint64, nonce uint64, hashes []string) error {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&db.STIPEpoch{}).
+			Where("epoch = ?", epoch).
+			Updates(map[string]interface{}{
+				"submitted": true,
+				"nonce":     nonce,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("could not mark epoch as submitted: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if len(hashes) > 0 {
+			result = tx.Model(&db.STIPTransactions{}).Where("hash IN ?", hashes).Update("nonce", nonce)
+			if result.Error != nil {
+				return fmt.Errorf("could not set transaction nonces: %w", result.Error)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not update epoch %d: %w", epoch, err)
+	}
+	return nil
+}
+
+// UpdateEpochExported marks an epoch as exported.
+func (s *Store) UpdateEpochExported(ctx context.Context, epoch uint64) error {
+	result := s.db.WithContext(ctx).Model(&db.STIPEpoch{}).Where("epoch = ?", epoch).Update("exported", true)
+	if result.Error != nil {
+		return fmt.Errorf("could not mark epoch %d as exported: %w", epoch, result.Error)
+	}
+	return nil
+}
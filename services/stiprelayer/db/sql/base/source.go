@@ -0,0 +1,54 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/synapsecns/sanguine/services/stiprelayer/db"
+	"gorm.io/gorm/clause"
+)
+
+// GetSourceCheckpoint gets the time up to which a rebate source has been read.
+func (s *Store) GetSourceCheckpoint(ctx context.Context, source string) (time.Time, error) {
+	var checkpoint db.STIPSourceCheckpoint
+	result := s.db.WithContext(ctx).Where(&db.STIPSourceCheckpoint{Source: source}).First(&checkpoint)
+	if result.Error != nil {
+		return time.Time{}, fmt.Errorf("could not get checkpoint of %s: %w", source, result.Error)
+	}
+	return checkpoint.Time, nil
+}
+
+// StoreSourceCheckpoint stores the time up to which a rebate source has been read.
+func (s *Store) StoreSourceCheckpoint(ctx context.Context, source string, checkpoint time.Time) error {
+	result := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "source"}},
+		DoUpdates: clause.AssignmentColumns([]string{"time"}),
+	}).Create(&db.STIPSourceCheckpoint{Source: source, Time: checkpoint})
+	if result.Error != nil {
+		return fmt.Errorf("could not store checkpoint of %s: %w", source, result.Error)
+	}
+	return nil
+}
+
+// GetArbPrice gets the stored ARB price of an hour.
+func (s *Store) GetArbPrice(ctx context.Context, hour time.Time) (float64, error) {
+	var price db.STIPArbPrice
+	result := s.db.WithContext(ctx).Where(&db.STIPArbPrice{Hour: hour.UTC()}).First(&price)
+	if result.Error != nil {
+		return 0, fmt.Errorf("could not get arb price at %s: %w", hour, result.Error)
+	}
+	return price.Price, nil
+}
+
+// StoreArbPrice stores the ARB price of an hour.
+func (s *Store) StoreArbPrice(ctx context.Context, hour time.Time, price float64) error {
+	result := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "hour"}},
+		DoUpdates: clause.AssignmentColumns([]string{"price"}),
+	}).Create(&db.STIPArbPrice{Hour: hour.UTC(), Price: price})
+	if result.Error != nil {
+		return fmt.Errorf("could not store arb price at %s: %w", hour, result.Error)
+	}
+	return nil
+}
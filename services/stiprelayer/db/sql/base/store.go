@@ -4,6 +4,7 @@
 	"context"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/synapsecns/sanguine/services/stiprelayer/db"
 	"gorm.io/gorm/clause"
@@ -47,6 +48,16 @@ func (s *Store) GetTotalArbRebated(ctx context.Context, address string) (*big.In
 	return totalRebated, nil
 }
 
+// GetLatestBlockTime gets the block time of the latest stored transaction.
+func (s *Store) GetLatestBlockTime(ctx context.Context) (time.Time, error) {
+	var stipTransaction db.STIPTransactions
+	result := s.db.WithContext(ctx).Order("block_time DESC").First(&stipTransaction)
+	if result.Error != nil {
+		return time.Time{}, fmt.Errorf("could not get latest transaction: %w", result.Error)
+	}
+	return stipTransaction.BlockTime, nil
+}
+
 // UpdateSTIPTransactionRebated updates the rebated status of a transaction.
 func (s *Store) UpdateSTIPTransactionRebated(ctx context.Context, hash string, nonce uint64, arbTransferAmount string) error {
 	result := s.db.WithContext(ctx).Model(&db.STIPTransactions{}).Where("hash = ?", hash).Update("rebated", true).Update("nonce", nonce).Update("arb_amount_rebated", arbTransferAmount)
@@ -28,10 +28,66 @@ type STIPTransactions struct {
 	ArbAmountRebated string    `gorm:"column:arb_amount_rebated"`
 }
 
+// STIPEpoch is a merkle epoch. Root is the zero hash if the epoch had no claims, in which case no root is published.
+// Epochs are stored before their root is submitted and exported, so that neither is lost if the relayer stops.
+type STIPEpoch struct {
+	Epoch       uint64    `gorm:"column:epoch;primaryKey;autoIncrement:false"`
+	Root        string    `gorm:"column:root"`
+	StartTime   time.Time `gorm:"column:start_time"`
+	EndTime     time.Time `gorm:"column:end_time"`
+	TotalAmount string    `gorm:"column:total_amount"`
+	Nonce       uint64    `gorm:"column:nonce"`
+	// Submitted is true once the root was submitted, or if there is no root to submit.
+	Submitted bool `gorm:"column:submitted"`
+	// Exported is true once the epoch was exported, or if there is no export to write.
+	Exported bool `gorm:"column:exported"`
+}
+
+// STIPClaim is the amount of ARB an address can claim in a merkle epoch.
+type STIPClaim struct {
+	Epoch   uint64 `gorm:"column:epoch;primaryKey;autoIncrement:false"`
+	Address string `gorm:"column:address;primaryKey"`
+	Index   uint32 `gorm:"column:claim_index"`
+	Amount  string `gorm:"column:amount"`
+	// Proof is the json encoded merkle proof.
+	Proof string `gorm:"column:proof"`
+	// Transactions are the json encoded hashes of the transactions the claim is made up of.
+	Transactions string `gorm:"column:transactions"`
+}
+
+// STIPSourceCheckpoint is the time up to which a rebate source has been read, including transactions that are not
+// eligible, so that the source is not read again from the latest eligible transaction.
+type STIPSourceCheckpoint struct {
+	Source string    `gorm:"column:source;primaryKey"`
+	Time   time.Time `gorm:"column:time"`
+}
+
+// STIPArbPrice is the USD price of ARB at the start of an hour.
+type STIPArbPrice struct {
+	Hour  time.Time `gorm:"column:hour;primaryKey"`
+	Price float64   `gorm:"column:price"`
+}
+
 // STIPDBReader is the interface for reading from the database.
 type STIPDBReader interface {
 	GetSTIPTransactionsNotRebated(ctx context.Context) ([]*STIPTransactions, error)
 	GetTotalArbRebated(ctx context.Context, address string) (*big.Int, error)
+	// GetLatestBlockTime gets the block time of the latest stored transaction. gorm.ErrRecordNotFound is returned if there are none.
+	GetLatestBlockTime(ctx context.Context) (time.Time, error)
+	// GetSourceCheckpoint gets the time up to which a rebate source has been read. gorm.ErrRecordNotFound is returned if it was never read.
+	GetSourceCheckpoint(ctx context.Context, source string) (time.Time, error)
+	// GetArbPrice gets the stored ARB price of an hour. gorm.ErrRecordNotFound is returned if it isn't stored.
+	GetArbPrice(ctx context.Context, hour time.Time) (float64, error)
+	// GetLatestEpoch gets the latest merkle epoch. gorm.ErrRecordNotFound is returned if there are none.
+	GetLatestEpoch(ctx context.Context) (*STIPEpoch, error)
+	// GetEpoch gets a merkle epoch. gorm.ErrRecordNotFound is returned if it doesn't exist.
+	GetEpoch(ctx context.Context, epoch uint64) (*STIPEpoch, error)
+	// GetClaimsByAddress gets the claims of an address in every epoch, oldest first.
+	GetClaimsByAddress(ctx context.Context, address string) ([]STIPClaim, error)
+	// GetEpochClaims gets the claims of an epoch, ordered by index.
+	GetEpochClaims(ctx context.Context, epoch uint64) ([]STIPClaim, error)
+	// GetUnfinishedEpochs gets the epochs that were not submitted or not exported yet, oldest first.
+	GetUnfinishedEpochs(ctx context.Context) ([]STIPEpoch, error)
 }
 
 // STIPDBWriter is the interface for writing to the database.
@@ -39,6 +95,17 @@ type STIPDBWriter interface {
 	UpdateSTIPTransactionRebated(ctx context.Context, hash string, nonce uint64, arbAmountRebated string) error
 	InsertNewStipTransactions(ctx context.Context, stipTransactions []STIPTransactions) error
 	UpdateSTIPTransactionDoNotProcess(ctx context.Context, hash string) error
+	// StoreSourceCheckpoint stores the time up to which a rebate source has been read.
+	StoreSourceCheckpoint(ctx context.Context, source string, checkpoint time.Time) error
+	// StoreArbPrice stores the ARB price of an hour.
+	StoreArbPrice(ctx context.Context, hour time.Time, price float64) error
+	// StoreEpoch stores a merkle epoch with its claims and marks the transactions in it as rebated
+	// with their ArbAmountRebated.
+	StoreEpoch(ctx context.Context, epoch STIPEpoch, claims []STIPClaim, rebated []*STIPTransactions) error
+	// UpdateEpochSubmitted marks an epoch as submitted and sets the nonce of the epoch and of its transactions.
+	UpdateEpochSubmitted(ctx context.Context, epoch uint64, nonce uint64, hashes []string) error
+	// UpdateEpochExported marks an epoch as exported.
+	UpdateEpochExported(ctx context.Context, epoch uint64) error
 }
 
 // STIPDB is the interface for the database service.
@@ -4,6 +4,7 @@
 	"time"
 
 	"github.com/synapsecns/sanguine/services/stiprelayer/db"
+	"gorm.io/gorm"
 )
 
 func (d *DBSuite) TestGetSTIPTransactionsNotRebated() {
@@ -40,6 +41,145 @@ func (d *DBSuite) TestGetSTIPTransactionsNotRebated() {
 	})
 }
 
+func (d *DBSuite) TestGetLatestBlockTime() {
+	d.RunOnAllDBs(func(testDB db.STIPDB) {
+		_, err := testDB.GetLatestBlockTime(d.GetTestContext())
+		d.Require().ErrorIs(err, gorm.ErrRecordNotFound)
+
+		latest := time.Date(2024, time.February, 1, 12, 0, 0, 0, time.UTC)
+		err = testDB.InsertNewStipTransactions(d.GetTestContext(), []db.STIPTransactions{
+			{Hash: "0xa", BlockTime: latest.Add(-time.Hour)},
+			{Hash: "0xb", BlockTime: latest},
+			{Hash: "0xc", BlockTime: latest.Add(-2 * time.Hour)},
+		})
+		d.Require().NoError(err)
+
+		blockTime, err := testDB.GetLatestBlockTime(d.GetTestContext())
+		d.Require().NoError(err)
+		d.True(latest.Equal(blockTime))
+	})
+}
+
+func (d *DBSuite) TestSourceCheckpoint() {
+	d.RunOnAllDBs(func(testDB db.STIPDB) {
+		_, err := testDB.GetSourceCheckpoint(d.GetTestContext(), "explorer")
+		d.Require().ErrorIs(err, gorm.ErrRecordNotFound)
+
+		checkpoint := time.Date(2024, time.February, 1, 12, 0, 0, 0, time.UTC)
+		d.Require().NoError(testDB.StoreSourceCheckpoint(d.GetTestContext(), "explorer", checkpoint))
+		d.Require().NoError(testDB.StoreSourceCheckpoint(d.GetTestContext(), "explorer", checkpoint.Add(time.Hour)))
+
+		stored, err := testDB.GetSourceCheckpoint(d.GetTestContext(), "explorer")
+		d.Require().NoError(err)
+		d.True(checkpoint.Add(time.Hour).Equal(stored))
+
+		_, err = testDB.GetSourceCheckpoint(d.GetTestContext(), "dune")
+		d.Require().ErrorIs(err, gorm.ErrRecordNotFound)
+	})
+}
+
+func (d *DBSuite) TestArbPrice() {
+	d.RunOnAllDBs(func(testDB db.STIPDB) {
+		hour := time.Date(2024, time.February, 1, 12, 0, 0, 0, time.UTC)
+		_, err := testDB.GetArbPrice(d.GetTestContext(), hour)
+		d.Require().ErrorIs(err, gorm.ErrRecordNotFound)
+
+		d.Require().NoError(testDB.StoreArbPrice(d.GetTestContext(), hour, 1.5))
+		d.Require().NoError(testDB.StoreArbPrice(d.GetTestContext(), hour.Add(time.Hour), 2))
+
+		price, err := testDB.GetArbPrice(d.GetTestContext(), hour)
+		d.Require().NoError(err)
+		d.Equal(1.5, price)
+	})
+}
+
 func (d *DBSuite) TestUpdateSTIPTransactionRebated() {}
 
 func (d *DBSuite) TestInsertNewStipTransactions() {}
+
+func (d *DBSuite) TestStoreEpoch() {
+	d.RunOnAllDBs(func(testDB db.STIPDB) {
+		_, err := testDB.GetLatestEpoch(d.GetTestContext())
+		d.Require().ErrorIs(err, gorm.ErrRecordNotFound)
+
+		transactions := []db.STIPTransactions{
+			{Hash: "0xa", Address: "0x1", BlockTime: time.Now()},
+			{Hash: "0xb", Address: "0x1", BlockTime: time.Now()},
+			{Hash: "0xc", Address: "0x2", BlockTime: time.Now()},
+		}
+		err = testDB.InsertNewStipTransactions(d.GetTestContext(), transactions)
+		d.Require().NoError(err)
+
+		for i := uint64(0); i < 2; i++ {
+			err = testDB.StoreEpoch(d.GetTestContext(), db.STIPEpoch{
+				Epoch:       i,
+				Root:        "0xroot",
+				TotalAmount: "30",
+			}, []db.STIPClaim{
+				{Epoch: i, Address: "0x1", Index: 0, Amount: "30", Proof: `["0xproof"]`, Transactions: `["0xa","0xb"]`},
+			}, []*db.STIPTransactions{
+				{Hash: "0xa", ArbAmountRebated: "10"},
+				{Hash: "0xb", ArbAmountRebated: "20"},
+			})
+			d.Require().NoError(err)
+		}
+
+		// epochs can't be stored twice
+		err = testDB.StoreEpoch(d.GetTestContext(), db.STIPEpoch{Epoch: 1}, nil, nil)
+		d.Require().Error(err)
+
+		// stored epochs are unfinished until they are submitted and exported
+		unfinished, err := testDB.GetUnfinishedEpochs(d.GetTestContext())
+		d.Require().NoError(err)
+		d.Require().Len(unfinished, 2)
+		d.Equal(uint64(0), unfinished[0].Epoch)
+
+		err = testDB.UpdateEpochSubmitted(d.GetTestContext(), 0, 1, []string{"0xa", "0xb"})
+		d.Require().NoError(err)
+		err = testDB.UpdateEpochExported(d.GetTestContext(), 0)
+		d.Require().NoError(err)
+		err = testDB.UpdateEpochSubmitted(d.GetTestContext(), 1, 2, nil)
+		d.Require().NoError(err)
+		err = testDB.UpdateEpochSubmitted(d.GetTestContext(), 2, 3, nil)
+		d.Require().ErrorIs(err, gorm.ErrRecordNotFound)
+
+		unfinished, err = testDB.GetUnfinishedEpochs(d.GetTestContext())
+		d.Require().NoError(err)
+		d.Require().Len(unfinished, 1)
+		d.Equal(uint64(1), unfinished[0].Epoch)
+		d.True(unfinished[0].Submitted)
+		d.False(unfinished[0].Exported)
+
+		latest, err := testDB.GetLatestEpoch(d.GetTestContext())
+		d.Require().NoError(err)
+		d.Equal(uint64(1), latest.Epoch)
+		d.Equal(uint64(2), latest.Nonce)
+
+		epochClaims, err := testDB.GetEpochClaims(d.GetTestContext(), 1)
+		d.Require().NoError(err)
+		d.Require().Len(epochClaims, 1)
+		d.Equal(`["0xa","0xb"]`, epochClaims[0].Transactions)
+
+		epoch, err := testDB.GetEpoch(d.GetTestContext(), 0)
+		d.Require().NoError(err)
+		d.Equal("0xroot", epoch.Root)
+
+		_, err = testDB.GetEpoch(d.GetTestContext(), 2)
+		d.Require().ErrorIs(err, gorm.ErrRecordNotFound)
+
+		claims, err := testDB.GetClaimsByAddress(d.GetTestContext(), "0x1")
+		d.Require().NoError(err)
+		d.Require().Len(claims, 2)
+		d.Equal(uint64(0), claims[0].Epoch)
+		d.Equal(`["0xproof"]`, claims[1].Proof)
+
+		notRebated, err := testDB.GetSTIPTransactionsNotRebated(d.GetTestContext())
+		d.Require().NoError(err)
+		d.Require().Len(notRebated, 1)
+		d.Equal("0xc", notRebated[0].Hash)
+
+		total, err := testDB.GetTotalArbRebated(d.GetTestContext(), "0x1")
+		d.Require().NoError(err)
+		d.Equal(int64(30), total.Int64())
+	})
+}
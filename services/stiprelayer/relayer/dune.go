@@ -5,32 +5,202 @@
 	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/synapsecns/sanguine/core/metrics"
-	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/trace"
 	"io"
 	"net/http"
 	"os"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/stiprelayer/db"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // DuneAPIKey is the API key for Dune, fetched from the environment variables.
 var DuneAPIKey = os.Getenv("DUNE_API_KEY")
 
+// duneQueryIDs are the Dune queries for each query type.
+var duneQueryIDs = map[string]string{
+	"bridge": "3345214",
+	"rfq":    "3348161",
+}
+
+// DuneSource is a RebateSource that reads eligible transactions from Dune queries.
+type DuneSource struct {
+	handler metrics.Handler
+}
+
+// NewDuneSource creates a new DuneSource.
+func NewDuneSource(handler metrics.Handler) *DuneSource {
+	return &DuneSource{
+		handler: handler,
+	}
+}
+
+// QueryResult represents the result of a Dune query.
+type QueryResult struct {
+	ExecutionID        string    `json:"execution_id"`
+	QueryID            int       `json:"query_id"`
+	State              string    `json:"state"`
+	SubmittedAt        time.Time `json:"submitted_at"`
+	ExpiresAt          time.Time `json:"expires_at"`
+	ExecutionStartedAt time.Time `json:"execution_started_at"`
+	ExecutionEndedAt   time.Time `json:"execution_ended_at"`
+	Result             Result    `json:"result"`
+}
+
+// Result represents the data structure for the result of a query execution.
+type Result struct {
+	Rows     []Row    `json:"rows"`
+	Metadata Metadata `json:"metadata"`
+}
+
+// Row represents a single row of the result of a query execution.
+type Row struct {
+	Address    string     `json:"address"`
+	Amount     float64    `json:"amount"`
+	AmountUsd  float64    `json:"amount_usd"`
+	ArbPrice   float64    `json:"arb_price"`
+	BlockTime  CustomTime `json:"block_time"`
+	Direction  string     `json:"direction"`
+	Hash       string     `json:"hash"`
+	Module     string     `json:"module"`
+	Token      string     `json:"token"`
+	TokenPrice float64    `json:"token_price"`
+}
+
+// Metadata represents the metadata of a query execution result.
+type Metadata struct {
+	ColumnNames         []string `json:"column_names"`
+	ResultSetBytes      int      `json:"result_set_bytes"`
+	TotalRowCount       int      `json:"total_row_count"`
+	DatapointCount      int      `json:"datapoint_count"`
+	PendingTimeMillis   int      `json:"pending_time_millis"`
+	ExecutionTimeMillis int      `json:"execution_time_millis"`
+}
+
+// CustomTime is a custom time type for handling specific time format in JSON unmarshalling.
+type CustomTime struct {
+	time.Time
+}
+
+const ctLayout = "2006-01-02 15:04:05.000 MST"
+
+// UnmarshalJSON overrides the default JSON unmarshaling for CustomTime to handle specific time format.
+func (ct *CustomTime) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), "\"")
+	if s == "null" {
+		return nil
+	}
+	t, err := time.Parse(ctLayout, s)
+	if err != nil {
+		return fmt.Errorf("failed to parse time: %w", err)
+	}
+	ct.Time = t
+	return nil
+}
+
+// GetTransactions executes the bridge and rfq queries and returns the rows after since.
+func (d *DuneSource) GetTransactions(ctx context.Context, since time.Time) ([]db.STIPTransactions, time.Time, error) {
+	var transactions []db.STIPTransactions
+	for _, queryType := range []string{"bridge", "rfq"} {
+		queryTransactions, err := d.ProcessExecutionResults(ctx, queryType, since)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("error processing execution results for %s: %w", queryType, err)
+		}
+		transactions = append(transactions, queryTransactions...)
+	}
+
+	// every row after since is returned, so the latest one is the latest transaction read.
+	readUntil := since
+	for _, transaction := range transactions {
+		if transaction.BlockTime.After(readUntil) {
+			readUntil = transaction.BlockTime
+		}
+	}
+	return transactions, readUntil, nil
+}
+
+// ProcessExecutionResults executes a query, waits for its results and returns the rows after since.
+func (d *DuneSource) ProcessExecutionResults(parentCtx context.Context, queryType string, since time.Time) (_ []db.STIPTransactions, err error) {
+	ctx, span := d.handler.Tracer().Start(parentCtx, "ProcessExecutionResults", trace.WithAttributes(attribute.String("queryType", queryType)))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	executionID, err := d.ExecuteDuneQuery(ctx, queryType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute Dune query: %w", err)
+	}
+
+	var getResultsJSONResult QueryResult
+	operation := func() error {
+		jsonResult, err := d.GetExecutionResults(ctx, executionID)
+		if err != nil {
+			return fmt.Errorf("failed to get execution results: %w", err)
+		}
+
+		if jsonResult.State != "QUERY_STATE_COMPLETED" {
+			// query state is not completed, so return an error to retry
+			return fmt.Errorf("query state is not completed")
+		}
+		getResultsJSONResult = *jsonResult
+		return nil
+	}
+
+	// Create a new exponential backoff policy
+	expBackOff := backoff.NewExponentialBackOff()
+	expBackOff.InitialInterval = 30 * time.Second
+	expBackOff.MaxElapsedTime = 300 * time.Second
+
+	// Retry the operation with the backoff policy
+	err = backoff.Retry(operation, expBackOff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get execution results after retries: %w", err)
+	}
+
+	var transactions []db.STIPTransactions
+	for _, row := range getResultsJSONResult.Result.Rows {
+		if row.BlockTime.After(since) {
+			transactions = append(transactions, row.toSTIPTransaction(getResultsJSONResult.ExecutionID))
+		}
+	}
+	return transactions, nil
+}
+
+// toSTIPTransaction converts a row to a transaction that has not been rebated.
+func (r Row) toSTIPTransaction(executionID string) db.STIPTransactions {
+	return db.STIPTransactions{
+		Address:     r.Address,
+		Amount:      r.Amount,
+		AmountUSD:   r.AmountUsd,
+		ArbPrice:    r.ArbPrice,
+		BlockTime:   r.BlockTime.Time,
+		Direction:   r.Direction,
+		ExecutionID: executionID,
+		Hash:        r.Hash,
+		Module:      r.Module,
+		Token:       r.Token,
+		TokenPrice:  r.TokenPrice,
+		Rebated:     false,
+	}
+}
+
 // ExecuteDuneQuery executes a predefined query on the Dune API and returns the http response.
-func (s *STIPRelayer) ExecuteDuneQuery(parentCtx context.Context, queryType string) (executionID string, err error) {
-	ctx, span := s.handler.Tracer().Start(parentCtx, "ExecuteDuneQuery", trace.WithAttributes(attribute.String("queryType", queryType)))
+func (d *DuneSource) ExecuteDuneQuery(parentCtx context.Context, queryType string) (executionID string, err error) {
+	ctx, span := d.handler.Tracer().Start(parentCtx, "ExecuteDuneQuery", trace.WithAttributes(attribute.String("queryType", queryType)))
 	defer func() {
 		metrics.EndSpanWithErr(span, err)
 	}()
 
 	client := &http.Client{}
-	var queryID string
-	if queryType == "bridge" {
-		queryID = "3345214"
-	} else if queryType == "rfq" {
-		queryID = "3348161"
+	queryID, ok := duneQueryIDs[queryType]
+	if !ok {
+		return "", fmt.Errorf("unknown query type %s", queryType)
 	}
-	s.handler.ConfigureHTTPClient(client)
+	d.handler.ConfigureHTTPClient(client)
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("https://api.dune.com/api/v1/query/%s/execute", queryID), bytes.NewBufferString(`{"performance": "large"}`))
 	if err != nil {
 		return "", fmt.Errorf("failed to create request: %w", err)
@@ -63,7 +233,6 @@ func (s *STIPRelayer) ExecuteDuneQuery(parentCtx context.Context, queryType stri
 		return "", fmt.Errorf("failed to unmarshal response body: %w", err)
 	}
 
-	var ok bool
 	executionID, ok = result["execution_id"]
 	if !ok {
 		return "", fmt.Errorf("no execution_id found in response")
@@ -73,14 +242,14 @@ func (s *STIPRelayer) ExecuteDuneQuery(parentCtx context.Context, queryType stri
 }
 
 // GetExecutionResults fetches the results of a Dune query execution using the provided execution ID.
-func (s *STIPRelayer) GetExecutionResults(parentCtx context.Context, executionID string) (_ *QueryResult, err error) {
-	ctx, span := s.handler.Tracer().Start(parentCtx, "ExecuteDuneQuery", trace.WithAttributes(attribute.String("executionID", executionID)))
+func (d *DuneSource) GetExecutionResults(parentCtx context.Context, executionID string) (_ *QueryResult, err error) {
+	ctx, span := d.handler.Tracer().Start(parentCtx, "ExecuteDuneQuery", trace.WithAttributes(attribute.String("executionID", executionID)))
 	defer func() {
 		metrics.EndSpanWithErr(span, err)
 	}()
 
 	client := &http.Client{}
-	s.handler.ConfigureHTTPClient(client)
+	d.handler.ConfigureHTTPClient(client)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://api.dune.com/api/v1/execution/%s/results", executionID), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -0,0 +1,314 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/stiprelayer/claims"
+	"github.com/synapsecns/sanguine/services/stiprelayer/db"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// merkleDistributorABI is the abi of the merkle distributor method each epoch's root is published with.
+const merkleDistributorABI = `[{"inputs":[{"internalType":"uint256","name":"epoch","type":"uint256"},{"internalType":"bytes32","name":"root","type":"bytes32"}],"name":"setMerkleRoot","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+// epochRebates are the rebates aggregated for an epoch.
+type epochRebates struct {
+	// amounts is the total rebate of each address.
+	amounts map[common.Address]*big.Int
+	// transactions are the hashes of the transactions each address's rebate is made up of.
+	transactions map[common.Address][]string
+	// rebated are the transactions in the epoch, with the amount rebated for each.
+	rebated []*db.STIPTransactions
+}
+
+// PublishEpochs finishes the stored epochs whose root was not submitted or exported yet, then publishes every
+// merkle epoch that has ended since the last stored epoch.
+func (s *STIPRelayer) PublishEpochs(ctx context.Context) error {
+	unfinished, err := s.db.GetUnfinishedEpochs(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get unfinished epochs: %w", err)
+	}
+	for i := range unfinished {
+		err = s.finishEpoch(ctx, &unfinished[i])
+		if err != nil {
+			return fmt.Errorf("could not finish epoch %d: %w", unfinished[i].Epoch, err)
+		}
+	}
+
+	for {
+		epoch, err := s.nextEpoch(ctx)
+		if err != nil {
+			return err
+		}
+
+		startTime, endTime := s.epochTimes(epoch)
+		if time.Now().Before(endTime) {
+			return nil
+		}
+
+		err = s.PublishEpoch(ctx, epoch)
+		if err != nil {
+			return fmt.Errorf("could not publish epoch %d: %w", epoch, err)
+		}
+		fmt.Printf("Published epoch %d (%s - %s)\n", epoch, startTime, endTime)
+	}
+}
+
+// nextEpoch returns the epoch after the latest stored epoch.
+func (s *STIPRelayer) nextEpoch(ctx context.Context) (uint64, error) {
+	latest, err := s.db.GetLatestEpoch(ctx)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("could not get latest epoch: %w", err)
+	}
+	return latest.Epoch + 1, nil
+}
+
+// epochTimes returns the start and end of an epoch.
+func (s *STIPRelayer) epochTimes(epoch uint64) (startTime, endTime time.Time) {
+	startTime = s.cfg.StartDate.Add(time.Duration(epoch) * s.cfg.EpochDuration)
+	return startTime, startTime.Add(s.cfg.EpochDuration)
+}
+
+// PublishEpoch aggregates the rebates of the transactions before the end of the epoch and stores the epoch, then
+// publishes the merkle root of the claims to the distributor and exports the epoch. Transactions from earlier epochs
+// that were never rebated are included.
+func (s *STIPRelayer) PublishEpoch(parentCtx context.Context, epoch uint64) (err error) {
+	ctx, span := s.handler.Tracer().Start(parentCtx, "PublishEpoch", trace.WithAttributes(attribute.Int64("epoch", int64(epoch))))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	startTime, endTime := s.epochTimes(epoch)
+	rebates, err := s.aggregateRebates(ctx, endTime)
+	if err != nil {
+		return fmt.Errorf("could not aggregate rebates: %w", err)
+	}
+
+	tree, err := claims.NewEpoch(epoch, rebates.amounts)
+	if err != nil {
+		return fmt.Errorf("could not build merkle tree: %w", err)
+	}
+	span.SetAttributes(attribute.Int("claims", len(tree.Claims)), attribute.String("root", tree.Root.Hex()))
+
+	stipClaims := make([]db.STIPClaim, len(tree.Claims))
+	for i, claim := range tree.Claims {
+		proof, err := json.Marshal(claims.HashesToStrings(claim.Proof))
+		if err != nil {
+			return fmt.Errorf("could not encode proof: %w", err)
+		}
+		transactions, err := json.Marshal(rebates.transactions[claim.Address])
+		if err != nil {
+			return fmt.Errorf("could not encode transactions: %w", err)
+		}
+		stipClaims[i] = db.STIPClaim{
+			Epoch:        epoch,
+			Address:      claim.Address.Hex(),
+			Index:        claim.Index,
+			Amount:       claim.Amount.String(),
+			Proof:        string(proof),
+			Transactions: string(transactions),
+		}
+	}
+
+	// the epoch is stored before anything is published, so a failed submission or export is retried from the db.
+	// an epoch without claims has no root to publish.
+	stipEpoch := db.STIPEpoch{
+		Epoch:       epoch,
+		Root:        tree.Root.Hex(),
+		StartTime:   startTime,
+		EndTime:     endTime,
+		TotalAmount: tree.Total.String(),
+		Submitted:   len(tree.Claims) == 0,
+		Exported:    s.cfg.EpochExportDir == "",
+	}
+	err = s.db.StoreEpoch(ctx, stipEpoch, stipClaims, rebates.rebated)
+	if err != nil {
+		return fmt.Errorf("could not store epoch: %w", err)
+	}
+
+	return s.finishEpoch(ctx, &stipEpoch)
+}
+
+// finishEpoch submits the root of a stored epoch and exports it, unless that was already done.
+func (s *STIPRelayer) finishEpoch(ctx context.Context, epoch *db.STIPEpoch) error {
+	if epoch.Submitted && epoch.Exported {
+		return nil
+	}
+
+	stipClaims, err := s.db.GetEpochClaims(ctx, epoch.Epoch)
+	if err != nil {
+		return fmt.Errorf("could not get claims: %w", err)
+	}
+
+	if !epoch.Submitted {
+		var hashes []string
+		for _, claim := range stipClaims {
+			var transactions []string
+			err = json.Unmarshal([]byte(claim.Transactions), &transactions)
+			if err != nil {
+				return fmt.Errorf("could not decode transactions of %s: %w", claim.Address, err)
+			}
+			hashes = append(hashes, transactions...)
+		}
+
+		nonce, err := s.submitRoot(ctx, epoch.Epoch, common.HexToHash(epoch.Root))
+		if err != nil {
+			return fmt.Errorf("could not submit root: %w", err)
+		}
+		err = s.db.UpdateEpochSubmitted(ctx, epoch.Epoch, nonce, hashes)
+		if err != nil {
+			return fmt.Errorf("could not mark epoch as submitted: %w", err)
+		}
+		epoch.Nonce = nonce
+		epoch.Submitted = true
+	}
+
+	if !epoch.Exported && s.cfg.EpochExportDir != "" {
+		export, err := epochExport(*epoch, stipClaims)
+		if err != nil {
+			return fmt.Errorf("could not create export: %w", err)
+		}
+		err = claims.WriteExport(s.cfg.EpochExportDir, export)
+		if err != nil {
+			return fmt.Errorf("could not export epoch: %w", err)
+		}
+		err = s.db.UpdateEpochExported(ctx, epoch.Epoch)
+		if err != nil {
+			return fmt.Errorf("could not mark epoch as exported: %w", err)
+		}
+		epoch.Exported = true
+	}
+	return nil
+}
+
+// epochExport creates the audit record of a stored epoch.
+func epochExport(epoch db.STIPEpoch, stipClaims []db.STIPClaim) (claims.Export, error) {
+	export := claims.Export{
+		Epoch:     epoch.Epoch,
+		Root:      epoch.Root,
+		StartTime: epoch.StartTime.UTC(),
+		EndTime:   epoch.EndTime.UTC(),
+		Total:     epoch.TotalAmount,
+		Nonce:     epoch.Nonce,
+		Claims:    make([]claims.ExportClaim, len(stipClaims)),
+	}
+	for i, claim := range stipClaims {
+		export.Claims[i] = claims.ExportClaim{
+			Index:   claim.Index,
+			Address: claim.Address,
+			Amount:  claim.Amount,
+		}
+		err := json.Unmarshal([]byte(claim.Proof), &export.Claims[i].Proof)
+		if err != nil {
+			return claims.Export{}, fmt.Errorf("could not decode proof of %s: %w", claim.Address, err)
+		}
+		err = json.Unmarshal([]byte(claim.Transactions), &export.Claims[i].Transactions)
+		if err != nil {
+			return claims.Export{}, fmt.Errorf("could not decode transactions of %s: %w", claim.Address, err)
+		}
+	}
+	return export, nil
+}
+
+// aggregateRebates sums the rebates of every transaction before the end time that hasn't been rebated, applying
+// ARBMaxTransfer to each transaction and ArbCapPerAddress to each address. Transactions that can't be rebated are
+// marked as do not process, the same as in transfer mode.
+func (s *STIPRelayer) aggregateRebates(ctx context.Context, endTime time.Time) (*epochRebates, error) {
+	transactions, err := s.db.GetSTIPTransactionsNotRebated(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting STIP transactions not rebated: %w", err)
+	}
+
+	rebates := &epochRebates{
+		amounts:      make(map[common.Address]*big.Int),
+		transactions: make(map[common.Address][]string),
+	}
+	// totals is the amount rebated to each address, including earlier epochs and transfers.
+	totals := make(map[common.Address]*big.Int)
+	for _, transaction := range transactions {
+		if !transaction.BlockTime.Before(endTime) {
+			continue
+		}
+		address := common.HexToAddress(transaction.Address)
+
+		amount, err := s.calculateRebate(transaction)
+		if err == nil {
+			if _, ok := totals[address]; !ok {
+				totals[address], err = s.db.GetTotalArbRebated(ctx, transaction.Address)
+				if err != nil {
+					return nil, fmt.Errorf("could not get total ARB rebated: %w", err)
+				}
+			}
+			amount, err = s.applyRebateCap(amount, totals[address])
+		}
+		if err != nil {
+			fmt.Printf("Not rebating transaction %s: %v\n", transaction.Hash, err)
+			err = s.db.UpdateSTIPTransactionDoNotProcess(ctx, transaction.Hash)
+			if err != nil {
+				return nil, fmt.Errorf("could not update STIP transaction as do not process: %w", err)
+			}
+			continue
+		}
+
+		if _, ok := rebates.amounts[address]; !ok {
+			rebates.amounts[address] = big.NewInt(0)
+		}
+		rebates.amounts[address].Add(rebates.amounts[address], amount)
+		totals[address] = new(big.Int).Add(totals[address], amount)
+		rebates.transactions[address] = append(rebates.transactions[address], transaction.Hash)
+
+		transaction.ArbAmountRebated = amount.String()
+		rebates.rebated = append(rebates.rebated, transaction)
+	}
+
+	// drop addresses whose rebates rounded down to nothing
+	for address, amount := range rebates.amounts {
+		if amount.Sign() == 0 {
+			delete(rebates.amounts, address)
+		}
+	}
+	return rebates, nil
+}
+
+// submitRoot publishes the root of an epoch to the merkle distributor.
+func (s *STIPRelayer) submitRoot(ctx context.Context, epoch uint64, root common.Hash) (uint64, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(merkleDistributorABI))
+	if err != nil {
+		return 0, fmt.Errorf("could not parse distributor abi: %w", err)
+	}
+
+	chainID := new(big.Int).SetUint64(s.cfg.ArbChainID)
+	backendClient, err := s.omnirpcClient.GetClient(ctx, chainID)
+	if err != nil {
+		return 0, fmt.Errorf("could not get client: %w", err)
+	}
+	distributor := bind.NewBoundContract(common.HexToAddress(s.cfg.DistributorAddress), parsedABI, backendClient, backendClient, backendClient)
+
+	nonce, err := s.submittter.SubmitTransaction(ctx, chainID, func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		tx, err = distributor.Transact(transactor, "setMerkleRoot", new(big.Int).SetUint64(epoch), root)
+		if err != nil {
+			return nil, fmt.Errorf("could not set merkle root: %w", err)
+		}
+		return tx, nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not submit merkle root: %w", err)
+	}
+	return nonce, nil
+}
@@ -0,0 +1,109 @@
+package relayer_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Flaque/filet"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/services/stiprelayer/claims"
+	"github.com/synapsecns/sanguine/services/stiprelayer/db"
+	"github.com/synapsecns/sanguine/services/stiprelayer/db/sql"
+	"github.com/synapsecns/sanguine/services/stiprelayer/relayer"
+	"github.com/synapsecns/sanguine/services/stiprelayer/stipconfig"
+)
+
+func (c *STIPRelayerSuite) TestPublishEpochsRetriesExport() {
+	dbType, err := dbcommon.DBTypeFromString("sqlite")
+	c.Require().NoError(err)
+	testDB, err := sql.Connect(c.GetTestContext(), dbType, filet.TmpDir(c.T(), ""), c.handler)
+	c.Require().NoError(err)
+
+	cfg := c.cfg
+	cfg.RebateMode = stipconfig.MerkleRebateMode
+	cfg.DistributorAddress = c.arbERC20Address.Hex()
+	cfg.EpochDuration = time.Hour
+	cfg.StartDate = time.Now().Add(-90 * time.Minute)
+	// the export directory can't be created under a file
+	cfg.EpochExportDir = filepath.Join(filet.TmpFile(c.T(), "", "").Name(), "epochs")
+
+	stipRelayer, err := relayer.NewSTIPRelayer(c.GetTestContext(), cfg, c.handler, c.omniRPCClient, testDB)
+	c.Require().NoError(err)
+	c.Require().Error(stipRelayer.PublishEpochs(c.GetTestContext()))
+
+	// the epoch is stored even though its export failed
+	epoch, err := testDB.GetLatestEpoch(c.GetTestContext())
+	c.Require().NoError(err)
+	c.Equal(uint64(0), epoch.Epoch)
+	c.True(epoch.Submitted)
+	c.False(epoch.Exported)
+
+	cfg.EpochExportDir = filet.TmpDir(c.T(), "")
+	stipRelayer, err = relayer.NewSTIPRelayer(c.GetTestContext(), cfg, c.handler, c.omniRPCClient, testDB)
+	c.Require().NoError(err)
+	c.Require().NoError(stipRelayer.PublishEpochs(c.GetTestContext()))
+
+	_, err = os.Stat(filepath.Join(cfg.EpochExportDir, claims.ExportFileName(0)))
+	c.Require().NoError(err)
+
+	unfinished, err := testDB.GetUnfinishedEpochs(c.GetTestContext())
+	c.Require().NoError(err)
+	c.Empty(unfinished)
+}
+
+func (c *STIPRelayerSuite) TestEpochExport() {
+	epoch, err := claims.NewEpoch(3, map[common.Address]*big.Int{
+		common.HexToAddress("0x1"): big.NewInt(100),
+		common.HexToAddress("0x2"): big.NewInt(200),
+	})
+	c.Require().NoError(err)
+
+	stipClaims := make([]db.STIPClaim, len(epoch.Claims))
+	for i, claim := range epoch.Claims {
+		proof, err := json.Marshal(claims.HashesToStrings(claim.Proof))
+		c.Require().NoError(err)
+		stipClaims[i] = db.STIPClaim{
+			Epoch:        epoch.Epoch,
+			Address:      claim.Address.Hex(),
+			Index:        claim.Index,
+			Amount:       claim.Amount.String(),
+			Proof:        string(proof),
+			Transactions: `["0xa","0xb"]`,
+		}
+	}
+
+	start := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.FixedZone("EST", -5*60*60))
+	export, err := relayer.EpochExport(db.STIPEpoch{
+		Epoch:       epoch.Epoch,
+		Root:        epoch.Root.Hex(),
+		StartTime:   start,
+		EndTime:     start.Add(24 * time.Hour),
+		TotalAmount: epoch.Total.String(),
+		Nonce:       5,
+	}, stipClaims)
+	c.Require().NoError(err)
+
+	c.Equal(epoch.Root.Hex(), export.Root)
+	c.Equal("300", export.Total)
+	c.Equal(uint64(5), export.Nonce)
+	// times are exported in utc
+	c.Equal(time.UTC, export.StartTime.Location())
+	c.True(start.Equal(export.StartTime))
+	c.Require().Len(export.Claims, 2)
+	for i, claim := range epoch.Claims {
+		c.Equal(claim.Index, export.Claims[i].Index)
+		c.Equal(claim.Address.Hex(), export.Claims[i].Address)
+		c.Equal(claim.Amount.String(), export.Claims[i].Amount)
+		c.Equal(claims.HashesToStrings(claim.Proof), export.Claims[i].Proof)
+		c.Equal([]string{"0xa", "0xb"}, export.Claims[i].Transactions)
+	}
+
+	// claims that can't be decoded aren't exported
+	stipClaims[0].Proof = "not json"
+	_, err = relayer.EpochExport(db.STIPEpoch{Epoch: epoch.Epoch}, stipClaims)
+	c.Require().Error(err)
+}
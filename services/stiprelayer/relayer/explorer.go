@@ -0,0 +1,321 @@
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/stiprelayer/db"
+	"github.com/synapsecns/sanguine/services/stiprelayer/stipconfig"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const (
+	// BridgeModule is the module of transactions sent through the synapse bridge.
+	BridgeModule = "SynapseBridge"
+	// CCTPModule is the module of transactions sent through synapse cctp.
+	CCTPModule = "SynapseCCTP"
+	// RFQModule is the module of transactions sent through the synapse rfq fastbridge.
+	RFQModule = "SynapseRFQ"
+)
+
+// The explorer's event types, which are pinned by the explorer's services/explorer/types/bridge tests.
+const (
+	// circleRequestSentEventType is bridge.CircleRequestSentEvent, the explorer's event type for synapse cctp requests.
+	circleRequestSentEventType = 10
+	// rfqBridgeRequestedEventType is bridge.RFQBridgeRequestedEvent, the explorer's event type for synapse rfq requests.
+	rfqBridgeRequestedEventType = 12
+)
+
+// arbCoinID is the defillama coin id of ARB.
+const arbCoinID = "coingecko:arbitrum"
+
+// explorerBridgeTransactionsQuery gets a page of completed bridge transactions to the given chains.
+const explorerBridgeTransactionsQuery = `query GetBridgeTransactions($chainIDTo: [Int], $startTime: Int, $page: Int) {
+	response: bridgeTransactions(chainIDTo: $chainIDTo, startTime: $startTime, pending: false, page: $page) {
+		fromInfo {
+			address
+			txnHash
+			formattedValue
+			USDValue
+			tokenSymbol
+			time
+			eventType
+		}
+		toInfo {
+			chainID
+		}
+	}
+}`
+
+// ExplorerSource is a RebateSource that derives eligible transactions from the explorer's bridgeTransactions.
+// A transaction is eligible if its destination, module and token have an entry in the FeesAndRebates table.
+type ExplorerSource struct {
+	explorerURL    string
+	priceAPIURL    string
+	feesAndRebates stipconfig.FeesAndRebates
+	// db persists the ARB price of each hour, so that it is only fetched once.
+	db      db.STIPDB
+	handler metrics.Handler
+	client  *http.Client
+	// priceMux protects arbPrices.
+	priceMux sync.Mutex
+	// arbPrices caches the ARB price by hour.
+	arbPrices map[int64]float64
+}
+
+// NewExplorerSource creates a new ExplorerSource.
+func NewExplorerSource(explorerURL, priceAPIURL string, feesAndRebates stipconfig.FeesAndRebates, store db.STIPDB, handler metrics.Handler) *ExplorerSource {
+	client := &http.Client{}
+	handler.ConfigureHTTPClient(client)
+
+	return &ExplorerSource{
+		explorerURL:    explorerURL,
+		priceAPIURL:    strings.TrimSuffix(priceAPIURL, "/"),
+		feesAndRebates: feesAndRebates,
+		db:             store,
+		handler:        handler,
+		client:         client,
+		arbPrices:      make(map[int64]float64),
+	}
+}
+
+type explorerPartialInfo struct {
+	ChainID        *int     `json:"chainID"`
+	Address        *string  `json:"address"`
+	TxnHash        *string  `json:"txnHash"`
+	FormattedValue *float64 `json:"formattedValue"`
+	USDValue       *float64 `json:"USDValue"`
+	TokenSymbol    *string  `json:"tokenSymbol"`
+	Time           *int     `json:"time"`
+	EventType      *int     `json:"eventType"`
+}
+
+type explorerBridgeTransaction struct {
+	FromInfo *explorerPartialInfo `json:"fromInfo"`
+	ToInfo   *explorerPartialInfo `json:"toInfo"`
+}
+
+type explorerResponse struct {
+	Data struct {
+		Response []explorerBridgeTransaction `json:"response"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// GetTransactions pages through the completed bridge transactions to the configured chains since the given time.
+func (e *ExplorerSource) GetTransactions(parentCtx context.Context, since time.Time) (_ []db.STIPTransactions, readUntil time.Time, err error) {
+	ctx, span := e.handler.Tracer().Start(parentCtx, "ExplorerSource.GetTransactions", trace.WithAttributes(attribute.String("since", since.String())))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	chainIDs := make([]int, 0, len(e.feesAndRebates))
+	for chainID := range e.feesAndRebates {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Ints(chainIDs)
+
+	readUntil = since
+	var transactions []db.STIPTransactions
+	for page := 1; ; page++ {
+		bridgeTransactions, err := e.getBridgeTransactions(ctx, chainIDs, since, page)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("could not get bridge transactions page %d: %w", page, err)
+		}
+		if len(bridgeTransactions) == 0 {
+			break
+		}
+
+		for _, bridgeTransaction := range bridgeTransactions {
+			if from := bridgeTransaction.FromInfo; from != nil && from.Time != nil {
+				if blockTime := time.Unix(int64(*from.Time), 0).UTC(); blockTime.After(readUntil) {
+					readUntil = blockTime
+				}
+			}
+
+			transaction, ok := e.toSTIPTransaction(bridgeTransaction)
+			if !ok || !transaction.BlockTime.After(since) {
+				continue
+			}
+
+			transaction.ArbPrice, err = e.getArbPrice(ctx, transaction.BlockTime)
+			if err != nil {
+				return nil, time.Time{}, fmt.Errorf("could not get arb price for %s: %w", transaction.Hash, err)
+			}
+			transactions = append(transactions, transaction)
+		}
+	}
+
+	span.SetAttributes(attribute.Int("transactions", len(transactions)), attribute.String("read_until", readUntil.String()))
+	return transactions, readUntil, nil
+}
+
+// toSTIPTransaction converts a bridge transaction to a transaction that has not been rebated.
+// False is returned if the transaction is incomplete or not in the FeesAndRebates table.
+func (e *ExplorerSource) toSTIPTransaction(bridgeTransaction explorerBridgeTransaction) (db.STIPTransactions, bool) {
+	from, to := bridgeTransaction.FromInfo, bridgeTransaction.ToInfo
+	if from == nil || to == nil || to.ChainID == nil || from.Address == nil || from.TxnHash == nil ||
+		from.FormattedValue == nil || from.USDValue == nil || from.TokenSymbol == nil || from.Time == nil {
+		return db.STIPTransactions{}, false
+	}
+
+	direction, ok := directionForChainID(*to.ChainID)
+	if !ok {
+		return db.STIPTransactions{}, false
+	}
+
+	module := BridgeModule
+	if from.EventType != nil {
+		switch *from.EventType {
+		case circleRequestSentEventType:
+			module = CCTPModule
+		case rfqBridgeRequestedEventType:
+			module = RFQModule
+		}
+	}
+
+	if _, ok := e.feesAndRebates[*to.ChainID][module][*from.TokenSymbol]; !ok {
+		return db.STIPTransactions{}, false
+	}
+
+	var tokenPrice float64
+	if *from.FormattedValue != 0 {
+		tokenPrice = *from.USDValue / *from.FormattedValue
+	}
+
+	return db.STIPTransactions{
+		ExecutionID: stipconfig.ExplorerRebateSource,
+		Address:     *from.Address,
+		Amount:      *from.FormattedValue,
+		AmountUSD:   *from.USDValue,
+		BlockTime:   time.Unix(int64(*from.Time), 0).UTC(),
+		Direction:   direction,
+		Hash:        *from.TxnHash,
+		Module:      module,
+		Token:       *from.TokenSymbol,
+		TokenPrice:  tokenPrice,
+		Rebated:     false,
+	}, true
+}
+
+func (e *ExplorerSource) getBridgeTransactions(ctx context.Context, chainIDs []int, since time.Time, page int) ([]explorerBridgeTransaction, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"query": explorerBridgeTransactionsQuery,
+		"variables": map[string]interface{}{
+			"chainIDTo": chainIDs,
+			"startTime": since.Unix(),
+			"page":      page,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal query: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.explorerURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var result explorerResponse
+	err = e.doJSON(req, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Errors) > 0 {
+		return nil, fmt.Errorf("explorer returned error: %s", result.Errors[0].Message)
+	}
+	return result.Data.Response, nil
+}
+
+// getArbPrice gets the USD price of ARB at the start of the hour of the given time.
+// Prices are cached in memory and in the database, so each hour is only fetched once.
+func (e *ExplorerSource) getArbPrice(ctx context.Context, at time.Time) (float64, error) {
+	hourTime := at.Truncate(time.Hour)
+	hour := hourTime.Unix()
+
+	e.priceMux.Lock()
+	price, ok := e.arbPrices[hour]
+	e.priceMux.Unlock()
+	if ok {
+		return price, nil
+	}
+
+	price, err := e.db.GetArbPrice(ctx, hourTime)
+	if err == nil {
+		e.cacheArbPrice(hour, price)
+		return price, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, fmt.Errorf("could not get stored arb price: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/prices/historical/%d/%s", e.priceAPIURL, hour, arbCoinID), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var result struct {
+		Coins map[string]struct {
+			Price float64 `json:"price"`
+		} `json:"coins"`
+	}
+	err = e.doJSON(req, &result)
+	if err != nil {
+		return 0, err
+	}
+
+	coin, ok := result.Coins[arbCoinID]
+	if !ok || coin.Price <= 0 {
+		return 0, fmt.Errorf("no price found for %s at %d", arbCoinID, hour)
+	}
+
+	err = e.db.StoreArbPrice(ctx, hourTime, coin.Price)
+	if err != nil {
+		return 0, fmt.Errorf("could not store arb price: %w", err)
+	}
+	e.cacheArbPrice(hour, coin.Price)
+	return coin.Price, nil
+}
+
+func (e *ExplorerSource) cacheArbPrice(hour int64, price float64) {
+	e.priceMux.Lock()
+	defer e.priceMux.Unlock()
+	e.arbPrices[hour] = price
+}
+
+// doJSON does the request and decodes the json response body into result.
+func (e *ExplorerSource) doJSON(req *http.Request, result interface{}) error {
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to do request: %w", err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			fmt.Printf("failed to close response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("expected status code 200, got %d", resp.StatusCode)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(result)
+	if err != nil {
+		return fmt.Errorf("failed to decode response body: %w", err)
+	}
+	return nil
+}
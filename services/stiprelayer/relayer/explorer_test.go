@@ -0,0 +1,199 @@
+package relayer_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/Flaque/filet"
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/services/stiprelayer/db/sql"
+	"github.com/synapsecns/sanguine/services/stiprelayer/relayer"
+	"github.com/synapsecns/sanguine/services/stiprelayer/stipconfig"
+)
+
+const explorerTransactionsPage = `{"data": {"response": [
+	{"fromInfo": {"address": "0x1", "txnHash": "0xa", "formattedValue": 1000, "USDValue": 999, "tokenSymbol": "USDC", "time": %[1]d, "eventType": 10}, "toInfo": {"chainID": 42161}},
+	{"fromInfo": {"address": "0x2", "txnHash": "0xb", "formattedValue": 2, "USDValue": 5000, "tokenSymbol": "WETH", "time": %[1]d, "eventType": 4}, "toInfo": {"chainID": 42161}},
+	{"fromInfo": {"address": "0x3", "txnHash": "0xc", "formattedValue": 100, "USDValue": 100, "tokenSymbol": "DAI", "time": %[1]d, "eventType": 4}, "toInfo": {"chainID": 42161}},
+	{"fromInfo": {"address": "0x4", "txnHash": "0xd", "formattedValue": 100, "USDValue": 100, "tokenSymbol": "USDC", "time": %[1]d, "eventType": 10}, "toInfo": {"chainID": 10}},
+	{"fromInfo": {"address": "0x5", "txnHash": "0xe", "formattedValue": 100, "USDValue": 100, "tokenSymbol": "USDC", "time": %[1]d, "eventType": 10}, "toInfo": null},
+	{"fromInfo": {"address": "0x6", "txnHash": "0xf", "formattedValue": 50, "USDValue": 50, "tokenSymbol": "USDC", "time": %[1]d, "eventType": 12}, "toInfo": {"chainID": 42161}}
+]}}`
+
+func (c *STIPRelayerSuite) TestExplorerSource() {
+	blockTime := time.Date(2024, time.February, 1, 12, 30, 0, 0, time.UTC)
+
+	var explorerRequests int32
+	var startTime int64
+	explorer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables struct {
+				ChainIDTo []int `json:"chainIDTo"`
+				StartTime int64 `json:"startTime"`
+				Page      int   `json:"page"`
+			} `json:"variables"`
+		}
+		c.Require().NoError(json.NewDecoder(r.Body).Decode(&body))
+		c.Equal([]int{1, 42161}, body.Variables.ChainIDTo)
+		atomic.StoreInt64(&startTime, body.Variables.StartTime)
+		atomic.AddInt32(&explorerRequests, 1)
+
+		if body.Variables.Page > 1 {
+			_, _ = w.Write([]byte(`{"data": {"response": []}}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, explorerTransactionsPage, blockTime.Unix())
+	}))
+	defer explorer.Close()
+
+	var priceRequests int32
+	prices := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(fmt.Sprintf("/prices/historical/%d/coingecko:arbitrum", blockTime.Truncate(time.Hour).Unix()), r.URL.Path)
+		atomic.AddInt32(&priceRequests, 1)
+		_, _ = w.Write([]byte(`{"coins": {"coingecko:arbitrum": {"price": 2}}}`))
+	}))
+	defer prices.Close()
+
+	feesAndRebates := stipconfig.FeesAndRebates{
+		42161: {
+			relayer.CCTPModule:   {"USDC": {RebateBps: 5}},
+			relayer.BridgeModule: {"WETH": {RebateBps: 10}},
+			relayer.RFQModule:    {"USDC": {RebateBps: 5}},
+		},
+		1: {
+			relayer.BridgeModule: {"WETH": {RebateBps: 10}},
+		},
+	}
+
+	dbType, err := dbcommon.DBTypeFromString("sqlite")
+	c.Require().NoError(err)
+	testDB, err := sql.Connect(c.GetTestContext(), dbType, filet.TmpDir(c.T(), ""), c.handler)
+	c.Require().NoError(err)
+
+	source := relayer.NewExplorerSource(explorer.URL, prices.URL, feesAndRebates, testDB, c.handler)
+	transactions, readUntil, err := source.GetTransactions(c.GetTestContext(), c.cfg.StartDate)
+	c.Require().NoError(err)
+	c.True(blockTime.Equal(readUntil))
+
+	// only transactions to a configured chain, module and token are eligible
+	c.Require().Len(transactions, 3)
+	c.Equal("0xa", transactions[0].Hash)
+	c.Equal("0x1", transactions[0].Address)
+	c.Equal("ARB", transactions[0].Direction)
+	c.Equal(relayer.CCTPModule, transactions[0].Module)
+	c.Equal("USDC", transactions[0].Token)
+	c.Equal(float64(999), transactions[0].AmountUSD)
+	c.Equal(float64(2), transactions[0].ArbPrice)
+	c.True(blockTime.Equal(transactions[0].BlockTime))
+
+	c.Equal("0xb", transactions[1].Hash)
+	c.Equal(relayer.BridgeModule, transactions[1].Module)
+	c.Equal(float64(2500), transactions[1].TokenPrice)
+
+	c.Equal("0xf", transactions[2].Hash)
+	c.Equal(relayer.RFQModule, transactions[2].Module)
+
+	c.Equal(c.cfg.StartDate.Unix(), atomic.LoadInt64(&startTime))
+	c.Equal(int32(2), atomic.LoadInt32(&explorerRequests))
+	// prices are cached by hour
+	c.Equal(int32(1), atomic.LoadInt32(&priceRequests))
+
+	// transactions before the start date are not eligible
+	transactions, readUntil, err = source.GetTransactions(c.GetTestContext(), blockTime)
+	c.Require().NoError(err)
+	c.Empty(transactions)
+	c.True(blockTime.Equal(readUntil))
+
+	// prices are persisted, so a restarted source doesn't fetch them again
+	source = relayer.NewExplorerSource(explorer.URL, prices.URL, feesAndRebates, testDB, c.handler)
+	transactions, _, err = source.GetTransactions(c.GetTestContext(), c.cfg.StartDate)
+	c.Require().NoError(err)
+	c.Require().Len(transactions, 3)
+	c.Equal(float64(2), transactions[0].ArbPrice)
+	c.Equal(int32(1), atomic.LoadInt32(&priceRequests))
+}
+
+func (c *STIPRelayerSuite) TestProcessRebateSourceCheckpoint() {
+	blockTime := time.Date(2024, time.March, 1, 12, 30, 0, 0, time.UTC)
+	// the latest transaction is to a chain that isn't eligible
+	latestTime := blockTime.Add(3 * time.Hour)
+
+	var startTime int64
+	explorer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables struct {
+				StartTime int64 `json:"startTime"`
+				Page      int   `json:"page"`
+			} `json:"variables"`
+		}
+		c.Require().NoError(json.NewDecoder(r.Body).Decode(&body))
+		atomic.StoreInt64(&startTime, body.Variables.StartTime)
+
+		if body.Variables.Page > 1 {
+			_, _ = w.Write([]byte(`{"data": {"response": []}}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"data": {"response": [
+			{"fromInfo": {"address": "0x1", "txnHash": "0xa", "formattedValue": 1000, "USDValue": 999, "tokenSymbol": "USDC", "time": %d, "eventType": 10}, "toInfo": {"chainID": 42161}},
+			{"fromInfo": {"address": "0x2", "txnHash": "0xb", "formattedValue": 100, "USDValue": 100, "tokenSymbol": "USDC", "time": %d, "eventType": 10}, "toInfo": {"chainID": 10}}
+		]}}`, blockTime.Unix(), latestTime.Unix())
+	}))
+	defer explorer.Close()
+
+	prices := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"coins": {"coingecko:arbitrum": {"price": 2}}}`))
+	}))
+	defer prices.Close()
+
+	dbType, err := dbcommon.DBTypeFromString("sqlite")
+	c.Require().NoError(err)
+	testDB, err := sql.Connect(c.GetTestContext(), dbType, filet.TmpDir(c.T(), ""), c.handler)
+	c.Require().NoError(err)
+
+	cfg := c.cfg
+	cfg.RebateSource = stipconfig.ExplorerRebateSource
+	cfg.ExplorerURL = explorer.URL
+	cfg.PriceAPIURL = prices.URL
+	cfg.FeesAndRebates = stipconfig.FeesAndRebates{
+		42161: {relayer.CCTPModule: {"USDC": {RebateBps: 5}}},
+	}
+
+	stipRelayer, err := relayer.NewSTIPRelayer(c.GetTestContext(), cfg, c.handler, c.omniRPCClient, testDB)
+	c.Require().NoError(err)
+	c.Require().NoError(stipRelayer.ProcessRebateSource(c.GetTestContext()))
+	c.Equal(cfg.StartDate.Unix(), atomic.LoadInt64(&startTime))
+
+	transactions, err := testDB.GetSTIPTransactionsNotRebated(c.GetTestContext())
+	c.Require().NoError(err)
+	c.Require().Len(transactions, 1)
+	c.Equal("0xa", transactions[0].Hash)
+
+	// the checkpoint includes transactions that aren't eligible
+	checkpoint, err := testDB.GetSourceCheckpoint(c.GetTestContext(), stipconfig.ExplorerRebateSource)
+	c.Require().NoError(err)
+	c.True(latestTime.Equal(checkpoint))
+
+	// the source is read again from the overlap before the checkpoint rather than the latest stored transaction
+	c.Require().NoError(stipRelayer.ProcessRebateSource(c.GetTestContext()))
+	c.Equal(latestTime.Add(-time.Hour).Unix(), atomic.LoadInt64(&startTime))
+}
+
+func (c *STIPRelayerSuite) TestNewRebateSource() {
+	source, err := relayer.NewRebateSource(stipconfig.Config{}, c.database, c.handler)
+	c.Require().NoError(err)
+	c.IsType(&relayer.DuneSource{}, source)
+
+	_, err = relayer.NewRebateSource(stipconfig.Config{RebateSource: stipconfig.ExplorerRebateSource}, c.database, c.handler)
+	c.Require().Error(err)
+
+	source, err = relayer.NewRebateSource(stipconfig.Config{RebateSource: "Explorer", ExplorerURL: "http://localhost"}, c.database, c.handler)
+	c.Require().NoError(err)
+	c.IsType(&relayer.ExplorerSource{}, source)
+
+	_, err = relayer.NewRebateSource(stipconfig.Config{RebateSource: "scribe"}, c.database, c.handler)
+	c.Require().Error(err)
+}
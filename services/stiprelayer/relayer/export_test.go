@@ -0,0 +1,11 @@
+package relayer
+
+import (
+	"github.com/synapsecns/sanguine/services/stiprelayer/claims"
+	"github.com/synapsecns/sanguine/services/stiprelayer/db"
+)
+
+// EpochExport wraps epochExport for testing.
+func EpochExport(epoch db.STIPEpoch, stipClaims []db.STIPClaim) (claims.Export, error) {
+	return epochExport(epoch, stipClaims)
+}
@@ -2,15 +2,14 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
-	"strings"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
-	"github.com/cenkalti/backoff"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -25,9 +24,10 @@
 	"github.com/synapsecns/sanguine/services/stiprelayer/stipconfig"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/time/rate"
+	"gorm.io/gorm"
 )
 
-// Check Dune Query
+// Get eligible transactions from the rebate source
 // Store in database
 
 // Call database
@@ -42,6 +42,7 @@ type STIPRelayer struct {
 	submittter    submitter.TransactionSubmitter
 	signer        signer.Signer
 	apiServer     *stipapi.Server
+	source        RebateSource
 }
 
 // NewSTIPRelayer creates a new STIPRelayer with the provided context and configuration.
@@ -51,17 +52,32 @@ func NewSTIPRelayer(ctx context.Context,
 	omniRPCClient omniClient.RPCClient,
 	store db.STIPDB,
 ) (*STIPRelayer, error) {
+	switch cfg.GetRebateMode() {
+	case stipconfig.TransferRebateMode:
+	case stipconfig.MerkleRebateMode:
+		if cfg.EpochDuration <= 0 || cfg.DistributorAddress == "" {
+			return nil, fmt.Errorf("epoch_duration and distributor_address are required in merkle mode")
+		}
+	default:
+		return nil, fmt.Errorf("unknown rebate mode %s", cfg.RebateMode)
+	}
+
 	sg, err := signerConfig.SignerFromConfig(ctx, cfg.Signer)
 	if err != nil {
 		return nil, fmt.Errorf("could not get signer: %w", err)
 	}
 	sm := submitter.NewTransactionSubmitter(handler, sg, omniRPCClient, store.SubmitterDB(), &cfg.SubmitterConfig)
 
-	apiServer, err := stipapi.NewStipAPI(ctx, cfg, handler)
+	apiServer, err := stipapi.NewStipAPI(ctx, cfg, handler, store)
 	if err != nil {
 		return nil, fmt.Errorf("could not get api server: %w", err)
 	}
 
+	source, err := NewRebateSource(cfg, store, handler)
+	if err != nil {
+		return nil, fmt.Errorf("could not get rebate source: %w", err)
+	}
+
 	return &STIPRelayer{
 		cfg:           cfg,
 		db:            store,
@@ -70,72 +86,10 @@ func NewSTIPRelayer(ctx context.Context,
 		submittter:    sm,
 		signer:        sg,
 		apiServer:     apiServer,
+		source:        source,
 	}, nil
 }
 
-// QueryResult represents the result of a Dune query.
-type QueryResult struct {
-	ExecutionID        string    `json:"execution_id"`
-	QueryID            int       `json:"query_id"`
-	State              string    `json:"state"`
-	SubmittedAt        time.Time `json:"submitted_at"`
-	ExpiresAt          time.Time `json:"expires_at"`
-	ExecutionStartedAt time.Time `json:"execution_started_at"`
-	ExecutionEndedAt   time.Time `json:"execution_ended_at"`
-	Result             Result    `json:"result"`
-}
-
-// Result represents the data structure for the result of a query execution.
-type Result struct {
-	Rows     []Row    `json:"rows"`
-	Metadata Metadata `json:"metadata"`
-}
-
-// Row represents a single row of the result of a query execution.
-type Row struct {
-	Address    string     `json:"address"`
-	Amount     float64    `json:"amount"`
-	AmountUsd  float64    `json:"amount_usd"`
-	ArbPrice   float64    `json:"arb_price"`
-	BlockTime  CustomTime `json:"block_time"`
-	Direction  string     `json:"direction"`
-	Hash       string     `json:"hash"`
-	Module     string     `json:"module"`
-	Token      string     `json:"token"`
-	TokenPrice float64    `json:"token_price"`
-}
-
-// Metadata represents the metadata of a query execution result.
-type Metadata struct {
-	ColumnNames         []string `json:"column_names"`
-	ResultSetBytes      int      `json:"result_set_bytes"`
-	TotalRowCount       int      `json:"total_row_count"`
-	DatapointCount      int      `json:"datapoint_count"`
-	PendingTimeMillis   int      `json:"pending_time_millis"`
-	ExecutionTimeMillis int      `json:"execution_time_millis"`
-}
-
-// CustomTime is a custom time type for handling specific time format in JSON unmarshalling.
-type CustomTime struct {
-	time.Time
-}
-
-const ctLayout = "2006-01-02 15:04:05.000 MST"
-
-// UnmarshalJSON overrides the default JSON unmarshaling for CustomTime to handle specific time format.
-func (ct *CustomTime) UnmarshalJSON(b []byte) error {
-	s := strings.Trim(string(b), "\"")
-	if s == "null" {
-		return nil
-	}
-	t, err := time.Parse(ctLayout, s)
-	if err != nil {
-		return fmt.Errorf("failed to parse time: %w", err)
-	}
-	ct.Time = t
-	return nil
-}
-
 // Run starts the STIPRelayer service by initiating various goroutines.
 func (s *STIPRelayer) Run(ctx context.Context) error {
 	g, ctx := errgroup.WithContext(ctx)
@@ -153,13 +107,9 @@ func (s *STIPRelayer) Run(ctx context.Context) error {
 		return nil
 	})
 
-	err := s.ProcessExecutionResults(ctx, "bridge")
+	err := s.ProcessRebateSource(ctx)
 	if err != nil {
-		return fmt.Errorf("error processing execution results for bridge: %w", err)
-	}
-	err = s.ProcessExecutionResults(ctx, "rfq")
-	if err != nil {
-		return fmt.Errorf("error processing execution results for rfq: %w", err)
+		return fmt.Errorf("error processing rebate source: %w", err)
 	}
 
 	// Start the ticker goroutine for requesting and storing execution results
@@ -194,7 +144,7 @@ func (s *STIPRelayer) StartSubmitter(ctx context.Context) error {
 // RequestAndStoreResults handles the continuous request of new execution results and storing them in the database.
 func (s *STIPRelayer) RequestAndStoreResults(ctx context.Context) error {
 	// TODO: If undefined, what do? Need a default, otherwise, panic
-	ticker := time.NewTicker(s.cfg.DuneInterval)
+	ticker := time.NewTicker(s.cfg.GetSourceInterval())
 	defer ticker.Stop()
 
 	for {
@@ -203,15 +153,9 @@ func (s *STIPRelayer) RequestAndStoreResults(ctx context.Context) error {
 			//nolint: wrapcheck
 			return ctx.Err() // exit if context is canceled
 		case <-ticker.C:
-			if err := s.ProcessExecutionResults(ctx, "bridge"); err != nil {
-				// Log the error and decide whether to continue based on the error
-				fmt.Printf("Error processing execution results for bridge: %v", err)
-				// Optionally, you can return the error to stop the goroutine
-				// return err
-			}
-			if err := s.ProcessExecutionResults(ctx, "rfq"); err != nil {
+			if err := s.ProcessRebateSource(ctx); err != nil {
 				// Log the error and decide whether to continue based on the error
-				fmt.Printf("Error processing execution results for rfq: %v", err)
+				fmt.Printf("Error processing rebate source: %v", err)
 				// Optionally, you can return the error to stop the goroutine
 				// return err
 			}
@@ -219,81 +163,69 @@ func (s *STIPRelayer) RequestAndStoreResults(ctx context.Context) error {
 	}
 }
 
-// ProcessExecutionResults encapsulates the logic for requesting and storing execution results.
-func (s *STIPRelayer) ProcessExecutionResults(parentCtx context.Context, queryType string) (err error) {
-	fmt.Println("Starting execution logic")
+// sourceOverlap is how far before the source's checkpoint the rebate source is read from again,
+// so that transactions which complete after newer ones were read are not missed.
+const sourceOverlap = time.Hour
 
-	ctx, span := s.handler.Tracer().Start(parentCtx, "ProcessExecutionResults", trace.WithAttributes(attribute.String("queryType", queryType)))
+// ProcessRebateSource gets the eligible transactions since the source's checkpoint from the rebate source, stores them in the database
+// and then advances the checkpoint.
+func (s *STIPRelayer) ProcessRebateSource(parentCtx context.Context) (err error) {
+	ctx, span := s.handler.Tracer().Start(parentCtx, "ProcessRebateSource", trace.WithAttributes(attribute.String("source", s.cfg.GetRebateSource())))
 	defer func() {
 		metrics.EndSpanWithErr(span, err)
 	}()
 
-	executionID, err := s.ExecuteDuneQuery(ctx, queryType)
+	since, err := s.getSourceStartTime(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to execute Dune query: %w", err)
+		return err
 	}
+	span.SetAttributes(attribute.String("since", since.String()))
 
-	// TODO: remove if exponentialBackoff.InitialInterval waits 30 seconds?
-	// time.Sleep(30 * time.Second) // Consider replacing this with a more robust solution
-	var getResultsJSONResult QueryResult
-	operation := func() error {
-		jsonResult, err := s.GetExecutionResults(ctx, executionID)
-		if err != nil {
-			return fmt.Errorf("failed to get execution results: %w", err)
-		}
-
-		if jsonResult.State != "QUERY_STATE_COMPLETED" {
-			// query state is not completed, so return an error to retry
-			return fmt.Errorf("query state is not completed")
-		}
-		getResultsJSONResult = *jsonResult
-		return nil
+	transactions, readUntil, err := s.source.GetTransactions(ctx, since)
+	if err != nil {
+		return fmt.Errorf("could not get transactions from rebate source: %w", err)
 	}
+	fmt.Println("Number of transactions after", since, ":", len(transactions))
 
-	// Create a new exponential backoff policy
-	expBackOff := backoff.NewExponentialBackOff()
-	expBackOff.InitialInterval = 30 * time.Second
-	expBackOff.MaxElapsedTime = 300 * time.Second
-
-	// Retry the operation with the backoff policy
-	err = backoff.Retry(operation, expBackOff)
+	err = s.StoreResultsInDatabase(ctx, transactions)
 	if err != nil {
-		return fmt.Errorf("failed to get execution results after retries: %w", err)
+		return err
 	}
 
-	var rowsAfterStartDate []Row
-	for _, row := range getResultsJSONResult.Result.Rows {
-		// TODO: Will this panic if StartDate not set?
-		if row.BlockTime.After(s.cfg.StartDate) {
-			rowsAfterStartDate = append(rowsAfterStartDate, row)
+	// only move the checkpoint forward, since is the overlap before the previous one.
+	if readUntil.After(since.Add(sourceOverlap)) {
+		err = s.db.StoreSourceCheckpoint(ctx, s.cfg.GetRebateSource(), readUntil)
+		if err != nil {
+			return fmt.Errorf("could not store source checkpoint: %w", err)
 		}
 	}
-	fmt.Println("Number of rows after start date:", len(rowsAfterStartDate))
-
-	// Convert each Row to a STIPTransactions and store them in the database
-	return s.StoreResultsInDatabase(ctx, rowsAfterStartDate, getResultsJSONResult.ExecutionID)
+	return nil
 }
 
-// StoreResultsInDatabase handles the storage of results in the database.
-func (s *STIPRelayer) StoreResultsInDatabase(ctx context.Context, rows []Row, executionID string) error {
-	stipTransactions := make([]db.STIPTransactions, len(rows))
-	for i, row := range rows {
-		stipTransactions[i] = db.STIPTransactions{
-			Address:     row.Address,
-			Amount:      row.Amount,
-			AmountUSD:   row.AmountUsd,
-			ArbPrice:    row.ArbPrice,
-			BlockTime:   row.BlockTime.Time,
-			Direction:   row.Direction,
-			ExecutionID: executionID,
-			Hash:        row.Hash,
-			Module:      row.Module,
-			Token:       row.Token,
-			TokenPrice:  row.TokenPrice,
-			Rebated:     false,
-		}
+// getSourceStartTime gets the time to read the rebate source from: the overlap before the source's checkpoint, or
+// before the latest stored transaction if the source has no checkpoint yet, or the start date if neither is after it.
+// Transactions that were already stored are ignored on insert.
+func (s *STIPRelayer) getSourceStartTime(ctx context.Context) (time.Time, error) {
+	latest, err := s.db.GetSourceCheckpoint(ctx, s.cfg.GetRebateSource())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		latest, err = s.db.GetLatestBlockTime(ctx)
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return s.cfg.StartDate, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("could not get latest read time: %w", err)
+	}
+
+	since := latest.Add(-sourceOverlap)
+	if since.Before(s.cfg.StartDate) {
+		return s.cfg.StartDate, nil
 	}
+	return since, nil
+}
 
+// StoreResultsInDatabase handles the storage of results in the database.
+func (s *STIPRelayer) StoreResultsInDatabase(ctx context.Context, stipTransactions []db.STIPTransactions) error {
 	if len(stipTransactions) > 0 {
 		if err := s.db.InsertNewStipTransactions(ctx, stipTransactions); err != nil {
 			return fmt.Errorf("error inserting new STIP transactions: %w", err)
@@ -304,6 +236,7 @@ func (s *STIPRelayer) StoreResultsInDatabase(ctx context.Context, rows []Row, ex
 }
 
 // QueryRebateAndUpdate handles the querying for new, non-relayed/rebated results, rebates/relays them, and updates the result row.
+// In merkle mode, the results are instead aggregated into epochs whose roots are published once they end.
 func (s *STIPRelayer) QueryRebateAndUpdate(ctx context.Context) error {
 	// TODO: If undefined, what do? Need a default, otherwise, panic
 	ticker := time.NewTicker(s.cfg.RebateInterval)
@@ -315,6 +248,12 @@ func (s *STIPRelayer) QueryRebateAndUpdate(ctx context.Context) error {
 			//nolint: wrapcheck
 			return ctx.Err() // exit if context is canceled
 		case <-ticker.C:
+			if s.cfg.GetRebateMode() == stipconfig.MerkleRebateMode {
+				if err := s.PublishEpochs(ctx); err != nil {
+					fmt.Printf("Error publishing epochs: %v", err)
+				}
+				continue
+			}
 			if err := s.RelayAndRebateTransactions(ctx); err != nil {
 				// Log the error and decide whether to continue based on the error
 				fmt.Printf("Error relaying and rebating transactions: %v", err)
@@ -419,16 +358,28 @@ func (s *STIPRelayer) SubmitAndRebateTransaction(ctx context.Context, transactio
 
 // CalculateTransferAmount determines the amount to transfer based on the transaction.
 func (s *STIPRelayer) CalculateTransferAmount(ctx context.Context, transaction *db.STIPTransactions) (*big.Int, error) {
-	var toChainID int
-	switch transaction.Direction {
-	case "ARB":
-		toChainID = 42161
-	case "ETH":
-		toChainID = 1
-	case "AVAX":
-		toChainID = 43114
+	transferAmount, err := s.calculateRebate(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// Finally, apply the rebate cap
+	totalArbRebated, err := s.db.GetTotalArbRebated(ctx, transaction.Address)
+	if err != nil {
+		return nil, fmt.Errorf("could not get total ARB rebated: %w", err)
+	}
+	transferAmount, err = s.applyRebateCap(transferAmount, totalArbRebated)
+	if err != nil {
+		return nil, fmt.Errorf("could not apply rebate cap: %w", err)
 	}
 
+	return transferAmount, nil
+}
+
+// calculateRebate determines the rebate of a transaction from the FeesAndRebates table, limited to ARBMaxTransfer.
+func (s *STIPRelayer) calculateRebate(transaction *db.STIPTransactions) (*big.Int, error) {
+	toChainID := directionChainIDs[transaction.Direction]
+
 	moduleConfig, ok := s.cfg.FeesAndRebates[toChainID][transaction.Module]
 	if !ok {
 		return nil, fmt.Errorf("module configuration not found for module %s", transaction.Module)
@@ -468,21 +419,11 @@ func (s *STIPRelayer) CalculateTransferAmount(ctx context.Context, transaction *
 	// transferAmount := new(big.Int)
 	// transferAmountFloat.Int(transferAmount) // Round to the nearest integer
 
-	// Finally, apply the rebate cap
-	var err error
-	transferAmount, err = s.applyRebateCap(ctx, transaction, transferAmount)
-	if err != nil {
-		return nil, fmt.Errorf("could not apply rebate cap: %w", err)
-	}
-
 	return transferAmount, nil
 }
 
-func (s *STIPRelayer) applyRebateCap(ctx context.Context, transaction *db.STIPTransactions, amount *big.Int) (*big.Int, error) {
-	totalArbRebated, err := s.db.GetTotalArbRebated(ctx, transaction.Address)
-	if err != nil {
-		return nil, fmt.Errorf("could not get total ARB rebated: %w", err)
-	}
+// applyRebateCap limits the amount so the address's total rebates stay within ArbCapPerAddress.
+func (s *STIPRelayer) applyRebateCap(amount, totalArbRebated *big.Int) (*big.Int, error) {
 	rebateCap := new(big.Int).Mul(big.NewInt(s.cfg.GetArbCapPerAddress()), big.NewInt(1e18)) // Convert to wei
 	remainingAmount := new(big.Int).Sub(rebateCap, totalArbRebated)
 
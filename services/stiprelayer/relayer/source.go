@@ -0,0 +1,51 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/stiprelayer/db"
+	"github.com/synapsecns/sanguine/services/stiprelayer/stipconfig"
+)
+
+// RebateSource provides the bridge transactions that are eligible for ARB rebates.
+type RebateSource interface {
+	// GetTransactions gets the eligible transactions that happened after since, along with the time of the latest
+	// transaction read, eligible or not, or since if there were none.
+	// Each transaction's Direction, Module and Token are keys in the FeesAndRebates table.
+	GetTransactions(ctx context.Context, since time.Time) (_ []db.STIPTransactions, readUntil time.Time, err error)
+}
+
+// NewRebateSource creates the rebate source selected in the config.
+func NewRebateSource(cfg stipconfig.Config, store db.STIPDB, handler metrics.Handler) (RebateSource, error) {
+	switch cfg.GetRebateSource() {
+	case stipconfig.DuneRebateSource:
+		return NewDuneSource(handler), nil
+	case stipconfig.ExplorerRebateSource:
+		if cfg.ExplorerURL == "" {
+			return nil, fmt.Errorf("explorer_url is required for the explorer rebate source")
+		}
+		return NewExplorerSource(cfg.ExplorerURL, cfg.GetPriceAPIURL(), cfg.FeesAndRebates, store, handler), nil
+	default:
+		return nil, fmt.Errorf("unknown rebate source %s", cfg.RebateSource)
+	}
+}
+
+// directionChainIDs maps the direction of a transaction to the chain id of its destination.
+var directionChainIDs = map[string]int{
+	"ARB":  42161,
+	"ETH":  1,
+	"AVAX": 43114,
+}
+
+// directionForChainID returns the direction of a transaction to the destination chain id.
+func directionForChainID(chainID int) (string, bool) {
+	for direction, directionChainID := range directionChainIDs {
+		if directionChainID == chainID {
+			return direction, true
+		}
+	}
+	return "", false
+}
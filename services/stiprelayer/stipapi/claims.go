@@ -0,0 +1,108 @@
+package stipapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// EpochResponse is a merkle epoch.
+type EpochResponse struct {
+	Epoch       uint64 `json:"epoch"`
+	Root        string `json:"root"`
+	StartTime   int64  `json:"start_time"`
+	EndTime     int64  `json:"end_time"`
+	TotalAmount string `json:"total_amount"`
+}
+
+// ClaimResponse is an address's claim in a merkle epoch, with the proof needed to claim it.
+type ClaimResponse struct {
+	Epoch   uint64   `json:"epoch"`
+	Root    string   `json:"root"`
+	Index   uint32   `json:"index"`
+	Address string   `json:"address"`
+	Amount  string   `json:"amount"`
+	Proof   []string `json:"proof"`
+}
+
+// GetEpoch returns a merkle epoch.
+func (h *Handler) GetEpoch(c *gin.Context) {
+	epochNumber, err := strconv.ParseUint(c.Param("epoch"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid epoch"})
+		return
+	}
+
+	epoch, err := h.db.GetEpoch(c, epochNumber)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "epoch not found"})
+		return
+	}
+	if err != nil {
+		logger.Errorf("could not get epoch %d: %v", epochNumber, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not get epoch"})
+		return
+	}
+
+	c.JSON(http.StatusOK, EpochResponse{
+		Epoch:       epoch.Epoch,
+		Root:        epoch.Root,
+		StartTime:   epoch.StartTime.Unix(),
+		EndTime:     epoch.EndTime.Unix(),
+		TotalAmount: epoch.TotalAmount,
+	})
+}
+
+// GetClaims returns the claims of an address in every merkle epoch with their proofs.
+// Claims of epochs whose root was not submitted yet are left out.
+func (h *Handler) GetClaims(c *gin.Context) {
+	address := c.Param("address")
+	if !common.IsHexAddress(address) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid address"})
+		return
+	}
+
+	claims, err := h.db.GetClaimsByAddress(c, common.HexToAddress(address).Hex())
+	if err != nil {
+		logger.Errorf("could not get claims for %s: %v", address, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not get claims"})
+		return
+	}
+
+	response := make([]ClaimResponse, 0, len(claims))
+	for _, claim := range claims {
+		epoch, err := h.db.GetEpoch(c, claim.Epoch)
+		if err != nil {
+			logger.Errorf("could not get epoch %d: %v", claim.Epoch, err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "could not get claims"})
+			return
+		}
+		if !epoch.Submitted {
+			continue
+		}
+
+		var proof []string
+		err = json.Unmarshal([]byte(claim.Proof), &proof)
+		if err != nil {
+			logger.Errorf("could not decode proof for %s in epoch %d: %v", claim.Address, claim.Epoch, err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "could not get claims"})
+			return
+		}
+
+		response = append(response, ClaimResponse{
+			Epoch:   claim.Epoch,
+			Root:    epoch.Root,
+			Index:   claim.Index,
+			Address: claim.Address,
+			Amount:  claim.Amount,
+			Proof:   proof,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
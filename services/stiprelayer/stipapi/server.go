@@ -11,6 +11,7 @@
 	"github.com/synapsecns/sanguine/core/ginhelper"
 	"github.com/synapsecns/sanguine/core/metrics"
 	baseServer "github.com/synapsecns/sanguine/core/server"
+	"github.com/synapsecns/sanguine/services/stiprelayer/db"
 	"github.com/synapsecns/sanguine/services/stiprelayer/stipconfig"
 )
 
@@ -19,13 +20,15 @@ type Server struct {
 	cfg     stipconfig.Config
 	engine  *gin.Engine
 	handler metrics.Handler
+	db      db.STIPDBReader
 }
 
-// NewStipAPI creates a new instance of Server with the provided configuration and metrics handler.
+// NewStipAPI creates a new instance of Server with the provided configuration, metrics handler and database.
 func NewStipAPI(
 	ctx context.Context,
 	cfg stipconfig.Config,
 	handler metrics.Handler,
+	store db.STIPDBReader,
 ) (*Server, error) {
 	if ctx == nil {
 		return nil, fmt.Errorf("context is nil")
@@ -33,10 +36,14 @@ func NewStipAPI(
 	if handler == nil {
 		return nil, fmt.Errorf("handler is nil")
 	}
+	if store == nil {
+		return nil, fmt.Errorf("store is nil")
+	}
 
 	return &Server{
 		cfg:     cfg,
 		handler: handler,
+		db:      store,
 	}, nil
 }
 
@@ -45,12 +52,14 @@ func NewStipAPI(
 // Handler is the REST API handler.
 type Handler struct {
 	cfg stipconfig.Config
+	db  db.STIPDBReader
 }
 
 // NewHandler creates a new REST API handler.
-func NewHandler(cfg stipconfig.Config) *Handler {
+func NewHandler(cfg stipconfig.Config, store db.STIPDBReader) *Handler {
 	return &Handler{
 		cfg: cfg,
+		db:  store,
 	}
 }
 
@@ -68,17 +77,21 @@ func (h *Handler) GetFeeAndRebateInfo(c *gin.Context) {
 const (
 	getHealthRoute      = "/health"
 	getFeeAndRebateInfo = "/fee-rebate-bps"
+	getEpochRoute       = "/epochs/:epoch"
+	getClaimsRoute      = "/claims/:address"
 )
 
 // Run runs the rest api server.
 func (r *Server) Run(ctx context.Context) error {
 	// TODO: Use Gin Helper
 	engine := ginhelper.New(logger)
-	h := NewHandler(r.cfg)
+	h := NewHandler(r.cfg, r.db)
 
 	// Assign GET routes
 	engine.GET(getHealthRoute, h.GetHealth)
 	engine.GET(getFeeAndRebateInfo, h.GetFeeAndRebateInfo)
+	engine.GET(getEpochRoute, h.GetEpoch)
+	engine.GET(getClaimsRoute, h.GetClaims)
 
 	r.engine = engine
 
@@ -5,6 +5,7 @@
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/jftuga/ellipsis"
@@ -53,8 +54,40 @@ type Config struct {
 	StipAPIPort      string                 `yaml:"stip_api_port"`
 	ARBMaxTransfer   int64                  `yaml:"ARB_max_transfer"`
 	ArbCapPerAddress int64                  `yaml:"arb_cap_per_address"`
+	// RebateSource is the source eligible transactions are read from, either "dune" or "explorer". Defaults to dune.
+	RebateSource string `yaml:"rebate_source"`
+	// SourceInterval is how often the rebate source is polled. Defaults to DuneInterval.
+	SourceInterval time.Duration `yaml:"source_interval"`
+	// ExplorerURL is the url of the explorer graphql api, used by the explorer rebate source.
+	ExplorerURL string `yaml:"explorer_url"`
+	// PriceAPIURL is the url of the defillama coins api used to price ARB for the explorer rebate source.
+	PriceAPIURL string `yaml:"price_api_url"`
+	// RebateMode is how rebates are paid out, either "transfer" or "merkle". Defaults to transfer.
+	RebateMode string `yaml:"rebate_mode"`
+	// EpochDuration is the length of each merkle epoch, starting from StartDate.
+	EpochDuration time.Duration `yaml:"epoch_duration"`
+	// DistributorAddress is the address of the merkle distributor each epoch's root is published to.
+	DistributorAddress string `yaml:"distributor_address"`
+	// EpochExportDir is the directory each epoch is exported to as json. Epochs aren't exported if unset.
+	EpochExportDir string `yaml:"epoch_export_dir"`
 }
 
+const (
+	// TransferRebateMode pays each rebate out as an ARB transfer.
+	TransferRebateMode = "transfer"
+	// MerkleRebateMode aggregates rebates into epochs that are claimed from a merkle distributor.
+	MerkleRebateMode = "merkle"
+)
+
+const (
+	// DuneRebateSource reads eligible transactions from Dune queries.
+	DuneRebateSource = "dune"
+	// ExplorerRebateSource reads eligible transactions from the explorer's bridgeTransactions.
+	ExplorerRebateSource = "explorer"
+)
+
+const defaultPriceAPIURL = "https://coins.llama.fi"
+
 const defaultArbCapPerAddress = 2000
 
 // GetArbCapPerAddress returns the configured arb cap per address, in human-readable units.
@@ -65,6 +98,38 @@ func (c Config) GetArbCapPerAddress() int64 {
 	return c.ArbCapPerAddress
 }
 
+// GetRebateSource returns the configured rebate source.
+func (c Config) GetRebateSource() string {
+	if c.RebateSource == "" {
+		return DuneRebateSource
+	}
+	return strings.ToLower(c.RebateSource)
+}
+
+// GetRebateMode returns the configured rebate mode.
+func (c Config) GetRebateMode() string {
+	if c.RebateMode == "" {
+		return TransferRebateMode
+	}
+	return strings.ToLower(c.RebateMode)
+}
+
+// GetSourceInterval returns how often the rebate source is polled.
+func (c Config) GetSourceInterval() time.Duration {
+	if c.SourceInterval == 0 {
+		return c.DuneInterval
+	}
+	return c.SourceInterval
+}
+
+// GetPriceAPIURL returns the configured price api url.
+func (c Config) GetPriceAPIURL() string {
+	if c.PriceAPIURL == "" {
+		return defaultPriceAPIURL
+	}
+	return strings.TrimSuffix(c.PriceAPIURL, "/")
+}
+
 // LoadConfig loads the config from the given path.
 func LoadConfig(path string) (config Config, err error) {
 	input, err := os.ReadFile(filepath.Clean(path))